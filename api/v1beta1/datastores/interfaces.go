@@ -65,9 +65,11 @@ type ContainerFilterOption func(*ContainerFilter)
 
 // ContainerFilter holds the internal filter criteria
 type ContainerFilter struct {
-	Name    *string
-	Image   *string
-	Runtime *string
+	Name       *string
+	Image      *string
+	Runtime    *string
+	Labels     map[string]string
+	Privileged *bool
 }
 
 // WithName filters containers by name (exact match)
@@ -91,6 +93,24 @@ func WithRuntime(runtime string) ContainerFilterOption {
 	}
 }
 
+// WithLabel filters containers by label value (exact match)
+// Multiple labels must all match
+func WithLabel(key, value string) ContainerFilterOption {
+	return func(f *ContainerFilter) {
+		if f.Labels == nil {
+			f.Labels = make(map[string]string)
+		}
+		f.Labels[key] = value
+	}
+}
+
+// WithPrivileged filters containers by whether they run privileged
+func WithPrivileged(privileged bool) ContainerFilterOption {
+	return func(f *ContainerFilter) {
+		f.Privileged = &privileged
+	}
+}
+
 // ContainerStore provides access to container information
 type ContainerStore interface {
 	DataStore
@@ -108,6 +128,7 @@ type ContainerStore interface {
 	//   containers, err := store.ListContainers()                                 // All containers
 	//   containers, err := store.ListContainers(WithName("nginx"))                // Filter by name
 	//   containers, err := store.ListContainers(WithImage("nginx:latest"), WithRuntime("docker"))
+	//   containers, err := store.ListContainers(WithLabel("app", "web"), WithPrivileged(true))
 	// Returns empty slice if no containers match the filter
 	// This is useful for discovery at startup or periodic inventory
 	ListContainers(opts ...ContainerFilterOption) ([]*ContainerInfo, error)
@@ -167,6 +188,16 @@ type SyscallStore interface {
 	GetSyscallID(name string) (int32, error)
 }
 
+// IPReputationStore provides access to IP reputation data (threat intelligence feeds)
+type IPReputationStore interface {
+	DataStore
+
+	// GetIPReputation retrieves the reputation of an IP address
+	// Exact IP entries take precedence over CIDR ranges containing the address
+	// Returns ErrNotFound if no reputation data is found
+	GetIPReputation(ip string) (*IPReputationInfo, error)
+}
+
 // WritableStore interface for datastores that support external data ingestion
 // Stores implementing this interface can be written to by detectors, extensions,
 // or external clients via gRPC.
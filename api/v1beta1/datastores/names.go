@@ -2,10 +2,11 @@ package datastores
 
 // Datastore names
 const (
-	Process   = "process"
-	Container = "container"
-	Symbol    = "symbol"
-	DNS       = "dns"
-	System    = "system"
-	Syscall   = "syscall"
+	Process    = "process"
+	Container  = "container"
+	Symbol     = "symbol"
+	DNS        = "dns"
+	System     = "system"
+	Syscall    = "syscall"
+	Reputation = "ip_reputation"
 )
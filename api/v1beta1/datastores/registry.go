@@ -43,6 +43,10 @@ type Registry interface {
 	// Never returns nil - check IsAvailable("syscall") for availability
 	Syscalls() SyscallStore
 
+	// IPReputation returns the IP reputation datastore
+	// Never returns nil - check IsAvailable("ip_reputation") for availability
+	IPReputation() IPReputationStore
+
 	// GetCustom retrieves a custom datastore by name
 	// Returns ErrNotFound if the datastore is not registered
 	GetCustom(name string) (DataStore, error)
@@ -23,19 +23,38 @@ type ProcessInfo struct {
 	// User/Group
 	UID uint32 // User ID - matches protobuf real_user.id
 	GID uint32 // Group ID
-	// Phase 2: CmdLine/Args (memory impact)
+
+	// Command line of the last exec, capped in size (shared, must not be modified)
+	CmdLine []string // Arguments, argv[0] included: ["python", "app.py"]
+	Env     []string // Allowed environment variables: ["LD_PRELOAD=/tmp/x.so"]
 }
 
 // ContainerInfo contains information about a container
 type ContainerInfo struct {
-	ID          string      // Container ID
-	Name        string      // Container name
-	Image       string      // Container image
-	ImageDigest string      // Image digest
-	Runtime     string      // Runtime (docker, containerd, crio)
-	StartTime   time.Time   // Container start time
-	Pod         *K8sPodInfo // Kubernetes pod info (nil for non-K8s containers)
-	// Phase 2: Labels (requires runtime extraction), Status, PID, Env, Mounts
+	ID                string            // Container ID
+	Name              string            // Container name
+	Image             string            // Container image
+	ImageDigest       string            // Image digest
+	Runtime           string            // Runtime (docker, containerd, crio)
+	StartTime         time.Time         // Container start time
+	Pod               *K8sPodInfo       // Kubernetes pod info (nil for non-K8s containers)
+	Labels            map[string]string // Container labels (shared, must not be modified)
+	Annotations       map[string]string // Kubernetes and runtime annotations (shared, must not be modified)
+	Privileged        bool              // Whether the container runs privileged
+	AddedCapabilities []string          // Capabilities added to the runtime default set (e.g., CAP_SYS_ADMIN)
+	HostNetwork       bool              // Whether the container shares the host network namespace
+	HostPID           bool              // Whether the container shares the host PID namespace
+	HostIPC           bool              // Whether the container shares the host IPC namespace
+	Mounts            []MountInfo       // Host paths and volumes mounted into the container
+	// Phase 2: Status, PID, Env
+}
+
+// MountInfo describes a mount of a container
+type MountInfo struct {
+	Type        string // Mount type (bind, volume, tmpfs)
+	Source      string // Host path or volume name
+	Destination string // Path inside the container
+	ReadOnly    bool   // Whether the mount is read-only
 }
 
 // K8sPodInfo contains Kubernetes pod metadata
@@ -60,6 +79,18 @@ type DNSResponse struct {
 	Domains []string // Resolved domain names (for reverse lookups)
 }
 
+// IPReputationInfo contains reputation information about an IP address
+type IPReputationInfo struct {
+	IP          string            // Looked up IP address
+	Match       string            // Matching entry: the IP itself or a CIDR range containing it
+	Status      string            // Reputation status: allow, deny, suspicious or unknown
+	Severity    int               // Severity score (1-10)
+	Source      string            // Source (feed) that provided the reputation
+	Tags        []string          // Threat tags (e.g. "botnet", "tor")
+	LastUpdated time.Time         // Time the reputation was last updated
+	Metadata    map[string]string // Additional source specific information
+}
+
 // HealthStatus represents the health state of a datastore
 type HealthStatus int
 
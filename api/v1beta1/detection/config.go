@@ -3,6 +3,7 @@ package detection
 import (
 	"fmt"
 	"strconv"
+	"strings"
 )
 
 // DetectorConfig provides type-safe access to detector-specific configuration.
@@ -20,6 +21,9 @@ type DetectorConfig interface {
 	// GetFloat64 retrieves a float64 configuration value
 	GetFloat64(key string, defaultValue float64) float64
 
+	// GetStringSlice retrieves a list of strings configuration value
+	GetStringSlice(key string, defaultValue []string) []string
+
 	// Has checks if a configuration key exists
 	Has(key string) bool
 }
@@ -163,6 +167,37 @@ func (c *detectorConfig) GetFloat64(key string, defaultValue float64) float64 {
 	return defaultValue
 }
 
+// GetStringSlice retrieves a list of strings with type conversion.
+// Comma-separated strings (as given on the command line) are split into their elements.
+func (c *detectorConfig) GetStringSlice(key string, defaultValue []string) []string {
+	val, ok := c.data[key]
+	if !ok {
+		return defaultValue
+	}
+
+	switch v := val.(type) {
+	case []string:
+		return v
+	case []any:
+		result := make([]string, 0, len(v))
+		for _, item := range v {
+			result = append(result, fmt.Sprintf("%v", item))
+		}
+		return result
+	case string:
+		if v == "" {
+			return []string{}
+		}
+		parts := strings.Split(v, ",")
+		for i := range parts {
+			parts[i] = strings.TrimSpace(parts[i])
+		}
+		return parts
+	}
+
+	return defaultValue
+}
+
 // Has checks if a configuration key exists
 func (c *detectorConfig) Has(key string) bool {
 	_, ok := c.data[key]
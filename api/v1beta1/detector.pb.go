@@ -0,0 +1,1011 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.2
+// 	protoc        v5.29.0
+// source: api/v1beta1/detector.proto
+
+package v1beta1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ListDetectorsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// IDs of the detectors to list, all detectors if empty
+	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+}
+
+func (x *ListDetectorsRequest) Reset() {
+	*x = ListDetectorsRequest{}
+	mi := &file_api_v1beta1_detector_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDetectorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDetectorsRequest) ProtoMessage() {}
+
+func (x *ListDetectorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1beta1_detector_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDetectorsRequest.ProtoReflect.Descriptor instead.
+func (*ListDetectorsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1beta1_detector_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListDetectorsRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type ListDetectorsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Detectors []*Detector `protobuf:"bytes,1,rep,name=detectors,proto3" json:"detectors,omitempty"`
+}
+
+func (x *ListDetectorsResponse) Reset() {
+	*x = ListDetectorsResponse{}
+	mi := &file_api_v1beta1_detector_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDetectorsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDetectorsResponse) ProtoMessage() {}
+
+func (x *ListDetectorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1beta1_detector_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDetectorsResponse.ProtoReflect.Descriptor instead.
+func (*ListDetectorsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1beta1_detector_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListDetectorsResponse) GetDetectors() []*Detector {
+	if x != nil {
+		return x.Detectors
+	}
+	return nil
+}
+
+type EnableDetectorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *EnableDetectorRequest) Reset() {
+	*x = EnableDetectorRequest{}
+	mi := &file_api_v1beta1_detector_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnableDetectorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnableDetectorRequest) ProtoMessage() {}
+
+func (x *EnableDetectorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1beta1_detector_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnableDetectorRequest.ProtoReflect.Descriptor instead.
+func (*EnableDetectorRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1beta1_detector_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EnableDetectorRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type EnableDetectorResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Detector *Detector `protobuf:"bytes,1,opt,name=detector,proto3" json:"detector,omitempty"`
+}
+
+func (x *EnableDetectorResponse) Reset() {
+	*x = EnableDetectorResponse{}
+	mi := &file_api_v1beta1_detector_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnableDetectorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnableDetectorResponse) ProtoMessage() {}
+
+func (x *EnableDetectorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1beta1_detector_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnableDetectorResponse.ProtoReflect.Descriptor instead.
+func (*EnableDetectorResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1beta1_detector_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *EnableDetectorResponse) GetDetector() *Detector {
+	if x != nil {
+		return x.Detector
+	}
+	return nil
+}
+
+type DisableDetectorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DisableDetectorRequest) Reset() {
+	*x = DisableDetectorRequest{}
+	mi := &file_api_v1beta1_detector_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisableDetectorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableDetectorRequest) ProtoMessage() {}
+
+func (x *DisableDetectorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1beta1_detector_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableDetectorRequest.ProtoReflect.Descriptor instead.
+func (*DisableDetectorRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1beta1_detector_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DisableDetectorRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DisableDetectorResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Detector *Detector `protobuf:"bytes,1,opt,name=detector,proto3" json:"detector,omitempty"`
+}
+
+func (x *DisableDetectorResponse) Reset() {
+	*x = DisableDetectorResponse{}
+	mi := &file_api_v1beta1_detector_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisableDetectorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableDetectorResponse) ProtoMessage() {}
+
+func (x *DisableDetectorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1beta1_detector_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableDetectorResponse.ProtoReflect.Descriptor instead.
+func (*DisableDetectorResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1beta1_detector_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DisableDetectorResponse) GetDetector() *Detector {
+	if x != nil {
+		return x.Detector
+	}
+	return nil
+}
+
+type LoadDetectorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// YAML detector document
+	Detector string `protobuf:"bytes,1,opt,name=detector,proto3" json:"detector,omitempty"`
+}
+
+func (x *LoadDetectorRequest) Reset() {
+	*x = LoadDetectorRequest{}
+	mi := &file_api_v1beta1_detector_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoadDetectorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoadDetectorRequest) ProtoMessage() {}
+
+func (x *LoadDetectorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1beta1_detector_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoadDetectorRequest.ProtoReflect.Descriptor instead.
+func (*LoadDetectorRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1beta1_detector_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *LoadDetectorRequest) GetDetector() string {
+	if x != nil {
+		return x.Detector
+	}
+	return ""
+}
+
+type LoadDetectorResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Detector *Detector `protobuf:"bytes,1,opt,name=detector,proto3" json:"detector,omitempty"`
+}
+
+func (x *LoadDetectorResponse) Reset() {
+	*x = LoadDetectorResponse{}
+	mi := &file_api_v1beta1_detector_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoadDetectorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoadDetectorResponse) ProtoMessage() {}
+
+func (x *LoadDetectorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1beta1_detector_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoadDetectorResponse.ProtoReflect.Descriptor instead.
+func (*LoadDetectorResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1beta1_detector_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *LoadDetectorResponse) GetDetector() *Detector {
+	if x != nil {
+		return x.Detector
+	}
+	return nil
+}
+
+// Detector describes a detector registered in tracee
+type Detector struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Enabled detectors are initialized and receive the events they require
+	Enabled bool `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Selected detectors produce an event selected by a policy
+	Selected      bool                  `protobuf:"varint,3,opt,name=selected,proto3" json:"selected,omitempty"`
+	ProducedEvent *EventDefinition      `protobuf:"bytes,4,opt,name=produced_event,json=producedEvent,proto3" json:"produced_event,omitempty"`
+	Threat        *Threat               `protobuf:"bytes,5,opt,name=threat,proto3" json:"threat,omitempty"`
+	Requirements  *DetectorRequirements `protobuf:"bytes,6,opt,name=requirements,proto3" json:"requirements,omitempty"`
+	Health        *DetectorHealth       `protobuf:"bytes,7,opt,name=health,proto3" json:"health,omitempty"`
+}
+
+func (x *Detector) Reset() {
+	*x = Detector{}
+	mi := &file_api_v1beta1_detector_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Detector) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Detector) ProtoMessage() {}
+
+func (x *Detector) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1beta1_detector_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Detector.ProtoReflect.Descriptor instead.
+func (*Detector) Descriptor() ([]byte, []int) {
+	return file_api_v1beta1_detector_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Detector) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Detector) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Detector) GetSelected() bool {
+	if x != nil {
+		return x.Selected
+	}
+	return false
+}
+
+func (x *Detector) GetProducedEvent() *EventDefinition {
+	if x != nil {
+		return x.ProducedEvent
+	}
+	return nil
+}
+
+func (x *Detector) GetThreat() *Threat {
+	if x != nil {
+		return x.Threat
+	}
+	return nil
+}
+
+func (x *Detector) GetRequirements() *DetectorRequirements {
+	if x != nil {
+		return x.Requirements
+	}
+	return nil
+}
+
+func (x *Detector) GetHealth() *DetectorHealth {
+	if x != nil {
+		return x.Health
+	}
+	return nil
+}
+
+type DetectorRequirements struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Events      []*DetectorEventRequirement `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	DataStores  []*DetectorRequirement      `protobuf:"bytes,2,rep,name=data_stores,json=dataStores,proto3" json:"data_stores,omitempty"`
+	Enrichments []*DetectorRequirement      `protobuf:"bytes,3,rep,name=enrichments,proto3" json:"enrichments,omitempty"`
+	// Supported architectures, all if empty
+	Architectures    []string `protobuf:"bytes,4,rep,name=architectures,proto3" json:"architectures,omitempty"`
+	MinTraceeVersion *Version `protobuf:"bytes,5,opt,name=min_tracee_version,json=minTraceeVersion,proto3" json:"min_tracee_version,omitempty"`
+	MaxTraceeVersion *Version `protobuf:"bytes,6,opt,name=max_tracee_version,json=maxTraceeVersion,proto3" json:"max_tracee_version,omitempty"`
+}
+
+func (x *DetectorRequirements) Reset() {
+	*x = DetectorRequirements{}
+	mi := &file_api_v1beta1_detector_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetectorRequirements) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetectorRequirements) ProtoMessage() {}
+
+func (x *DetectorRequirements) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1beta1_detector_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetectorRequirements.ProtoReflect.Descriptor instead.
+func (*DetectorRequirements) Descriptor() ([]byte, []int) {
+	return file_api_v1beta1_detector_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DetectorRequirements) GetEvents() []*DetectorEventRequirement {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *DetectorRequirements) GetDataStores() []*DetectorRequirement {
+	if x != nil {
+		return x.DataStores
+	}
+	return nil
+}
+
+func (x *DetectorRequirements) GetEnrichments() []*DetectorRequirement {
+	if x != nil {
+		return x.Enrichments
+	}
+	return nil
+}
+
+func (x *DetectorRequirements) GetArchitectures() []string {
+	if x != nil {
+		return x.Architectures
+	}
+	return nil
+}
+
+func (x *DetectorRequirements) GetMinTraceeVersion() *Version {
+	if x != nil {
+		return x.MinTraceeVersion
+	}
+	return nil
+}
+
+func (x *DetectorRequirements) GetMaxTraceeVersion() *Version {
+	if x != nil {
+		return x.MaxTraceeVersion
+	}
+	return nil
+}
+
+type DetectorEventRequirement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name         string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Optional     bool     `protobuf:"varint,2,opt,name=optional,proto3" json:"optional,omitempty"`
+	MinVersion   *Version `protobuf:"bytes,3,opt,name=min_version,json=minVersion,proto3" json:"min_version,omitempty"`
+	MaxVersion   *Version `protobuf:"bytes,4,opt,name=max_version,json=maxVersion,proto3" json:"max_version,omitempty"`
+	DataFilters  []string `protobuf:"bytes,5,rep,name=data_filters,json=dataFilters,proto3" json:"data_filters,omitempty"`
+	ScopeFilters []string `protobuf:"bytes,6,rep,name=scope_filters,json=scopeFilters,proto3" json:"scope_filters,omitempty"`
+}
+
+func (x *DetectorEventRequirement) Reset() {
+	*x = DetectorEventRequirement{}
+	mi := &file_api_v1beta1_detector_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetectorEventRequirement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetectorEventRequirement) ProtoMessage() {}
+
+func (x *DetectorEventRequirement) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1beta1_detector_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetectorEventRequirement.ProtoReflect.Descriptor instead.
+func (*DetectorEventRequirement) Descriptor() ([]byte, []int) {
+	return file_api_v1beta1_detector_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DetectorEventRequirement) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DetectorEventRequirement) GetOptional() bool {
+	if x != nil {
+		return x.Optional
+	}
+	return false
+}
+
+func (x *DetectorEventRequirement) GetMinVersion() *Version {
+	if x != nil {
+		return x.MinVersion
+	}
+	return nil
+}
+
+func (x *DetectorEventRequirement) GetMaxVersion() *Version {
+	if x != nil {
+		return x.MaxVersion
+	}
+	return nil
+}
+
+func (x *DetectorEventRequirement) GetDataFilters() []string {
+	if x != nil {
+		return x.DataFilters
+	}
+	return nil
+}
+
+func (x *DetectorEventRequirement) GetScopeFilters() []string {
+	if x != nil {
+		return x.ScopeFilters
+	}
+	return nil
+}
+
+type DetectorRequirement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Optional bool   `protobuf:"varint,2,opt,name=optional,proto3" json:"optional,omitempty"`
+}
+
+func (x *DetectorRequirement) Reset() {
+	*x = DetectorRequirement{}
+	mi := &file_api_v1beta1_detector_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetectorRequirement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetectorRequirement) ProtoMessage() {}
+
+func (x *DetectorRequirement) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1beta1_detector_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetectorRequirement.ProtoReflect.Descriptor instead.
+func (*DetectorRequirement) Descriptor() ([]byte, []int) {
+	return file_api_v1beta1_detector_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DetectorRequirement) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DetectorRequirement) GetOptional() bool {
+	if x != nil {
+		return x.Optional
+	}
+	return false
+}
+
+type DetectorHealth struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EventsProcessed uint64 `protobuf:"varint,1,opt,name=events_processed,json=eventsProcessed,proto3" json:"events_processed,omitempty"`
+	EventsProduced  uint64 `protobuf:"varint,2,opt,name=events_produced,json=eventsProduced,proto3" json:"events_produced,omitempty"`
+	Errors          uint64 `protobuf:"varint,3,opt,name=errors,proto3" json:"errors,omitempty"`
+	// Time spent processing events, in seconds
+	ExecutionSeconds float64 `protobuf:"fixed64,4,opt,name=execution_seconds,json=executionSeconds,proto3" json:"execution_seconds,omitempty"`
+}
+
+func (x *DetectorHealth) Reset() {
+	*x = DetectorHealth{}
+	mi := &file_api_v1beta1_detector_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetectorHealth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetectorHealth) ProtoMessage() {}
+
+func (x *DetectorHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1beta1_detector_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetectorHealth.ProtoReflect.Descriptor instead.
+func (*DetectorHealth) Descriptor() ([]byte, []int) {
+	return file_api_v1beta1_detector_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DetectorHealth) GetEventsProcessed() uint64 {
+	if x != nil {
+		return x.EventsProcessed
+	}
+	return 0
+}
+
+func (x *DetectorHealth) GetEventsProduced() uint64 {
+	if x != nil {
+		return x.EventsProduced
+	}
+	return 0
+}
+
+func (x *DetectorHealth) GetErrors() uint64 {
+	if x != nil {
+		return x.Errors
+	}
+	return 0
+}
+
+func (x *DetectorHealth) GetExecutionSeconds() float64 {
+	if x != nil {
+		return x.ExecutionSeconds
+	}
+	return 0
+}
+
+var File_api_v1beta1_detector_proto protoreflect.FileDescriptor
+
+var file_api_v1beta1_detector_proto_rawDesc = []byte{
+	0x0a, 0x1a, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x64, 0x65,
+	0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x74, 0x72,
+	0x61, 0x63, 0x65, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x1a, 0x1c, 0x61, 0x70,
+	0x69, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x74, 0x68, 0x72, 0x65, 0x61, 0x74, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x28, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x74, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03,
+	0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0x4f,
+	0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x09, 0x64, 0x65, 0x74, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x72, 0x61,
+	0x63, 0x65, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x74, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x52, 0x09, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x22,
+	0x27, 0x0a, 0x15, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x4e, 0x0a, 0x16, 0x45, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x34, 0x0a, 0x08, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x65, 0x65, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x08,
+	0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x22, 0x28, 0x0a, 0x16, 0x44, 0x69, 0x73, 0x61,
+	0x62, 0x6c, 0x65, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0x4f, 0x0a, 0x17, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x74,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a,
+	0x08, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x65, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x08, 0x64, 0x65, 0x74, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x22, 0x31, 0x0a, 0x13, 0x4c, 0x6f, 0x61, 0x64, 0x44, 0x65, 0x74, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65,
+	0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65,
+	0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x22, 0x4c, 0x0a, 0x14, 0x4c, 0x6f, 0x61, 0x64, 0x44, 0x65,
+	0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34,
+	0x0a, 0x08, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x65, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x08, 0x64, 0x65, 0x74, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x22, 0xca, 0x02, 0x0a, 0x08, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x73,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x46, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x65, 0x64, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x65, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0d, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
+	0x2e, 0x0a, 0x06, 0x74, 0x68, 0x72, 0x65, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x65, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x54, 0x68, 0x72, 0x65, 0x61, 0x74, 0x52, 0x06, 0x74, 0x68, 0x72, 0x65, 0x61, 0x74, 0x12,
+	0x48, 0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x65, 0x65, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x0c, 0x72, 0x65, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x36, 0x0a, 0x06, 0x68, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x74, 0x72, 0x61, 0x63,
+	0x65, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x74, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x22, 0x99, 0x03, 0x0a, 0x14, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x40, 0x0a, 0x06, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x74, 0x72, 0x61,
+	0x63, 0x65, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x74, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x44, 0x0a, 0x0b,
+	0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x23, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x65, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x69,
+	0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x53, 0x74, 0x6f, 0x72,
+	0x65, 0x73, 0x12, 0x45, 0x0a, 0x0b, 0x65, 0x6e, 0x72, 0x69, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x65, 0x65,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x65, 0x6e,
+	0x72, 0x69, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x61, 0x72, 0x63,
+	0x68, 0x69, 0x74, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0d, 0x61, 0x72, 0x63, 0x68, 0x69, 0x74, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12,
+	0x45, 0x0a, 0x12, 0x6d, 0x69, 0x6e, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x65, 0x65, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x72,
+	0x61, 0x63, 0x65, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x6d, 0x69, 0x6e, 0x54, 0x72, 0x61, 0x63, 0x65, 0x65, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x45, 0x0a, 0x12, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x72,
+	0x61, 0x63, 0x65, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x65, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x6d, 0x61, 0x78,
+	0x54, 0x72, 0x61, 0x63, 0x65, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x86, 0x02,
+	0x0a, 0x18, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x12, 0x38, 0x0a, 0x0b, 0x6d, 0x69,
+	0x6e, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x65, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x6d, 0x69, 0x6e, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x72, 0x61, 0x63,
+	0x65, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x21,
+	0x0a, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x73, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x22, 0x45, 0x0a, 0x13, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x22, 0xa9, 0x01,
+	0x0a, 0x0e, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x12, 0x29, 0x0a, 0x10, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x50, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x2b, 0x0a, 0x11,
+	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x32, 0x8f, 0x03, 0x0a, 0x0f, 0x44, 0x65,
+	0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5c, 0x0a,
+	0x0d, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x24,
+	0x2e, 0x74, 0x72, 0x61, 0x63, 0x65, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x65, 0x65, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x0e, 0x45,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x25, 0x2e,
+	0x74, 0x72, 0x61, 0x63, 0x65, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x45,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x65, 0x65, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x74, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x0f,
+	0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12,
+	0x26, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x65, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x65, 0x65,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65,
+	0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x59, 0x0a, 0x0c, 0x4c, 0x6f, 0x61, 0x64, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x12, 0x23, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x65, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x65, 0x65, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x44, 0x65, 0x74, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x2b, 0x5a, 0x29, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x2f, 0x61, 0x71, 0x75, 0x61, 0x73, 0x65, 0x63,
+	0x75, 0x72, 0x69, 0x74, 0x79, 0x2f, 0x74, 0x72, 0x61, 0x63, 0x65, 0x65, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_api_v1beta1_detector_proto_rawDescOnce sync.Once
+	file_api_v1beta1_detector_proto_rawDescData = file_api_v1beta1_detector_proto_rawDesc
+)
+
+func file_api_v1beta1_detector_proto_rawDescGZIP() []byte {
+	file_api_v1beta1_detector_proto_rawDescOnce.Do(func() {
+		file_api_v1beta1_detector_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_v1beta1_detector_proto_rawDescData)
+	})
+	return file_api_v1beta1_detector_proto_rawDescData
+}
+
+var file_api_v1beta1_detector_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_api_v1beta1_detector_proto_goTypes = []any{
+	(*ListDetectorsRequest)(nil),     // 0: tracee.v1beta1.ListDetectorsRequest
+	(*ListDetectorsResponse)(nil),    // 1: tracee.v1beta1.ListDetectorsResponse
+	(*EnableDetectorRequest)(nil),    // 2: tracee.v1beta1.EnableDetectorRequest
+	(*EnableDetectorResponse)(nil),   // 3: tracee.v1beta1.EnableDetectorResponse
+	(*DisableDetectorRequest)(nil),   // 4: tracee.v1beta1.DisableDetectorRequest
+	(*DisableDetectorResponse)(nil),  // 5: tracee.v1beta1.DisableDetectorResponse
+	(*LoadDetectorRequest)(nil),      // 6: tracee.v1beta1.LoadDetectorRequest
+	(*LoadDetectorResponse)(nil),     // 7: tracee.v1beta1.LoadDetectorResponse
+	(*Detector)(nil),                 // 8: tracee.v1beta1.Detector
+	(*DetectorRequirements)(nil),     // 9: tracee.v1beta1.DetectorRequirements
+	(*DetectorEventRequirement)(nil), // 10: tracee.v1beta1.DetectorEventRequirement
+	(*DetectorRequirement)(nil),      // 11: tracee.v1beta1.DetectorRequirement
+	(*DetectorHealth)(nil),           // 12: tracee.v1beta1.DetectorHealth
+	(*EventDefinition)(nil),          // 13: tracee.v1beta1.EventDefinition
+	(*Threat)(nil),                   // 14: tracee.v1beta1.Threat
+	(*Version)(nil),                  // 15: tracee.v1beta1.Version
+}
+var file_api_v1beta1_detector_proto_depIdxs = []int32{
+	8,  // 0: tracee.v1beta1.ListDetectorsResponse.detectors:type_name -> tracee.v1beta1.Detector
+	8,  // 1: tracee.v1beta1.EnableDetectorResponse.detector:type_name -> tracee.v1beta1.Detector
+	8,  // 2: tracee.v1beta1.DisableDetectorResponse.detector:type_name -> tracee.v1beta1.Detector
+	8,  // 3: tracee.v1beta1.LoadDetectorResponse.detector:type_name -> tracee.v1beta1.Detector
+	13, // 4: tracee.v1beta1.Detector.produced_event:type_name -> tracee.v1beta1.EventDefinition
+	14, // 5: tracee.v1beta1.Detector.threat:type_name -> tracee.v1beta1.Threat
+	9,  // 6: tracee.v1beta1.Detector.requirements:type_name -> tracee.v1beta1.DetectorRequirements
+	12, // 7: tracee.v1beta1.Detector.health:type_name -> tracee.v1beta1.DetectorHealth
+	10, // 8: tracee.v1beta1.DetectorRequirements.events:type_name -> tracee.v1beta1.DetectorEventRequirement
+	11, // 9: tracee.v1beta1.DetectorRequirements.data_stores:type_name -> tracee.v1beta1.DetectorRequirement
+	11, // 10: tracee.v1beta1.DetectorRequirements.enrichments:type_name -> tracee.v1beta1.DetectorRequirement
+	15, // 11: tracee.v1beta1.DetectorRequirements.min_tracee_version:type_name -> tracee.v1beta1.Version
+	15, // 12: tracee.v1beta1.DetectorRequirements.max_tracee_version:type_name -> tracee.v1beta1.Version
+	15, // 13: tracee.v1beta1.DetectorEventRequirement.min_version:type_name -> tracee.v1beta1.Version
+	15, // 14: tracee.v1beta1.DetectorEventRequirement.max_version:type_name -> tracee.v1beta1.Version
+	0,  // 15: tracee.v1beta1.DetectorService.ListDetectors:input_type -> tracee.v1beta1.ListDetectorsRequest
+	2,  // 16: tracee.v1beta1.DetectorService.EnableDetector:input_type -> tracee.v1beta1.EnableDetectorRequest
+	4,  // 17: tracee.v1beta1.DetectorService.DisableDetector:input_type -> tracee.v1beta1.DisableDetectorRequest
+	6,  // 18: tracee.v1beta1.DetectorService.LoadDetector:input_type -> tracee.v1beta1.LoadDetectorRequest
+	1,  // 19: tracee.v1beta1.DetectorService.ListDetectors:output_type -> tracee.v1beta1.ListDetectorsResponse
+	3,  // 20: tracee.v1beta1.DetectorService.EnableDetector:output_type -> tracee.v1beta1.EnableDetectorResponse
+	5,  // 21: tracee.v1beta1.DetectorService.DisableDetector:output_type -> tracee.v1beta1.DisableDetectorResponse
+	7,  // 22: tracee.v1beta1.DetectorService.LoadDetector:output_type -> tracee.v1beta1.LoadDetectorResponse
+	19, // [19:23] is the sub-list for method output_type
+	15, // [15:19] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
+}
+
+func init() { file_api_v1beta1_detector_proto_init() }
+func file_api_v1beta1_detector_proto_init() {
+	if File_api_v1beta1_detector_proto != nil {
+		return
+	}
+	file_api_v1beta1_definition_proto_init()
+	file_api_v1beta1_threat_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_api_v1beta1_detector_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_v1beta1_detector_proto_goTypes,
+		DependencyIndexes: file_api_v1beta1_detector_proto_depIdxs,
+		MessageInfos:      file_api_v1beta1_detector_proto_msgTypes,
+	}.Build()
+	File_api_v1beta1_detector_proto = out.File
+	file_api_v1beta1_detector_proto_rawDesc = nil
+	file_api_v1beta1_detector_proto_goTypes = nil
+	file_api_v1beta1_detector_proto_depIdxs = nil
+}
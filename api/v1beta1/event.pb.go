@@ -569,6 +569,8 @@ const (
 	EventId_chmod_common                    EventId = 1091
 	EventId_security_sb_umount              EventId = 1092
 	EventId_security_task_prctl             EventId = 1093
+	EventId_net_packet_tls_base             EventId = 1094
+	EventId_net_packet_flow_connless_base   EventId = 1095
 	// Events originated from user-space
 	EventId_net_packet_ipv4          EventId = 2000
 	EventId_net_packet_ipv6          EventId = 2001
@@ -585,18 +587,25 @@ const (
 	EventId_net_flow_tcp_begin       EventId = 2012
 	EventId_net_flow_tcp_end         EventId = 2013
 	// max_user_net_id = 2014; // Reserved: MaxUserNetID marker (consumes ID slot)
-	EventId_net_tcp_connect      EventId = 2015
-	EventId_init_namespaces      EventId = 2016
-	EventId_container_create     EventId = 2017
-	EventId_container_remove     EventId = 2018
-	EventId_existing_container   EventId = 2019
-	EventId_hooked_syscall       EventId = 2020
-	EventId_hooked_seq_ops       EventId = 2021
-	EventId_symbols_loaded       EventId = 2022
-	EventId_symbols_collision    EventId = 2023
-	EventId_hidden_kernel_module EventId = 2024
-	EventId_ftrace_hook          EventId = 2025
-	EventId_tracee_info          EventId = 2026
+	EventId_net_tcp_connect             EventId = 2015
+	EventId_init_namespaces             EventId = 2016
+	EventId_container_create            EventId = 2017
+	EventId_container_remove            EventId = 2018
+	EventId_existing_container          EventId = 2019
+	EventId_hooked_syscall              EventId = 2020
+	EventId_hooked_seq_ops              EventId = 2021
+	EventId_symbols_loaded              EventId = 2022
+	EventId_symbols_collision           EventId = 2023
+	EventId_hidden_kernel_module        EventId = 2024
+	EventId_ftrace_hook                 EventId = 2025
+	EventId_tracee_info                 EventId = 2026
+	EventId_policy_action               EventId = 2027
+	EventId_net_packet_tls_client_hello EventId = 2028
+	EventId_net_packet_tls_server_hello EventId = 2029
+	EventId_net_flow_udp_begin          EventId = 2030
+	EventId_net_flow_udp_end            EventId = 2031
+	EventId_net_flow_icmp_begin         EventId = 2032
+	EventId_net_flow_icmp_end           EventId = 2033
 )
 
 // Enum value maps for EventId.
@@ -1142,6 +1151,8 @@ var (
 		1091: "chmod_common",
 		1092: "security_sb_umount",
 		1093: "security_task_prctl",
+		1094: "net_packet_tls_base",
+		1095: "net_packet_flow_connless_base",
 		2000: "net_packet_ipv4",
 		2001: "net_packet_ipv6",
 		2002: "net_packet_tcp",
@@ -1168,6 +1179,13 @@ var (
 		2024: "hidden_kernel_module",
 		2025: "ftrace_hook",
 		2026: "tracee_info",
+		2027: "policy_action",
+		2028: "net_packet_tls_client_hello",
+		2029: "net_packet_tls_server_hello",
+		2030: "net_flow_udp_begin",
+		2031: "net_flow_udp_end",
+		2032: "net_flow_icmp_begin",
+		2033: "net_flow_icmp_end",
 	}
 	EventId_value = map[string]int32{
 		"unspecified":                     0,
@@ -1710,6 +1728,8 @@ var (
 		"chmod_common":                    1091,
 		"security_sb_umount":              1092,
 		"security_task_prctl":             1093,
+		"net_packet_tls_base":             1094,
+		"net_packet_flow_connless_base":   1095,
 		"net_packet_ipv4":                 2000,
 		"net_packet_ipv6":                 2001,
 		"net_packet_tcp":                  2002,
@@ -1736,6 +1756,13 @@ var (
 		"hidden_kernel_module":            2024,
 		"ftrace_hook":                     2025,
 		"tracee_info":                     2026,
+		"policy_action":                   2027,
+		"net_packet_tls_client_hello":     2028,
+		"net_packet_tls_server_hello":     2029,
+		"net_flow_udp_begin":              2030,
+		"net_flow_udp_end":                2031,
+		"net_flow_icmp_begin":             2032,
+		"net_flow_icmp_end":               2033,
 	}
 )
 
@@ -1985,6 +2012,8 @@ type Process struct {
 	RealUser   *User                   `protobuf:"bytes,5,opt,name=real_user,json=realUser,proto3,oneof" json:"real_user,omitempty"`
 	Thread     *Thread                 `protobuf:"bytes,6,opt,name=thread,proto3,oneof" json:"thread,omitempty"`
 	Ancestors  []*Process              `protobuf:"bytes,7,rep,name=ancestors,proto3" json:"ancestors,omitempty"`
+	Cmdline    []string                `protobuf:"bytes,8,rep,name=cmdline,proto3" json:"cmdline,omitempty"`
+	Env        []string                `protobuf:"bytes,9,rep,name=env,proto3" json:"env,omitempty"`
 }
 
 func (x *Process) Reset() {
@@ -2066,6 +2095,20 @@ func (x *Process) GetAncestors() []*Process {
 	return nil
 }
 
+func (x *Process) GetCmdline() []string {
+	if x != nil {
+		return x.Cmdline
+	}
+	return nil
+}
+
+func (x *Process) GetEnv() []string {
+	if x != nil {
+		return x.Env
+	}
+	return nil
+}
+
 type Executable struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2360,10 +2403,16 @@ type Container struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id      string          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name    string          `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Image   *ContainerImage `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"`
-	Started bool            `protobuf:"varint,4,opt,name=started,proto3" json:"started,omitempty"`
+	Id                string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name              string            `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Image             *ContainerImage   `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"`
+	Started           bool              `protobuf:"varint,4,opt,name=started,proto3" json:"started,omitempty"`
+	Labels            map[string]string `protobuf:"bytes,5,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Privileged        bool              `protobuf:"varint,6,opt,name=privileged,proto3" json:"privileged,omitempty"`
+	AddedCapabilities []string          `protobuf:"bytes,7,rep,name=added_capabilities,json=addedCapabilities,proto3" json:"added_capabilities,omitempty"`
+	HostNetwork       bool              `protobuf:"varint,8,opt,name=host_network,json=hostNetwork,proto3" json:"host_network,omitempty"`
+	HostPid           bool              `protobuf:"varint,9,opt,name=host_pid,json=hostPid,proto3" json:"host_pid,omitempty"`
+	HostIpc           bool              `protobuf:"varint,10,opt,name=host_ipc,json=hostIpc,proto3" json:"host_ipc,omitempty"`
 }
 
 func (x *Container) Reset() {
@@ -2424,6 +2473,48 @@ func (x *Container) GetStarted() bool {
 	return false
 }
 
+func (x *Container) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *Container) GetPrivileged() bool {
+	if x != nil {
+		return x.Privileged
+	}
+	return false
+}
+
+func (x *Container) GetAddedCapabilities() []string {
+	if x != nil {
+		return x.AddedCapabilities
+	}
+	return nil
+}
+
+func (x *Container) GetHostNetwork() bool {
+	if x != nil {
+		return x.HostNetwork
+	}
+	return false
+}
+
+func (x *Container) GetHostPid() bool {
+	if x != nil {
+		return x.HostPid
+	}
+	return false
+}
+
+func (x *Container) GetHostIpc() bool {
+	if x != nil {
+		return x.HostIpc
+	}
+	return false
+}
+
 type ContainerImage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2770,7 +2861,7 @@ var file_api_v1beta1_event_proto_rawDesc = []byte{
 	0x61, 0x31, 0x2e, 0x4b, 0x38, 0x73, 0x48, 0x02, 0x52, 0x03, 0x6b, 0x38, 0x73, 0x88, 0x01, 0x01,
 	0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x42, 0x0c, 0x0a, 0x0a,
 	0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x6b,
-	0x38, 0x73, 0x22, 0xba, 0x03, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x12, 0x3f,
+	0x38, 0x73, 0x22, 0xe6, 0x03, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x12, 0x3f,
 	0x0a, 0x0a, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x65, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65,
 	0x74, 0x61, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x48, 0x00,
@@ -2795,714 +2886,750 @@ var file_api_v1beta1_event_proto_rawDesc = []byte{
 	0x12, 0x35, 0x0a, 0x09, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x07, 0x20,
 	0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x65, 0x65, 0x2e, 0x76, 0x31, 0x62,
 	0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x09, 0x61, 0x6e,
-	0x63, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x73, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x65, 0x78, 0x65, 0x63,
-	0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x72, 0x65, 0x61, 0x6c, 0x5f,
-	0x75, 0x73, 0x65, 0x72, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x22,
-	0x20, 0x0a, 0x0a, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x12, 0x0a,
-	0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74,
-	0x68, 0x22, 0x34, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x52, 0x02, 0x69, 0x64, 0x22, 0x91, 0x03, 0x0a, 0x06, 0x54, 0x68, 0x72, 0x65,
-	0x61, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x39, 0x0a, 0x09, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x52, 0x08, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x64, 0x12, 0x37, 0x0a, 0x08,
-	0x68, 0x6f, 0x73, 0x74, 0x5f, 0x74, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x63, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6d, 0x64, 0x6c, 0x69,
+	0x6e, 0x65, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6d, 0x64, 0x6c, 0x69, 0x6e,
+	0x65, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x76, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03,
+	0x65, 0x6e, 0x76, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x72, 0x65, 0x61, 0x6c, 0x5f, 0x75, 0x73, 0x65, 0x72,
+	0x42, 0x09, 0x0a, 0x07, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x22, 0x20, 0x0a, 0x0a, 0x45,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x34, 0x0a,
+	0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0x91, 0x03, 0x0a, 0x06, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x12, 0x39,
+	0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x39, 0x0a,
+	0x09, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08,
+	0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x64, 0x12, 0x37, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74,
+	0x5f, 0x74, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e,
+	0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x68, 0x6f, 0x73, 0x74, 0x54, 0x69,
+	0x64, 0x12, 0x2e, 0x0a, 0x03, 0x74, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
 	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x68, 0x6f,
-	0x73, 0x74, 0x54, 0x69, 0x64, 0x12, 0x2e, 0x0a, 0x03, 0x74, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x52, 0x03, 0x74, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x79, 0x73, 0x63, 0x61, 0x6c, 0x6c,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x79, 0x73, 0x63, 0x61, 0x6c, 0x6c, 0x12,
-	0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x06, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x12, 0x4d, 0x0a, 0x10, 0x75, 0x73, 0x65, 0x72, 0x5f,
-	0x73, 0x74, 0x61, 0x63, 0x6b, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1e, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x65, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x63,
-	0x65, 0x48, 0x00, 0x52, 0x0e, 0x75, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x54, 0x72,
-	0x61, 0x63, 0x65, 0x88, 0x01, 0x01, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f,
-	0x73, 0x74, 0x61, 0x63, 0x6b, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x65, 0x22, 0x4c, 0x0a, 0x0e, 0x55,
-	0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x12, 0x3a, 0x0a,
-	0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x1c, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x65, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x2e, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x09,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x22, 0x40, 0x0a, 0x0c, 0x53, 0x74, 0x61,
-	0x63, 0x6b, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x22, 0x7f, 0x0a, 0x09, 0x43,
-	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x05,
-	0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x74, 0x72,
-	0x61, 0x63, 0x65, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x6e,
-	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x05, 0x69, 0x6d, 0x61,
-	0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x22, 0x57, 0x0a, 0x0e,
-	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x21,
-	0x0a, 0x0c, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x70, 0x6f, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74,
-	0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x68, 0x0a, 0x03, 0x4b, 0x38, 0x73, 0x12, 0x25, 0x0a, 0x03,
-	0x70, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x72, 0x61, 0x63,
-	0x65, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x6f, 0x64, 0x52, 0x03,
-	0x70, 0x6f, 0x64, 0x12, 0x3a, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x65, 0x65, 0x2e,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4b, 0x38, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x73,
-	0x70, 0x61, 0x63, 0x65, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x22,
-	0x9f, 0x01, 0x0a, 0x03, 0x50, 0x6f, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x37, 0x0a,
-	0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
-	0x74, 0x72, 0x61, 0x63, 0x65, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50,
-	0x6f, 0x64, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06,
-	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
-	0x01, 0x22, 0x22, 0x0a, 0x0c, 0x4b, 0x38, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
-	0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x98, 0x01, 0x0a, 0x0c, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74,
-	0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x64, 0x61,
-	0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x65,
-	0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x34, 0x0a, 0x06, 0x70, 0x61,
-	0x72, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x74, 0x72, 0x61,
-	0x63, 0x65, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x74, 0x65,
-	0x63, 0x74, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
-	0x2a, 0xab, 0x4d, 0x0a, 0x07, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x0f, 0x0a, 0x0b,
-	0x75, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x10, 0x00, 0x12, 0x08, 0x0a,
-	0x04, 0x72, 0x65, 0x61, 0x64, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x77, 0x72, 0x69, 0x74, 0x65,
-	0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x6f, 0x70, 0x65, 0x6e, 0x10, 0x03, 0x12, 0x09, 0x0a, 0x05,
-	0x63, 0x6c, 0x6f, 0x73, 0x65, 0x10, 0x04, 0x12, 0x08, 0x0a, 0x04, 0x73, 0x74, 0x61, 0x74, 0x10,
-	0x05, 0x12, 0x09, 0x0a, 0x05, 0x66, 0x73, 0x74, 0x61, 0x74, 0x10, 0x06, 0x12, 0x09, 0x0a, 0x05,
-	0x6c, 0x73, 0x74, 0x61, 0x74, 0x10, 0x07, 0x12, 0x08, 0x0a, 0x04, 0x70, 0x6f, 0x6c, 0x6c, 0x10,
-	0x08, 0x12, 0x09, 0x0a, 0x05, 0x6c, 0x73, 0x65, 0x65, 0x6b, 0x10, 0x09, 0x12, 0x08, 0x0a, 0x04,
-	0x6d, 0x6d, 0x61, 0x70, 0x10, 0x0a, 0x12, 0x0c, 0x0a, 0x08, 0x6d, 0x70, 0x72, 0x6f, 0x74, 0x65,
-	0x63, 0x74, 0x10, 0x0b, 0x12, 0x0a, 0x0a, 0x06, 0x6d, 0x75, 0x6e, 0x6d, 0x61, 0x70, 0x10, 0x0c,
-	0x12, 0x07, 0x0a, 0x03, 0x62, 0x72, 0x6b, 0x10, 0x0d, 0x12, 0x10, 0x0a, 0x0c, 0x72, 0x74, 0x5f,
-	0x73, 0x69, 0x67, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x0e, 0x12, 0x12, 0x0a, 0x0e, 0x72,
-	0x74, 0x5f, 0x73, 0x69, 0x67, 0x70, 0x72, 0x6f, 0x63, 0x6d, 0x61, 0x73, 0x6b, 0x10, 0x0f, 0x12,
-	0x10, 0x0a, 0x0c, 0x72, 0x74, 0x5f, 0x73, 0x69, 0x67, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x10,
-	0x10, 0x12, 0x09, 0x0a, 0x05, 0x69, 0x6f, 0x63, 0x74, 0x6c, 0x10, 0x11, 0x12, 0x0b, 0x0a, 0x07,
-	0x70, 0x72, 0x65, 0x61, 0x64, 0x36, 0x34, 0x10, 0x12, 0x12, 0x0c, 0x0a, 0x08, 0x70, 0x77, 0x72,
-	0x69, 0x74, 0x65, 0x36, 0x34, 0x10, 0x13, 0x12, 0x09, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x64, 0x76,
-	0x10, 0x14, 0x12, 0x0a, 0x0a, 0x06, 0x77, 0x72, 0x69, 0x74, 0x65, 0x76, 0x10, 0x15, 0x12, 0x0a,
-	0x0a, 0x06, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x10, 0x16, 0x12, 0x08, 0x0a, 0x04, 0x70, 0x69,
-	0x70, 0x65, 0x10, 0x17, 0x12, 0x0a, 0x0a, 0x06, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x10, 0x18,
-	0x12, 0x0f, 0x0a, 0x0b, 0x73, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x79, 0x69, 0x65, 0x6c, 0x64, 0x10,
-	0x19, 0x12, 0x0a, 0x0a, 0x06, 0x6d, 0x72, 0x65, 0x6d, 0x61, 0x70, 0x10, 0x1a, 0x12, 0x09, 0x0a,
-	0x05, 0x6d, 0x73, 0x79, 0x6e, 0x63, 0x10, 0x1b, 0x12, 0x0b, 0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x63,
-	0x6f, 0x72, 0x65, 0x10, 0x1c, 0x12, 0x0b, 0x0a, 0x07, 0x6d, 0x61, 0x64, 0x76, 0x69, 0x73, 0x65,
-	0x10, 0x1d, 0x12, 0x0a, 0x0a, 0x06, 0x73, 0x68, 0x6d, 0x67, 0x65, 0x74, 0x10, 0x1e, 0x12, 0x09,
-	0x0a, 0x05, 0x73, 0x68, 0x6d, 0x61, 0x74, 0x10, 0x1f, 0x12, 0x0a, 0x0a, 0x06, 0x73, 0x68, 0x6d,
-	0x63, 0x74, 0x6c, 0x10, 0x20, 0x12, 0x07, 0x0a, 0x03, 0x64, 0x75, 0x70, 0x10, 0x21, 0x12, 0x08,
-	0x0a, 0x04, 0x64, 0x75, 0x70, 0x32, 0x10, 0x22, 0x12, 0x09, 0x0a, 0x05, 0x70, 0x61, 0x75, 0x73,
-	0x65, 0x10, 0x23, 0x12, 0x0d, 0x0a, 0x09, 0x6e, 0x61, 0x6e, 0x6f, 0x73, 0x6c, 0x65, 0x65, 0x70,
-	0x10, 0x24, 0x12, 0x0d, 0x0a, 0x09, 0x67, 0x65, 0x74, 0x69, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x10,
-	0x25, 0x12, 0x09, 0x0a, 0x05, 0x61, 0x6c, 0x61, 0x72, 0x6d, 0x10, 0x26, 0x12, 0x0d, 0x0a, 0x09,
-	0x73, 0x65, 0x74, 0x69, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x10, 0x27, 0x12, 0x0a, 0x0a, 0x06, 0x67,
-	0x65, 0x74, 0x70, 0x69, 0x64, 0x10, 0x28, 0x12, 0x0c, 0x0a, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x66,
-	0x69, 0x6c, 0x65, 0x10, 0x29, 0x12, 0x0a, 0x0a, 0x06, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x10,
-	0x2a, 0x12, 0x0b, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x10, 0x2b, 0x12, 0x0a,
-	0x0a, 0x06, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x10, 0x2c, 0x12, 0x0a, 0x0a, 0x06, 0x73, 0x65,
-	0x6e, 0x64, 0x74, 0x6f, 0x10, 0x2d, 0x12, 0x0c, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x76, 0x66, 0x72,
-	0x6f, 0x6d, 0x10, 0x2e, 0x12, 0x0b, 0x0a, 0x07, 0x73, 0x65, 0x6e, 0x64, 0x6d, 0x73, 0x67, 0x10,
-	0x2f, 0x12, 0x0b, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x76, 0x6d, 0x73, 0x67, 0x10, 0x30, 0x12, 0x0c,
-	0x0a, 0x08, 0x73, 0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x10, 0x31, 0x12, 0x08, 0x0a, 0x04,
-	0x62, 0x69, 0x6e, 0x64, 0x10, 0x32, 0x12, 0x0a, 0x0a, 0x06, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e,
-	0x10, 0x33, 0x12, 0x0f, 0x0a, 0x0b, 0x67, 0x65, 0x74, 0x73, 0x6f, 0x63, 0x6b, 0x6e, 0x61, 0x6d,
-	0x65, 0x10, 0x34, 0x12, 0x0f, 0x0a, 0x0b, 0x67, 0x65, 0x74, 0x70, 0x65, 0x65, 0x72, 0x6e, 0x61,
-	0x6d, 0x65, 0x10, 0x35, 0x12, 0x0e, 0x0a, 0x0a, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x70, 0x61,
-	0x69, 0x72, 0x10, 0x36, 0x12, 0x0e, 0x0a, 0x0a, 0x73, 0x65, 0x74, 0x73, 0x6f, 0x63, 0x6b, 0x6f,
-	0x70, 0x74, 0x10, 0x37, 0x12, 0x0e, 0x0a, 0x0a, 0x67, 0x65, 0x74, 0x73, 0x6f, 0x63, 0x6b, 0x6f,
-	0x70, 0x74, 0x10, 0x38, 0x12, 0x09, 0x0a, 0x05, 0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x10, 0x39, 0x12,
-	0x08, 0x0a, 0x04, 0x66, 0x6f, 0x72, 0x6b, 0x10, 0x3a, 0x12, 0x09, 0x0a, 0x05, 0x76, 0x66, 0x6f,
-	0x72, 0x6b, 0x10, 0x3b, 0x12, 0x0a, 0x0a, 0x06, 0x65, 0x78, 0x65, 0x63, 0x76, 0x65, 0x10, 0x3c,
-	0x12, 0x08, 0x0a, 0x04, 0x65, 0x78, 0x69, 0x74, 0x10, 0x3d, 0x12, 0x09, 0x0a, 0x05, 0x77, 0x61,
-	0x69, 0x74, 0x34, 0x10, 0x3e, 0x12, 0x08, 0x0a, 0x04, 0x6b, 0x69, 0x6c, 0x6c, 0x10, 0x3f, 0x12,
-	0x09, 0x0a, 0x05, 0x75, 0x6e, 0x61, 0x6d, 0x65, 0x10, 0x40, 0x12, 0x0a, 0x0a, 0x06, 0x73, 0x65,
-	0x6d, 0x67, 0x65, 0x74, 0x10, 0x41, 0x12, 0x09, 0x0a, 0x05, 0x73, 0x65, 0x6d, 0x6f, 0x70, 0x10,
-	0x42, 0x12, 0x0a, 0x0a, 0x06, 0x73, 0x65, 0x6d, 0x63, 0x74, 0x6c, 0x10, 0x43, 0x12, 0x09, 0x0a,
-	0x05, 0x73, 0x68, 0x6d, 0x64, 0x74, 0x10, 0x44, 0x12, 0x0a, 0x0a, 0x06, 0x6d, 0x73, 0x67, 0x67,
-	0x65, 0x74, 0x10, 0x45, 0x12, 0x0a, 0x0a, 0x06, 0x6d, 0x73, 0x67, 0x73, 0x6e, 0x64, 0x10, 0x46,
-	0x12, 0x0a, 0x0a, 0x06, 0x6d, 0x73, 0x67, 0x72, 0x63, 0x76, 0x10, 0x47, 0x12, 0x0a, 0x0a, 0x06,
-	0x6d, 0x73, 0x67, 0x63, 0x74, 0x6c, 0x10, 0x48, 0x12, 0x09, 0x0a, 0x05, 0x66, 0x63, 0x6e, 0x74,
-	0x6c, 0x10, 0x49, 0x12, 0x09, 0x0a, 0x05, 0x66, 0x6c, 0x6f, 0x63, 0x6b, 0x10, 0x4a, 0x12, 0x09,
-	0x0a, 0x05, 0x66, 0x73, 0x79, 0x6e, 0x63, 0x10, 0x4b, 0x12, 0x0d, 0x0a, 0x09, 0x66, 0x64, 0x61,
-	0x74, 0x61, 0x73, 0x79, 0x6e, 0x63, 0x10, 0x4c, 0x12, 0x0c, 0x0a, 0x08, 0x74, 0x72, 0x75, 0x6e,
-	0x63, 0x61, 0x74, 0x65, 0x10, 0x4d, 0x12, 0x0d, 0x0a, 0x09, 0x66, 0x74, 0x72, 0x75, 0x6e, 0x63,
-	0x61, 0x74, 0x65, 0x10, 0x4e, 0x12, 0x0c, 0x0a, 0x08, 0x67, 0x65, 0x74, 0x64, 0x65, 0x6e, 0x74,
-	0x73, 0x10, 0x4f, 0x12, 0x0a, 0x0a, 0x06, 0x67, 0x65, 0x74, 0x63, 0x77, 0x64, 0x10, 0x50, 0x12,
-	0x09, 0x0a, 0x05, 0x63, 0x68, 0x64, 0x69, 0x72, 0x10, 0x51, 0x12, 0x0a, 0x0a, 0x06, 0x66, 0x63,
-	0x68, 0x64, 0x69, 0x72, 0x10, 0x52, 0x12, 0x0a, 0x0a, 0x06, 0x72, 0x65, 0x6e, 0x61, 0x6d, 0x65,
-	0x10, 0x53, 0x12, 0x09, 0x0a, 0x05, 0x6d, 0x6b, 0x64, 0x69, 0x72, 0x10, 0x54, 0x12, 0x09, 0x0a,
-	0x05, 0x72, 0x6d, 0x64, 0x69, 0x72, 0x10, 0x55, 0x12, 0x09, 0x0a, 0x05, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x10, 0x56, 0x12, 0x08, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x10, 0x57, 0x12, 0x0a, 0x0a,
-	0x06, 0x75, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x10, 0x58, 0x12, 0x0b, 0x0a, 0x07, 0x73, 0x79, 0x6d,
-	0x6c, 0x69, 0x6e, 0x6b, 0x10, 0x59, 0x12, 0x0c, 0x0a, 0x08, 0x72, 0x65, 0x61, 0x64, 0x6c, 0x69,
-	0x6e, 0x6b, 0x10, 0x5a, 0x12, 0x09, 0x0a, 0x05, 0x63, 0x68, 0x6d, 0x6f, 0x64, 0x10, 0x5b, 0x12,
-	0x0a, 0x0a, 0x06, 0x66, 0x63, 0x68, 0x6d, 0x6f, 0x64, 0x10, 0x5c, 0x12, 0x09, 0x0a, 0x05, 0x63,
-	0x68, 0x6f, 0x77, 0x6e, 0x10, 0x5d, 0x12, 0x0a, 0x0a, 0x06, 0x66, 0x63, 0x68, 0x6f, 0x77, 0x6e,
-	0x10, 0x5e, 0x12, 0x0a, 0x0a, 0x06, 0x6c, 0x63, 0x68, 0x6f, 0x77, 0x6e, 0x10, 0x5f, 0x12, 0x09,
-	0x0a, 0x05, 0x75, 0x6d, 0x61, 0x73, 0x6b, 0x10, 0x60, 0x12, 0x10, 0x0a, 0x0c, 0x67, 0x65, 0x74,
-	0x74, 0x69, 0x6d, 0x65, 0x6f, 0x66, 0x64, 0x61, 0x79, 0x10, 0x61, 0x12, 0x0d, 0x0a, 0x09, 0x67,
-	0x65, 0x74, 0x72, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x10, 0x62, 0x12, 0x0d, 0x0a, 0x09, 0x67, 0x65,
-	0x74, 0x72, 0x75, 0x73, 0x61, 0x67, 0x65, 0x10, 0x63, 0x12, 0x0b, 0x0a, 0x07, 0x73, 0x79, 0x73,
-	0x69, 0x6e, 0x66, 0x6f, 0x10, 0x64, 0x12, 0x09, 0x0a, 0x05, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x10,
-	0x65, 0x12, 0x0a, 0x0a, 0x06, 0x70, 0x74, 0x72, 0x61, 0x63, 0x65, 0x10, 0x66, 0x12, 0x0a, 0x0a,
-	0x06, 0x67, 0x65, 0x74, 0x75, 0x69, 0x64, 0x10, 0x67, 0x12, 0x0a, 0x0a, 0x06, 0x73, 0x79, 0x73,
-	0x6c, 0x6f, 0x67, 0x10, 0x68, 0x12, 0x0a, 0x0a, 0x06, 0x67, 0x65, 0x74, 0x67, 0x69, 0x64, 0x10,
-	0x69, 0x12, 0x0a, 0x0a, 0x06, 0x73, 0x65, 0x74, 0x75, 0x69, 0x64, 0x10, 0x6a, 0x12, 0x0a, 0x0a,
-	0x06, 0x73, 0x65, 0x74, 0x67, 0x69, 0x64, 0x10, 0x6b, 0x12, 0x0b, 0x0a, 0x07, 0x67, 0x65, 0x74,
-	0x65, 0x75, 0x69, 0x64, 0x10, 0x6c, 0x12, 0x0b, 0x0a, 0x07, 0x67, 0x65, 0x74, 0x65, 0x67, 0x69,
-	0x64, 0x10, 0x6d, 0x12, 0x0b, 0x0a, 0x07, 0x73, 0x65, 0x74, 0x70, 0x67, 0x69, 0x64, 0x10, 0x6e,
-	0x12, 0x0b, 0x0a, 0x07, 0x67, 0x65, 0x74, 0x70, 0x70, 0x69, 0x64, 0x10, 0x6f, 0x12, 0x0b, 0x0a,
-	0x07, 0x67, 0x65, 0x74, 0x70, 0x67, 0x72, 0x70, 0x10, 0x70, 0x12, 0x0a, 0x0a, 0x06, 0x73, 0x65,
-	0x74, 0x73, 0x69, 0x64, 0x10, 0x71, 0x12, 0x0c, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x72, 0x65, 0x75,
-	0x69, 0x64, 0x10, 0x72, 0x12, 0x0c, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x72, 0x65, 0x67, 0x69, 0x64,
-	0x10, 0x73, 0x12, 0x0d, 0x0a, 0x09, 0x67, 0x65, 0x74, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x10,
-	0x74, 0x12, 0x0d, 0x0a, 0x09, 0x73, 0x65, 0x74, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x10, 0x75,
-	0x12, 0x0d, 0x0a, 0x09, 0x73, 0x65, 0x74, 0x72, 0x65, 0x73, 0x75, 0x69, 0x64, 0x10, 0x76, 0x12,
-	0x0d, 0x0a, 0x09, 0x67, 0x65, 0x74, 0x72, 0x65, 0x73, 0x75, 0x69, 0x64, 0x10, 0x77, 0x12, 0x0d,
-	0x0a, 0x09, 0x73, 0x65, 0x74, 0x72, 0x65, 0x73, 0x67, 0x69, 0x64, 0x10, 0x78, 0x12, 0x0d, 0x0a,
-	0x09, 0x67, 0x65, 0x74, 0x72, 0x65, 0x73, 0x67, 0x69, 0x64, 0x10, 0x79, 0x12, 0x0b, 0x0a, 0x07,
-	0x67, 0x65, 0x74, 0x70, 0x67, 0x69, 0x64, 0x10, 0x7a, 0x12, 0x0c, 0x0a, 0x08, 0x73, 0x65, 0x74,
-	0x66, 0x73, 0x75, 0x69, 0x64, 0x10, 0x7b, 0x12, 0x0c, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x66, 0x73,
-	0x67, 0x69, 0x64, 0x10, 0x7c, 0x12, 0x0a, 0x0a, 0x06, 0x67, 0x65, 0x74, 0x73, 0x69, 0x64, 0x10,
-	0x7d, 0x12, 0x0a, 0x0a, 0x06, 0x63, 0x61, 0x70, 0x67, 0x65, 0x74, 0x10, 0x7e, 0x12, 0x0a, 0x0a,
-	0x06, 0x63, 0x61, 0x70, 0x73, 0x65, 0x74, 0x10, 0x7f, 0x12, 0x12, 0x0a, 0x0d, 0x72, 0x74, 0x5f,
-	0x73, 0x69, 0x67, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x10, 0x80, 0x01, 0x12, 0x14, 0x0a,
-	0x0f, 0x72, 0x74, 0x5f, 0x73, 0x69, 0x67, 0x74, 0x69, 0x6d, 0x65, 0x64, 0x77, 0x61, 0x69, 0x74,
-	0x10, 0x81, 0x01, 0x12, 0x14, 0x0a, 0x0f, 0x72, 0x74, 0x5f, 0x73, 0x69, 0x67, 0x71, 0x75, 0x65,
-	0x75, 0x65, 0x69, 0x6e, 0x66, 0x6f, 0x10, 0x82, 0x01, 0x12, 0x12, 0x0a, 0x0d, 0x72, 0x74, 0x5f,
-	0x73, 0x69, 0x67, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x10, 0x83, 0x01, 0x12, 0x10, 0x0a,
-	0x0b, 0x73, 0x69, 0x67, 0x61, 0x6c, 0x74, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x10, 0x84, 0x01, 0x12,
-	0x0a, 0x0a, 0x05, 0x75, 0x74, 0x69, 0x6d, 0x65, 0x10, 0x85, 0x01, 0x12, 0x0a, 0x0a, 0x05, 0x6d,
-	0x6b, 0x6e, 0x6f, 0x64, 0x10, 0x86, 0x01, 0x12, 0x0b, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x6c, 0x69,
-	0x62, 0x10, 0x87, 0x01, 0x12, 0x10, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c,
-	0x69, 0x74, 0x79, 0x10, 0x88, 0x01, 0x12, 0x0a, 0x0a, 0x05, 0x75, 0x73, 0x74, 0x61, 0x74, 0x10,
-	0x89, 0x01, 0x12, 0x0b, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x66, 0x73, 0x10, 0x8a, 0x01, 0x12,
-	0x0c, 0x0a, 0x07, 0x66, 0x73, 0x74, 0x61, 0x74, 0x66, 0x73, 0x10, 0x8b, 0x01, 0x12, 0x0a, 0x0a,
-	0x05, 0x73, 0x79, 0x73, 0x66, 0x73, 0x10, 0x8c, 0x01, 0x12, 0x10, 0x0a, 0x0b, 0x67, 0x65, 0x74,
-	0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x10, 0x8d, 0x01, 0x12, 0x10, 0x0a, 0x0b, 0x73,
-	0x65, 0x74, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x10, 0x8e, 0x01, 0x12, 0x13, 0x0a,
-	0x0e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x74, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x10,
-	0x8f, 0x01, 0x12, 0x13, 0x0a, 0x0e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x67, 0x65, 0x74, 0x70,
-	0x61, 0x72, 0x61, 0x6d, 0x10, 0x90, 0x01, 0x12, 0x17, 0x0a, 0x12, 0x73, 0x63, 0x68, 0x65, 0x64,
-	0x5f, 0x73, 0x65, 0x74, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x10, 0x91, 0x01,
-	0x12, 0x17, 0x0a, 0x12, 0x73, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x67, 0x65, 0x74, 0x73, 0x63, 0x68,
-	0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x10, 0x92, 0x01, 0x12, 0x1b, 0x0a, 0x16, 0x73, 0x63, 0x68,
-	0x65, 0x64, 0x5f, 0x67, 0x65, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x5f,
-	0x6d, 0x61, 0x78, 0x10, 0x93, 0x01, 0x12, 0x1b, 0x0a, 0x16, 0x73, 0x63, 0x68, 0x65, 0x64, 0x5f,
-	0x67, 0x65, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x6d, 0x69, 0x6e,
-	0x10, 0x94, 0x01, 0x12, 0x1a, 0x0a, 0x15, 0x73, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x72, 0x72, 0x5f,
-	0x67, 0x65, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x10, 0x95, 0x01, 0x12,
-	0x0a, 0x0a, 0x05, 0x6d, 0x6c, 0x6f, 0x63, 0x6b, 0x10, 0x96, 0x01, 0x12, 0x0c, 0x0a, 0x07, 0x6d,
-	0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x10, 0x97, 0x01, 0x12, 0x0d, 0x0a, 0x08, 0x6d, 0x6c, 0x6f,
-	0x63, 0x6b, 0x61, 0x6c, 0x6c, 0x10, 0x98, 0x01, 0x12, 0x0f, 0x0a, 0x0a, 0x6d, 0x75, 0x6e, 0x6c,
-	0x6f, 0x63, 0x6b, 0x61, 0x6c, 0x6c, 0x10, 0x99, 0x01, 0x12, 0x0c, 0x0a, 0x07, 0x76, 0x68, 0x61,
-	0x6e, 0x67, 0x75, 0x70, 0x10, 0x9a, 0x01, 0x12, 0x0f, 0x0a, 0x0a, 0x6d, 0x6f, 0x64, 0x69, 0x66,
-	0x79, 0x5f, 0x6c, 0x64, 0x74, 0x10, 0x9b, 0x01, 0x12, 0x0f, 0x0a, 0x0a, 0x70, 0x69, 0x76, 0x6f,
-	0x74, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x10, 0x9c, 0x01, 0x12, 0x0b, 0x0a, 0x06, 0x73, 0x79, 0x73,
-	0x63, 0x74, 0x6c, 0x10, 0x9d, 0x01, 0x12, 0x0a, 0x0a, 0x05, 0x70, 0x72, 0x63, 0x74, 0x6c, 0x10,
-	0x9e, 0x01, 0x12, 0x0f, 0x0a, 0x0a, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x70, 0x72, 0x63, 0x74, 0x6c,
-	0x10, 0x9f, 0x01, 0x12, 0x0d, 0x0a, 0x08, 0x61, 0x64, 0x6a, 0x74, 0x69, 0x6d, 0x65, 0x78, 0x10,
-	0xa0, 0x01, 0x12, 0x0e, 0x0a, 0x09, 0x73, 0x65, 0x74, 0x72, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x10,
-	0xa1, 0x01, 0x12, 0x0b, 0x0a, 0x06, 0x63, 0x68, 0x72, 0x6f, 0x6f, 0x74, 0x10, 0xa2, 0x01, 0x12,
-	0x09, 0x0a, 0x04, 0x73, 0x79, 0x6e, 0x63, 0x10, 0xa3, 0x01, 0x12, 0x09, 0x0a, 0x04, 0x61, 0x63,
-	0x63, 0x74, 0x10, 0xa4, 0x01, 0x12, 0x11, 0x0a, 0x0c, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6d, 0x65,
-	0x6f, 0x66, 0x64, 0x61, 0x79, 0x10, 0xa5, 0x01, 0x12, 0x0a, 0x0a, 0x05, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x10, 0xa6, 0x01, 0x12, 0x0c, 0x0a, 0x07, 0x75, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x32, 0x10,
-	0xa7, 0x01, 0x12, 0x0b, 0x0a, 0x06, 0x73, 0x77, 0x61, 0x70, 0x6f, 0x6e, 0x10, 0xa8, 0x01, 0x12,
-	0x0c, 0x0a, 0x07, 0x73, 0x77, 0x61, 0x70, 0x6f, 0x66, 0x66, 0x10, 0xa9, 0x01, 0x12, 0x0b, 0x0a,
-	0x06, 0x72, 0x65, 0x62, 0x6f, 0x6f, 0x74, 0x10, 0xaa, 0x01, 0x12, 0x10, 0x0a, 0x0b, 0x73, 0x65,
-	0x74, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x10, 0xab, 0x01, 0x12, 0x12, 0x0a, 0x0d,
-	0x73, 0x65, 0x74, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x6e, 0x61, 0x6d, 0x65, 0x10, 0xac, 0x01,
-	0x12, 0x09, 0x0a, 0x04, 0x69, 0x6f, 0x70, 0x6c, 0x10, 0xad, 0x01, 0x12, 0x0b, 0x0a, 0x06, 0x69,
-	0x6f, 0x70, 0x65, 0x72, 0x6d, 0x10, 0xae, 0x01, 0x12, 0x12, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x10, 0xaf, 0x01, 0x12, 0x10, 0x0a, 0x0b,
-	0x69, 0x6e, 0x69, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x10, 0xb0, 0x01, 0x12, 0x12,
-	0x0a, 0x0d, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x10,
-	0xb1, 0x01, 0x12, 0x14, 0x0a, 0x0f, 0x67, 0x65, 0x74, 0x5f, 0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c,
-	0x5f, 0x73, 0x79, 0x6d, 0x73, 0x10, 0xb2, 0x01, 0x12, 0x11, 0x0a, 0x0c, 0x71, 0x75, 0x65, 0x72,
-	0x79, 0x5f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x10, 0xb3, 0x01, 0x12, 0x0d, 0x0a, 0x08, 0x71,
-	0x75, 0x6f, 0x74, 0x61, 0x63, 0x74, 0x6c, 0x10, 0xb4, 0x01, 0x12, 0x0f, 0x0a, 0x0a, 0x6e, 0x66,
-	0x73, 0x73, 0x65, 0x72, 0x76, 0x63, 0x74, 0x6c, 0x10, 0xb5, 0x01, 0x12, 0x0c, 0x0a, 0x07, 0x67,
-	0x65, 0x74, 0x70, 0x6d, 0x73, 0x67, 0x10, 0xb6, 0x01, 0x12, 0x0c, 0x0a, 0x07, 0x70, 0x75, 0x74,
-	0x70, 0x6d, 0x73, 0x67, 0x10, 0xb7, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x61, 0x66, 0x73, 0x10, 0xb8,
-	0x01, 0x12, 0x0c, 0x0a, 0x07, 0x74, 0x75, 0x78, 0x63, 0x61, 0x6c, 0x6c, 0x10, 0xb9, 0x01, 0x12,
-	0x0d, 0x0a, 0x08, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x10, 0xba, 0x01, 0x12, 0x0b,
-	0x0a, 0x06, 0x67, 0x65, 0x74, 0x74, 0x69, 0x64, 0x10, 0xbb, 0x01, 0x12, 0x0e, 0x0a, 0x09, 0x72,
-	0x65, 0x61, 0x64, 0x61, 0x68, 0x65, 0x61, 0x64, 0x10, 0xbc, 0x01, 0x12, 0x0d, 0x0a, 0x08, 0x73,
-	0x65, 0x74, 0x78, 0x61, 0x74, 0x74, 0x72, 0x10, 0xbd, 0x01, 0x12, 0x0e, 0x0a, 0x09, 0x6c, 0x73,
-	0x65, 0x74, 0x78, 0x61, 0x74, 0x74, 0x72, 0x10, 0xbe, 0x01, 0x12, 0x0e, 0x0a, 0x09, 0x66, 0x73,
-	0x65, 0x74, 0x78, 0x61, 0x74, 0x74, 0x72, 0x10, 0xbf, 0x01, 0x12, 0x0d, 0x0a, 0x08, 0x67, 0x65,
-	0x74, 0x78, 0x61, 0x74, 0x74, 0x72, 0x10, 0xc0, 0x01, 0x12, 0x0e, 0x0a, 0x09, 0x6c, 0x67, 0x65,
-	0x74, 0x78, 0x61, 0x74, 0x74, 0x72, 0x10, 0xc1, 0x01, 0x12, 0x0e, 0x0a, 0x09, 0x66, 0x67, 0x65,
-	0x74, 0x78, 0x61, 0x74, 0x74, 0x72, 0x10, 0xc2, 0x01, 0x12, 0x0e, 0x0a, 0x09, 0x6c, 0x69, 0x73,
-	0x74, 0x78, 0x61, 0x74, 0x74, 0x72, 0x10, 0xc3, 0x01, 0x12, 0x0f, 0x0a, 0x0a, 0x6c, 0x6c, 0x69,
-	0x73, 0x74, 0x78, 0x61, 0x74, 0x74, 0x72, 0x10, 0xc4, 0x01, 0x12, 0x0f, 0x0a, 0x0a, 0x66, 0x6c,
-	0x69, 0x73, 0x74, 0x78, 0x61, 0x74, 0x74, 0x72, 0x10, 0xc5, 0x01, 0x12, 0x10, 0x0a, 0x0b, 0x72,
-	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x78, 0x61, 0x74, 0x74, 0x72, 0x10, 0xc6, 0x01, 0x12, 0x11, 0x0a,
-	0x0c, 0x6c, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x78, 0x61, 0x74, 0x74, 0x72, 0x10, 0xc7, 0x01,
-	0x12, 0x11, 0x0a, 0x0c, 0x66, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x78, 0x61, 0x74, 0x74, 0x72,
-	0x10, 0xc8, 0x01, 0x12, 0x0a, 0x0a, 0x05, 0x74, 0x6b, 0x69, 0x6c, 0x6c, 0x10, 0xc9, 0x01, 0x12,
-	0x09, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x10, 0xca, 0x01, 0x12, 0x0a, 0x0a, 0x05, 0x66, 0x75,
-	0x74, 0x65, 0x78, 0x10, 0xcb, 0x01, 0x12, 0x16, 0x0a, 0x11, 0x73, 0x63, 0x68, 0x65, 0x64, 0x5f,
-	0x73, 0x65, 0x74, 0x61, 0x66, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x79, 0x10, 0xcc, 0x01, 0x12, 0x16,
-	0x0a, 0x11, 0x73, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x67, 0x65, 0x74, 0x61, 0x66, 0x66, 0x69, 0x6e,
-	0x69, 0x74, 0x79, 0x10, 0xcd, 0x01, 0x12, 0x14, 0x0a, 0x0f, 0x73, 0x65, 0x74, 0x5f, 0x74, 0x68,
-	0x72, 0x65, 0x61, 0x64, 0x5f, 0x61, 0x72, 0x65, 0x61, 0x10, 0xce, 0x01, 0x12, 0x0d, 0x0a, 0x08,
-	0x69, 0x6f, 0x5f, 0x73, 0x65, 0x74, 0x75, 0x70, 0x10, 0xcf, 0x01, 0x12, 0x0f, 0x0a, 0x0a, 0x69,
-	0x6f, 0x5f, 0x64, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x10, 0xd0, 0x01, 0x12, 0x11, 0x0a, 0x0c,
-	0x69, 0x6f, 0x5f, 0x67, 0x65, 0x74, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x10, 0xd1, 0x01, 0x12,
-	0x0e, 0x0a, 0x09, 0x69, 0x6f, 0x5f, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x10, 0xd2, 0x01, 0x12,
-	0x0e, 0x0a, 0x09, 0x69, 0x6f, 0x5f, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x10, 0xd3, 0x01, 0x12,
-	0x14, 0x0a, 0x0f, 0x67, 0x65, 0x74, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x61, 0x72,
-	0x65, 0x61, 0x10, 0xd4, 0x01, 0x12, 0x13, 0x0a, 0x0e, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x5f,
-	0x64, 0x63, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x10, 0xd5, 0x01, 0x12, 0x11, 0x0a, 0x0c, 0x65, 0x70,
-	0x6f, 0x6c, 0x6c, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x10, 0xd6, 0x01, 0x12, 0x12, 0x0a,
-	0x0d, 0x65, 0x70, 0x6f, 0x6c, 0x6c, 0x5f, 0x63, 0x74, 0x6c, 0x5f, 0x6f, 0x6c, 0x64, 0x10, 0xd7,
-	0x01, 0x12, 0x13, 0x0a, 0x0e, 0x65, 0x70, 0x6f, 0x6c, 0x6c, 0x5f, 0x77, 0x61, 0x69, 0x74, 0x5f,
-	0x6f, 0x6c, 0x64, 0x10, 0xd8, 0x01, 0x12, 0x15, 0x0a, 0x10, 0x72, 0x65, 0x6d, 0x61, 0x70, 0x5f,
-	0x66, 0x69, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x73, 0x10, 0xd9, 0x01, 0x12, 0x0f, 0x0a,
-	0x0a, 0x67, 0x65, 0x74, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x36, 0x34, 0x10, 0xda, 0x01, 0x12, 0x14,
-	0x0a, 0x0f, 0x73, 0x65, 0x74, 0x5f, 0x74, 0x69, 0x64, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x10, 0xdb, 0x01, 0x12, 0x14, 0x0a, 0x0f, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f,
-	0x73, 0x79, 0x73, 0x63, 0x61, 0x6c, 0x6c, 0x10, 0xdc, 0x01, 0x12, 0x0f, 0x0a, 0x0a, 0x73, 0x65,
-	0x6d, 0x74, 0x69, 0x6d, 0x65, 0x64, 0x6f, 0x70, 0x10, 0xdd, 0x01, 0x12, 0x0e, 0x0a, 0x09, 0x66,
-	0x61, 0x64, 0x76, 0x69, 0x73, 0x65, 0x36, 0x34, 0x10, 0xde, 0x01, 0x12, 0x11, 0x0a, 0x0c, 0x74,
-	0x69, 0x6d, 0x65, 0x72, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x10, 0xdf, 0x01, 0x12, 0x12,
-	0x0a, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6d, 0x65, 0x10,
-	0xe0, 0x01, 0x12, 0x12, 0x0a, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x5f, 0x67, 0x65, 0x74, 0x74,
-	0x69, 0x6d, 0x65, 0x10, 0xe1, 0x01, 0x12, 0x15, 0x0a, 0x10, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x5f,
-	0x67, 0x65, 0x74, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x75, 0x6e, 0x10, 0xe2, 0x01, 0x12, 0x11, 0x0a,
-	0x0c, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x10, 0xe3, 0x01,
-	0x12, 0x12, 0x0a, 0x0d, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6d,
-	0x65, 0x10, 0xe4, 0x01, 0x12, 0x12, 0x0a, 0x0d, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x67, 0x65,
-	0x74, 0x74, 0x69, 0x6d, 0x65, 0x10, 0xe5, 0x01, 0x12, 0x11, 0x0a, 0x0c, 0x63, 0x6c, 0x6f, 0x63,
-	0x6b, 0x5f, 0x67, 0x65, 0x74, 0x72, 0x65, 0x73, 0x10, 0xe6, 0x01, 0x12, 0x14, 0x0a, 0x0f, 0x63,
-	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x61, 0x6e, 0x6f, 0x73, 0x6c, 0x65, 0x65, 0x70, 0x10, 0xe7,
-	0x01, 0x12, 0x0f, 0x0a, 0x0a, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x10,
-	0xe8, 0x01, 0x12, 0x0f, 0x0a, 0x0a, 0x65, 0x70, 0x6f, 0x6c, 0x6c, 0x5f, 0x77, 0x61, 0x69, 0x74,
-	0x10, 0xe9, 0x01, 0x12, 0x0e, 0x0a, 0x09, 0x65, 0x70, 0x6f, 0x6c, 0x6c, 0x5f, 0x63, 0x74, 0x6c,
-	0x10, 0xea, 0x01, 0x12, 0x0b, 0x0a, 0x06, 0x74, 0x67, 0x6b, 0x69, 0x6c, 0x6c, 0x10, 0xeb, 0x01,
-	0x12, 0x0b, 0x0a, 0x06, 0x75, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x10, 0xec, 0x01, 0x12, 0x0c, 0x0a,
-	0x07, 0x76, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x10, 0xed, 0x01, 0x12, 0x0a, 0x0a, 0x05, 0x6d,
-	0x62, 0x69, 0x6e, 0x64, 0x10, 0xee, 0x01, 0x12, 0x12, 0x0a, 0x0d, 0x73, 0x65, 0x74, 0x5f, 0x6d,
-	0x65, 0x6d, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x10, 0xef, 0x01, 0x12, 0x12, 0x0a, 0x0d, 0x67,
-	0x65, 0x74, 0x5f, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x10, 0xf0, 0x01, 0x12,
-	0x0c, 0x0a, 0x07, 0x6d, 0x71, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x10, 0xf1, 0x01, 0x12, 0x0e, 0x0a,
-	0x09, 0x6d, 0x71, 0x5f, 0x75, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x10, 0xf2, 0x01, 0x12, 0x11, 0x0a,
-	0x0c, 0x6d, 0x71, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x64, 0x73, 0x65, 0x6e, 0x64, 0x10, 0xf3, 0x01,
-	0x12, 0x14, 0x0a, 0x0f, 0x6d, 0x71, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x64, 0x72, 0x65, 0x63, 0x65,
-	0x69, 0x76, 0x65, 0x10, 0xf4, 0x01, 0x12, 0x0e, 0x0a, 0x09, 0x6d, 0x71, 0x5f, 0x6e, 0x6f, 0x74,
-	0x69, 0x66, 0x79, 0x10, 0xf5, 0x01, 0x12, 0x12, 0x0a, 0x0d, 0x6d, 0x71, 0x5f, 0x67, 0x65, 0x74,
-	0x73, 0x65, 0x74, 0x61, 0x74, 0x74, 0x72, 0x10, 0xf6, 0x01, 0x12, 0x0f, 0x0a, 0x0a, 0x6b, 0x65,
-	0x78, 0x65, 0x63, 0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x10, 0xf7, 0x01, 0x12, 0x0b, 0x0a, 0x06, 0x77,
-	0x61, 0x69, 0x74, 0x69, 0x64, 0x10, 0xf8, 0x01, 0x12, 0x0c, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x5f,
-	0x6b, 0x65, 0x79, 0x10, 0xf9, 0x01, 0x12, 0x10, 0x0a, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x5f, 0x6b, 0x65, 0x79, 0x10, 0xfa, 0x01, 0x12, 0x0b, 0x0a, 0x06, 0x6b, 0x65, 0x79, 0x63,
-	0x74, 0x6c, 0x10, 0xfb, 0x01, 0x12, 0x0f, 0x0a, 0x0a, 0x69, 0x6f, 0x70, 0x72, 0x69, 0x6f, 0x5f,
-	0x73, 0x65, 0x74, 0x10, 0xfc, 0x01, 0x12, 0x0f, 0x0a, 0x0a, 0x69, 0x6f, 0x70, 0x72, 0x69, 0x6f,
-	0x5f, 0x67, 0x65, 0x74, 0x10, 0xfd, 0x01, 0x12, 0x11, 0x0a, 0x0c, 0x69, 0x6e, 0x6f, 0x74, 0x69,
-	0x66, 0x79, 0x5f, 0x69, 0x6e, 0x69, 0x74, 0x10, 0xfe, 0x01, 0x12, 0x16, 0x0a, 0x11, 0x69, 0x6e,
-	0x6f, 0x74, 0x69, 0x66, 0x79, 0x5f, 0x61, 0x64, 0x64, 0x5f, 0x77, 0x61, 0x74, 0x63, 0x68, 0x10,
-	0xff, 0x01, 0x12, 0x15, 0x0a, 0x10, 0x69, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x5f, 0x72, 0x6d,
-	0x5f, 0x77, 0x61, 0x74, 0x63, 0x68, 0x10, 0x80, 0x02, 0x12, 0x12, 0x0a, 0x0d, 0x6d, 0x69, 0x67,
-	0x72, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x73, 0x10, 0x81, 0x02, 0x12, 0x0b, 0x0a,
-	0x06, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x74, 0x10, 0x82, 0x02, 0x12, 0x0c, 0x0a, 0x07, 0x6d, 0x6b,
-	0x64, 0x69, 0x72, 0x61, 0x74, 0x10, 0x83, 0x02, 0x12, 0x0c, 0x0a, 0x07, 0x6d, 0x6b, 0x6e, 0x6f,
-	0x64, 0x61, 0x74, 0x10, 0x84, 0x02, 0x12, 0x0d, 0x0a, 0x08, 0x66, 0x63, 0x68, 0x6f, 0x77, 0x6e,
-	0x61, 0x74, 0x10, 0x85, 0x02, 0x12, 0x0e, 0x0a, 0x09, 0x66, 0x75, 0x74, 0x69, 0x6d, 0x65, 0x73,
-	0x61, 0x74, 0x10, 0x86, 0x02, 0x12, 0x0f, 0x0a, 0x0a, 0x6e, 0x65, 0x77, 0x66, 0x73, 0x74, 0x61,
-	0x74, 0x61, 0x74, 0x10, 0x87, 0x02, 0x12, 0x0d, 0x0a, 0x08, 0x75, 0x6e, 0x6c, 0x69, 0x6e, 0x6b,
-	0x61, 0x74, 0x10, 0x88, 0x02, 0x12, 0x0d, 0x0a, 0x08, 0x72, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x61,
-	0x74, 0x10, 0x89, 0x02, 0x12, 0x0b, 0x0a, 0x06, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x74, 0x10, 0x8a,
-	0x02, 0x12, 0x0e, 0x0a, 0x09, 0x73, 0x79, 0x6d, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x74, 0x10, 0x8b,
-	0x02, 0x12, 0x0f, 0x0a, 0x0a, 0x72, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x74, 0x10,
-	0x8c, 0x02, 0x12, 0x0d, 0x0a, 0x08, 0x66, 0x63, 0x68, 0x6d, 0x6f, 0x64, 0x61, 0x74, 0x10, 0x8d,
-	0x02, 0x12, 0x0e, 0x0a, 0x09, 0x66, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x61, 0x74, 0x10, 0x8e,
-	0x02, 0x12, 0x0d, 0x0a, 0x08, 0x70, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x36, 0x10, 0x8f, 0x02,
-	0x12, 0x0a, 0x0a, 0x05, 0x70, 0x70, 0x6f, 0x6c, 0x6c, 0x10, 0x90, 0x02, 0x12, 0x0c, 0x0a, 0x07,
-	0x75, 0x6e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x10, 0x91, 0x02, 0x12, 0x14, 0x0a, 0x0f, 0x73, 0x65,
-	0x74, 0x5f, 0x72, 0x6f, 0x62, 0x75, 0x73, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x10, 0x92, 0x02,
-	0x12, 0x14, 0x0a, 0x0f, 0x67, 0x65, 0x74, 0x5f, 0x72, 0x6f, 0x62, 0x75, 0x73, 0x74, 0x5f, 0x6c,
-	0x69, 0x73, 0x74, 0x10, 0x93, 0x02, 0x12, 0x0b, 0x0a, 0x06, 0x73, 0x70, 0x6c, 0x69, 0x63, 0x65,
-	0x10, 0x94, 0x02, 0x12, 0x08, 0x0a, 0x03, 0x74, 0x65, 0x65, 0x10, 0x95, 0x02, 0x12, 0x14, 0x0a,
-	0x0f, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65,
-	0x10, 0x96, 0x02, 0x12, 0x0d, 0x0a, 0x08, 0x76, 0x6d, 0x73, 0x70, 0x6c, 0x69, 0x63, 0x65, 0x10,
-	0x97, 0x02, 0x12, 0x0f, 0x0a, 0x0a, 0x6d, 0x6f, 0x76, 0x65, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x73,
-	0x10, 0x98, 0x02, 0x12, 0x0e, 0x0a, 0x09, 0x75, 0x74, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x61, 0x74,
-	0x10, 0x99, 0x02, 0x12, 0x10, 0x0a, 0x0b, 0x65, 0x70, 0x6f, 0x6c, 0x6c, 0x5f, 0x70, 0x77, 0x61,
-	0x69, 0x74, 0x10, 0x9a, 0x02, 0x12, 0x0d, 0x0a, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x66,
-	0x64, 0x10, 0x9b, 0x02, 0x12, 0x13, 0x0a, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x66, 0x64, 0x5f,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x10, 0x9c, 0x02, 0x12, 0x0c, 0x0a, 0x07, 0x65, 0x76, 0x65,
-	0x6e, 0x74, 0x66, 0x64, 0x10, 0x9d, 0x02, 0x12, 0x0e, 0x0a, 0x09, 0x66, 0x61, 0x6c, 0x6c, 0x6f,
-	0x63, 0x61, 0x74, 0x65, 0x10, 0x9e, 0x02, 0x12, 0x14, 0x0a, 0x0f, 0x74, 0x69, 0x6d, 0x65, 0x72,
-	0x66, 0x64, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6d, 0x65, 0x10, 0x9f, 0x02, 0x12, 0x14, 0x0a,
-	0x0f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x66, 0x64, 0x5f, 0x67, 0x65, 0x74, 0x74, 0x69, 0x6d, 0x65,
-	0x10, 0xa0, 0x02, 0x12, 0x0c, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x34, 0x10, 0xa1,
-	0x02, 0x12, 0x0e, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x66, 0x64, 0x34, 0x10, 0xa2,
-	0x02, 0x12, 0x0d, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x66, 0x64, 0x32, 0x10, 0xa3, 0x02,
-	0x12, 0x12, 0x0a, 0x0d, 0x65, 0x70, 0x6f, 0x6c, 0x6c, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x31, 0x10, 0xa4, 0x02, 0x12, 0x09, 0x0a, 0x04, 0x64, 0x75, 0x70, 0x33, 0x10, 0xa5, 0x02, 0x12,
-	0x0a, 0x0a, 0x05, 0x70, 0x69, 0x70, 0x65, 0x32, 0x10, 0xa6, 0x02, 0x12, 0x12, 0x0a, 0x0d, 0x69,
-	0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x5f, 0x69, 0x6e, 0x69, 0x74, 0x31, 0x10, 0xa7, 0x02, 0x12,
-	0x0b, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x61, 0x64, 0x76, 0x10, 0xa8, 0x02, 0x12, 0x0c, 0x0a, 0x07,
-	0x70, 0x77, 0x72, 0x69, 0x74, 0x65, 0x76, 0x10, 0xa9, 0x02, 0x12, 0x16, 0x0a, 0x11, 0x72, 0x74,
-	0x5f, 0x74, 0x67, 0x73, 0x69, 0x67, 0x71, 0x75, 0x65, 0x75, 0x65, 0x69, 0x6e, 0x66, 0x6f, 0x10,
-	0xaa, 0x02, 0x12, 0x14, 0x0a, 0x0f, 0x70, 0x65, 0x72, 0x66, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74,
-	0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x10, 0xab, 0x02, 0x12, 0x0d, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x76,
-	0x6d, 0x6d, 0x73, 0x67, 0x10, 0xac, 0x02, 0x12, 0x12, 0x0a, 0x0d, 0x66, 0x61, 0x6e, 0x6f, 0x74,
-	0x69, 0x66, 0x79, 0x5f, 0x69, 0x6e, 0x69, 0x74, 0x10, 0xad, 0x02, 0x12, 0x12, 0x0a, 0x0d, 0x66,
-	0x61, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x5f, 0x6d, 0x61, 0x72, 0x6b, 0x10, 0xae, 0x02, 0x12,
-	0x0e, 0x0a, 0x09, 0x70, 0x72, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x36, 0x34, 0x10, 0xaf, 0x02, 0x12,
-	0x16, 0x0a, 0x11, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x5f, 0x68, 0x61, 0x6e, 0x64, 0x6c,
-	0x65, 0x5f, 0x61, 0x74, 0x10, 0xb0, 0x02, 0x12, 0x16, 0x0a, 0x11, 0x6f, 0x70, 0x65, 0x6e, 0x5f,
-	0x62, 0x79, 0x5f, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x5f, 0x61, 0x74, 0x10, 0xb1, 0x02, 0x12,
-	0x12, 0x0a, 0x0d, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x61, 0x64, 0x6a, 0x74, 0x69, 0x6d, 0x65,
-	0x10, 0xb2, 0x02, 0x12, 0x0b, 0x0a, 0x06, 0x73, 0x79, 0x6e, 0x63, 0x66, 0x73, 0x10, 0xb3, 0x02,
-	0x12, 0x0d, 0x0a, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x6d, 0x6d, 0x73, 0x67, 0x10, 0xb4, 0x02, 0x12,
-	0x0a, 0x0a, 0x05, 0x73, 0x65, 0x74, 0x6e, 0x73, 0x10, 0xb5, 0x02, 0x12, 0x0b, 0x0a, 0x06, 0x67,
-	0x65, 0x74, 0x63, 0x70, 0x75, 0x10, 0xb6, 0x02, 0x12, 0x15, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x63,
-	0x65, 0x73, 0x73, 0x5f, 0x76, 0x6d, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x76, 0x10, 0xb7, 0x02, 0x12,
-	0x16, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x76, 0x6d, 0x5f, 0x77, 0x72,
-	0x69, 0x74, 0x65, 0x76, 0x10, 0xb8, 0x02, 0x12, 0x09, 0x0a, 0x04, 0x6b, 0x63, 0x6d, 0x70, 0x10,
-	0xb9, 0x02, 0x12, 0x11, 0x0a, 0x0c, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x75,
-	0x6c, 0x65, 0x10, 0xba, 0x02, 0x12, 0x12, 0x0a, 0x0d, 0x73, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x73,
-	0x65, 0x74, 0x61, 0x74, 0x74, 0x72, 0x10, 0xbb, 0x02, 0x12, 0x12, 0x0a, 0x0d, 0x73, 0x63, 0x68,
-	0x65, 0x64, 0x5f, 0x67, 0x65, 0x74, 0x61, 0x74, 0x74, 0x72, 0x10, 0xbc, 0x02, 0x12, 0x0e, 0x0a,
-	0x09, 0x72, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x61, 0x74, 0x32, 0x10, 0xbd, 0x02, 0x12, 0x0c, 0x0a,
-	0x07, 0x73, 0x65, 0x63, 0x63, 0x6f, 0x6d, 0x70, 0x10, 0xbe, 0x02, 0x12, 0x0e, 0x0a, 0x09, 0x67,
-	0x65, 0x74, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x10, 0xbf, 0x02, 0x12, 0x11, 0x0a, 0x0c, 0x6d,
-	0x65, 0x6d, 0x66, 0x64, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x10, 0xc0, 0x02, 0x12, 0x14,
-	0x0a, 0x0f, 0x6b, 0x65, 0x78, 0x65, 0x63, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6c, 0x6f, 0x61,
-	0x64, 0x10, 0xc1, 0x02, 0x12, 0x08, 0x0a, 0x03, 0x62, 0x70, 0x66, 0x10, 0xc2, 0x02, 0x12, 0x0d,
-	0x0a, 0x08, 0x65, 0x78, 0x65, 0x63, 0x76, 0x65, 0x61, 0x74, 0x10, 0xc3, 0x02, 0x12, 0x10, 0x0a,
-	0x0b, 0x75, 0x73, 0x65, 0x72, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x66, 0x64, 0x10, 0xc4, 0x02, 0x12,
-	0x0f, 0x0a, 0x0a, 0x6d, 0x65, 0x6d, 0x62, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x10, 0xc5, 0x02,
-	0x12, 0x0b, 0x0a, 0x06, 0x6d, 0x6c, 0x6f, 0x63, 0x6b, 0x32, 0x10, 0xc6, 0x02, 0x12, 0x14, 0x0a,
-	0x0f, 0x63, 0x6f, 0x70, 0x79, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65,
-	0x10, 0xc7, 0x02, 0x12, 0x0c, 0x0a, 0x07, 0x70, 0x72, 0x65, 0x61, 0x64, 0x76, 0x32, 0x10, 0xc8,
-	0x02, 0x12, 0x0d, 0x0a, 0x08, 0x70, 0x77, 0x72, 0x69, 0x74, 0x65, 0x76, 0x32, 0x10, 0xc9, 0x02,
-	0x12, 0x12, 0x0a, 0x0d, 0x70, 0x6b, 0x65, 0x79, 0x5f, 0x6d, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63,
-	0x74, 0x10, 0xca, 0x02, 0x12, 0x0f, 0x0a, 0x0a, 0x70, 0x6b, 0x65, 0x79, 0x5f, 0x61, 0x6c, 0x6c,
-	0x6f, 0x63, 0x10, 0xcb, 0x02, 0x12, 0x0e, 0x0a, 0x09, 0x70, 0x6b, 0x65, 0x79, 0x5f, 0x66, 0x72,
-	0x65, 0x65, 0x10, 0xcc, 0x02, 0x12, 0x0a, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x78, 0x10, 0xcd,
-	0x02, 0x12, 0x12, 0x0a, 0x0d, 0x69, 0x6f, 0x5f, 0x70, 0x67, 0x65, 0x74, 0x65, 0x76, 0x65, 0x6e,
-	0x74, 0x73, 0x10, 0xce, 0x02, 0x12, 0x09, 0x0a, 0x04, 0x72, 0x73, 0x65, 0x71, 0x10, 0xcf, 0x02,
-	0x12, 0x16, 0x0a, 0x11, 0x70, 0x69, 0x64, 0x66, 0x64, 0x5f, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x73,
-	0x69, 0x67, 0x6e, 0x61, 0x6c, 0x10, 0xd0, 0x02, 0x12, 0x13, 0x0a, 0x0e, 0x69, 0x6f, 0x5f, 0x75,
-	0x72, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x65, 0x74, 0x75, 0x70, 0x10, 0xd1, 0x02, 0x12, 0x13, 0x0a,
-	0x0e, 0x69, 0x6f, 0x5f, 0x75, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x10,
-	0xd2, 0x02, 0x12, 0x16, 0x0a, 0x11, 0x69, 0x6f, 0x5f, 0x75, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x72,
-	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x10, 0xd3, 0x02, 0x12, 0x0e, 0x0a, 0x09, 0x6f, 0x70,
-	0x65, 0x6e, 0x5f, 0x74, 0x72, 0x65, 0x65, 0x10, 0xd4, 0x02, 0x12, 0x0f, 0x0a, 0x0a, 0x6d, 0x6f,
-	0x76, 0x65, 0x5f, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x10, 0xd5, 0x02, 0x12, 0x0b, 0x0a, 0x06, 0x66,
-	0x73, 0x6f, 0x70, 0x65, 0x6e, 0x10, 0xd6, 0x02, 0x12, 0x0d, 0x0a, 0x08, 0x66, 0x73, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x10, 0xd7, 0x02, 0x12, 0x0c, 0x0a, 0x07, 0x66, 0x73, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x10, 0xd8, 0x02, 0x12, 0x0b, 0x0a, 0x06, 0x66, 0x73, 0x70, 0x69, 0x63, 0x6b, 0x10,
-	0xd9, 0x02, 0x12, 0x0f, 0x0a, 0x0a, 0x70, 0x69, 0x64, 0x66, 0x64, 0x5f, 0x6f, 0x70, 0x65, 0x6e,
-	0x10, 0xda, 0x02, 0x12, 0x0b, 0x0a, 0x06, 0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x33, 0x10, 0xdb, 0x02,
-	0x12, 0x10, 0x0a, 0x0b, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x10,
-	0xdc, 0x02, 0x12, 0x0c, 0x0a, 0x07, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x74, 0x32, 0x10, 0xdd, 0x02,
-	0x12, 0x10, 0x0a, 0x0b, 0x70, 0x69, 0x64, 0x66, 0x64, 0x5f, 0x67, 0x65, 0x74, 0x66, 0x64, 0x10,
-	0xde, 0x02, 0x12, 0x0f, 0x0a, 0x0a, 0x66, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x61, 0x74, 0x32,
-	0x10, 0xdf, 0x02, 0x12, 0x14, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x6d,
-	0x61, 0x64, 0x76, 0x69, 0x73, 0x65, 0x10, 0xe0, 0x02, 0x12, 0x11, 0x0a, 0x0c, 0x65, 0x70, 0x6f,
-	0x6c, 0x6c, 0x5f, 0x70, 0x77, 0x61, 0x69, 0x74, 0x32, 0x10, 0xe1, 0x02, 0x12, 0x12, 0x0a, 0x0d,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x74, 0x61, 0x74, 0x74, 0x72, 0x10, 0xe2, 0x02,
-	0x12, 0x10, 0x0a, 0x0b, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x63, 0x74, 0x6c, 0x5f, 0x66, 0x64, 0x10,
-	0xe3, 0x02, 0x12, 0x1c, 0x0a, 0x17, 0x6c, 0x61, 0x6e, 0x64, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x65, 0x74, 0x10, 0xe4, 0x02,
-	0x12, 0x16, 0x0a, 0x11, 0x6c, 0x61, 0x6e, 0x64, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x61, 0x64, 0x64,
-	0x5f, 0x72, 0x75, 0x6c, 0x65, 0x10, 0xe5, 0x02, 0x12, 0x1b, 0x0a, 0x16, 0x6c, 0x61, 0x6e, 0x64,
-	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x5f, 0x73, 0x65,
-	0x6c, 0x66, 0x10, 0xe6, 0x02, 0x12, 0x11, 0x0a, 0x0c, 0x6d, 0x65, 0x6d, 0x66, 0x64, 0x5f, 0x73,
-	0x65, 0x63, 0x72, 0x65, 0x74, 0x10, 0xe7, 0x02, 0x12, 0x15, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x63,
-	0x65, 0x73, 0x73, 0x5f, 0x6d, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x10, 0xe8, 0x02, 0x12,
-	0x0c, 0x0a, 0x07, 0x77, 0x61, 0x69, 0x74, 0x70, 0x69, 0x64, 0x10, 0xe9, 0x02, 0x12, 0x0d, 0x0a,
-	0x08, 0x6f, 0x6c, 0x64, 0x66, 0x73, 0x74, 0x61, 0x74, 0x10, 0xea, 0x02, 0x12, 0x0a, 0x0a, 0x05,
-	0x62, 0x72, 0x65, 0x61, 0x6b, 0x10, 0xeb, 0x02, 0x12, 0x0c, 0x0a, 0x07, 0x6f, 0x6c, 0x64, 0x73,
-	0x74, 0x61, 0x74, 0x10, 0xec, 0x02, 0x12, 0x0b, 0x0a, 0x06, 0x75, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x10, 0xed, 0x02, 0x12, 0x0a, 0x0a, 0x05, 0x73, 0x74, 0x69, 0x6d, 0x65, 0x10, 0xee, 0x02, 0x12,
-	0x09, 0x0a, 0x04, 0x73, 0x74, 0x74, 0x79, 0x10, 0xef, 0x02, 0x12, 0x09, 0x0a, 0x04, 0x67, 0x74,
-	0x74, 0x79, 0x10, 0xf0, 0x02, 0x12, 0x09, 0x0a, 0x04, 0x6e, 0x69, 0x63, 0x65, 0x10, 0xf1, 0x02,
-	0x12, 0x0a, 0x0a, 0x05, 0x66, 0x74, 0x69, 0x6d, 0x65, 0x10, 0xf2, 0x02, 0x12, 0x09, 0x0a, 0x04,
-	0x70, 0x72, 0x6f, 0x66, 0x10, 0xf3, 0x02, 0x12, 0x0b, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x61,
-	0x6c, 0x10, 0xf4, 0x02, 0x12, 0x09, 0x0a, 0x04, 0x6c, 0x6f, 0x63, 0x6b, 0x10, 0xf5, 0x02, 0x12,
-	0x08, 0x0a, 0x03, 0x6d, 0x70, 0x78, 0x10, 0xf6, 0x02, 0x12, 0x0b, 0x0a, 0x06, 0x75, 0x6c, 0x69,
-	0x6d, 0x69, 0x74, 0x10, 0xf7, 0x02, 0x12, 0x10, 0x0a, 0x0b, 0x6f, 0x6c, 0x64, 0x6f, 0x6c, 0x64,
-	0x75, 0x6e, 0x61, 0x6d, 0x65, 0x10, 0xf8, 0x02, 0x12, 0x0e, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0xf9, 0x02, 0x12, 0x0d, 0x0a, 0x08, 0x73, 0x67, 0x65, 0x74,
-	0x6d, 0x61, 0x73, 0x6b, 0x10, 0xfa, 0x02, 0x12, 0x0d, 0x0a, 0x08, 0x73, 0x73, 0x65, 0x74, 0x6d,
-	0x61, 0x73, 0x6b, 0x10, 0xfb, 0x02, 0x12, 0x0f, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x73, 0x75, 0x73,
-	0x70, 0x65, 0x6e, 0x64, 0x10, 0xfc, 0x02, 0x12, 0x0f, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x70, 0x65,
-	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x10, 0xfd, 0x02, 0x12, 0x0d, 0x0a, 0x08, 0x6f, 0x6c, 0x64, 0x6c,
-	0x73, 0x74, 0x61, 0x74, 0x10, 0xfe, 0x02, 0x12, 0x0c, 0x0a, 0x07, 0x72, 0x65, 0x61, 0x64, 0x64,
-	0x69, 0x72, 0x10, 0xff, 0x02, 0x12, 0x0b, 0x0a, 0x06, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x10,
-	0x80, 0x03, 0x12, 0x0f, 0x0a, 0x0a, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x63, 0x61, 0x6c, 0x6c,
-	0x10, 0x81, 0x03, 0x12, 0x0d, 0x0a, 0x08, 0x6f, 0x6c, 0x64, 0x75, 0x6e, 0x61, 0x6d, 0x65, 0x10,
-	0x82, 0x03, 0x12, 0x09, 0x0a, 0x04, 0x69, 0x64, 0x6c, 0x65, 0x10, 0x83, 0x03, 0x12, 0x0c, 0x0a,
-	0x07, 0x76, 0x6d, 0x38, 0x36, 0x6f, 0x6c, 0x64, 0x10, 0x84, 0x03, 0x12, 0x08, 0x0a, 0x03, 0x69,
-	0x70, 0x63, 0x10, 0x85, 0x03, 0x12, 0x0e, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x72, 0x65, 0x74, 0x75,
-	0x72, 0x6e, 0x10, 0x86, 0x03, 0x12, 0x10, 0x0a, 0x0b, 0x73, 0x69, 0x67, 0x70, 0x72, 0x6f, 0x63,
-	0x6d, 0x61, 0x73, 0x6b, 0x10, 0x87, 0x03, 0x12, 0x0c, 0x0a, 0x07, 0x62, 0x64, 0x66, 0x6c, 0x75,
-	0x73, 0x68, 0x10, 0x88, 0x03, 0x12, 0x10, 0x0a, 0x0b, 0x61, 0x66, 0x73, 0x5f, 0x73, 0x79, 0x73,
-	0x63, 0x61, 0x6c, 0x6c, 0x10, 0x89, 0x03, 0x12, 0x0b, 0x0a, 0x06, 0x6c, 0x6c, 0x73, 0x65, 0x65,
-	0x6b, 0x10, 0x8a, 0x03, 0x12, 0x0f, 0x0a, 0x0a, 0x6f, 0x6c, 0x64, 0x5f, 0x73, 0x65, 0x6c, 0x65,
-	0x63, 0x74, 0x10, 0x8b, 0x03, 0x12, 0x09, 0x0a, 0x04, 0x76, 0x6d, 0x38, 0x36, 0x10, 0x8c, 0x03,
-	0x12, 0x12, 0x0a, 0x0d, 0x6f, 0x6c, 0x64, 0x5f, 0x67, 0x65, 0x74, 0x72, 0x6c, 0x69, 0x6d, 0x69,
-	0x74, 0x10, 0x8d, 0x03, 0x12, 0x0a, 0x0a, 0x05, 0x6d, 0x6d, 0x61, 0x70, 0x32, 0x10, 0x8e, 0x03,
-	0x12, 0x0f, 0x0a, 0x0a, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x36, 0x34, 0x10, 0x8f,
-	0x03, 0x12, 0x10, 0x0a, 0x0b, 0x66, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x36, 0x34,
-	0x10, 0x90, 0x03, 0x12, 0x0b, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x36, 0x34, 0x10, 0x91, 0x03,
-	0x12, 0x0c, 0x0a, 0x07, 0x6c, 0x73, 0x74, 0x61, 0x74, 0x36, 0x34, 0x10, 0x92, 0x03, 0x12, 0x0c,
-	0x0a, 0x07, 0x66, 0x73, 0x74, 0x61, 0x74, 0x36, 0x34, 0x10, 0x93, 0x03, 0x12, 0x0d, 0x0a, 0x08,
-	0x6c, 0x63, 0x68, 0x6f, 0x77, 0x6e, 0x31, 0x36, 0x10, 0x94, 0x03, 0x12, 0x0d, 0x0a, 0x08, 0x67,
-	0x65, 0x74, 0x75, 0x69, 0x64, 0x31, 0x36, 0x10, 0x95, 0x03, 0x12, 0x0d, 0x0a, 0x08, 0x67, 0x65,
-	0x74, 0x67, 0x69, 0x64, 0x31, 0x36, 0x10, 0x96, 0x03, 0x12, 0x0e, 0x0a, 0x09, 0x67, 0x65, 0x74,
-	0x65, 0x75, 0x69, 0x64, 0x31, 0x36, 0x10, 0x97, 0x03, 0x12, 0x0e, 0x0a, 0x09, 0x67, 0x65, 0x74,
-	0x65, 0x67, 0x69, 0x64, 0x31, 0x36, 0x10, 0x98, 0x03, 0x12, 0x0f, 0x0a, 0x0a, 0x73, 0x65, 0x74,
-	0x72, 0x65, 0x75, 0x69, 0x64, 0x31, 0x36, 0x10, 0x99, 0x03, 0x12, 0x0f, 0x0a, 0x0a, 0x73, 0x65,
-	0x74, 0x72, 0x65, 0x67, 0x69, 0x64, 0x31, 0x36, 0x10, 0x9a, 0x03, 0x12, 0x10, 0x0a, 0x0b, 0x67,
-	0x65, 0x74, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x31, 0x36, 0x10, 0x9b, 0x03, 0x12, 0x10, 0x0a,
-	0x0b, 0x73, 0x65, 0x74, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x31, 0x36, 0x10, 0x9c, 0x03, 0x12,
-	0x0d, 0x0a, 0x08, 0x66, 0x63, 0x68, 0x6f, 0x77, 0x6e, 0x31, 0x36, 0x10, 0x9d, 0x03, 0x12, 0x10,
-	0x0a, 0x0b, 0x73, 0x65, 0x74, 0x72, 0x65, 0x73, 0x75, 0x69, 0x64, 0x31, 0x36, 0x10, 0x9e, 0x03,
-	0x12, 0x10, 0x0a, 0x0b, 0x67, 0x65, 0x74, 0x72, 0x65, 0x73, 0x75, 0x69, 0x64, 0x31, 0x36, 0x10,
-	0x9f, 0x03, 0x12, 0x10, 0x0a, 0x0b, 0x73, 0x65, 0x74, 0x72, 0x65, 0x73, 0x67, 0x69, 0x64, 0x31,
-	0x36, 0x10, 0xa0, 0x03, 0x12, 0x10, 0x0a, 0x0b, 0x67, 0x65, 0x74, 0x72, 0x65, 0x73, 0x67, 0x69,
-	0x64, 0x31, 0x36, 0x10, 0xa1, 0x03, 0x12, 0x0c, 0x0a, 0x07, 0x63, 0x68, 0x6f, 0x77, 0x6e, 0x31,
-	0x36, 0x10, 0xa2, 0x03, 0x12, 0x0d, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x75, 0x69, 0x64, 0x31, 0x36,
-	0x10, 0xa3, 0x03, 0x12, 0x0d, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x67, 0x69, 0x64, 0x31, 0x36, 0x10,
-	0xa4, 0x03, 0x12, 0x0f, 0x0a, 0x0a, 0x73, 0x65, 0x74, 0x66, 0x73, 0x75, 0x69, 0x64, 0x31, 0x36,
-	0x10, 0xa5, 0x03, 0x12, 0x0f, 0x0a, 0x0a, 0x73, 0x65, 0x74, 0x66, 0x73, 0x67, 0x69, 0x64, 0x31,
-	0x36, 0x10, 0xa6, 0x03, 0x12, 0x0c, 0x0a, 0x07, 0x66, 0x63, 0x6e, 0x74, 0x6c, 0x36, 0x34, 0x10,
-	0xa7, 0x03, 0x12, 0x0f, 0x0a, 0x0a, 0x73, 0x65, 0x6e, 0x64, 0x66, 0x69, 0x6c, 0x65, 0x33, 0x32,
-	0x10, 0xa8, 0x03, 0x12, 0x0d, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74, 0x66, 0x73, 0x36, 0x34, 0x10,
-	0xa9, 0x03, 0x12, 0x0e, 0x0a, 0x09, 0x66, 0x73, 0x74, 0x61, 0x74, 0x66, 0x73, 0x36, 0x34, 0x10,
-	0xaa, 0x03, 0x12, 0x11, 0x0a, 0x0c, 0x66, 0x61, 0x64, 0x76, 0x69, 0x73, 0x65, 0x36, 0x34, 0x5f,
-	0x36, 0x34, 0x10, 0xab, 0x03, 0x12, 0x14, 0x0a, 0x0f, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x67,
-	0x65, 0x74, 0x74, 0x69, 0x6d, 0x65, 0x33, 0x32, 0x10, 0xac, 0x03, 0x12, 0x14, 0x0a, 0x0f, 0x63,
-	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6d, 0x65, 0x33, 0x32, 0x10, 0xad,
-	0x03, 0x12, 0x14, 0x0a, 0x0f, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x61, 0x64, 0x6a, 0x74, 0x69,
-	0x6d, 0x65, 0x36, 0x34, 0x10, 0xae, 0x03, 0x12, 0x18, 0x0a, 0x13, 0x63, 0x6c, 0x6f, 0x63, 0x6b,
-	0x5f, 0x67, 0x65, 0x74, 0x72, 0x65, 0x73, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x33, 0x32, 0x10, 0xaf,
-	0x03, 0x12, 0x1b, 0x0a, 0x16, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x61, 0x6e, 0x6f, 0x73,
-	0x6c, 0x65, 0x65, 0x70, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x33, 0x32, 0x10, 0xb0, 0x03, 0x12, 0x14,
-	0x0a, 0x0f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x5f, 0x67, 0x65, 0x74, 0x74, 0x69, 0x6d, 0x65, 0x33,
-	0x32, 0x10, 0xb1, 0x03, 0x12, 0x14, 0x0a, 0x0f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x5f, 0x73, 0x65,
-	0x74, 0x74, 0x69, 0x6d, 0x65, 0x33, 0x32, 0x10, 0xb2, 0x03, 0x12, 0x16, 0x0a, 0x11, 0x74, 0x69,
-	0x6d, 0x65, 0x72, 0x66, 0x64, 0x5f, 0x67, 0x65, 0x74, 0x74, 0x69, 0x6d, 0x65, 0x33, 0x32, 0x10,
-	0xb3, 0x03, 0x12, 0x16, 0x0a, 0x11, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x66, 0x64, 0x5f, 0x73, 0x65,
-	0x74, 0x74, 0x69, 0x6d, 0x65, 0x33, 0x32, 0x10, 0xb4, 0x03, 0x12, 0x15, 0x0a, 0x10, 0x75, 0x74,
-	0x69, 0x6d, 0x65, 0x6e, 0x73, 0x61, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x33, 0x32, 0x10, 0xb5,
-	0x03, 0x12, 0x14, 0x0a, 0x0f, 0x70, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x36, 0x5f, 0x74, 0x69,
-	0x6d, 0x65, 0x33, 0x32, 0x10, 0xb6, 0x03, 0x12, 0x11, 0x0a, 0x0c, 0x70, 0x70, 0x6f, 0x6c, 0x6c,
-	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x33, 0x32, 0x10, 0xb7, 0x03, 0x12, 0x19, 0x0a, 0x14, 0x69, 0x6f,
-	0x5f, 0x70, 0x67, 0x65, 0x74, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x74, 0x69, 0x6d, 0x65,
-	0x33, 0x32, 0x10, 0xb8, 0x03, 0x12, 0x14, 0x0a, 0x0f, 0x72, 0x65, 0x63, 0x76, 0x6d, 0x6d, 0x73,
-	0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x33, 0x32, 0x10, 0xb9, 0x03, 0x12, 0x18, 0x0a, 0x13, 0x6d,
-	0x71, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x64, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65,
-	0x33, 0x32, 0x10, 0xba, 0x03, 0x12, 0x1b, 0x0a, 0x16, 0x6d, 0x71, 0x5f, 0x74, 0x69, 0x6d, 0x65,
-	0x64, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x33, 0x32, 0x10,
-	0xbb, 0x03, 0x12, 0x1b, 0x0a, 0x16, 0x72, 0x74, 0x5f, 0x73, 0x69, 0x67, 0x74, 0x69, 0x6d, 0x65,
-	0x64, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x33, 0x32, 0x10, 0xbc, 0x03, 0x12,
-	0x11, 0x0a, 0x0c, 0x66, 0x75, 0x74, 0x65, 0x78, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x33, 0x32, 0x10,
-	0xbd, 0x03, 0x12, 0x21, 0x0a, 0x1c, 0x73, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x72, 0x72, 0x5f, 0x67,
-	0x65, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65,
-	0x33, 0x32, 0x10, 0xbe, 0x03, 0x12, 0x14, 0x0a, 0x0f, 0x6e, 0x65, 0x74, 0x5f, 0x70, 0x61, 0x63,
-	0x6b, 0x65, 0x74, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x10, 0xe8, 0x07, 0x12, 0x13, 0x0a, 0x0e, 0x6e,
-	0x65, 0x74, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x72, 0x61, 0x77, 0x10, 0xe9, 0x07,
-	0x12, 0x17, 0x0a, 0x12, 0x6e, 0x65, 0x74, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x69,
-	0x70, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x10, 0xea, 0x07, 0x12, 0x18, 0x0a, 0x13, 0x6e, 0x65, 0x74,
-	0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x74, 0x63, 0x70, 0x5f, 0x62, 0x61, 0x73, 0x65,
-	0x10, 0xeb, 0x07, 0x12, 0x18, 0x0a, 0x13, 0x6e, 0x65, 0x74, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65,
-	0x74, 0x5f, 0x75, 0x64, 0x70, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x10, 0xec, 0x07, 0x12, 0x19, 0x0a,
-	0x14, 0x6e, 0x65, 0x74, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x63, 0x6d, 0x70,
-	0x5f, 0x62, 0x61, 0x73, 0x65, 0x10, 0xed, 0x07, 0x12, 0x1b, 0x0a, 0x16, 0x6e, 0x65, 0x74, 0x5f,
-	0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x63, 0x6d, 0x70, 0x76, 0x36, 0x5f, 0x62, 0x61,
-	0x73, 0x65, 0x10, 0xee, 0x07, 0x12, 0x18, 0x0a, 0x13, 0x6e, 0x65, 0x74, 0x5f, 0x70, 0x61, 0x63,
-	0x6b, 0x65, 0x74, 0x5f, 0x64, 0x6e, 0x73, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x10, 0xef, 0x07, 0x12,
-	0x19, 0x0a, 0x14, 0x6e, 0x65, 0x74, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x68, 0x74,
-	0x74, 0x70, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x10, 0xf0, 0x07, 0x12, 0x17, 0x0a, 0x12, 0x6e, 0x65,
-	0x74, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x63, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65,
-	0x10, 0xf1, 0x07, 0x12, 0x19, 0x0a, 0x14, 0x6e, 0x65, 0x74, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65,
-	0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x10, 0xf2, 0x07, 0x12, 0x0e,
-	0x0a, 0x09, 0x73, 0x79, 0x73, 0x5f, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x10, 0xf4, 0x07, 0x12, 0x0d,
-	0x0a, 0x08, 0x73, 0x79, 0x73, 0x5f, 0x65, 0x78, 0x69, 0x74, 0x10, 0xf5, 0x07, 0x12, 0x17, 0x0a,
-	0x12, 0x73, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x66,
-	0x6f, 0x72, 0x6b, 0x10, 0xf6, 0x07, 0x12, 0x17, 0x0a, 0x12, 0x73, 0x63, 0x68, 0x65, 0x64, 0x5f,
-	0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x10, 0xf7, 0x07, 0x12,
-	0x17, 0x0a, 0x12, 0x73, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
-	0x5f, 0x65, 0x78, 0x69, 0x74, 0x10, 0xf8, 0x07, 0x12, 0x11, 0x0a, 0x0c, 0x73, 0x63, 0x68, 0x65,
-	0x64, 0x5f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x10, 0xf9, 0x07, 0x12, 0x0c, 0x0a, 0x07, 0x64,
-	0x6f, 0x5f, 0x65, 0x78, 0x69, 0x74, 0x10, 0xfa, 0x07, 0x12, 0x10, 0x0a, 0x0b, 0x63, 0x61, 0x70,
-	0x5f, 0x63, 0x61, 0x70, 0x61, 0x62, 0x6c, 0x65, 0x10, 0xfb, 0x07, 0x12, 0x0e, 0x0a, 0x09, 0x76,
-	0x66, 0x73, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x10, 0xfc, 0x07, 0x12, 0x0f, 0x0a, 0x0a, 0x76,
-	0x66, 0x73, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x76, 0x10, 0xfd, 0x07, 0x12, 0x0d, 0x0a, 0x08,
-	0x76, 0x66, 0x73, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x10, 0xfe, 0x07, 0x12, 0x0e, 0x0a, 0x09, 0x76,
-	0x66, 0x73, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x76, 0x10, 0xff, 0x07, 0x12, 0x13, 0x0a, 0x0e, 0x6d,
-	0x65, 0x6d, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x5f, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x10, 0x80, 0x08,
-	0x12, 0x11, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x73,
-	0x10, 0x81, 0x08, 0x12, 0x13, 0x0a, 0x0e, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x5f, 0x74, 0x61,
-	0x73, 0x6b, 0x5f, 0x6e, 0x73, 0x10, 0x82, 0x08, 0x12, 0x10, 0x0a, 0x0b, 0x6d, 0x61, 0x67, 0x69,
-	0x63, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x10, 0x83, 0x08, 0x12, 0x17, 0x0a, 0x12, 0x63, 0x67,
-	0x72, 0x6f, 0x75, 0x70, 0x5f, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x5f, 0x74, 0x61, 0x73, 0x6b,
-	0x10, 0x84, 0x08, 0x12, 0x11, 0x0a, 0x0c, 0x63, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6d, 0x6b,
-	0x64, 0x69, 0x72, 0x10, 0x85, 0x08, 0x12, 0x11, 0x0a, 0x0c, 0x63, 0x67, 0x72, 0x6f, 0x75, 0x70,
-	0x5f, 0x72, 0x6d, 0x64, 0x69, 0x72, 0x10, 0x86, 0x08, 0x12, 0x18, 0x0a, 0x13, 0x73, 0x65, 0x63,
-	0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x62, 0x70, 0x72, 0x6d, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b,
-	0x10, 0x87, 0x08, 0x12, 0x17, 0x0a, 0x12, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f,
-	0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x10, 0x88, 0x08, 0x12, 0x1a, 0x0a, 0x15,
-	0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x75,
-	0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x10, 0x89, 0x08, 0x12, 0x1b, 0x0a, 0x16, 0x73, 0x65, 0x63, 0x75,
-	0x72, 0x69, 0x74, 0x79, 0x5f, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x10, 0x8a, 0x08, 0x12, 0x1b, 0x0a, 0x16, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74,
-	0x79, 0x5f, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x10,
-	0x8b, 0x08, 0x12, 0x1c, 0x0a, 0x17, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x73,
-	0x6f, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x10, 0x8c, 0x08,
-	0x12, 0x1b, 0x0a, 0x16, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x73, 0x6f, 0x63,
-	0x6b, 0x65, 0x74, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x10, 0x8d, 0x08, 0x12, 0x19, 0x0a,
-	0x14, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74,
-	0x5f, 0x62, 0x69, 0x6e, 0x64, 0x10, 0x8e, 0x08, 0x12, 0x1f, 0x0a, 0x1a, 0x73, 0x65, 0x63, 0x75,
-	0x72, 0x69, 0x74, 0x79, 0x5f, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x73, 0x65, 0x74, 0x73,
-	0x6f, 0x63, 0x6b, 0x6f, 0x70, 0x74, 0x10, 0x8f, 0x08, 0x12, 0x16, 0x0a, 0x11, 0x73, 0x65, 0x63,
-	0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x73, 0x62, 0x5f, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x10, 0x90,
-	0x08, 0x12, 0x11, 0x0a, 0x0c, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x62, 0x70,
-	0x66, 0x10, 0x91, 0x08, 0x12, 0x15, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79,
-	0x5f, 0x62, 0x70, 0x66, 0x5f, 0x6d, 0x61, 0x70, 0x10, 0x92, 0x08, 0x12, 0x1e, 0x0a, 0x19, 0x73,
-	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x5f, 0x72,
-	0x65, 0x61, 0x64, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x10, 0x93, 0x08, 0x12, 0x19, 0x0a, 0x14, 0x73,
-	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x6d, 0x6b,
-	0x6e, 0x6f, 0x64, 0x10, 0x94, 0x08, 0x12, 0x23, 0x0a, 0x1e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69,
-	0x74, 0x79, 0x5f, 0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x72,
-	0x65, 0x61, 0x64, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x10, 0x95, 0x08, 0x12, 0x1b, 0x0a, 0x16, 0x73,
-	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x73, 0x79,
-	0x6d, 0x6c, 0x69, 0x6e, 0x6b, 0x10, 0x96, 0x08, 0x12, 0x17, 0x0a, 0x12, 0x73, 0x65, 0x63, 0x75,
-	0x72, 0x69, 0x74, 0x79, 0x5f, 0x6d, 0x6d, 0x61, 0x70, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x10, 0x97,
-	0x08, 0x12, 0x1b, 0x0a, 0x16, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x66, 0x69,
-	0x6c, 0x65, 0x5f, 0x6d, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x10, 0x98, 0x08, 0x12, 0x0f,
-	0x0a, 0x0a, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x64, 0x75, 0x70, 0x10, 0x99, 0x08, 0x12,
-	0x12, 0x0a, 0x0d, 0x7a, 0x65, 0x72, 0x6f, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x6f, 0x64, 0x65, 0x73,
-	0x10, 0x9a, 0x08, 0x12, 0x13, 0x0a, 0x0e, 0x5f, 0x5f, 0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x5f,
-	0x77, 0x72, 0x69, 0x74, 0x65, 0x10, 0x9b, 0x08, 0x12, 0x10, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x63,
-	0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x10, 0x9c, 0x08, 0x12, 0x12, 0x0a, 0x0d, 0x6b, 0x70,
-	0x72, 0x6f, 0x62, 0x65, 0x5f, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x10, 0x9d, 0x08, 0x12, 0x18,
-	0x0a, 0x13, 0x63, 0x61, 0x6c, 0x6c, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x6d, 0x6f, 0x64, 0x65, 0x68,
-	0x65, 0x6c, 0x70, 0x65, 0x72, 0x10, 0x9e, 0x08, 0x12, 0x16, 0x0a, 0x11, 0x64, 0x69, 0x72, 0x74,
-	0x79, 0x5f, 0x70, 0x69, 0x70, 0x65, 0x5f, 0x73, 0x70, 0x6c, 0x69, 0x63, 0x65, 0x10, 0x9f, 0x08,
-	0x12, 0x18, 0x0a, 0x13, 0x64, 0x65, 0x62, 0x75, 0x67, 0x66, 0x73, 0x5f, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x10, 0xa0, 0x08, 0x12, 0x18, 0x0a, 0x13, 0x73, 0x79,
-	0x73, 0x63, 0x61, 0x6c, 0x6c, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x63, 0x68, 0x65, 0x63,
-	0x6b, 0x10, 0xa1, 0x08, 0x12, 0x17, 0x0a, 0x12, 0x64, 0x65, 0x62, 0x75, 0x67, 0x66, 0x73, 0x5f,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x64, 0x69, 0x72, 0x10, 0xa2, 0x08, 0x12, 0x0f, 0x0a,
-	0x0a, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x10, 0xa3, 0x08, 0x12, 0x14,
-	0x0a, 0x0f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x63, 0x68, 0x72, 0x64, 0x65,
-	0x76, 0x10, 0xa4, 0x08, 0x12, 0x19, 0x0a, 0x14, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x5f, 0x6f,
-	0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x10, 0xa5, 0x08, 0x12,
-	0x13, 0x0a, 0x0e, 0x64, 0x6f, 0x5f, 0x69, 0x6e, 0x69, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x75, 0x6c,
-	0x65, 0x10, 0xa6, 0x08, 0x12, 0x12, 0x0a, 0x0d, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x61,
-	0x63, 0x63, 0x65, 0x70, 0x74, 0x10, 0xa7, 0x08, 0x12, 0x13, 0x0a, 0x0e, 0x6c, 0x6f, 0x61, 0x64,
-	0x5f, 0x65, 0x6c, 0x66, 0x5f, 0x70, 0x68, 0x64, 0x72, 0x73, 0x10, 0xa8, 0x08, 0x12, 0x15, 0x0a,
-	0x10, 0x68, 0x6f, 0x6f, 0x6b, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x63, 0x5f, 0x66, 0x6f, 0x70,
-	0x73, 0x10, 0xa9, 0x08, 0x12, 0x16, 0x0a, 0x11, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x5f, 0x6e, 0x65,
-	0x74, 0x5f, 0x73, 0x65, 0x71, 0x5f, 0x6f, 0x70, 0x73, 0x10, 0xaa, 0x08, 0x12, 0x10, 0x0a, 0x0b,
-	0x74, 0x61, 0x73, 0x6b, 0x5f, 0x72, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x10, 0xab, 0x08, 0x12, 0x1a,
-	0x0a, 0x15, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x6e, 0x6f, 0x64, 0x65,
-	0x5f, 0x72, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x10, 0xac, 0x08, 0x12, 0x11, 0x0a, 0x0c, 0x64, 0x6f,
-	0x5f, 0x73, 0x69, 0x67, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0xad, 0x08, 0x12, 0x0f, 0x0a,
-	0x0a, 0x62, 0x70, 0x66, 0x5f, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x10, 0xae, 0x08, 0x12, 0x19,
-	0x0a, 0x14, 0x6b, 0x61, 0x6c, 0x6c, 0x73, 0x79, 0x6d, 0x73, 0x5f, 0x6c, 0x6f, 0x6f, 0x6b, 0x75,
-	0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x10, 0xaf, 0x08, 0x12, 0x0c, 0x0a, 0x07, 0x64, 0x6f, 0x5f,
-	0x6d, 0x6d, 0x61, 0x70, 0x10, 0xb0, 0x08, 0x12, 0x13, 0x0a, 0x0e, 0x70, 0x72, 0x69, 0x6e, 0x74,
-	0x5f, 0x6d, 0x65, 0x6d, 0x5f, 0x64, 0x75, 0x6d, 0x70, 0x10, 0xb1, 0x08, 0x12, 0x0f, 0x0a, 0x0a,
-	0x76, 0x66, 0x73, 0x5f, 0x75, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x10, 0xb2, 0x08, 0x12, 0x10, 0x0a,
-	0x0b, 0x64, 0x6f, 0x5f, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x10, 0xb3, 0x08, 0x12,
-	0x16, 0x0a, 0x11, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x10, 0xb4, 0x08, 0x12, 0x12, 0x0a, 0x0d, 0x69, 0x6e, 0x6f, 0x74, 0x69,
-	0x66, 0x79, 0x5f, 0x77, 0x61, 0x74, 0x63, 0x68, 0x10, 0xb5, 0x08, 0x12, 0x16, 0x0a, 0x11, 0x73,
-	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x62, 0x70, 0x66, 0x5f, 0x70, 0x72, 0x6f, 0x67,
-	0x10, 0xb6, 0x08, 0x12, 0x1b, 0x0a, 0x16, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x65,
-	0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0xb7, 0x08,
-	0x12, 0x19, 0x0a, 0x14, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x61, 0x74,
-	0x68, 0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x10, 0xb8, 0x08, 0x12, 0x0f, 0x0a, 0x0a, 0x73,
-	0x65, 0x74, 0x5f, 0x66, 0x73, 0x5f, 0x70, 0x77, 0x64, 0x10, 0xb9, 0x08, 0x12, 0x1e, 0x0a, 0x19,
-	0x73, 0x75, 0x73, 0x70, 0x69, 0x63, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x73, 0x79, 0x73, 0x63, 0x61,
-	0x6c, 0x6c, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x10, 0xba, 0x08, 0x12, 0x10, 0x0a, 0x0b,
-	0x73, 0x74, 0x61, 0x63, 0x6b, 0x5f, 0x70, 0x69, 0x76, 0x6f, 0x74, 0x10, 0xbb, 0x08, 0x12, 0x20,
-	0x0a, 0x1b, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x5f, 0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x5f,
-	0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x65, 0x6b, 0x65, 0x72, 0x10, 0xbc, 0x08,
-	0x12, 0x10, 0x0a, 0x0b, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x10,
-	0xbd, 0x08, 0x12, 0x10, 0x0a, 0x0b, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x66, 0x72, 0x65,
-	0x65, 0x10, 0xbe, 0x08, 0x12, 0x15, 0x0a, 0x10, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x5f,
-	0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x10, 0xbf, 0x08, 0x12, 0x24, 0x0a, 0x1f, 0x70,
-	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x5f, 0x66,
-	0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x10, 0xc0,
-	0x08, 0x12, 0x1c, 0x0a, 0x17, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x61,
-	0x73, 0x6b, 0x5f, 0x73, 0x65, 0x74, 0x72, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x10, 0xc1, 0x08, 0x12,
-	0x17, 0x0a, 0x12, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x73, 0x65, 0x74, 0x74,
-	0x69, 0x6d, 0x65, 0x36, 0x34, 0x10, 0xc2, 0x08, 0x12, 0x11, 0x0a, 0x0c, 0x63, 0x68, 0x6d, 0x6f,
-	0x64, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x10, 0xc3, 0x08, 0x12, 0x17, 0x0a, 0x12, 0x73,
-	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x73, 0x62, 0x5f, 0x75, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x10, 0xc4, 0x08, 0x12, 0x18, 0x0a, 0x13, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79,
-	0x5f, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x70, 0x72, 0x63, 0x74, 0x6c, 0x10, 0xc5, 0x08, 0x12, 0x14,
-	0x0a, 0x0f, 0x6e, 0x65, 0x74, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x70, 0x76,
-	0x34, 0x10, 0xd0, 0x0f, 0x12, 0x14, 0x0a, 0x0f, 0x6e, 0x65, 0x74, 0x5f, 0x70, 0x61, 0x63, 0x6b,
-	0x65, 0x74, 0x5f, 0x69, 0x70, 0x76, 0x36, 0x10, 0xd1, 0x0f, 0x12, 0x13, 0x0a, 0x0e, 0x6e, 0x65,
-	0x74, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x74, 0x63, 0x70, 0x10, 0xd2, 0x0f, 0x12,
-	0x13, 0x0a, 0x0e, 0x6e, 0x65, 0x74, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x75, 0x64,
-	0x70, 0x10, 0xd3, 0x0f, 0x12, 0x14, 0x0a, 0x0f, 0x6e, 0x65, 0x74, 0x5f, 0x70, 0x61, 0x63, 0x6b,
-	0x65, 0x74, 0x5f, 0x69, 0x63, 0x6d, 0x70, 0x10, 0xd4, 0x0f, 0x12, 0x16, 0x0a, 0x11, 0x6e, 0x65,
-	0x74, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x63, 0x6d, 0x70, 0x76, 0x36, 0x10,
-	0xd5, 0x0f, 0x12, 0x13, 0x0a, 0x0e, 0x6e, 0x65, 0x74, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74,
-	0x5f, 0x64, 0x6e, 0x73, 0x10, 0xd6, 0x0f, 0x12, 0x1b, 0x0a, 0x16, 0x6e, 0x65, 0x74, 0x5f, 0x70,
-	0x61, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x64, 0x6e, 0x73, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x10, 0xd7, 0x0f, 0x12, 0x1c, 0x0a, 0x17, 0x6e, 0x65, 0x74, 0x5f, 0x70, 0x61, 0x63, 0x6b,
-	0x65, 0x74, 0x5f, 0x64, 0x6e, 0x73, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x10,
-	0xd8, 0x0f, 0x12, 0x14, 0x0a, 0x0f, 0x6e, 0x65, 0x74, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74,
-	0x5f, 0x68, 0x74, 0x74, 0x70, 0x10, 0xd9, 0x0f, 0x12, 0x1c, 0x0a, 0x17, 0x6e, 0x65, 0x74, 0x5f,
-	0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x10, 0xda, 0x0f, 0x12, 0x1d, 0x0a, 0x18, 0x6e, 0x65, 0x74, 0x5f, 0x70, 0x61,
-	0x63, 0x6b, 0x65, 0x74, 0x5f, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x10, 0xdb, 0x0f, 0x12, 0x17, 0x0a, 0x12, 0x6e, 0x65, 0x74, 0x5f, 0x66, 0x6c, 0x6f,
-	0x77, 0x5f, 0x74, 0x63, 0x70, 0x5f, 0x62, 0x65, 0x67, 0x69, 0x6e, 0x10, 0xdc, 0x0f, 0x12, 0x15,
-	0x0a, 0x10, 0x6e, 0x65, 0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x74, 0x63, 0x70, 0x5f, 0x65,
-	0x6e, 0x64, 0x10, 0xdd, 0x0f, 0x12, 0x14, 0x0a, 0x0f, 0x6e, 0x65, 0x74, 0x5f, 0x74, 0x63, 0x70,
-	0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x10, 0xdf, 0x0f, 0x12, 0x14, 0x0a, 0x0f, 0x69,
-	0x6e, 0x69, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x10, 0xe0,
-	0x0f, 0x12, 0x15, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x10, 0xe1, 0x0f, 0x12, 0x15, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74,
-	0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x10, 0xe2, 0x0f, 0x12,
-	0x17, 0x0a, 0x12, 0x65, 0x78, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x6e, 0x74,
-	0x61, 0x69, 0x6e, 0x65, 0x72, 0x10, 0xe3, 0x0f, 0x12, 0x13, 0x0a, 0x0e, 0x68, 0x6f, 0x6f, 0x6b,
-	0x65, 0x64, 0x5f, 0x73, 0x79, 0x73, 0x63, 0x61, 0x6c, 0x6c, 0x10, 0xe4, 0x0f, 0x12, 0x13, 0x0a,
-	0x0e, 0x68, 0x6f, 0x6f, 0x6b, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x71, 0x5f, 0x6f, 0x70, 0x73, 0x10,
-	0xe5, 0x0f, 0x12, 0x13, 0x0a, 0x0e, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x5f, 0x6c, 0x6f,
-	0x61, 0x64, 0x65, 0x64, 0x10, 0xe6, 0x0f, 0x12, 0x16, 0x0a, 0x11, 0x73, 0x79, 0x6d, 0x62, 0x6f,
-	0x6c, 0x73, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x10, 0xe7, 0x0f, 0x12,
-	0x19, 0x0a, 0x14, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x5f, 0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c,
-	0x5f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x10, 0xe8, 0x0f, 0x12, 0x10, 0x0a, 0x0b, 0x66, 0x74,
-	0x72, 0x61, 0x63, 0x65, 0x5f, 0x68, 0x6f, 0x6f, 0x6b, 0x10, 0xe9, 0x0f, 0x12, 0x10, 0x0a, 0x0b,
-	0x74, 0x72, 0x61, 0x63, 0x65, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x10, 0xea, 0x0f, 0x22, 0x06,
-	0x08, 0xdc, 0x0b, 0x10, 0xcf, 0x0f, 0x22, 0x06, 0x08, 0xb8, 0x17, 0x10, 0x9f, 0x1f, 0x42, 0x2b,
-	0x5a, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x2f, 0x61, 0x71, 0x75, 0x61,
-	0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x2f, 0x74, 0x72, 0x61, 0x63, 0x65, 0x65, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x03, 0x74, 0x69,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x79, 0x73, 0x63, 0x61, 0x6c, 0x6c, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x73, 0x79, 0x73, 0x63, 0x61, 0x6c, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x63,
+	0x6f, 0x6d, 0x70, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x63, 0x6f, 0x6d,
+	0x70, 0x61, 0x74, 0x12, 0x4d, 0x0a, 0x10, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x63,
+	0x6b, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
+	0x74, 0x72, 0x61, 0x63, 0x65, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x48, 0x00, 0x52,
+	0x0e, 0x75, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x88,
+	0x01, 0x01, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x63,
+	0x6b, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x65, 0x22, 0x4c, 0x0a, 0x0e, 0x55, 0x73, 0x65, 0x72, 0x53,
+	0x74, 0x61, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x12, 0x3a, 0x0a, 0x09, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x74,
+	0x72, 0x61, 0x63, 0x65, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x74,
+	0x61, 0x63, 0x6b, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x65, 0x73, 0x22, 0x40, 0x0a, 0x0c, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x22, 0xa1, 0x03, 0x0a, 0x09, 0x43, 0x6f, 0x6e, 0x74,
+	0x61, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x05, 0x69, 0x6d, 0x61,
+	0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x65,
+	0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69,
+	0x6e, 0x65, 0x72, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x12, 0x3d, 0x0a, 0x06, 0x6c, 0x61, 0x62,
+	0x65, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x74, 0x72, 0x61, 0x63,
+	0x65, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61,
+	0x69, 0x6e, 0x65, 0x72, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x69, 0x76,
+	0x69, 0x6c, 0x65, 0x67, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x70, 0x72,
+	0x69, 0x76, 0x69, 0x6c, 0x65, 0x67, 0x65, 0x64, 0x12, 0x2d, 0x0a, 0x12, 0x61, 0x64, 0x64, 0x65,
+	0x64, 0x5f, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x07,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x61, 0x64, 0x64, 0x65, 0x64, 0x43, 0x61, 0x70, 0x61, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x68, 0x6f, 0x73, 0x74, 0x5f,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x68,
+	0x6f, 0x73, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x19, 0x0a, 0x08, 0x68, 0x6f,
+	0x73, 0x74, 0x5f, 0x70, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x6f,
+	0x73, 0x74, 0x50, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x70,
+	0x63, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x70, 0x63,
+	0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x57, 0x0a, 0x0e, 0x43,
+	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a,
+	0x0c, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x70, 0x6f, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x68, 0x0a, 0x03, 0x4b, 0x38, 0x73, 0x12, 0x25, 0x0a, 0x03, 0x70,
+	0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x65,
+	0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x6f, 0x64, 0x52, 0x03, 0x70,
+	0x6f, 0x64, 0x12, 0x3a, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x65, 0x65, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4b, 0x38, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x22, 0x9f,
+	0x01, 0x0a, 0x03, 0x50, 0x6f, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x37, 0x0a, 0x06,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x74,
+	0x72, 0x61, 0x63, 0x65, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x6f,
+	0x64, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c,
+	0x61, 0x62, 0x65, 0x6c, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0x22, 0x0a, 0x0c, 0x4b, 0x38, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x98, 0x01, 0x0a, 0x0c, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x65, 0x65,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x34, 0x0a, 0x06, 0x70, 0x61, 0x72,
+	0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x74, 0x72, 0x61, 0x63,
+	0x65, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x74, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x2a,
+	0xa3, 0x4f, 0x0a, 0x07, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x0f, 0x0a, 0x0b, 0x75,
+	0x6e, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04,
+	0x72, 0x65, 0x61, 0x64, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x77, 0x72, 0x69, 0x74, 0x65, 0x10,
+	0x02, 0x12, 0x08, 0x0a, 0x04, 0x6f, 0x70, 0x65, 0x6e, 0x10, 0x03, 0x12, 0x09, 0x0a, 0x05, 0x63,
+	0x6c, 0x6f, 0x73, 0x65, 0x10, 0x04, 0x12, 0x08, 0x0a, 0x04, 0x73, 0x74, 0x61, 0x74, 0x10, 0x05,
+	0x12, 0x09, 0x0a, 0x05, 0x66, 0x73, 0x74, 0x61, 0x74, 0x10, 0x06, 0x12, 0x09, 0x0a, 0x05, 0x6c,
+	0x73, 0x74, 0x61, 0x74, 0x10, 0x07, 0x12, 0x08, 0x0a, 0x04, 0x70, 0x6f, 0x6c, 0x6c, 0x10, 0x08,
+	0x12, 0x09, 0x0a, 0x05, 0x6c, 0x73, 0x65, 0x65, 0x6b, 0x10, 0x09, 0x12, 0x08, 0x0a, 0x04, 0x6d,
+	0x6d, 0x61, 0x70, 0x10, 0x0a, 0x12, 0x0c, 0x0a, 0x08, 0x6d, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63,
+	0x74, 0x10, 0x0b, 0x12, 0x0a, 0x0a, 0x06, 0x6d, 0x75, 0x6e, 0x6d, 0x61, 0x70, 0x10, 0x0c, 0x12,
+	0x07, 0x0a, 0x03, 0x62, 0x72, 0x6b, 0x10, 0x0d, 0x12, 0x10, 0x0a, 0x0c, 0x72, 0x74, 0x5f, 0x73,
+	0x69, 0x67, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x0e, 0x12, 0x12, 0x0a, 0x0e, 0x72, 0x74,
+	0x5f, 0x73, 0x69, 0x67, 0x70, 0x72, 0x6f, 0x63, 0x6d, 0x61, 0x73, 0x6b, 0x10, 0x0f, 0x12, 0x10,
+	0x0a, 0x0c, 0x72, 0x74, 0x5f, 0x73, 0x69, 0x67, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x10, 0x10,
+	0x12, 0x09, 0x0a, 0x05, 0x69, 0x6f, 0x63, 0x74, 0x6c, 0x10, 0x11, 0x12, 0x0b, 0x0a, 0x07, 0x70,
+	0x72, 0x65, 0x61, 0x64, 0x36, 0x34, 0x10, 0x12, 0x12, 0x0c, 0x0a, 0x08, 0x70, 0x77, 0x72, 0x69,
+	0x74, 0x65, 0x36, 0x34, 0x10, 0x13, 0x12, 0x09, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x64, 0x76, 0x10,
+	0x14, 0x12, 0x0a, 0x0a, 0x06, 0x77, 0x72, 0x69, 0x74, 0x65, 0x76, 0x10, 0x15, 0x12, 0x0a, 0x0a,
+	0x06, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x10, 0x16, 0x12, 0x08, 0x0a, 0x04, 0x70, 0x69, 0x70,
+	0x65, 0x10, 0x17, 0x12, 0x0a, 0x0a, 0x06, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x10, 0x18, 0x12,
+	0x0f, 0x0a, 0x0b, 0x73, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x79, 0x69, 0x65, 0x6c, 0x64, 0x10, 0x19,
+	0x12, 0x0a, 0x0a, 0x06, 0x6d, 0x72, 0x65, 0x6d, 0x61, 0x70, 0x10, 0x1a, 0x12, 0x09, 0x0a, 0x05,
+	0x6d, 0x73, 0x79, 0x6e, 0x63, 0x10, 0x1b, 0x12, 0x0b, 0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x63, 0x6f,
+	0x72, 0x65, 0x10, 0x1c, 0x12, 0x0b, 0x0a, 0x07, 0x6d, 0x61, 0x64, 0x76, 0x69, 0x73, 0x65, 0x10,
+	0x1d, 0x12, 0x0a, 0x0a, 0x06, 0x73, 0x68, 0x6d, 0x67, 0x65, 0x74, 0x10, 0x1e, 0x12, 0x09, 0x0a,
+	0x05, 0x73, 0x68, 0x6d, 0x61, 0x74, 0x10, 0x1f, 0x12, 0x0a, 0x0a, 0x06, 0x73, 0x68, 0x6d, 0x63,
+	0x74, 0x6c, 0x10, 0x20, 0x12, 0x07, 0x0a, 0x03, 0x64, 0x75, 0x70, 0x10, 0x21, 0x12, 0x08, 0x0a,
+	0x04, 0x64, 0x75, 0x70, 0x32, 0x10, 0x22, 0x12, 0x09, 0x0a, 0x05, 0x70, 0x61, 0x75, 0x73, 0x65,
+	0x10, 0x23, 0x12, 0x0d, 0x0a, 0x09, 0x6e, 0x61, 0x6e, 0x6f, 0x73, 0x6c, 0x65, 0x65, 0x70, 0x10,
+	0x24, 0x12, 0x0d, 0x0a, 0x09, 0x67, 0x65, 0x74, 0x69, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x10, 0x25,
+	0x12, 0x09, 0x0a, 0x05, 0x61, 0x6c, 0x61, 0x72, 0x6d, 0x10, 0x26, 0x12, 0x0d, 0x0a, 0x09, 0x73,
+	0x65, 0x74, 0x69, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x10, 0x27, 0x12, 0x0a, 0x0a, 0x06, 0x67, 0x65,
+	0x74, 0x70, 0x69, 0x64, 0x10, 0x28, 0x12, 0x0c, 0x0a, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x66, 0x69,
+	0x6c, 0x65, 0x10, 0x29, 0x12, 0x0a, 0x0a, 0x06, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x10, 0x2a,
+	0x12, 0x0b, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x10, 0x2b, 0x12, 0x0a, 0x0a,
+	0x06, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x10, 0x2c, 0x12, 0x0a, 0x0a, 0x06, 0x73, 0x65, 0x6e,
+	0x64, 0x74, 0x6f, 0x10, 0x2d, 0x12, 0x0c, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x76, 0x66, 0x72, 0x6f,
+	0x6d, 0x10, 0x2e, 0x12, 0x0b, 0x0a, 0x07, 0x73, 0x65, 0x6e, 0x64, 0x6d, 0x73, 0x67, 0x10, 0x2f,
+	0x12, 0x0b, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x76, 0x6d, 0x73, 0x67, 0x10, 0x30, 0x12, 0x0c, 0x0a,
+	0x08, 0x73, 0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x10, 0x31, 0x12, 0x08, 0x0a, 0x04, 0x62,
+	0x69, 0x6e, 0x64, 0x10, 0x32, 0x12, 0x0a, 0x0a, 0x06, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x10,
+	0x33, 0x12, 0x0f, 0x0a, 0x0b, 0x67, 0x65, 0x74, 0x73, 0x6f, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65,
+	0x10, 0x34, 0x12, 0x0f, 0x0a, 0x0b, 0x67, 0x65, 0x74, 0x70, 0x65, 0x65, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x10, 0x35, 0x12, 0x0e, 0x0a, 0x0a, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x70, 0x61, 0x69,
+	0x72, 0x10, 0x36, 0x12, 0x0e, 0x0a, 0x0a, 0x73, 0x65, 0x74, 0x73, 0x6f, 0x63, 0x6b, 0x6f, 0x70,
+	0x74, 0x10, 0x37, 0x12, 0x0e, 0x0a, 0x0a, 0x67, 0x65, 0x74, 0x73, 0x6f, 0x63, 0x6b, 0x6f, 0x70,
+	0x74, 0x10, 0x38, 0x12, 0x09, 0x0a, 0x05, 0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x10, 0x39, 0x12, 0x08,
+	0x0a, 0x04, 0x66, 0x6f, 0x72, 0x6b, 0x10, 0x3a, 0x12, 0x09, 0x0a, 0x05, 0x76, 0x66, 0x6f, 0x72,
+	0x6b, 0x10, 0x3b, 0x12, 0x0a, 0x0a, 0x06, 0x65, 0x78, 0x65, 0x63, 0x76, 0x65, 0x10, 0x3c, 0x12,
+	0x08, 0x0a, 0x04, 0x65, 0x78, 0x69, 0x74, 0x10, 0x3d, 0x12, 0x09, 0x0a, 0x05, 0x77, 0x61, 0x69,
+	0x74, 0x34, 0x10, 0x3e, 0x12, 0x08, 0x0a, 0x04, 0x6b, 0x69, 0x6c, 0x6c, 0x10, 0x3f, 0x12, 0x09,
+	0x0a, 0x05, 0x75, 0x6e, 0x61, 0x6d, 0x65, 0x10, 0x40, 0x12, 0x0a, 0x0a, 0x06, 0x73, 0x65, 0x6d,
+	0x67, 0x65, 0x74, 0x10, 0x41, 0x12, 0x09, 0x0a, 0x05, 0x73, 0x65, 0x6d, 0x6f, 0x70, 0x10, 0x42,
+	0x12, 0x0a, 0x0a, 0x06, 0x73, 0x65, 0x6d, 0x63, 0x74, 0x6c, 0x10, 0x43, 0x12, 0x09, 0x0a, 0x05,
+	0x73, 0x68, 0x6d, 0x64, 0x74, 0x10, 0x44, 0x12, 0x0a, 0x0a, 0x06, 0x6d, 0x73, 0x67, 0x67, 0x65,
+	0x74, 0x10, 0x45, 0x12, 0x0a, 0x0a, 0x06, 0x6d, 0x73, 0x67, 0x73, 0x6e, 0x64, 0x10, 0x46, 0x12,
+	0x0a, 0x0a, 0x06, 0x6d, 0x73, 0x67, 0x72, 0x63, 0x76, 0x10, 0x47, 0x12, 0x0a, 0x0a, 0x06, 0x6d,
+	0x73, 0x67, 0x63, 0x74, 0x6c, 0x10, 0x48, 0x12, 0x09, 0x0a, 0x05, 0x66, 0x63, 0x6e, 0x74, 0x6c,
+	0x10, 0x49, 0x12, 0x09, 0x0a, 0x05, 0x66, 0x6c, 0x6f, 0x63, 0x6b, 0x10, 0x4a, 0x12, 0x09, 0x0a,
+	0x05, 0x66, 0x73, 0x79, 0x6e, 0x63, 0x10, 0x4b, 0x12, 0x0d, 0x0a, 0x09, 0x66, 0x64, 0x61, 0x74,
+	0x61, 0x73, 0x79, 0x6e, 0x63, 0x10, 0x4c, 0x12, 0x0c, 0x0a, 0x08, 0x74, 0x72, 0x75, 0x6e, 0x63,
+	0x61, 0x74, 0x65, 0x10, 0x4d, 0x12, 0x0d, 0x0a, 0x09, 0x66, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61,
+	0x74, 0x65, 0x10, 0x4e, 0x12, 0x0c, 0x0a, 0x08, 0x67, 0x65, 0x74, 0x64, 0x65, 0x6e, 0x74, 0x73,
+	0x10, 0x4f, 0x12, 0x0a, 0x0a, 0x06, 0x67, 0x65, 0x74, 0x63, 0x77, 0x64, 0x10, 0x50, 0x12, 0x09,
+	0x0a, 0x05, 0x63, 0x68, 0x64, 0x69, 0x72, 0x10, 0x51, 0x12, 0x0a, 0x0a, 0x06, 0x66, 0x63, 0x68,
+	0x64, 0x69, 0x72, 0x10, 0x52, 0x12, 0x0a, 0x0a, 0x06, 0x72, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x10,
+	0x53, 0x12, 0x09, 0x0a, 0x05, 0x6d, 0x6b, 0x64, 0x69, 0x72, 0x10, 0x54, 0x12, 0x09, 0x0a, 0x05,
+	0x72, 0x6d, 0x64, 0x69, 0x72, 0x10, 0x55, 0x12, 0x09, 0x0a, 0x05, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x10, 0x56, 0x12, 0x08, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x10, 0x57, 0x12, 0x0a, 0x0a, 0x06,
+	0x75, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x10, 0x58, 0x12, 0x0b, 0x0a, 0x07, 0x73, 0x79, 0x6d, 0x6c,
+	0x69, 0x6e, 0x6b, 0x10, 0x59, 0x12, 0x0c, 0x0a, 0x08, 0x72, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e,
+	0x6b, 0x10, 0x5a, 0x12, 0x09, 0x0a, 0x05, 0x63, 0x68, 0x6d, 0x6f, 0x64, 0x10, 0x5b, 0x12, 0x0a,
+	0x0a, 0x06, 0x66, 0x63, 0x68, 0x6d, 0x6f, 0x64, 0x10, 0x5c, 0x12, 0x09, 0x0a, 0x05, 0x63, 0x68,
+	0x6f, 0x77, 0x6e, 0x10, 0x5d, 0x12, 0x0a, 0x0a, 0x06, 0x66, 0x63, 0x68, 0x6f, 0x77, 0x6e, 0x10,
+	0x5e, 0x12, 0x0a, 0x0a, 0x06, 0x6c, 0x63, 0x68, 0x6f, 0x77, 0x6e, 0x10, 0x5f, 0x12, 0x09, 0x0a,
+	0x05, 0x75, 0x6d, 0x61, 0x73, 0x6b, 0x10, 0x60, 0x12, 0x10, 0x0a, 0x0c, 0x67, 0x65, 0x74, 0x74,
+	0x69, 0x6d, 0x65, 0x6f, 0x66, 0x64, 0x61, 0x79, 0x10, 0x61, 0x12, 0x0d, 0x0a, 0x09, 0x67, 0x65,
+	0x74, 0x72, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x10, 0x62, 0x12, 0x0d, 0x0a, 0x09, 0x67, 0x65, 0x74,
+	0x72, 0x75, 0x73, 0x61, 0x67, 0x65, 0x10, 0x63, 0x12, 0x0b, 0x0a, 0x07, 0x73, 0x79, 0x73, 0x69,
+	0x6e, 0x66, 0x6f, 0x10, 0x64, 0x12, 0x09, 0x0a, 0x05, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x10, 0x65,
+	0x12, 0x0a, 0x0a, 0x06, 0x70, 0x74, 0x72, 0x61, 0x63, 0x65, 0x10, 0x66, 0x12, 0x0a, 0x0a, 0x06,
+	0x67, 0x65, 0x74, 0x75, 0x69, 0x64, 0x10, 0x67, 0x12, 0x0a, 0x0a, 0x06, 0x73, 0x79, 0x73, 0x6c,
+	0x6f, 0x67, 0x10, 0x68, 0x12, 0x0a, 0x0a, 0x06, 0x67, 0x65, 0x74, 0x67, 0x69, 0x64, 0x10, 0x69,
+	0x12, 0x0a, 0x0a, 0x06, 0x73, 0x65, 0x74, 0x75, 0x69, 0x64, 0x10, 0x6a, 0x12, 0x0a, 0x0a, 0x06,
+	0x73, 0x65, 0x74, 0x67, 0x69, 0x64, 0x10, 0x6b, 0x12, 0x0b, 0x0a, 0x07, 0x67, 0x65, 0x74, 0x65,
+	0x75, 0x69, 0x64, 0x10, 0x6c, 0x12, 0x0b, 0x0a, 0x07, 0x67, 0x65, 0x74, 0x65, 0x67, 0x69, 0x64,
+	0x10, 0x6d, 0x12, 0x0b, 0x0a, 0x07, 0x73, 0x65, 0x74, 0x70, 0x67, 0x69, 0x64, 0x10, 0x6e, 0x12,
+	0x0b, 0x0a, 0x07, 0x67, 0x65, 0x74, 0x70, 0x70, 0x69, 0x64, 0x10, 0x6f, 0x12, 0x0b, 0x0a, 0x07,
+	0x67, 0x65, 0x74, 0x70, 0x67, 0x72, 0x70, 0x10, 0x70, 0x12, 0x0a, 0x0a, 0x06, 0x73, 0x65, 0x74,
+	0x73, 0x69, 0x64, 0x10, 0x71, 0x12, 0x0c, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x72, 0x65, 0x75, 0x69,
+	0x64, 0x10, 0x72, 0x12, 0x0c, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x72, 0x65, 0x67, 0x69, 0x64, 0x10,
+	0x73, 0x12, 0x0d, 0x0a, 0x09, 0x67, 0x65, 0x74, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x10, 0x74,
+	0x12, 0x0d, 0x0a, 0x09, 0x73, 0x65, 0x74, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x10, 0x75, 0x12,
+	0x0d, 0x0a, 0x09, 0x73, 0x65, 0x74, 0x72, 0x65, 0x73, 0x75, 0x69, 0x64, 0x10, 0x76, 0x12, 0x0d,
+	0x0a, 0x09, 0x67, 0x65, 0x74, 0x72, 0x65, 0x73, 0x75, 0x69, 0x64, 0x10, 0x77, 0x12, 0x0d, 0x0a,
+	0x09, 0x73, 0x65, 0x74, 0x72, 0x65, 0x73, 0x67, 0x69, 0x64, 0x10, 0x78, 0x12, 0x0d, 0x0a, 0x09,
+	0x67, 0x65, 0x74, 0x72, 0x65, 0x73, 0x67, 0x69, 0x64, 0x10, 0x79, 0x12, 0x0b, 0x0a, 0x07, 0x67,
+	0x65, 0x74, 0x70, 0x67, 0x69, 0x64, 0x10, 0x7a, 0x12, 0x0c, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x66,
+	0x73, 0x75, 0x69, 0x64, 0x10, 0x7b, 0x12, 0x0c, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x66, 0x73, 0x67,
+	0x69, 0x64, 0x10, 0x7c, 0x12, 0x0a, 0x0a, 0x06, 0x67, 0x65, 0x74, 0x73, 0x69, 0x64, 0x10, 0x7d,
+	0x12, 0x0a, 0x0a, 0x06, 0x63, 0x61, 0x70, 0x67, 0x65, 0x74, 0x10, 0x7e, 0x12, 0x0a, 0x0a, 0x06,
+	0x63, 0x61, 0x70, 0x73, 0x65, 0x74, 0x10, 0x7f, 0x12, 0x12, 0x0a, 0x0d, 0x72, 0x74, 0x5f, 0x73,
+	0x69, 0x67, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x10, 0x80, 0x01, 0x12, 0x14, 0x0a, 0x0f,
+	0x72, 0x74, 0x5f, 0x73, 0x69, 0x67, 0x74, 0x69, 0x6d, 0x65, 0x64, 0x77, 0x61, 0x69, 0x74, 0x10,
+	0x81, 0x01, 0x12, 0x14, 0x0a, 0x0f, 0x72, 0x74, 0x5f, 0x73, 0x69, 0x67, 0x71, 0x75, 0x65, 0x75,
+	0x65, 0x69, 0x6e, 0x66, 0x6f, 0x10, 0x82, 0x01, 0x12, 0x12, 0x0a, 0x0d, 0x72, 0x74, 0x5f, 0x73,
+	0x69, 0x67, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x10, 0x83, 0x01, 0x12, 0x10, 0x0a, 0x0b,
+	0x73, 0x69, 0x67, 0x61, 0x6c, 0x74, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x10, 0x84, 0x01, 0x12, 0x0a,
+	0x0a, 0x05, 0x75, 0x74, 0x69, 0x6d, 0x65, 0x10, 0x85, 0x01, 0x12, 0x0a, 0x0a, 0x05, 0x6d, 0x6b,
+	0x6e, 0x6f, 0x64, 0x10, 0x86, 0x01, 0x12, 0x0b, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x6c, 0x69, 0x62,
+	0x10, 0x87, 0x01, 0x12, 0x10, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x69,
+	0x74, 0x79, 0x10, 0x88, 0x01, 0x12, 0x0a, 0x0a, 0x05, 0x75, 0x73, 0x74, 0x61, 0x74, 0x10, 0x89,
+	0x01, 0x12, 0x0b, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x66, 0x73, 0x10, 0x8a, 0x01, 0x12, 0x0c,
+	0x0a, 0x07, 0x66, 0x73, 0x74, 0x61, 0x74, 0x66, 0x73, 0x10, 0x8b, 0x01, 0x12, 0x0a, 0x0a, 0x05,
+	0x73, 0x79, 0x73, 0x66, 0x73, 0x10, 0x8c, 0x01, 0x12, 0x10, 0x0a, 0x0b, 0x67, 0x65, 0x74, 0x70,
+	0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x10, 0x8d, 0x01, 0x12, 0x10, 0x0a, 0x0b, 0x73, 0x65,
+	0x74, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x10, 0x8e, 0x01, 0x12, 0x13, 0x0a, 0x0e,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x74, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x10, 0x8f,
+	0x01, 0x12, 0x13, 0x0a, 0x0e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x67, 0x65, 0x74, 0x70, 0x61,
+	0x72, 0x61, 0x6d, 0x10, 0x90, 0x01, 0x12, 0x17, 0x0a, 0x12, 0x73, 0x63, 0x68, 0x65, 0x64, 0x5f,
+	0x73, 0x65, 0x74, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x10, 0x91, 0x01, 0x12,
+	0x17, 0x0a, 0x12, 0x73, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x67, 0x65, 0x74, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x72, 0x10, 0x92, 0x01, 0x12, 0x1b, 0x0a, 0x16, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x5f, 0x67, 0x65, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x6d,
+	0x61, 0x78, 0x10, 0x93, 0x01, 0x12, 0x1b, 0x0a, 0x16, 0x73, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x67,
+	0x65, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x6d, 0x69, 0x6e, 0x10,
+	0x94, 0x01, 0x12, 0x1a, 0x0a, 0x15, 0x73, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x72, 0x72, 0x5f, 0x67,
+	0x65, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x10, 0x95, 0x01, 0x12, 0x0a,
+	0x0a, 0x05, 0x6d, 0x6c, 0x6f, 0x63, 0x6b, 0x10, 0x96, 0x01, 0x12, 0x0c, 0x0a, 0x07, 0x6d, 0x75,
+	0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x10, 0x97, 0x01, 0x12, 0x0d, 0x0a, 0x08, 0x6d, 0x6c, 0x6f, 0x63,
+	0x6b, 0x61, 0x6c, 0x6c, 0x10, 0x98, 0x01, 0x12, 0x0f, 0x0a, 0x0a, 0x6d, 0x75, 0x6e, 0x6c, 0x6f,
+	0x63, 0x6b, 0x61, 0x6c, 0x6c, 0x10, 0x99, 0x01, 0x12, 0x0c, 0x0a, 0x07, 0x76, 0x68, 0x61, 0x6e,
+	0x67, 0x75, 0x70, 0x10, 0x9a, 0x01, 0x12, 0x0f, 0x0a, 0x0a, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x79,
+	0x5f, 0x6c, 0x64, 0x74, 0x10, 0x9b, 0x01, 0x12, 0x0f, 0x0a, 0x0a, 0x70, 0x69, 0x76, 0x6f, 0x74,
+	0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x10, 0x9c, 0x01, 0x12, 0x0b, 0x0a, 0x06, 0x73, 0x79, 0x73, 0x63,
+	0x74, 0x6c, 0x10, 0x9d, 0x01, 0x12, 0x0a, 0x0a, 0x05, 0x70, 0x72, 0x63, 0x74, 0x6c, 0x10, 0x9e,
+	0x01, 0x12, 0x0f, 0x0a, 0x0a, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x70, 0x72, 0x63, 0x74, 0x6c, 0x10,
+	0x9f, 0x01, 0x12, 0x0d, 0x0a, 0x08, 0x61, 0x64, 0x6a, 0x74, 0x69, 0x6d, 0x65, 0x78, 0x10, 0xa0,
+	0x01, 0x12, 0x0e, 0x0a, 0x09, 0x73, 0x65, 0x74, 0x72, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x10, 0xa1,
+	0x01, 0x12, 0x0b, 0x0a, 0x06, 0x63, 0x68, 0x72, 0x6f, 0x6f, 0x74, 0x10, 0xa2, 0x01, 0x12, 0x09,
+	0x0a, 0x04, 0x73, 0x79, 0x6e, 0x63, 0x10, 0xa3, 0x01, 0x12, 0x09, 0x0a, 0x04, 0x61, 0x63, 0x63,
+	0x74, 0x10, 0xa4, 0x01, 0x12, 0x11, 0x0a, 0x0c, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6d, 0x65, 0x6f,
+	0x66, 0x64, 0x61, 0x79, 0x10, 0xa5, 0x01, 0x12, 0x0a, 0x0a, 0x05, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x10, 0xa6, 0x01, 0x12, 0x0c, 0x0a, 0x07, 0x75, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x32, 0x10, 0xa7,
+	0x01, 0x12, 0x0b, 0x0a, 0x06, 0x73, 0x77, 0x61, 0x70, 0x6f, 0x6e, 0x10, 0xa8, 0x01, 0x12, 0x0c,
+	0x0a, 0x07, 0x73, 0x77, 0x61, 0x70, 0x6f, 0x66, 0x66, 0x10, 0xa9, 0x01, 0x12, 0x0b, 0x0a, 0x06,
+	0x72, 0x65, 0x62, 0x6f, 0x6f, 0x74, 0x10, 0xaa, 0x01, 0x12, 0x10, 0x0a, 0x0b, 0x73, 0x65, 0x74,
+	0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x10, 0xab, 0x01, 0x12, 0x12, 0x0a, 0x0d, 0x73,
+	0x65, 0x74, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x6e, 0x61, 0x6d, 0x65, 0x10, 0xac, 0x01, 0x12,
+	0x09, 0x0a, 0x04, 0x69, 0x6f, 0x70, 0x6c, 0x10, 0xad, 0x01, 0x12, 0x0b, 0x0a, 0x06, 0x69, 0x6f,
+	0x70, 0x65, 0x72, 0x6d, 0x10, 0xae, 0x01, 0x12, 0x12, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x10, 0xaf, 0x01, 0x12, 0x10, 0x0a, 0x0b, 0x69,
+	0x6e, 0x69, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x10, 0xb0, 0x01, 0x12, 0x12, 0x0a,
+	0x0d, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x10, 0xb1,
+	0x01, 0x12, 0x14, 0x0a, 0x0f, 0x67, 0x65, 0x74, 0x5f, 0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x5f,
+	0x73, 0x79, 0x6d, 0x73, 0x10, 0xb2, 0x01, 0x12, 0x11, 0x0a, 0x0c, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x5f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x10, 0xb3, 0x01, 0x12, 0x0d, 0x0a, 0x08, 0x71, 0x75,
+	0x6f, 0x74, 0x61, 0x63, 0x74, 0x6c, 0x10, 0xb4, 0x01, 0x12, 0x0f, 0x0a, 0x0a, 0x6e, 0x66, 0x73,
+	0x73, 0x65, 0x72, 0x76, 0x63, 0x74, 0x6c, 0x10, 0xb5, 0x01, 0x12, 0x0c, 0x0a, 0x07, 0x67, 0x65,
+	0x74, 0x70, 0x6d, 0x73, 0x67, 0x10, 0xb6, 0x01, 0x12, 0x0c, 0x0a, 0x07, 0x70, 0x75, 0x74, 0x70,
+	0x6d, 0x73, 0x67, 0x10, 0xb7, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x61, 0x66, 0x73, 0x10, 0xb8, 0x01,
+	0x12, 0x0c, 0x0a, 0x07, 0x74, 0x75, 0x78, 0x63, 0x61, 0x6c, 0x6c, 0x10, 0xb9, 0x01, 0x12, 0x0d,
+	0x0a, 0x08, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x10, 0xba, 0x01, 0x12, 0x0b, 0x0a,
+	0x06, 0x67, 0x65, 0x74, 0x74, 0x69, 0x64, 0x10, 0xbb, 0x01, 0x12, 0x0e, 0x0a, 0x09, 0x72, 0x65,
+	0x61, 0x64, 0x61, 0x68, 0x65, 0x61, 0x64, 0x10, 0xbc, 0x01, 0x12, 0x0d, 0x0a, 0x08, 0x73, 0x65,
+	0x74, 0x78, 0x61, 0x74, 0x74, 0x72, 0x10, 0xbd, 0x01, 0x12, 0x0e, 0x0a, 0x09, 0x6c, 0x73, 0x65,
+	0x74, 0x78, 0x61, 0x74, 0x74, 0x72, 0x10, 0xbe, 0x01, 0x12, 0x0e, 0x0a, 0x09, 0x66, 0x73, 0x65,
+	0x74, 0x78, 0x61, 0x74, 0x74, 0x72, 0x10, 0xbf, 0x01, 0x12, 0x0d, 0x0a, 0x08, 0x67, 0x65, 0x74,
+	0x78, 0x61, 0x74, 0x74, 0x72, 0x10, 0xc0, 0x01, 0x12, 0x0e, 0x0a, 0x09, 0x6c, 0x67, 0x65, 0x74,
+	0x78, 0x61, 0x74, 0x74, 0x72, 0x10, 0xc1, 0x01, 0x12, 0x0e, 0x0a, 0x09, 0x66, 0x67, 0x65, 0x74,
+	0x78, 0x61, 0x74, 0x74, 0x72, 0x10, 0xc2, 0x01, 0x12, 0x0e, 0x0a, 0x09, 0x6c, 0x69, 0x73, 0x74,
+	0x78, 0x61, 0x74, 0x74, 0x72, 0x10, 0xc3, 0x01, 0x12, 0x0f, 0x0a, 0x0a, 0x6c, 0x6c, 0x69, 0x73,
+	0x74, 0x78, 0x61, 0x74, 0x74, 0x72, 0x10, 0xc4, 0x01, 0x12, 0x0f, 0x0a, 0x0a, 0x66, 0x6c, 0x69,
+	0x73, 0x74, 0x78, 0x61, 0x74, 0x74, 0x72, 0x10, 0xc5, 0x01, 0x12, 0x10, 0x0a, 0x0b, 0x72, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x78, 0x61, 0x74, 0x74, 0x72, 0x10, 0xc6, 0x01, 0x12, 0x11, 0x0a, 0x0c,
+	0x6c, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x78, 0x61, 0x74, 0x74, 0x72, 0x10, 0xc7, 0x01, 0x12,
+	0x11, 0x0a, 0x0c, 0x66, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x78, 0x61, 0x74, 0x74, 0x72, 0x10,
+	0xc8, 0x01, 0x12, 0x0a, 0x0a, 0x05, 0x74, 0x6b, 0x69, 0x6c, 0x6c, 0x10, 0xc9, 0x01, 0x12, 0x09,
+	0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x10, 0xca, 0x01, 0x12, 0x0a, 0x0a, 0x05, 0x66, 0x75, 0x74,
+	0x65, 0x78, 0x10, 0xcb, 0x01, 0x12, 0x16, 0x0a, 0x11, 0x73, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x73,
+	0x65, 0x74, 0x61, 0x66, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x79, 0x10, 0xcc, 0x01, 0x12, 0x16, 0x0a,
+	0x11, 0x73, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x67, 0x65, 0x74, 0x61, 0x66, 0x66, 0x69, 0x6e, 0x69,
+	0x74, 0x79, 0x10, 0xcd, 0x01, 0x12, 0x14, 0x0a, 0x0f, 0x73, 0x65, 0x74, 0x5f, 0x74, 0x68, 0x72,
+	0x65, 0x61, 0x64, 0x5f, 0x61, 0x72, 0x65, 0x61, 0x10, 0xce, 0x01, 0x12, 0x0d, 0x0a, 0x08, 0x69,
+	0x6f, 0x5f, 0x73, 0x65, 0x74, 0x75, 0x70, 0x10, 0xcf, 0x01, 0x12, 0x0f, 0x0a, 0x0a, 0x69, 0x6f,
+	0x5f, 0x64, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x10, 0xd0, 0x01, 0x12, 0x11, 0x0a, 0x0c, 0x69,
+	0x6f, 0x5f, 0x67, 0x65, 0x74, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x10, 0xd1, 0x01, 0x12, 0x0e,
+	0x0a, 0x09, 0x69, 0x6f, 0x5f, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x10, 0xd2, 0x01, 0x12, 0x0e,
+	0x0a, 0x09, 0x69, 0x6f, 0x5f, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x10, 0xd3, 0x01, 0x12, 0x14,
+	0x0a, 0x0f, 0x67, 0x65, 0x74, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x61, 0x72, 0x65,
+	0x61, 0x10, 0xd4, 0x01, 0x12, 0x13, 0x0a, 0x0e, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x5f, 0x64,
+	0x63, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x10, 0xd5, 0x01, 0x12, 0x11, 0x0a, 0x0c, 0x65, 0x70, 0x6f,
+	0x6c, 0x6c, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x10, 0xd6, 0x01, 0x12, 0x12, 0x0a, 0x0d,
+	0x65, 0x70, 0x6f, 0x6c, 0x6c, 0x5f, 0x63, 0x74, 0x6c, 0x5f, 0x6f, 0x6c, 0x64, 0x10, 0xd7, 0x01,
+	0x12, 0x13, 0x0a, 0x0e, 0x65, 0x70, 0x6f, 0x6c, 0x6c, 0x5f, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x6f,
+	0x6c, 0x64, 0x10, 0xd8, 0x01, 0x12, 0x15, 0x0a, 0x10, 0x72, 0x65, 0x6d, 0x61, 0x70, 0x5f, 0x66,
+	0x69, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x73, 0x10, 0xd9, 0x01, 0x12, 0x0f, 0x0a, 0x0a,
+	0x67, 0x65, 0x74, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x36, 0x34, 0x10, 0xda, 0x01, 0x12, 0x14, 0x0a,
+	0x0f, 0x73, 0x65, 0x74, 0x5f, 0x74, 0x69, 0x64, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x10, 0xdb, 0x01, 0x12, 0x14, 0x0a, 0x0f, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x73,
+	0x79, 0x73, 0x63, 0x61, 0x6c, 0x6c, 0x10, 0xdc, 0x01, 0x12, 0x0f, 0x0a, 0x0a, 0x73, 0x65, 0x6d,
+	0x74, 0x69, 0x6d, 0x65, 0x64, 0x6f, 0x70, 0x10, 0xdd, 0x01, 0x12, 0x0e, 0x0a, 0x09, 0x66, 0x61,
+	0x64, 0x76, 0x69, 0x73, 0x65, 0x36, 0x34, 0x10, 0xde, 0x01, 0x12, 0x11, 0x0a, 0x0c, 0x74, 0x69,
+	0x6d, 0x65, 0x72, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x10, 0xdf, 0x01, 0x12, 0x12, 0x0a,
+	0x0d, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6d, 0x65, 0x10, 0xe0,
+	0x01, 0x12, 0x12, 0x0a, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x5f, 0x67, 0x65, 0x74, 0x74, 0x69,
+	0x6d, 0x65, 0x10, 0xe1, 0x01, 0x12, 0x15, 0x0a, 0x10, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x5f, 0x67,
+	0x65, 0x74, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x75, 0x6e, 0x10, 0xe2, 0x01, 0x12, 0x11, 0x0a, 0x0c,
+	0x74, 0x69, 0x6d, 0x65, 0x72, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x10, 0xe3, 0x01, 0x12,
+	0x12, 0x0a, 0x0d, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6d, 0x65,
+	0x10, 0xe4, 0x01, 0x12, 0x12, 0x0a, 0x0d, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x67, 0x65, 0x74,
+	0x74, 0x69, 0x6d, 0x65, 0x10, 0xe5, 0x01, 0x12, 0x11, 0x0a, 0x0c, 0x63, 0x6c, 0x6f, 0x63, 0x6b,
+	0x5f, 0x67, 0x65, 0x74, 0x72, 0x65, 0x73, 0x10, 0xe6, 0x01, 0x12, 0x14, 0x0a, 0x0f, 0x63, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x61, 0x6e, 0x6f, 0x73, 0x6c, 0x65, 0x65, 0x70, 0x10, 0xe7, 0x01,
+	0x12, 0x0f, 0x0a, 0x0a, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x10, 0xe8,
+	0x01, 0x12, 0x0f, 0x0a, 0x0a, 0x65, 0x70, 0x6f, 0x6c, 0x6c, 0x5f, 0x77, 0x61, 0x69, 0x74, 0x10,
+	0xe9, 0x01, 0x12, 0x0e, 0x0a, 0x09, 0x65, 0x70, 0x6f, 0x6c, 0x6c, 0x5f, 0x63, 0x74, 0x6c, 0x10,
+	0xea, 0x01, 0x12, 0x0b, 0x0a, 0x06, 0x74, 0x67, 0x6b, 0x69, 0x6c, 0x6c, 0x10, 0xeb, 0x01, 0x12,
+	0x0b, 0x0a, 0x06, 0x75, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x10, 0xec, 0x01, 0x12, 0x0c, 0x0a, 0x07,
+	0x76, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x10, 0xed, 0x01, 0x12, 0x0a, 0x0a, 0x05, 0x6d, 0x62,
+	0x69, 0x6e, 0x64, 0x10, 0xee, 0x01, 0x12, 0x12, 0x0a, 0x0d, 0x73, 0x65, 0x74, 0x5f, 0x6d, 0x65,
+	0x6d, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x10, 0xef, 0x01, 0x12, 0x12, 0x0a, 0x0d, 0x67, 0x65,
+	0x74, 0x5f, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x10, 0xf0, 0x01, 0x12, 0x0c,
+	0x0a, 0x07, 0x6d, 0x71, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x10, 0xf1, 0x01, 0x12, 0x0e, 0x0a, 0x09,
+	0x6d, 0x71, 0x5f, 0x75, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x10, 0xf2, 0x01, 0x12, 0x11, 0x0a, 0x0c,
+	0x6d, 0x71, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x64, 0x73, 0x65, 0x6e, 0x64, 0x10, 0xf3, 0x01, 0x12,
+	0x14, 0x0a, 0x0f, 0x6d, 0x71, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x64, 0x72, 0x65, 0x63, 0x65, 0x69,
+	0x76, 0x65, 0x10, 0xf4, 0x01, 0x12, 0x0e, 0x0a, 0x09, 0x6d, 0x71, 0x5f, 0x6e, 0x6f, 0x74, 0x69,
+	0x66, 0x79, 0x10, 0xf5, 0x01, 0x12, 0x12, 0x0a, 0x0d, 0x6d, 0x71, 0x5f, 0x67, 0x65, 0x74, 0x73,
+	0x65, 0x74, 0x61, 0x74, 0x74, 0x72, 0x10, 0xf6, 0x01, 0x12, 0x0f, 0x0a, 0x0a, 0x6b, 0x65, 0x78,
+	0x65, 0x63, 0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x10, 0xf7, 0x01, 0x12, 0x0b, 0x0a, 0x06, 0x77, 0x61,
+	0x69, 0x74, 0x69, 0x64, 0x10, 0xf8, 0x01, 0x12, 0x0c, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x5f, 0x6b,
+	0x65, 0x79, 0x10, 0xf9, 0x01, 0x12, 0x10, 0x0a, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x5f, 0x6b, 0x65, 0x79, 0x10, 0xfa, 0x01, 0x12, 0x0b, 0x0a, 0x06, 0x6b, 0x65, 0x79, 0x63, 0x74,
+	0x6c, 0x10, 0xfb, 0x01, 0x12, 0x0f, 0x0a, 0x0a, 0x69, 0x6f, 0x70, 0x72, 0x69, 0x6f, 0x5f, 0x73,
+	0x65, 0x74, 0x10, 0xfc, 0x01, 0x12, 0x0f, 0x0a, 0x0a, 0x69, 0x6f, 0x70, 0x72, 0x69, 0x6f, 0x5f,
+	0x67, 0x65, 0x74, 0x10, 0xfd, 0x01, 0x12, 0x11, 0x0a, 0x0c, 0x69, 0x6e, 0x6f, 0x74, 0x69, 0x66,
+	0x79, 0x5f, 0x69, 0x6e, 0x69, 0x74, 0x10, 0xfe, 0x01, 0x12, 0x16, 0x0a, 0x11, 0x69, 0x6e, 0x6f,
+	0x74, 0x69, 0x66, 0x79, 0x5f, 0x61, 0x64, 0x64, 0x5f, 0x77, 0x61, 0x74, 0x63, 0x68, 0x10, 0xff,
+	0x01, 0x12, 0x15, 0x0a, 0x10, 0x69, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x5f, 0x72, 0x6d, 0x5f,
+	0x77, 0x61, 0x74, 0x63, 0x68, 0x10, 0x80, 0x02, 0x12, 0x12, 0x0a, 0x0d, 0x6d, 0x69, 0x67, 0x72,
+	0x61, 0x74, 0x65, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x73, 0x10, 0x81, 0x02, 0x12, 0x0b, 0x0a, 0x06,
+	0x6f, 0x70, 0x65, 0x6e, 0x61, 0x74, 0x10, 0x82, 0x02, 0x12, 0x0c, 0x0a, 0x07, 0x6d, 0x6b, 0x64,
+	0x69, 0x72, 0x61, 0x74, 0x10, 0x83, 0x02, 0x12, 0x0c, 0x0a, 0x07, 0x6d, 0x6b, 0x6e, 0x6f, 0x64,
+	0x61, 0x74, 0x10, 0x84, 0x02, 0x12, 0x0d, 0x0a, 0x08, 0x66, 0x63, 0x68, 0x6f, 0x77, 0x6e, 0x61,
+	0x74, 0x10, 0x85, 0x02, 0x12, 0x0e, 0x0a, 0x09, 0x66, 0x75, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x61,
+	0x74, 0x10, 0x86, 0x02, 0x12, 0x0f, 0x0a, 0x0a, 0x6e, 0x65, 0x77, 0x66, 0x73, 0x74, 0x61, 0x74,
+	0x61, 0x74, 0x10, 0x87, 0x02, 0x12, 0x0d, 0x0a, 0x08, 0x75, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x61,
+	0x74, 0x10, 0x88, 0x02, 0x12, 0x0d, 0x0a, 0x08, 0x72, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x61, 0x74,
+	0x10, 0x89, 0x02, 0x12, 0x0b, 0x0a, 0x06, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x74, 0x10, 0x8a, 0x02,
+	0x12, 0x0e, 0x0a, 0x09, 0x73, 0x79, 0x6d, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x74, 0x10, 0x8b, 0x02,
+	0x12, 0x0f, 0x0a, 0x0a, 0x72, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x74, 0x10, 0x8c,
+	0x02, 0x12, 0x0d, 0x0a, 0x08, 0x66, 0x63, 0x68, 0x6d, 0x6f, 0x64, 0x61, 0x74, 0x10, 0x8d, 0x02,
+	0x12, 0x0e, 0x0a, 0x09, 0x66, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x61, 0x74, 0x10, 0x8e, 0x02,
+	0x12, 0x0d, 0x0a, 0x08, 0x70, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x36, 0x10, 0x8f, 0x02, 0x12,
+	0x0a, 0x0a, 0x05, 0x70, 0x70, 0x6f, 0x6c, 0x6c, 0x10, 0x90, 0x02, 0x12, 0x0c, 0x0a, 0x07, 0x75,
+	0x6e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x10, 0x91, 0x02, 0x12, 0x14, 0x0a, 0x0f, 0x73, 0x65, 0x74,
+	0x5f, 0x72, 0x6f, 0x62, 0x75, 0x73, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x10, 0x92, 0x02, 0x12,
+	0x14, 0x0a, 0x0f, 0x67, 0x65, 0x74, 0x5f, 0x72, 0x6f, 0x62, 0x75, 0x73, 0x74, 0x5f, 0x6c, 0x69,
+	0x73, 0x74, 0x10, 0x93, 0x02, 0x12, 0x0b, 0x0a, 0x06, 0x73, 0x70, 0x6c, 0x69, 0x63, 0x65, 0x10,
+	0x94, 0x02, 0x12, 0x08, 0x0a, 0x03, 0x74, 0x65, 0x65, 0x10, 0x95, 0x02, 0x12, 0x14, 0x0a, 0x0f,
+	0x73, 0x79, 0x6e, 0x63, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x10,
+	0x96, 0x02, 0x12, 0x0d, 0x0a, 0x08, 0x76, 0x6d, 0x73, 0x70, 0x6c, 0x69, 0x63, 0x65, 0x10, 0x97,
+	0x02, 0x12, 0x0f, 0x0a, 0x0a, 0x6d, 0x6f, 0x76, 0x65, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x73, 0x10,
+	0x98, 0x02, 0x12, 0x0e, 0x0a, 0x09, 0x75, 0x74, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x61, 0x74, 0x10,
+	0x99, 0x02, 0x12, 0x10, 0x0a, 0x0b, 0x65, 0x70, 0x6f, 0x6c, 0x6c, 0x5f, 0x70, 0x77, 0x61, 0x69,
+	0x74, 0x10, 0x9a, 0x02, 0x12, 0x0d, 0x0a, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x66, 0x64,
+	0x10, 0x9b, 0x02, 0x12, 0x13, 0x0a, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x66, 0x64, 0x5f, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x10, 0x9c, 0x02, 0x12, 0x0c, 0x0a, 0x07, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x66, 0x64, 0x10, 0x9d, 0x02, 0x12, 0x0e, 0x0a, 0x09, 0x66, 0x61, 0x6c, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x65, 0x10, 0x9e, 0x02, 0x12, 0x14, 0x0a, 0x0f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x66,
+	0x64, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6d, 0x65, 0x10, 0x9f, 0x02, 0x12, 0x14, 0x0a, 0x0f,
+	0x74, 0x69, 0x6d, 0x65, 0x72, 0x66, 0x64, 0x5f, 0x67, 0x65, 0x74, 0x74, 0x69, 0x6d, 0x65, 0x10,
+	0xa0, 0x02, 0x12, 0x0c, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x34, 0x10, 0xa1, 0x02,
+	0x12, 0x0e, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x66, 0x64, 0x34, 0x10, 0xa2, 0x02,
+	0x12, 0x0d, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x66, 0x64, 0x32, 0x10, 0xa3, 0x02, 0x12,
+	0x12, 0x0a, 0x0d, 0x65, 0x70, 0x6f, 0x6c, 0x6c, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x31,
+	0x10, 0xa4, 0x02, 0x12, 0x09, 0x0a, 0x04, 0x64, 0x75, 0x70, 0x33, 0x10, 0xa5, 0x02, 0x12, 0x0a,
+	0x0a, 0x05, 0x70, 0x69, 0x70, 0x65, 0x32, 0x10, 0xa6, 0x02, 0x12, 0x12, 0x0a, 0x0d, 0x69, 0x6e,
+	0x6f, 0x74, 0x69, 0x66, 0x79, 0x5f, 0x69, 0x6e, 0x69, 0x74, 0x31, 0x10, 0xa7, 0x02, 0x12, 0x0b,
+	0x0a, 0x06, 0x70, 0x72, 0x65, 0x61, 0x64, 0x76, 0x10, 0xa8, 0x02, 0x12, 0x0c, 0x0a, 0x07, 0x70,
+	0x77, 0x72, 0x69, 0x74, 0x65, 0x76, 0x10, 0xa9, 0x02, 0x12, 0x16, 0x0a, 0x11, 0x72, 0x74, 0x5f,
+	0x74, 0x67, 0x73, 0x69, 0x67, 0x71, 0x75, 0x65, 0x75, 0x65, 0x69, 0x6e, 0x66, 0x6f, 0x10, 0xaa,
+	0x02, 0x12, 0x14, 0x0a, 0x0f, 0x70, 0x65, 0x72, 0x66, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f,
+	0x6f, 0x70, 0x65, 0x6e, 0x10, 0xab, 0x02, 0x12, 0x0d, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x76, 0x6d,
+	0x6d, 0x73, 0x67, 0x10, 0xac, 0x02, 0x12, 0x12, 0x0a, 0x0d, 0x66, 0x61, 0x6e, 0x6f, 0x74, 0x69,
+	0x66, 0x79, 0x5f, 0x69, 0x6e, 0x69, 0x74, 0x10, 0xad, 0x02, 0x12, 0x12, 0x0a, 0x0d, 0x66, 0x61,
+	0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x5f, 0x6d, 0x61, 0x72, 0x6b, 0x10, 0xae, 0x02, 0x12, 0x0e,
+	0x0a, 0x09, 0x70, 0x72, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x36, 0x34, 0x10, 0xaf, 0x02, 0x12, 0x16,
+	0x0a, 0x11, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x5f, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65,
+	0x5f, 0x61, 0x74, 0x10, 0xb0, 0x02, 0x12, 0x16, 0x0a, 0x11, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x62,
+	0x79, 0x5f, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x5f, 0x61, 0x74, 0x10, 0xb1, 0x02, 0x12, 0x12,
+	0x0a, 0x0d, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x61, 0x64, 0x6a, 0x74, 0x69, 0x6d, 0x65, 0x10,
+	0xb2, 0x02, 0x12, 0x0b, 0x0a, 0x06, 0x73, 0x79, 0x6e, 0x63, 0x66, 0x73, 0x10, 0xb3, 0x02, 0x12,
+	0x0d, 0x0a, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x6d, 0x6d, 0x73, 0x67, 0x10, 0xb4, 0x02, 0x12, 0x0a,
+	0x0a, 0x05, 0x73, 0x65, 0x74, 0x6e, 0x73, 0x10, 0xb5, 0x02, 0x12, 0x0b, 0x0a, 0x06, 0x67, 0x65,
+	0x74, 0x63, 0x70, 0x75, 0x10, 0xb6, 0x02, 0x12, 0x15, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x5f, 0x76, 0x6d, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x76, 0x10, 0xb7, 0x02, 0x12, 0x16,
+	0x0a, 0x11, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x76, 0x6d, 0x5f, 0x77, 0x72, 0x69,
+	0x74, 0x65, 0x76, 0x10, 0xb8, 0x02, 0x12, 0x09, 0x0a, 0x04, 0x6b, 0x63, 0x6d, 0x70, 0x10, 0xb9,
+	0x02, 0x12, 0x11, 0x0a, 0x0c, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x75, 0x6c,
+	0x65, 0x10, 0xba, 0x02, 0x12, 0x12, 0x0a, 0x0d, 0x73, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x73, 0x65,
+	0x74, 0x61, 0x74, 0x74, 0x72, 0x10, 0xbb, 0x02, 0x12, 0x12, 0x0a, 0x0d, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x5f, 0x67, 0x65, 0x74, 0x61, 0x74, 0x74, 0x72, 0x10, 0xbc, 0x02, 0x12, 0x0e, 0x0a, 0x09,
+	0x72, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x61, 0x74, 0x32, 0x10, 0xbd, 0x02, 0x12, 0x0c, 0x0a, 0x07,
+	0x73, 0x65, 0x63, 0x63, 0x6f, 0x6d, 0x70, 0x10, 0xbe, 0x02, 0x12, 0x0e, 0x0a, 0x09, 0x67, 0x65,
+	0x74, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x10, 0xbf, 0x02, 0x12, 0x11, 0x0a, 0x0c, 0x6d, 0x65,
+	0x6d, 0x66, 0x64, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x10, 0xc0, 0x02, 0x12, 0x14, 0x0a,
+	0x0f, 0x6b, 0x65, 0x78, 0x65, 0x63, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6c, 0x6f, 0x61, 0x64,
+	0x10, 0xc1, 0x02, 0x12, 0x08, 0x0a, 0x03, 0x62, 0x70, 0x66, 0x10, 0xc2, 0x02, 0x12, 0x0d, 0x0a,
+	0x08, 0x65, 0x78, 0x65, 0x63, 0x76, 0x65, 0x61, 0x74, 0x10, 0xc3, 0x02, 0x12, 0x10, 0x0a, 0x0b,
+	0x75, 0x73, 0x65, 0x72, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x66, 0x64, 0x10, 0xc4, 0x02, 0x12, 0x0f,
+	0x0a, 0x0a, 0x6d, 0x65, 0x6d, 0x62, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x10, 0xc5, 0x02, 0x12,
+	0x0b, 0x0a, 0x06, 0x6d, 0x6c, 0x6f, 0x63, 0x6b, 0x32, 0x10, 0xc6, 0x02, 0x12, 0x14, 0x0a, 0x0f,
+	0x63, 0x6f, 0x70, 0x79, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x10,
+	0xc7, 0x02, 0x12, 0x0c, 0x0a, 0x07, 0x70, 0x72, 0x65, 0x61, 0x64, 0x76, 0x32, 0x10, 0xc8, 0x02,
+	0x12, 0x0d, 0x0a, 0x08, 0x70, 0x77, 0x72, 0x69, 0x74, 0x65, 0x76, 0x32, 0x10, 0xc9, 0x02, 0x12,
+	0x12, 0x0a, 0x0d, 0x70, 0x6b, 0x65, 0x79, 0x5f, 0x6d, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74,
+	0x10, 0xca, 0x02, 0x12, 0x0f, 0x0a, 0x0a, 0x70, 0x6b, 0x65, 0x79, 0x5f, 0x61, 0x6c, 0x6c, 0x6f,
+	0x63, 0x10, 0xcb, 0x02, 0x12, 0x0e, 0x0a, 0x09, 0x70, 0x6b, 0x65, 0x79, 0x5f, 0x66, 0x72, 0x65,
+	0x65, 0x10, 0xcc, 0x02, 0x12, 0x0a, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x78, 0x10, 0xcd, 0x02,
+	0x12, 0x12, 0x0a, 0x0d, 0x69, 0x6f, 0x5f, 0x70, 0x67, 0x65, 0x74, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x10, 0xce, 0x02, 0x12, 0x09, 0x0a, 0x04, 0x72, 0x73, 0x65, 0x71, 0x10, 0xcf, 0x02, 0x12,
+	0x16, 0x0a, 0x11, 0x70, 0x69, 0x64, 0x66, 0x64, 0x5f, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x73, 0x69,
+	0x67, 0x6e, 0x61, 0x6c, 0x10, 0xd0, 0x02, 0x12, 0x13, 0x0a, 0x0e, 0x69, 0x6f, 0x5f, 0x75, 0x72,
+	0x69, 0x6e, 0x67, 0x5f, 0x73, 0x65, 0x74, 0x75, 0x70, 0x10, 0xd1, 0x02, 0x12, 0x13, 0x0a, 0x0e,
+	0x69, 0x6f, 0x5f, 0x75, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x10, 0xd2,
+	0x02, 0x12, 0x16, 0x0a, 0x11, 0x69, 0x6f, 0x5f, 0x75, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x10, 0xd3, 0x02, 0x12, 0x0e, 0x0a, 0x09, 0x6f, 0x70, 0x65,
+	0x6e, 0x5f, 0x74, 0x72, 0x65, 0x65, 0x10, 0xd4, 0x02, 0x12, 0x0f, 0x0a, 0x0a, 0x6d, 0x6f, 0x76,
+	0x65, 0x5f, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x10, 0xd5, 0x02, 0x12, 0x0b, 0x0a, 0x06, 0x66, 0x73,
+	0x6f, 0x70, 0x65, 0x6e, 0x10, 0xd6, 0x02, 0x12, 0x0d, 0x0a, 0x08, 0x66, 0x73, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x10, 0xd7, 0x02, 0x12, 0x0c, 0x0a, 0x07, 0x66, 0x73, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x10, 0xd8, 0x02, 0x12, 0x0b, 0x0a, 0x06, 0x66, 0x73, 0x70, 0x69, 0x63, 0x6b, 0x10, 0xd9,
+	0x02, 0x12, 0x0f, 0x0a, 0x0a, 0x70, 0x69, 0x64, 0x66, 0x64, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x10,
+	0xda, 0x02, 0x12, 0x0b, 0x0a, 0x06, 0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x33, 0x10, 0xdb, 0x02, 0x12,
+	0x10, 0x0a, 0x0b, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x10, 0xdc,
+	0x02, 0x12, 0x0c, 0x0a, 0x07, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x74, 0x32, 0x10, 0xdd, 0x02, 0x12,
+	0x10, 0x0a, 0x0b, 0x70, 0x69, 0x64, 0x66, 0x64, 0x5f, 0x67, 0x65, 0x74, 0x66, 0x64, 0x10, 0xde,
+	0x02, 0x12, 0x0f, 0x0a, 0x0a, 0x66, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x61, 0x74, 0x32, 0x10,
+	0xdf, 0x02, 0x12, 0x14, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x6d, 0x61,
+	0x64, 0x76, 0x69, 0x73, 0x65, 0x10, 0xe0, 0x02, 0x12, 0x11, 0x0a, 0x0c, 0x65, 0x70, 0x6f, 0x6c,
+	0x6c, 0x5f, 0x70, 0x77, 0x61, 0x69, 0x74, 0x32, 0x10, 0xe1, 0x02, 0x12, 0x12, 0x0a, 0x0d, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x74, 0x61, 0x74, 0x74, 0x72, 0x10, 0xe2, 0x02, 0x12,
+	0x10, 0x0a, 0x0b, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x63, 0x74, 0x6c, 0x5f, 0x66, 0x64, 0x10, 0xe3,
+	0x02, 0x12, 0x1c, 0x0a, 0x17, 0x6c, 0x61, 0x6e, 0x64, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x65, 0x74, 0x10, 0xe4, 0x02, 0x12,
+	0x16, 0x0a, 0x11, 0x6c, 0x61, 0x6e, 0x64, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x61, 0x64, 0x64, 0x5f,
+	0x72, 0x75, 0x6c, 0x65, 0x10, 0xe5, 0x02, 0x12, 0x1b, 0x0a, 0x16, 0x6c, 0x61, 0x6e, 0x64, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x5f, 0x73, 0x65, 0x6c,
+	0x66, 0x10, 0xe6, 0x02, 0x12, 0x11, 0x0a, 0x0c, 0x6d, 0x65, 0x6d, 0x66, 0x64, 0x5f, 0x73, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x10, 0xe7, 0x02, 0x12, 0x15, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x5f, 0x6d, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x10, 0xe8, 0x02, 0x12, 0x0c,
+	0x0a, 0x07, 0x77, 0x61, 0x69, 0x74, 0x70, 0x69, 0x64, 0x10, 0xe9, 0x02, 0x12, 0x0d, 0x0a, 0x08,
+	0x6f, 0x6c, 0x64, 0x66, 0x73, 0x74, 0x61, 0x74, 0x10, 0xea, 0x02, 0x12, 0x0a, 0x0a, 0x05, 0x62,
+	0x72, 0x65, 0x61, 0x6b, 0x10, 0xeb, 0x02, 0x12, 0x0c, 0x0a, 0x07, 0x6f, 0x6c, 0x64, 0x73, 0x74,
+	0x61, 0x74, 0x10, 0xec, 0x02, 0x12, 0x0b, 0x0a, 0x06, 0x75, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x10,
+	0xed, 0x02, 0x12, 0x0a, 0x0a, 0x05, 0x73, 0x74, 0x69, 0x6d, 0x65, 0x10, 0xee, 0x02, 0x12, 0x09,
+	0x0a, 0x04, 0x73, 0x74, 0x74, 0x79, 0x10, 0xef, 0x02, 0x12, 0x09, 0x0a, 0x04, 0x67, 0x74, 0x74,
+	0x79, 0x10, 0xf0, 0x02, 0x12, 0x09, 0x0a, 0x04, 0x6e, 0x69, 0x63, 0x65, 0x10, 0xf1, 0x02, 0x12,
+	0x0a, 0x0a, 0x05, 0x66, 0x74, 0x69, 0x6d, 0x65, 0x10, 0xf2, 0x02, 0x12, 0x09, 0x0a, 0x04, 0x70,
+	0x72, 0x6f, 0x66, 0x10, 0xf3, 0x02, 0x12, 0x0b, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c,
+	0x10, 0xf4, 0x02, 0x12, 0x09, 0x0a, 0x04, 0x6c, 0x6f, 0x63, 0x6b, 0x10, 0xf5, 0x02, 0x12, 0x08,
+	0x0a, 0x03, 0x6d, 0x70, 0x78, 0x10, 0xf6, 0x02, 0x12, 0x0b, 0x0a, 0x06, 0x75, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x10, 0xf7, 0x02, 0x12, 0x10, 0x0a, 0x0b, 0x6f, 0x6c, 0x64, 0x6f, 0x6c, 0x64, 0x75,
+	0x6e, 0x61, 0x6d, 0x65, 0x10, 0xf8, 0x02, 0x12, 0x0e, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x10, 0xf9, 0x02, 0x12, 0x0d, 0x0a, 0x08, 0x73, 0x67, 0x65, 0x74, 0x6d,
+	0x61, 0x73, 0x6b, 0x10, 0xfa, 0x02, 0x12, 0x0d, 0x0a, 0x08, 0x73, 0x73, 0x65, 0x74, 0x6d, 0x61,
+	0x73, 0x6b, 0x10, 0xfb, 0x02, 0x12, 0x0f, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x73, 0x75, 0x73, 0x70,
+	0x65, 0x6e, 0x64, 0x10, 0xfc, 0x02, 0x12, 0x0f, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x70, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x10, 0xfd, 0x02, 0x12, 0x0d, 0x0a, 0x08, 0x6f, 0x6c, 0x64, 0x6c, 0x73,
+	0x74, 0x61, 0x74, 0x10, 0xfe, 0x02, 0x12, 0x0c, 0x0a, 0x07, 0x72, 0x65, 0x61, 0x64, 0x64, 0x69,
+	0x72, 0x10, 0xff, 0x02, 0x12, 0x0b, 0x0a, 0x06, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x10, 0x80,
+	0x03, 0x12, 0x0f, 0x0a, 0x0a, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x63, 0x61, 0x6c, 0x6c, 0x10,
+	0x81, 0x03, 0x12, 0x0d, 0x0a, 0x08, 0x6f, 0x6c, 0x64, 0x75, 0x6e, 0x61, 0x6d, 0x65, 0x10, 0x82,
+	0x03, 0x12, 0x09, 0x0a, 0x04, 0x69, 0x64, 0x6c, 0x65, 0x10, 0x83, 0x03, 0x12, 0x0c, 0x0a, 0x07,
+	0x76, 0x6d, 0x38, 0x36, 0x6f, 0x6c, 0x64, 0x10, 0x84, 0x03, 0x12, 0x08, 0x0a, 0x03, 0x69, 0x70,
+	0x63, 0x10, 0x85, 0x03, 0x12, 0x0e, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x72, 0x65, 0x74, 0x75, 0x72,
+	0x6e, 0x10, 0x86, 0x03, 0x12, 0x10, 0x0a, 0x0b, 0x73, 0x69, 0x67, 0x70, 0x72, 0x6f, 0x63, 0x6d,
+	0x61, 0x73, 0x6b, 0x10, 0x87, 0x03, 0x12, 0x0c, 0x0a, 0x07, 0x62, 0x64, 0x66, 0x6c, 0x75, 0x73,
+	0x68, 0x10, 0x88, 0x03, 0x12, 0x10, 0x0a, 0x0b, 0x61, 0x66, 0x73, 0x5f, 0x73, 0x79, 0x73, 0x63,
+	0x61, 0x6c, 0x6c, 0x10, 0x89, 0x03, 0x12, 0x0b, 0x0a, 0x06, 0x6c, 0x6c, 0x73, 0x65, 0x65, 0x6b,
+	0x10, 0x8a, 0x03, 0x12, 0x0f, 0x0a, 0x0a, 0x6f, 0x6c, 0x64, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x10, 0x8b, 0x03, 0x12, 0x09, 0x0a, 0x04, 0x76, 0x6d, 0x38, 0x36, 0x10, 0x8c, 0x03, 0x12,
+	0x12, 0x0a, 0x0d, 0x6f, 0x6c, 0x64, 0x5f, 0x67, 0x65, 0x74, 0x72, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x10, 0x8d, 0x03, 0x12, 0x0a, 0x0a, 0x05, 0x6d, 0x6d, 0x61, 0x70, 0x32, 0x10, 0x8e, 0x03, 0x12,
+	0x0f, 0x0a, 0x0a, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x36, 0x34, 0x10, 0x8f, 0x03,
+	0x12, 0x10, 0x0a, 0x0b, 0x66, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x36, 0x34, 0x10,
+	0x90, 0x03, 0x12, 0x0b, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x36, 0x34, 0x10, 0x91, 0x03, 0x12,
+	0x0c, 0x0a, 0x07, 0x6c, 0x73, 0x74, 0x61, 0x74, 0x36, 0x34, 0x10, 0x92, 0x03, 0x12, 0x0c, 0x0a,
+	0x07, 0x66, 0x73, 0x74, 0x61, 0x74, 0x36, 0x34, 0x10, 0x93, 0x03, 0x12, 0x0d, 0x0a, 0x08, 0x6c,
+	0x63, 0x68, 0x6f, 0x77, 0x6e, 0x31, 0x36, 0x10, 0x94, 0x03, 0x12, 0x0d, 0x0a, 0x08, 0x67, 0x65,
+	0x74, 0x75, 0x69, 0x64, 0x31, 0x36, 0x10, 0x95, 0x03, 0x12, 0x0d, 0x0a, 0x08, 0x67, 0x65, 0x74,
+	0x67, 0x69, 0x64, 0x31, 0x36, 0x10, 0x96, 0x03, 0x12, 0x0e, 0x0a, 0x09, 0x67, 0x65, 0x74, 0x65,
+	0x75, 0x69, 0x64, 0x31, 0x36, 0x10, 0x97, 0x03, 0x12, 0x0e, 0x0a, 0x09, 0x67, 0x65, 0x74, 0x65,
+	0x67, 0x69, 0x64, 0x31, 0x36, 0x10, 0x98, 0x03, 0x12, 0x0f, 0x0a, 0x0a, 0x73, 0x65, 0x74, 0x72,
+	0x65, 0x75, 0x69, 0x64, 0x31, 0x36, 0x10, 0x99, 0x03, 0x12, 0x0f, 0x0a, 0x0a, 0x73, 0x65, 0x74,
+	0x72, 0x65, 0x67, 0x69, 0x64, 0x31, 0x36, 0x10, 0x9a, 0x03, 0x12, 0x10, 0x0a, 0x0b, 0x67, 0x65,
+	0x74, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x31, 0x36, 0x10, 0x9b, 0x03, 0x12, 0x10, 0x0a, 0x0b,
+	0x73, 0x65, 0x74, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x31, 0x36, 0x10, 0x9c, 0x03, 0x12, 0x0d,
+	0x0a, 0x08, 0x66, 0x63, 0x68, 0x6f, 0x77, 0x6e, 0x31, 0x36, 0x10, 0x9d, 0x03, 0x12, 0x10, 0x0a,
+	0x0b, 0x73, 0x65, 0x74, 0x72, 0x65, 0x73, 0x75, 0x69, 0x64, 0x31, 0x36, 0x10, 0x9e, 0x03, 0x12,
+	0x10, 0x0a, 0x0b, 0x67, 0x65, 0x74, 0x72, 0x65, 0x73, 0x75, 0x69, 0x64, 0x31, 0x36, 0x10, 0x9f,
+	0x03, 0x12, 0x10, 0x0a, 0x0b, 0x73, 0x65, 0x74, 0x72, 0x65, 0x73, 0x67, 0x69, 0x64, 0x31, 0x36,
+	0x10, 0xa0, 0x03, 0x12, 0x10, 0x0a, 0x0b, 0x67, 0x65, 0x74, 0x72, 0x65, 0x73, 0x67, 0x69, 0x64,
+	0x31, 0x36, 0x10, 0xa1, 0x03, 0x12, 0x0c, 0x0a, 0x07, 0x63, 0x68, 0x6f, 0x77, 0x6e, 0x31, 0x36,
+	0x10, 0xa2, 0x03, 0x12, 0x0d, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x75, 0x69, 0x64, 0x31, 0x36, 0x10,
+	0xa3, 0x03, 0x12, 0x0d, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x67, 0x69, 0x64, 0x31, 0x36, 0x10, 0xa4,
+	0x03, 0x12, 0x0f, 0x0a, 0x0a, 0x73, 0x65, 0x74, 0x66, 0x73, 0x75, 0x69, 0x64, 0x31, 0x36, 0x10,
+	0xa5, 0x03, 0x12, 0x0f, 0x0a, 0x0a, 0x73, 0x65, 0x74, 0x66, 0x73, 0x67, 0x69, 0x64, 0x31, 0x36,
+	0x10, 0xa6, 0x03, 0x12, 0x0c, 0x0a, 0x07, 0x66, 0x63, 0x6e, 0x74, 0x6c, 0x36, 0x34, 0x10, 0xa7,
+	0x03, 0x12, 0x0f, 0x0a, 0x0a, 0x73, 0x65, 0x6e, 0x64, 0x66, 0x69, 0x6c, 0x65, 0x33, 0x32, 0x10,
+	0xa8, 0x03, 0x12, 0x0d, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74, 0x66, 0x73, 0x36, 0x34, 0x10, 0xa9,
+	0x03, 0x12, 0x0e, 0x0a, 0x09, 0x66, 0x73, 0x74, 0x61, 0x74, 0x66, 0x73, 0x36, 0x34, 0x10, 0xaa,
+	0x03, 0x12, 0x11, 0x0a, 0x0c, 0x66, 0x61, 0x64, 0x76, 0x69, 0x73, 0x65, 0x36, 0x34, 0x5f, 0x36,
+	0x34, 0x10, 0xab, 0x03, 0x12, 0x14, 0x0a, 0x0f, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x67, 0x65,
+	0x74, 0x74, 0x69, 0x6d, 0x65, 0x33, 0x32, 0x10, 0xac, 0x03, 0x12, 0x14, 0x0a, 0x0f, 0x63, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6d, 0x65, 0x33, 0x32, 0x10, 0xad, 0x03,
+	0x12, 0x14, 0x0a, 0x0f, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x61, 0x64, 0x6a, 0x74, 0x69, 0x6d,
+	0x65, 0x36, 0x34, 0x10, 0xae, 0x03, 0x12, 0x18, 0x0a, 0x13, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
+	0x67, 0x65, 0x74, 0x72, 0x65, 0x73, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x33, 0x32, 0x10, 0xaf, 0x03,
+	0x12, 0x1b, 0x0a, 0x16, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x61, 0x6e, 0x6f, 0x73, 0x6c,
+	0x65, 0x65, 0x70, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x33, 0x32, 0x10, 0xb0, 0x03, 0x12, 0x14, 0x0a,
+	0x0f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x5f, 0x67, 0x65, 0x74, 0x74, 0x69, 0x6d, 0x65, 0x33, 0x32,
+	0x10, 0xb1, 0x03, 0x12, 0x14, 0x0a, 0x0f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x74,
+	0x74, 0x69, 0x6d, 0x65, 0x33, 0x32, 0x10, 0xb2, 0x03, 0x12, 0x16, 0x0a, 0x11, 0x74, 0x69, 0x6d,
+	0x65, 0x72, 0x66, 0x64, 0x5f, 0x67, 0x65, 0x74, 0x74, 0x69, 0x6d, 0x65, 0x33, 0x32, 0x10, 0xb3,
+	0x03, 0x12, 0x16, 0x0a, 0x11, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x66, 0x64, 0x5f, 0x73, 0x65, 0x74,
+	0x74, 0x69, 0x6d, 0x65, 0x33, 0x32, 0x10, 0xb4, 0x03, 0x12, 0x15, 0x0a, 0x10, 0x75, 0x74, 0x69,
+	0x6d, 0x65, 0x6e, 0x73, 0x61, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x33, 0x32, 0x10, 0xb5, 0x03,
+	0x12, 0x14, 0x0a, 0x0f, 0x70, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x36, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x33, 0x32, 0x10, 0xb6, 0x03, 0x12, 0x11, 0x0a, 0x0c, 0x70, 0x70, 0x6f, 0x6c, 0x6c, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x33, 0x32, 0x10, 0xb7, 0x03, 0x12, 0x19, 0x0a, 0x14, 0x69, 0x6f, 0x5f,
+	0x70, 0x67, 0x65, 0x74, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x33,
+	0x32, 0x10, 0xb8, 0x03, 0x12, 0x14, 0x0a, 0x0f, 0x72, 0x65, 0x63, 0x76, 0x6d, 0x6d, 0x73, 0x67,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x33, 0x32, 0x10, 0xb9, 0x03, 0x12, 0x18, 0x0a, 0x13, 0x6d, 0x71,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x64, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x33,
+	0x32, 0x10, 0xba, 0x03, 0x12, 0x1b, 0x0a, 0x16, 0x6d, 0x71, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x64,
+	0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x33, 0x32, 0x10, 0xbb,
+	0x03, 0x12, 0x1b, 0x0a, 0x16, 0x72, 0x74, 0x5f, 0x73, 0x69, 0x67, 0x74, 0x69, 0x6d, 0x65, 0x64,
+	0x77, 0x61, 0x69, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x33, 0x32, 0x10, 0xbc, 0x03, 0x12, 0x11,
+	0x0a, 0x0c, 0x66, 0x75, 0x74, 0x65, 0x78, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x33, 0x32, 0x10, 0xbd,
+	0x03, 0x12, 0x21, 0x0a, 0x1c, 0x73, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x72, 0x72, 0x5f, 0x67, 0x65,
+	0x74, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x33,
+	0x32, 0x10, 0xbe, 0x03, 0x12, 0x14, 0x0a, 0x0f, 0x6e, 0x65, 0x74, 0x5f, 0x70, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x10, 0xe8, 0x07, 0x12, 0x13, 0x0a, 0x0e, 0x6e, 0x65,
+	0x74, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x72, 0x61, 0x77, 0x10, 0xe9, 0x07, 0x12,
+	0x17, 0x0a, 0x12, 0x6e, 0x65, 0x74, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x70,
+	0x5f, 0x62, 0x61, 0x73, 0x65, 0x10, 0xea, 0x07, 0x12, 0x18, 0x0a, 0x13, 0x6e, 0x65, 0x74, 0x5f,
+	0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x74, 0x63, 0x70, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x10,
+	0xeb, 0x07, 0x12, 0x18, 0x0a, 0x13, 0x6e, 0x65, 0x74, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x5f, 0x75, 0x64, 0x70, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x10, 0xec, 0x07, 0x12, 0x19, 0x0a, 0x14,
+	0x6e, 0x65, 0x74, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x63, 0x6d, 0x70, 0x5f,
+	0x62, 0x61, 0x73, 0x65, 0x10, 0xed, 0x07, 0x12, 0x1b, 0x0a, 0x16, 0x6e, 0x65, 0x74, 0x5f, 0x70,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x63, 0x6d, 0x70, 0x76, 0x36, 0x5f, 0x62, 0x61, 0x73,
+	0x65, 0x10, 0xee, 0x07, 0x12, 0x18, 0x0a, 0x13, 0x6e, 0x65, 0x74, 0x5f, 0x70, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x5f, 0x64, 0x6e, 0x73, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x10, 0xef, 0x07, 0x12, 0x19,
+	0x0a, 0x14, 0x6e, 0x65, 0x74, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x68, 0x74, 0x74,
+	0x70, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x10, 0xf0, 0x07, 0x12, 0x17, 0x0a, 0x12, 0x6e, 0x65, 0x74,
+	0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x63, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x10,
+	0xf1, 0x07, 0x12, 0x19, 0x0a, 0x14, 0x6e, 0x65, 0x74, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x10, 0xf2, 0x07, 0x12, 0x0e, 0x0a,
+	0x09, 0x73, 0x79, 0x73, 0x5f, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x10, 0xf4, 0x07, 0x12, 0x0d, 0x0a,
+	0x08, 0x73, 0x79, 0x73, 0x5f, 0x65, 0x78, 0x69, 0x74, 0x10, 0xf5, 0x07, 0x12, 0x17, 0x0a, 0x12,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x66, 0x6f,
+	0x72, 0x6b, 0x10, 0xf6, 0x07, 0x12, 0x17, 0x0a, 0x12, 0x73, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x70,
+	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x10, 0xf7, 0x07, 0x12, 0x17,
+	0x0a, 0x12, 0x73, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f,
+	0x65, 0x78, 0x69, 0x74, 0x10, 0xf8, 0x07, 0x12, 0x11, 0x0a, 0x0c, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x5f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x10, 0xf9, 0x07, 0x12, 0x0c, 0x0a, 0x07, 0x64, 0x6f,
+	0x5f, 0x65, 0x78, 0x69, 0x74, 0x10, 0xfa, 0x07, 0x12, 0x10, 0x0a, 0x0b, 0x63, 0x61, 0x70, 0x5f,
+	0x63, 0x61, 0x70, 0x61, 0x62, 0x6c, 0x65, 0x10, 0xfb, 0x07, 0x12, 0x0e, 0x0a, 0x09, 0x76, 0x66,
+	0x73, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x10, 0xfc, 0x07, 0x12, 0x0f, 0x0a, 0x0a, 0x76, 0x66,
+	0x73, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x76, 0x10, 0xfd, 0x07, 0x12, 0x0d, 0x0a, 0x08, 0x76,
+	0x66, 0x73, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x10, 0xfe, 0x07, 0x12, 0x0e, 0x0a, 0x09, 0x76, 0x66,
+	0x73, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x76, 0x10, 0xff, 0x07, 0x12, 0x13, 0x0a, 0x0e, 0x6d, 0x65,
+	0x6d, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x5f, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x10, 0x80, 0x08, 0x12,
+	0x11, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x73, 0x10,
+	0x81, 0x08, 0x12, 0x13, 0x0a, 0x0e, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x5f, 0x74, 0x61, 0x73,
+	0x6b, 0x5f, 0x6e, 0x73, 0x10, 0x82, 0x08, 0x12, 0x10, 0x0a, 0x0b, 0x6d, 0x61, 0x67, 0x69, 0x63,
+	0x5f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x10, 0x83, 0x08, 0x12, 0x17, 0x0a, 0x12, 0x63, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x5f, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x10,
+	0x84, 0x08, 0x12, 0x11, 0x0a, 0x0c, 0x63, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6d, 0x6b, 0x64,
+	0x69, 0x72, 0x10, 0x85, 0x08, 0x12, 0x11, 0x0a, 0x0c, 0x63, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f,
+	0x72, 0x6d, 0x64, 0x69, 0x72, 0x10, 0x86, 0x08, 0x12, 0x18, 0x0a, 0x13, 0x73, 0x65, 0x63, 0x75,
+	0x72, 0x69, 0x74, 0x79, 0x5f, 0x62, 0x70, 0x72, 0x6d, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x10,
+	0x87, 0x08, 0x12, 0x17, 0x0a, 0x12, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x66,
+	0x69, 0x6c, 0x65, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x10, 0x88, 0x08, 0x12, 0x1a, 0x0a, 0x15, 0x73,
+	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x75, 0x6e,
+	0x6c, 0x69, 0x6e, 0x6b, 0x10, 0x89, 0x08, 0x12, 0x1b, 0x0a, 0x16, 0x73, 0x65, 0x63, 0x75, 0x72,
+	0x69, 0x74, 0x79, 0x5f, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x10, 0x8a, 0x08, 0x12, 0x1b, 0x0a, 0x16, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79,
+	0x5f, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x10, 0x8b,
+	0x08, 0x12, 0x1c, 0x0a, 0x17, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x73, 0x6f,
+	0x63, 0x6b, 0x65, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x10, 0x8c, 0x08, 0x12,
+	0x1b, 0x0a, 0x16, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x73, 0x6f, 0x63, 0x6b,
+	0x65, 0x74, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x10, 0x8d, 0x08, 0x12, 0x19, 0x0a, 0x14,
+	0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x5f,
+	0x62, 0x69, 0x6e, 0x64, 0x10, 0x8e, 0x08, 0x12, 0x1f, 0x0a, 0x1a, 0x73, 0x65, 0x63, 0x75, 0x72,
+	0x69, 0x74, 0x79, 0x5f, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x73, 0x65, 0x74, 0x73, 0x6f,
+	0x63, 0x6b, 0x6f, 0x70, 0x74, 0x10, 0x8f, 0x08, 0x12, 0x16, 0x0a, 0x11, 0x73, 0x65, 0x63, 0x75,
+	0x72, 0x69, 0x74, 0x79, 0x5f, 0x73, 0x62, 0x5f, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x10, 0x90, 0x08,
+	0x12, 0x11, 0x0a, 0x0c, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x62, 0x70, 0x66,
+	0x10, 0x91, 0x08, 0x12, 0x15, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f,
+	0x62, 0x70, 0x66, 0x5f, 0x6d, 0x61, 0x70, 0x10, 0x92, 0x08, 0x12, 0x1e, 0x0a, 0x19, 0x73, 0x65,
+	0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x5f, 0x72, 0x65,
+	0x61, 0x64, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x10, 0x93, 0x08, 0x12, 0x19, 0x0a, 0x14, 0x73, 0x65,
+	0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x6d, 0x6b, 0x6e,
+	0x6f, 0x64, 0x10, 0x94, 0x08, 0x12, 0x23, 0x0a, 0x1e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74,
+	0x79, 0x5f, 0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x72, 0x65,
+	0x61, 0x64, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x10, 0x95, 0x08, 0x12, 0x1b, 0x0a, 0x16, 0x73, 0x65,
+	0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x73, 0x79, 0x6d,
+	0x6c, 0x69, 0x6e, 0x6b, 0x10, 0x96, 0x08, 0x12, 0x17, 0x0a, 0x12, 0x73, 0x65, 0x63, 0x75, 0x72,
+	0x69, 0x74, 0x79, 0x5f, 0x6d, 0x6d, 0x61, 0x70, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x10, 0x97, 0x08,
+	0x12, 0x1b, 0x0a, 0x16, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x66, 0x69, 0x6c,
+	0x65, 0x5f, 0x6d, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x10, 0x98, 0x08, 0x12, 0x0f, 0x0a,
+	0x0a, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x64, 0x75, 0x70, 0x10, 0x99, 0x08, 0x12, 0x12,
+	0x0a, 0x0d, 0x7a, 0x65, 0x72, 0x6f, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x10,
+	0x9a, 0x08, 0x12, 0x13, 0x0a, 0x0e, 0x5f, 0x5f, 0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x5f, 0x77,
+	0x72, 0x69, 0x74, 0x65, 0x10, 0x9b, 0x08, 0x12, 0x10, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x63, 0x5f,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x10, 0x9c, 0x08, 0x12, 0x12, 0x0a, 0x0d, 0x6b, 0x70, 0x72,
+	0x6f, 0x62, 0x65, 0x5f, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x10, 0x9d, 0x08, 0x12, 0x18, 0x0a,
+	0x13, 0x63, 0x61, 0x6c, 0x6c, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x6d, 0x6f, 0x64, 0x65, 0x68, 0x65,
+	0x6c, 0x70, 0x65, 0x72, 0x10, 0x9e, 0x08, 0x12, 0x16, 0x0a, 0x11, 0x64, 0x69, 0x72, 0x74, 0x79,
+	0x5f, 0x70, 0x69, 0x70, 0x65, 0x5f, 0x73, 0x70, 0x6c, 0x69, 0x63, 0x65, 0x10, 0x9f, 0x08, 0x12,
+	0x18, 0x0a, 0x13, 0x64, 0x65, 0x62, 0x75, 0x67, 0x66, 0x73, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x10, 0xa0, 0x08, 0x12, 0x18, 0x0a, 0x13, 0x73, 0x79, 0x73,
+	0x63, 0x61, 0x6c, 0x6c, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x10, 0xa1, 0x08, 0x12, 0x17, 0x0a, 0x12, 0x64, 0x65, 0x62, 0x75, 0x67, 0x66, 0x73, 0x5f, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x64, 0x69, 0x72, 0x10, 0xa2, 0x08, 0x12, 0x0f, 0x0a, 0x0a,
+	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x10, 0xa3, 0x08, 0x12, 0x14, 0x0a,
+	0x0f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x63, 0x68, 0x72, 0x64, 0x65, 0x76,
+	0x10, 0xa4, 0x08, 0x12, 0x19, 0x0a, 0x14, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x5f, 0x6f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x10, 0xa5, 0x08, 0x12, 0x13,
+	0x0a, 0x0e, 0x64, 0x6f, 0x5f, 0x69, 0x6e, 0x69, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
+	0x10, 0xa6, 0x08, 0x12, 0x12, 0x0a, 0x0d, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x61, 0x63,
+	0x63, 0x65, 0x70, 0x74, 0x10, 0xa7, 0x08, 0x12, 0x13, 0x0a, 0x0e, 0x6c, 0x6f, 0x61, 0x64, 0x5f,
+	0x65, 0x6c, 0x66, 0x5f, 0x70, 0x68, 0x64, 0x72, 0x73, 0x10, 0xa8, 0x08, 0x12, 0x15, 0x0a, 0x10,
+	0x68, 0x6f, 0x6f, 0x6b, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x63, 0x5f, 0x66, 0x6f, 0x70, 0x73,
+	0x10, 0xa9, 0x08, 0x12, 0x16, 0x0a, 0x11, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x5f, 0x6e, 0x65, 0x74,
+	0x5f, 0x73, 0x65, 0x71, 0x5f, 0x6f, 0x70, 0x73, 0x10, 0xaa, 0x08, 0x12, 0x10, 0x0a, 0x0b, 0x74,
+	0x61, 0x73, 0x6b, 0x5f, 0x72, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x10, 0xab, 0x08, 0x12, 0x1a, 0x0a,
+	0x15, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x6e, 0x6f, 0x64, 0x65, 0x5f,
+	0x72, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x10, 0xac, 0x08, 0x12, 0x11, 0x0a, 0x0c, 0x64, 0x6f, 0x5f,
+	0x73, 0x69, 0x67, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0xad, 0x08, 0x12, 0x0f, 0x0a, 0x0a,
+	0x62, 0x70, 0x66, 0x5f, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x10, 0xae, 0x08, 0x12, 0x19, 0x0a,
+	0x14, 0x6b, 0x61, 0x6c, 0x6c, 0x73, 0x79, 0x6d, 0x73, 0x5f, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x10, 0xaf, 0x08, 0x12, 0x0c, 0x0a, 0x07, 0x64, 0x6f, 0x5f, 0x6d,
+	0x6d, 0x61, 0x70, 0x10, 0xb0, 0x08, 0x12, 0x13, 0x0a, 0x0e, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x5f,
+	0x6d, 0x65, 0x6d, 0x5f, 0x64, 0x75, 0x6d, 0x70, 0x10, 0xb1, 0x08, 0x12, 0x0f, 0x0a, 0x0a, 0x76,
+	0x66, 0x73, 0x5f, 0x75, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x10, 0xb2, 0x08, 0x12, 0x10, 0x0a, 0x0b,
+	0x64, 0x6f, 0x5f, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x10, 0xb3, 0x08, 0x12, 0x16,
+	0x0a, 0x11, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x10, 0xb4, 0x08, 0x12, 0x12, 0x0a, 0x0d, 0x69, 0x6e, 0x6f, 0x74, 0x69, 0x66,
+	0x79, 0x5f, 0x77, 0x61, 0x74, 0x63, 0x68, 0x10, 0xb5, 0x08, 0x12, 0x16, 0x0a, 0x11, 0x73, 0x65,
+	0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x62, 0x70, 0x66, 0x5f, 0x70, 0x72, 0x6f, 0x67, 0x10,
+	0xb6, 0x08, 0x12, 0x1b, 0x0a, 0x16, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x65, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x65, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0xb7, 0x08, 0x12,
+	0x19, 0x0a, 0x14, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x61, 0x74, 0x68,
+	0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x10, 0xb8, 0x08, 0x12, 0x0f, 0x0a, 0x0a, 0x73, 0x65,
+	0x74, 0x5f, 0x66, 0x73, 0x5f, 0x70, 0x77, 0x64, 0x10, 0xb9, 0x08, 0x12, 0x1e, 0x0a, 0x19, 0x73,
+	0x75, 0x73, 0x70, 0x69, 0x63, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x73, 0x79, 0x73, 0x63, 0x61, 0x6c,
+	0x6c, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x10, 0xba, 0x08, 0x12, 0x10, 0x0a, 0x0b, 0x73,
+	0x74, 0x61, 0x63, 0x6b, 0x5f, 0x70, 0x69, 0x76, 0x6f, 0x74, 0x10, 0xbb, 0x08, 0x12, 0x20, 0x0a,
+	0x1b, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x5f, 0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x5f, 0x6d,
+	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x65, 0x6b, 0x65, 0x72, 0x10, 0xbc, 0x08, 0x12,
+	0x10, 0x0a, 0x0b, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x10, 0xbd,
+	0x08, 0x12, 0x10, 0x0a, 0x0b, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x66, 0x72, 0x65, 0x65,
+	0x10, 0xbe, 0x08, 0x12, 0x15, 0x0a, 0x10, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x5f, 0x66,
+	0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x10, 0xbf, 0x08, 0x12, 0x24, 0x0a, 0x1f, 0x70, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x5f, 0x66, 0x61,
+	0x69, 0x6c, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x10, 0xc0, 0x08,
+	0x12, 0x1c, 0x0a, 0x17, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x61, 0x73,
+	0x6b, 0x5f, 0x73, 0x65, 0x74, 0x72, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x10, 0xc1, 0x08, 0x12, 0x17,
+	0x0a, 0x12, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x69,
+	0x6d, 0x65, 0x36, 0x34, 0x10, 0xc2, 0x08, 0x12, 0x11, 0x0a, 0x0c, 0x63, 0x68, 0x6d, 0x6f, 0x64,
+	0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x10, 0xc3, 0x08, 0x12, 0x17, 0x0a, 0x12, 0x73, 0x65,
+	0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x73, 0x62, 0x5f, 0x75, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x10, 0xc4, 0x08, 0x12, 0x18, 0x0a, 0x13, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f,
+	0x74, 0x61, 0x73, 0x6b, 0x5f, 0x70, 0x72, 0x63, 0x74, 0x6c, 0x10, 0xc5, 0x08, 0x12, 0x18, 0x0a,
+	0x13, 0x6e, 0x65, 0x74, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x74, 0x6c, 0x73, 0x5f,
+	0x62, 0x61, 0x73, 0x65, 0x10, 0xc6, 0x08, 0x12, 0x22, 0x0a, 0x1d, 0x6e, 0x65, 0x74, 0x5f, 0x70,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x6c,
+	0x65, 0x73, 0x73, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x10, 0xc7, 0x08, 0x12, 0x14, 0x0a, 0x0f, 0x6e,
+	0x65, 0x74, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x70, 0x76, 0x34, 0x10, 0xd0,
+	0x0f, 0x12, 0x14, 0x0a, 0x0f, 0x6e, 0x65, 0x74, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x5f,
+	0x69, 0x70, 0x76, 0x36, 0x10, 0xd1, 0x0f, 0x12, 0x13, 0x0a, 0x0e, 0x6e, 0x65, 0x74, 0x5f, 0x70,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x74, 0x63, 0x70, 0x10, 0xd2, 0x0f, 0x12, 0x13, 0x0a, 0x0e,
+	0x6e, 0x65, 0x74, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x75, 0x64, 0x70, 0x10, 0xd3,
+	0x0f, 0x12, 0x14, 0x0a, 0x0f, 0x6e, 0x65, 0x74, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x5f,
+	0x69, 0x63, 0x6d, 0x70, 0x10, 0xd4, 0x0f, 0x12, 0x16, 0x0a, 0x11, 0x6e, 0x65, 0x74, 0x5f, 0x70,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x63, 0x6d, 0x70, 0x76, 0x36, 0x10, 0xd5, 0x0f, 0x12,
+	0x13, 0x0a, 0x0e, 0x6e, 0x65, 0x74, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x64, 0x6e,
+	0x73, 0x10, 0xd6, 0x0f, 0x12, 0x1b, 0x0a, 0x16, 0x6e, 0x65, 0x74, 0x5f, 0x70, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x5f, 0x64, 0x6e, 0x73, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x10, 0xd7,
+	0x0f, 0x12, 0x1c, 0x0a, 0x17, 0x6e, 0x65, 0x74, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x5f,
+	0x64, 0x6e, 0x73, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x10, 0xd8, 0x0f, 0x12,
+	0x14, 0x0a, 0x0f, 0x6e, 0x65, 0x74, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x68, 0x74,
+	0x74, 0x70, 0x10, 0xd9, 0x0f, 0x12, 0x1c, 0x0a, 0x17, 0x6e, 0x65, 0x74, 0x5f, 0x70, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x5f, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x10, 0xda, 0x0f, 0x12, 0x1d, 0x0a, 0x18, 0x6e, 0x65, 0x74, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x5f, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x10,
+	0xdb, 0x0f, 0x12, 0x17, 0x0a, 0x12, 0x6e, 0x65, 0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x74,
+	0x63, 0x70, 0x5f, 0x62, 0x65, 0x67, 0x69, 0x6e, 0x10, 0xdc, 0x0f, 0x12, 0x15, 0x0a, 0x10, 0x6e,
+	0x65, 0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x74, 0x63, 0x70, 0x5f, 0x65, 0x6e, 0x64, 0x10,
+	0xdd, 0x0f, 0x12, 0x14, 0x0a, 0x0f, 0x6e, 0x65, 0x74, 0x5f, 0x74, 0x63, 0x70, 0x5f, 0x63, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x10, 0xdf, 0x0f, 0x12, 0x14, 0x0a, 0x0f, 0x69, 0x6e, 0x69, 0x74,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x10, 0xe0, 0x0f, 0x12, 0x15,
+	0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x10, 0xe1, 0x0f, 0x12, 0x15, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x65, 0x72, 0x5f, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x10, 0xe2, 0x0f, 0x12, 0x17, 0x0a, 0x12,
+	0x65, 0x78, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x65, 0x72, 0x10, 0xe3, 0x0f, 0x12, 0x13, 0x0a, 0x0e, 0x68, 0x6f, 0x6f, 0x6b, 0x65, 0x64, 0x5f,
+	0x73, 0x79, 0x73, 0x63, 0x61, 0x6c, 0x6c, 0x10, 0xe4, 0x0f, 0x12, 0x13, 0x0a, 0x0e, 0x68, 0x6f,
+	0x6f, 0x6b, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x71, 0x5f, 0x6f, 0x70, 0x73, 0x10, 0xe5, 0x0f, 0x12,
+	0x13, 0x0a, 0x0e, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x65,
+	0x64, 0x10, 0xe6, 0x0f, 0x12, 0x16, 0x0a, 0x11, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x5f,
+	0x63, 0x6f, 0x6c, 0x6c, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x10, 0xe7, 0x0f, 0x12, 0x19, 0x0a, 0x14,
+	0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x5f, 0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x5f, 0x6d, 0x6f,
+	0x64, 0x75, 0x6c, 0x65, 0x10, 0xe8, 0x0f, 0x12, 0x10, 0x0a, 0x0b, 0x66, 0x74, 0x72, 0x61, 0x63,
+	0x65, 0x5f, 0x68, 0x6f, 0x6f, 0x6b, 0x10, 0xe9, 0x0f, 0x12, 0x10, 0x0a, 0x0b, 0x74, 0x72, 0x61,
+	0x63, 0x65, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x10, 0xea, 0x0f, 0x12, 0x12, 0x0a, 0x0d, 0x70,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0xeb, 0x0f, 0x12,
+	0x20, 0x0a, 0x1b, 0x6e, 0x65, 0x74, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x74, 0x6c,
+	0x73, 0x5f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x10, 0xec,
+	0x0f, 0x12, 0x20, 0x0a, 0x1b, 0x6e, 0x65, 0x74, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x5f,
+	0x74, 0x6c, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x68, 0x65, 0x6c, 0x6c, 0x6f,
+	0x10, 0xed, 0x0f, 0x12, 0x17, 0x0a, 0x12, 0x6e, 0x65, 0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x5f,
+	0x75, 0x64, 0x70, 0x5f, 0x62, 0x65, 0x67, 0x69, 0x6e, 0x10, 0xee, 0x0f, 0x12, 0x15, 0x0a, 0x10,
+	0x6e, 0x65, 0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x75, 0x64, 0x70, 0x5f, 0x65, 0x6e, 0x64,
+	0x10, 0xef, 0x0f, 0x12, 0x18, 0x0a, 0x13, 0x6e, 0x65, 0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x5f,
+	0x69, 0x63, 0x6d, 0x70, 0x5f, 0x62, 0x65, 0x67, 0x69, 0x6e, 0x10, 0xf0, 0x0f, 0x12, 0x16, 0x0a,
+	0x11, 0x6e, 0x65, 0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x69, 0x63, 0x6d, 0x70, 0x5f, 0x65,
+	0x6e, 0x64, 0x10, 0xf1, 0x0f, 0x22, 0x06, 0x08, 0xdc, 0x0b, 0x10, 0xcf, 0x0f, 0x22, 0x06, 0x08,
+	0xb8, 0x17, 0x10, 0x9f, 0x1f, 0x42, 0x2b, 0x5a, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x2f, 0x61, 0x71, 0x75, 0x61, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x2f,
+	0x74, 0x72, 0x61, 0x63, 0x65, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3518,7 +3645,7 @@ func file_api_v1beta1_event_proto_rawDescGZIP() []byte {
 }
 
 var file_api_v1beta1_event_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_api_v1beta1_event_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_api_v1beta1_event_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
 var file_api_v1beta1_event_proto_goTypes = []any{
 	(EventId)(0),                   // 0: tracee.v1beta1.EventId
 	(*Event)(nil),                  // 1: tracee.v1beta1.Event
@@ -3536,48 +3663,50 @@ var file_api_v1beta1_event_proto_goTypes = []any{
 	(*Pod)(nil),                    // 13: tracee.v1beta1.Pod
 	(*K8SNamespace)(nil),           // 14: tracee.v1beta1.K8sNamespace
 	(*DetectedFrom)(nil),           // 15: tracee.v1beta1.DetectedFrom
-	nil,                            // 16: tracee.v1beta1.Pod.LabelsEntry
-	(*timestamppb.Timestamp)(nil),  // 17: google.protobuf.Timestamp
-	(*EventValue)(nil),             // 18: tracee.v1beta1.EventValue
-	(*Threat)(nil),                 // 19: tracee.v1beta1.Threat
-	(*wrapperspb.UInt32Value)(nil), // 20: google.protobuf.UInt32Value
+	nil,                            // 16: tracee.v1beta1.Container.LabelsEntry
+	nil,                            // 17: tracee.v1beta1.Pod.LabelsEntry
+	(*timestamppb.Timestamp)(nil),  // 18: google.protobuf.Timestamp
+	(*EventValue)(nil),             // 19: tracee.v1beta1.EventValue
+	(*Threat)(nil),                 // 20: tracee.v1beta1.Threat
+	(*wrapperspb.UInt32Value)(nil), // 21: google.protobuf.UInt32Value
 }
 var file_api_v1beta1_event_proto_depIdxs = []int32{
-	17, // 0: tracee.v1beta1.Event.timestamp:type_name -> google.protobuf.Timestamp
+	18, // 0: tracee.v1beta1.Event.timestamp:type_name -> google.protobuf.Timestamp
 	0,  // 1: tracee.v1beta1.Event.id:type_name -> tracee.v1beta1.EventId
 	2,  // 2: tracee.v1beta1.Event.policies:type_name -> tracee.v1beta1.Policies
 	3,  // 3: tracee.v1beta1.Event.workload:type_name -> tracee.v1beta1.Workload
-	18, // 4: tracee.v1beta1.Event.data:type_name -> tracee.v1beta1.EventValue
-	19, // 5: tracee.v1beta1.Event.threat:type_name -> tracee.v1beta1.Threat
+	19, // 4: tracee.v1beta1.Event.data:type_name -> tracee.v1beta1.EventValue
+	20, // 5: tracee.v1beta1.Event.threat:type_name -> tracee.v1beta1.Threat
 	15, // 6: tracee.v1beta1.Event.detected_from:type_name -> tracee.v1beta1.DetectedFrom
 	4,  // 7: tracee.v1beta1.Workload.process:type_name -> tracee.v1beta1.Process
 	10, // 8: tracee.v1beta1.Workload.container:type_name -> tracee.v1beta1.Container
 	12, // 9: tracee.v1beta1.Workload.k8s:type_name -> tracee.v1beta1.K8s
 	5,  // 10: tracee.v1beta1.Process.executable:type_name -> tracee.v1beta1.Executable
-	20, // 11: tracee.v1beta1.Process.unique_id:type_name -> google.protobuf.UInt32Value
-	20, // 12: tracee.v1beta1.Process.host_pid:type_name -> google.protobuf.UInt32Value
-	20, // 13: tracee.v1beta1.Process.pid:type_name -> google.protobuf.UInt32Value
+	21, // 11: tracee.v1beta1.Process.unique_id:type_name -> google.protobuf.UInt32Value
+	21, // 12: tracee.v1beta1.Process.host_pid:type_name -> google.protobuf.UInt32Value
+	21, // 13: tracee.v1beta1.Process.pid:type_name -> google.protobuf.UInt32Value
 	6,  // 14: tracee.v1beta1.Process.real_user:type_name -> tracee.v1beta1.User
 	7,  // 15: tracee.v1beta1.Process.thread:type_name -> tracee.v1beta1.Thread
 	4,  // 16: tracee.v1beta1.Process.ancestors:type_name -> tracee.v1beta1.Process
-	20, // 17: tracee.v1beta1.User.id:type_name -> google.protobuf.UInt32Value
-	17, // 18: tracee.v1beta1.Thread.start_time:type_name -> google.protobuf.Timestamp
-	20, // 19: tracee.v1beta1.Thread.unique_id:type_name -> google.protobuf.UInt32Value
-	20, // 20: tracee.v1beta1.Thread.host_tid:type_name -> google.protobuf.UInt32Value
-	20, // 21: tracee.v1beta1.Thread.tid:type_name -> google.protobuf.UInt32Value
+	21, // 17: tracee.v1beta1.User.id:type_name -> google.protobuf.UInt32Value
+	18, // 18: tracee.v1beta1.Thread.start_time:type_name -> google.protobuf.Timestamp
+	21, // 19: tracee.v1beta1.Thread.unique_id:type_name -> google.protobuf.UInt32Value
+	21, // 20: tracee.v1beta1.Thread.host_tid:type_name -> google.protobuf.UInt32Value
+	21, // 21: tracee.v1beta1.Thread.tid:type_name -> google.protobuf.UInt32Value
 	8,  // 22: tracee.v1beta1.Thread.user_stack_trace:type_name -> tracee.v1beta1.UserStackTrace
 	9,  // 23: tracee.v1beta1.UserStackTrace.addresses:type_name -> tracee.v1beta1.StackAddress
 	11, // 24: tracee.v1beta1.Container.image:type_name -> tracee.v1beta1.ContainerImage
-	13, // 25: tracee.v1beta1.K8s.pod:type_name -> tracee.v1beta1.Pod
-	14, // 26: tracee.v1beta1.K8s.namespace:type_name -> tracee.v1beta1.K8sNamespace
-	16, // 27: tracee.v1beta1.Pod.labels:type_name -> tracee.v1beta1.Pod.LabelsEntry
-	18, // 28: tracee.v1beta1.DetectedFrom.data:type_name -> tracee.v1beta1.EventValue
-	15, // 29: tracee.v1beta1.DetectedFrom.parent:type_name -> tracee.v1beta1.DetectedFrom
-	30, // [30:30] is the sub-list for method output_type
-	30, // [30:30] is the sub-list for method input_type
-	30, // [30:30] is the sub-list for extension type_name
-	30, // [30:30] is the sub-list for extension extendee
-	0,  // [0:30] is the sub-list for field type_name
+	16, // 25: tracee.v1beta1.Container.labels:type_name -> tracee.v1beta1.Container.LabelsEntry
+	13, // 26: tracee.v1beta1.K8s.pod:type_name -> tracee.v1beta1.Pod
+	14, // 27: tracee.v1beta1.K8s.namespace:type_name -> tracee.v1beta1.K8sNamespace
+	17, // 28: tracee.v1beta1.Pod.labels:type_name -> tracee.v1beta1.Pod.LabelsEntry
+	19, // 29: tracee.v1beta1.DetectedFrom.data:type_name -> tracee.v1beta1.EventValue
+	15, // 30: tracee.v1beta1.DetectedFrom.parent:type_name -> tracee.v1beta1.DetectedFrom
+	31, // [31:31] is the sub-list for method output_type
+	31, // [31:31] is the sub-list for method input_type
+	31, // [31:31] is the sub-list for extension type_name
+	31, // [31:31] is the sub-list for extension extendee
+	0,  // [0:31] is the sub-list for field type_name
 }
 
 func init() { file_api_v1beta1_event_proto_init() }
@@ -3597,7 +3726,7 @@ func file_api_v1beta1_event_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_v1beta1_event_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   16,
+			NumMessages:   17,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
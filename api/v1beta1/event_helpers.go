@@ -330,6 +330,12 @@ func NewValue(name string, value any) (*EventValue, error) {
 	case *HTTPResponse:
 		ev.Value = &EventValue_HttpResponse{HttpResponse: v}
 
+	// TLS types
+	case *TLSClientHello:
+		ev.Value = &EventValue_TlsClientHello{TlsClientHello: v}
+	case *TLSServerHello:
+		ev.Value = &EventValue_TlsServerHello{TlsServerHello: v}
+
 	// Other specialized types
 	case *Credentials:
 		ev.Value = &EventValue_Credentials{Credentials: v}
@@ -292,6 +292,24 @@ func writeProcess(buf *bytes.Buffer, p *Process) {
 		first = false
 	}
 
+	if len(p.Cmdline) > 0 {
+		if !first {
+			buf.WriteByte(',')
+		}
+		buf.WriteString(`"cmdline":`)
+		writeStringArray(buf, p.Cmdline)
+		first = false
+	}
+
+	if len(p.Env) > 0 {
+		if !first {
+			buf.WriteByte(',')
+		}
+		buf.WriteString(`"env":`)
+		writeStringArray(buf, p.Env)
+		first = false
+	}
+
 	if len(p.Ancestors) > 0 {
 		if !first {
 			buf.WriteByte(',')
@@ -309,6 +327,36 @@ func writeProcess(buf *bytes.Buffer, p *Process) {
 	buf.WriteByte('}')
 }
 
+func writeStringArray(buf *bytes.Buffer, values []string) {
+	buf.WriteByte('[')
+	for i, s := range values {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		buf.WriteByte('"')
+		writeEscapedString(buf, s)
+		buf.WriteByte('"')
+	}
+	buf.WriteByte(']')
+}
+
+func writeStringMap(buf *bytes.Buffer, values map[string]string) {
+	buf.WriteByte('{')
+	i := 0
+	for key, val := range values {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		buf.WriteByte('"')
+		writeEscapedString(buf, key)
+		buf.WriteString(`":"`)
+		writeEscapedString(buf, val)
+		buf.WriteByte('"')
+		i++
+	}
+	buf.WriteByte('}')
+}
+
 func writeThread(buf *bytes.Buffer, t *Thread) {
 	if t == nil {
 		buf.WriteString("null")
@@ -437,6 +485,28 @@ func writeContainer(buf *bytes.Buffer, c *Container) {
 
 	buf.WriteString(`,"started":`)
 	writeBool(buf, c.Started)
+
+	if len(c.Labels) > 0 {
+		buf.WriteString(`,"labels":`)
+		writeStringMap(buf, c.Labels)
+	}
+	if c.Privileged {
+		buf.WriteString(`,"privileged":true`)
+	}
+	if len(c.AddedCapabilities) > 0 {
+		buf.WriteString(`,"added_capabilities":`)
+		writeStringArray(buf, c.AddedCapabilities)
+	}
+	if c.HostNetwork {
+		buf.WriteString(`,"host_network":true`)
+	}
+	if c.HostPid {
+		buf.WriteString(`,"host_pid":true`)
+	}
+	if c.HostIpc {
+		buf.WriteString(`,"host_ipc":true`)
+	}
+
 	buf.WriteByte('}')
 }
 
@@ -456,20 +526,8 @@ func writeK8s(buf *bytes.Buffer, k *K8S) {
 		buf.WriteByte('"')
 
 		if len(k.Pod.Labels) > 0 {
-			buf.WriteString(`,"labels":{`)
-			i := 0
-			for key, val := range k.Pod.Labels {
-				if i > 0 {
-					buf.WriteByte(',')
-				}
-				buf.WriteByte('"')
-				writeEscapedString(buf, key)
-				buf.WriteString(`":"`)
-				writeEscapedString(buf, val)
-				buf.WriteByte('"')
-				i++
-			}
-			buf.WriteByte('}')
+			buf.WriteString(`,"labels":`)
+			writeStringMap(buf, k.Pod.Labels)
 		}
 
 		buf.WriteByte('}')
@@ -707,6 +765,12 @@ func writeEventValue(buf *bytes.Buffer, ev *EventValue) {
 	case *EventValue_HttpResponse:
 		buf.WriteString(`,"http_response":`)
 		writeJSONFallback(buf, v.HttpResponse)
+	case *EventValue_TlsClientHello:
+		buf.WriteString(`,"tls_client_hello":`)
+		writeJSONFallback(buf, v.TlsClientHello)
+	case *EventValue_TlsServerHello:
+		buf.WriteString(`,"tls_server_hello":`)
+		writeJSONFallback(buf, v.TlsServerHello)
 	case *EventValue_Pointer:
 		buf.WriteString(`,"pointer":"`)
 		writeUint(buf, v.Pointer)
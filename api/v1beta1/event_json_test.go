@@ -146,6 +146,48 @@ func TestEventMarshalJSON(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "event with command lines",
+			event: &Event{
+				Timestamp: timestamppb.Now(),
+				Id:        8,
+				Name:      "event_with_cmdline",
+				Workload: &Workload{
+					Process: &Process{
+						Executable: &Executable{Path: "/bin/sh"},
+						Cmdline:    []string{"sh", "-c", `echo "hi"`},
+						Ancestors: []*Process{
+							{
+								UniqueId: wrapperspb.UInt32(111),
+								Cmdline:  []string{"python3", "-c", "import pty; pty.spawn('/bin/sh')"},
+								Env:      []string{"LD_PRELOAD=/tmp/x.so"},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "event with container runtime metadata",
+			event: &Event{
+				Timestamp: timestamppb.Now(),
+				Id:        9,
+				Name:      "event_with_container",
+				Workload: &Workload{
+					Container: &Container{
+						Id:                "abc123",
+						Name:              "web",
+						Image:             &ContainerImage{Name: "nginx:latest"},
+						Started:           true,
+						Labels:            map[string]string{"app": "web", "team": `"ops"`},
+						Privileged:        true,
+						AddedCapabilities: []string{"CAP_SYS_ADMIN", "CAP_NET_ADMIN"},
+						HostNetwork:       true,
+						HostPid:           true,
+					},
+				},
+			},
+		},
 		{
 			name: "event with nil pointer in ancestors (should not panic)",
 			event: &Event{
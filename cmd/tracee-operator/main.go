@@ -62,10 +62,8 @@ func main() {
 	// PolicyReconciler to watch for changes to Policy objects and deal with them.
 
 	policyReconciler := &controller.PolicyReconciler{
-		Client:          mgr.GetClient(),
-		Scheme:          mgr.GetScheme(),
-		TraceeNamespace: config.TraceeNamespace,
-		TraceeName:      config.TraceeName,
+		Client: mgr.GetClient(),
+		Scheme: mgr.GetScheme(),
 	}
 	if err := policyReconciler.SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "PolicyReconciler")
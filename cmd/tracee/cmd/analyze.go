@@ -14,6 +14,11 @@ import (
 	"github.com/aquasecurity/tracee/pkg/config"
 )
 
+const (
+	engineSignatures = "signatures"
+	engineDetectors  = "detectors"
+)
+
 func init() {
 	rootCmd.AddCommand(analyzeCmd)
 
@@ -38,7 +43,7 @@ func init() {
 		"events",
 		"e",
 		[]string{},
-		"Define which signature events to load",
+		"Define which signature (or detector) events to load",
 	)
 
 	// signatures-dir
@@ -48,6 +53,20 @@ func init() {
 		"Directory where to search for signatures in Go plugin (.so) format",
 	)
 
+	// engine
+	analyzeCmd.Flags().String(
+		"engine",
+		engineSignatures,
+		"Engine used to analyze events: signatures (trace.Event JSON input) or detectors (v1beta1 JSON input, as written by the json printer)",
+	)
+
+	// detectors
+	analyzeCmd.Flags().StringArray(
+		flags.DetectorsFlag,
+		[]string{},
+		"Directories where to search for YAML detectors and lists, or config.<id>.<key>=<value> detector parameters (detectors engine only)",
+	)
+
 	analyzeCmd.Flags().StringArrayP(
 		flags.LoggingFlag,
 		flags.LoggingFlagShort,
@@ -59,20 +78,26 @@ func init() {
 var analyzeCmd = &cobra.Command{
 	Use:     "analyze [--source file]",
 	Aliases: []string{},
-	Short:   "Analyze past events with signature events [Experimental]",
+	Short:   "Analyze past events with signature events or detectors [Experimental]",
 	Long: `Analyze allow you to explore signature events with past events.
 
 Tracee can be used to collect events and store it in a file. This file can be used as input to analyze.
 
 eg:
 tracee --events ptrace --output=json:events.json
-tracee analyze --events anti_debugging --source events.json`,
+tracee analyze --events anti_debugging --source events.json
+
+With --engine detectors, the recorded events are replayed through the detector engine,
+loading the built-in Go detectors and the YAML detectors and lists from --detectors:
+tracee analyze --engine detectors --detectors ./examples/detectors/yaml --source events.json`,
 	PreRun: func(cmd *cobra.Command, args []string) {
 		bindViperFlag(cmd, "events")
 		bindViperFlag(cmd, "source")
 		bindViperFlag(cmd, "output")
 		bindViperFlag(cmd, flags.LoggingFlag)
 		bindViperFlag(cmd, "signatures-dir")
+		bindViperFlag(cmd, "engine")
+		bindViperFlag(cmd, flags.DetectorsFlag)
 	},
 	Run:                   command,
 	DisableFlagsInUseLine: true,
@@ -97,6 +122,11 @@ func command(cmd *cobra.Command, args []string) {
 		logger.Fatalw("Failed to get signatures-dir flag", "err", err)
 	}
 
+	engineKind := viper.GetString("engine")
+	if engineKind != engineSignatures && engineKind != engineDetectors {
+		logger.Fatalw("Invalid engine, must be one of: signatures, detectors", "engine", engineKind)
+	}
+
 	// Set up printer output (outpath:format)
 	outputArg := viper.GetString("output")
 
@@ -127,6 +157,9 @@ func command(cmd *cobra.Command, args []string) {
 	}
 
 	if outFormat == "legacy" {
+		if engineKind == engineDetectors {
+			logger.Fatalw("Legacy output is not supported by the detectors engine")
+		}
 		if outPath != "stdout" && outPath != "" {
 			legacyOutFile, err = flags.CreateOutputFile(outPath)
 			if err != nil {
@@ -153,6 +186,22 @@ func command(cmd *cobra.Command, args []string) {
 		signatureEvents = nil
 	}
 
+	if engineKind == engineDetectors {
+		detectorsConfig, err := flags.PrepareDetectors(viper.GetStringSlice(flags.DetectorsFlag))
+		if err != nil {
+			logger.Fatalw("Failed to prepare detectors configuration", "error", err)
+		}
+
+		analyze.AnalyzeDetectors(analyze.Config{
+			Source:          sourceFile,
+			Printer:         p,
+			DetectorDirs:    detectorsConfig.Paths,
+			DetectorEvents:  signatureEvents,
+			DetectorsConfig: detectorsConfig.Config,
+		})
+		return
+	}
+
 	signatureDirs := viper.GetStringSlice("signatures-dir")
 
 	analyze.Analyze(analyze.Config{
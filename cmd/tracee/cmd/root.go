@@ -195,7 +195,7 @@ func initCmd() error {
 		flags.DetectorsFlag,
 		"d",
 		[]string{},
-		"[path...]\t\t\t\tConfigure YAML detector search directories",
+		"[path|config.<id>.<key>=<value>...]\tConfigure YAML detector search directories and detector parameters",
 	)
 	err = viper.BindPFlag(flags.DetectorsFlag, rootCmd.Flags().Lookup(flags.DetectorsFlag))
 	if err != nil {
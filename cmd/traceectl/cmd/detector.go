@@ -0,0 +1,137 @@
+package cmd
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/aquasecurity/tracee/cmd/traceectl/pkg/client"
+	cmdcobra "github.com/aquasecurity/tracee/cmd/traceectl/pkg/cmd/cobra"
+	"github.com/aquasecurity/tracee/cmd/traceectl/pkg/cmd/flags"
+	"github.com/aquasecurity/tracee/cmd/traceectl/pkg/cmd/printer"
+)
+
+var detectorCmd = &cobra.Command{
+	Use:   "detector [list | enable | disable | load]",
+	Short: "Manage tracee detectors",
+	Long: `Manage the detectors of a running tracee, without restarting it.
+
+
+	Examples:
+	  traceectl detector list
+	  traceectl detector enable TRC-102
+	  traceectl detector disable TRC-102
+	  traceectl detector load ./detector.yaml
+	`,
+	Args: cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		cmd.Help()
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(detectorCmd)
+	//
+	// List Detectors
+	//
+	detectorCmd.AddCommand(listDetectorsCmd)
+	listDetectorsCmd.Flags().String(flags.ServerFlag, client.DefaultSocket, "Specify the server address: a unix socket path, unix:<path> or tcp:<host:port>.")
+	listDetectorsCmd.Flags().String(flags.FormatFlag, printer.TableFormat, "Specify the format (json or table).")
+	listDetectorsCmd.Flags().String(flags.OutputFlag, "stdout", "Specify the output destination.")
+
+	//
+	// Enable Detector
+	//
+	detectorCmd.AddCommand(enableDetectorCmd)
+	enableDetectorCmd.Flags().String(flags.ServerFlag, client.DefaultSocket, "Specify the server address: a unix socket path, unix:<path> or tcp:<host:port>.")
+	enableDetectorCmd.Flags().String(flags.OutputFlag, "stdout", "Specify the output destination.")
+
+	//
+	// Disable Detector
+	//
+	detectorCmd.AddCommand(disableDetectorCmd)
+	disableDetectorCmd.Flags().String(flags.ServerFlag, client.DefaultSocket, "Specify the server address: a unix socket path, unix:<path> or tcp:<host:port>.")
+	disableDetectorCmd.Flags().String(flags.OutputFlag, "stdout", "Specify the output destination.")
+
+	//
+	// Load Detector
+	//
+	detectorCmd.AddCommand(loadDetectorCmd)
+	loadDetectorCmd.Flags().String(flags.ServerFlag, client.DefaultSocket, "Specify the server address: a unix socket path, unix:<path> or tcp:<host:port>.")
+	loadDetectorCmd.Flags().String(flags.OutputFlag, "stdout", "Specify the output destination.")
+}
+
+var listDetectorsCmd = &cobra.Command{
+	Use:   "list [DETECTOR...]",
+	Short: "List detectors",
+	Long:  `Lists the detectors registered in tracee with their state and health, all of them if no detector ID is given.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		runner, err := cmdcobra.GetListDetectors(cmd)
+		if err != nil {
+			cmd.PrintErrf("error creating runner: %s\n", err)
+			os.Exit(1)
+		}
+
+		if err := runner.Run(args); err != nil {
+			cmd.PrintErrf("error running: %s\n", err)
+			os.Exit(1)
+		}
+	},
+}
+
+var enableDetectorCmd = &cobra.Command{
+	Use:   "enable DETECTOR",
+	Short: "Enable a detector",
+	Long:  `Enables a detector by ID. It produces events again if its event is selected by a policy.`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		runner, err := cmdcobra.GetEnableDetector(cmd)
+		if err != nil {
+			cmd.PrintErrf("error creating runner: %s\n", err)
+			os.Exit(1)
+		}
+
+		if err := runner.Run(args); err != nil {
+			cmd.PrintErrf("error running: %s\n", err)
+			os.Exit(1)
+		}
+	},
+}
+
+var disableDetectorCmd = &cobra.Command{
+	Use:   "disable DETECTOR",
+	Short: "Disable a detector",
+	Long:  `Disables a detector by ID. It stops receiving events until enabled again.`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		runner, err := cmdcobra.GetDisableDetector(cmd)
+		if err != nil {
+			cmd.PrintErrf("error creating runner: %s\n", err)
+			os.Exit(1)
+		}
+
+		if err := runner.Run(args); err != nil {
+			cmd.PrintErrf("error running: %s\n", err)
+			os.Exit(1)
+		}
+	},
+}
+
+var loadDetectorCmd = &cobra.Command{
+	Use:   "load FILE",
+	Short: "Load a YAML detector",
+	Long:  `Loads a YAML detector into tracee. Its event can then be selected by policies.`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		runner, err := cmdcobra.GetLoadDetector(cmd)
+		if err != nil {
+			cmd.PrintErrf("error creating runner: %s\n", err)
+			os.Exit(1)
+		}
+
+		if err := runner.Run(args); err != nil {
+			cmd.PrintErrf("error running: %s\n", err)
+			os.Exit(1)
+		}
+	},
+}
@@ -35,7 +35,7 @@ func init() {
 	//
 	eventCmd.AddCommand(describeEventCmd)
 
-	describeEventCmd.Flags().String(flags.ServerFlag, client.DefaultSocket, "Specify the server unix socket.")
+	describeEventCmd.Flags().String(flags.ServerFlag, client.DefaultSocket, "Specify the server address: a unix socket path, unix:<path> or tcp:<host:port>.")
 	describeEventCmd.Flags().String(flags.FormatFlag, printer.TableFormat, "Specify the format (json or table).")
 	describeEventCmd.Flags().String(flags.OutputFlag, "stdout", "Specify the output destination.")
 
@@ -43,14 +43,14 @@ func init() {
 	// Enable Event
 	//
 	eventCmd.AddCommand(enableEventCmd)
-	enableEventCmd.Flags().String(flags.ServerFlag, client.DefaultSocket, "Specify the server unix socket.")
+	enableEventCmd.Flags().String(flags.ServerFlag, client.DefaultSocket, "Specify the server address: a unix socket path, unix:<path> or tcp:<host:port>.")
 	enableEventCmd.Flags().String(flags.OutputFlag, "stdout", "Specify the output destination.")
 
 	//
 	// Disable Event
 	//
 	eventCmd.AddCommand(disableEventCmd)
-	disableEventCmd.Flags().String(flags.ServerFlag, client.DefaultSocket, "Specify the server unix socket.")
+	disableEventCmd.Flags().String(flags.ServerFlag, client.DefaultSocket, "Specify the server address: a unix socket path, unix:<path> or tcp:<host:port>.")
 	disableEventCmd.Flags().String(flags.OutputFlag, "stdout", "Specify the output destination.")
 }
 
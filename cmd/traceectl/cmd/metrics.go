@@ -31,5 +31,5 @@ var metricsCmd = &cobra.Command{
 func init() {
 	rootCmd.AddCommand(metricsCmd)
 
-	metricsCmd.Flags().String(flags.ServerFlag, client.DefaultSocket, "Specify the server unix socket.")
+	metricsCmd.Flags().String(flags.ServerFlag, client.DefaultSocket, "Specify the server address: a unix socket path, unix:<path> or tcp:<host:port>.")
 }
@@ -0,0 +1,138 @@
+package cmd
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/aquasecurity/tracee/cmd/traceectl/pkg/client"
+	cmdcobra "github.com/aquasecurity/tracee/cmd/traceectl/pkg/cmd/cobra"
+	"github.com/aquasecurity/tracee/cmd/traceectl/pkg/cmd/flags"
+	"github.com/aquasecurity/tracee/cmd/traceectl/pkg/cmd/printer"
+)
+
+var policyCmd = &cobra.Command{
+	Use:   "policy [create | update | delete | list]",
+	Short: "Manage tracee policies",
+	Long: `Manage the policies applied by a running tracee, without restarting it.
+
+
+	Examples:
+	  traceectl policy create ./policy.yaml
+	  traceectl policy update ./policy.yaml
+	  traceectl policy delete my-policy
+	  traceectl policy list
+	`,
+	Args: cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		cmd.Help()
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(policyCmd)
+	//
+	// Create Policy
+	//
+	policyCmd.AddCommand(createPolicyCmd)
+	createPolicyCmd.Flags().String(flags.ServerFlag, client.DefaultSocket, "Specify the server address: a unix socket path, unix:<path> or tcp:<host:port>.")
+	createPolicyCmd.Flags().String(flags.OutputFlag, "stdout", "Specify the output destination.")
+
+	//
+	// Update Policy
+	//
+	policyCmd.AddCommand(updatePolicyCmd)
+	updatePolicyCmd.Flags().String(flags.ServerFlag, client.DefaultSocket, "Specify the server address: a unix socket path, unix:<path> or tcp:<host:port>.")
+	updatePolicyCmd.Flags().String(flags.OutputFlag, "stdout", "Specify the output destination.")
+
+	//
+	// Delete Policy
+	//
+	policyCmd.AddCommand(deletePolicyCmd)
+	deletePolicyCmd.Flags().String(flags.ServerFlag, client.DefaultSocket, "Specify the server address: a unix socket path, unix:<path> or tcp:<host:port>.")
+	deletePolicyCmd.Flags().String(flags.OutputFlag, "stdout", "Specify the output destination.")
+
+	//
+	// List Policies
+	//
+	policyCmd.AddCommand(listPoliciesCmd)
+	listPoliciesCmd.Flags().String(flags.ServerFlag, client.DefaultSocket, "Specify the server address: a unix socket path, unix:<path> or tcp:<host:port>.")
+	listPoliciesCmd.Flags().String(flags.FormatFlag, printer.TableFormat, "Specify the format (json or table).")
+	listPoliciesCmd.Flags().String(flags.OutputFlag, "stdout", "Specify the output destination.")
+}
+
+var createPolicyCmd = &cobra.Command{
+	Use:   "create FILE",
+	Short: "Create a policy",
+	Long:  `Creates a policy from a policy file (yaml or json). A policy with the same name must not exist.`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		runner, err := cmdcobra.GetCreatePolicy(cmd)
+		if err != nil {
+			cmd.PrintErrf("error creating runner: %s\n", err)
+			os.Exit(1)
+		}
+
+		if err := runner.Run(args); err != nil {
+			cmd.PrintErrf("error running: %s\n", err)
+			os.Exit(1)
+		}
+	},
+}
+
+var updatePolicyCmd = &cobra.Command{
+	Use:   "update FILE",
+	Short: "Update a policy",
+	Long:  `Replaces the policy with the same name as the policy file (yaml or json).`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		runner, err := cmdcobra.GetUpdatePolicy(cmd)
+		if err != nil {
+			cmd.PrintErrf("error creating runner: %s\n", err)
+			os.Exit(1)
+		}
+
+		if err := runner.Run(args); err != nil {
+			cmd.PrintErrf("error running: %s\n", err)
+			os.Exit(1)
+		}
+	},
+}
+
+var deletePolicyCmd = &cobra.Command{
+	Use:   "delete POLICY",
+	Short: "Delete a policy",
+	Long:  `Deletes a policy by name.`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		runner, err := cmdcobra.GetDeletePolicy(cmd)
+		if err != nil {
+			cmd.PrintErrf("error creating runner: %s\n", err)
+			os.Exit(1)
+		}
+
+		if err := runner.Run(args); err != nil {
+			cmd.PrintErrf("error running: %s\n", err)
+			os.Exit(1)
+		}
+	},
+}
+
+var listPoliciesCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List policies",
+	Long:  `Lists the policies applied by tracee, with the events they select.`,
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		runner, err := cmdcobra.GetListPolicies(cmd)
+		if err != nil {
+			cmd.PrintErrf("error creating runner: %s\n", err)
+			os.Exit(1)
+		}
+
+		if err := runner.Run(args); err != nil {
+			cmd.PrintErrf("error running: %s\n", err)
+			os.Exit(1)
+		}
+	},
+}
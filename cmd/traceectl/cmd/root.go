@@ -4,6 +4,8 @@ import (
 	"os"
 
 	"github.com/spf13/cobra"
+
+	"github.com/aquasecurity/tracee/cmd/traceectl/pkg/cmd/flags"
 )
 
 var (
@@ -20,6 +22,11 @@ This tool allows you to manage events, stream events directly from tracee, and g
 )
 
 func init() {
+	rootCmd.PersistentFlags().Bool(flags.TLSFlag, false, "Connect to the server with TLS (implied by the other tls flags).")
+	rootCmd.PersistentFlags().String(flags.TLSCertFlag, "", "Client certificate file, for servers requiring mutual TLS.")
+	rootCmd.PersistentFlags().String(flags.TLSKeyFlag, "", "Client certificate private key file.")
+	rootCmd.PersistentFlags().String(flags.TLSCAFlag, "", "CA certificates file verifying the server certificate (system CAs by default).")
+	rootCmd.PersistentFlags().String(flags.TLSServerNameFlag, "", "Server name to verify the server certificate against (the server host by default).")
 }
 
 func Execute() {
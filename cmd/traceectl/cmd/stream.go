@@ -17,6 +17,7 @@ var streamCmd = &cobra.Command{
 	Short: "Stream events from tracee",
 	Long: `Stream Management:
 Stream events matching specified policies from tracee and print formatted events to stdout.
+Events can be further filtered by tracee, by event name, container, pod, namespace or a CEL condition.
 `,
 	Args: cobra.ArbitraryArgs,
 	Run: func(cmd *cobra.Command, args []string) {
@@ -42,7 +43,7 @@ func init() {
 	if err := viper.BindPFlag(flags.FormatFlag, streamCmd.Flags().Lookup(flags.FormatFlag)); err != nil {
 		panic(err)
 	}
-	streamCmd.Flags().String(flags.ServerFlag, client.DefaultSocket, "Specify the server unix socket.")
+	streamCmd.Flags().String(flags.ServerFlag, client.DefaultSocket, "Specify the server address: a unix socket path, unix:<path> or tcp:<host:port>.")
 	if err := viper.BindPFlag(flags.ServerFlag, streamCmd.Flags().Lookup(flags.ServerFlag)); err != nil {
 		panic(err)
 	}
@@ -50,4 +51,24 @@ func init() {
 	if err := viper.BindPFlag(flags.OutputFlag, streamCmd.Flags().Lookup(flags.OutputFlag)); err != nil {
 		panic(err)
 	}
+	streamCmd.Flags().StringSlice(flags.EventsFlag, nil, "Stream only the given events (comma separated or repeated).")
+	if err := viper.BindPFlag(flags.EventsFlag, streamCmd.Flags().Lookup(flags.EventsFlag)); err != nil {
+		panic(err)
+	}
+	streamCmd.Flags().String(flags.FilterFlag, "", "Stream only the events matching a CEL condition, e.g. 'workload.container.name == \"nginx\"'.")
+	if err := viper.BindPFlag(flags.FilterFlag, streamCmd.Flags().Lookup(flags.FilterFlag)); err != nil {
+		panic(err)
+	}
+	streamCmd.Flags().StringSlice(flags.ContainerFlag, nil, "Stream only the events of the given containers (IDs, ID prefixes or names).")
+	if err := viper.BindPFlag(flags.ContainerFlag, streamCmd.Flags().Lookup(flags.ContainerFlag)); err != nil {
+		panic(err)
+	}
+	streamCmd.Flags().StringSlice(flags.PodFlag, nil, "Stream only the events of the given kubernetes pods.")
+	if err := viper.BindPFlag(flags.PodFlag, streamCmd.Flags().Lookup(flags.PodFlag)); err != nil {
+		panic(err)
+	}
+	streamCmd.Flags().StringSlice(flags.NamespaceFlag, nil, "Stream only the events of the given kubernetes namespaces.")
+	if err := viper.BindPFlag(flags.NamespaceFlag, streamCmd.Flags().Lookup(flags.NamespaceFlag)); err != nil {
+		panic(err)
+	}
 }
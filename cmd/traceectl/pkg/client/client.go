@@ -2,6 +2,7 @@ package client
 
 import (
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 
 	pb "github.com/aquasecurity/tracee/api/v1beta1"
@@ -9,32 +10,57 @@ import (
 
 const (
 	DefaultSocket = "/var/run/tracee.sock"
+
+	ProtocolUnix = "unix"
+	ProtocolTCP  = "tcp"
 )
 
 type Server struct {
+	Protocol         string
 	Addr             string
+	TLS              TLSConfig
 	conn             *grpc.ClientConn
 	diagnosticClient pb.DiagnosticServiceClient
+	detectorClient   pb.DetectorServiceClient
 	serviceClient    pb.TraceeServiceClient
 }
 
-func NewClient(addr string) (*Server, error) {
+func NewClient(protocol, addr string, tlsConfig TLSConfig) (*Server, error) {
 	return &Server{
-		Addr: addr,
+		Protocol: protocol,
+		Addr:     addr,
+		TLS:      tlsConfig,
 	}, nil
 }
 func (s *Server) Connect() error {
 	var opts []grpc.DialOption
-	opts = append(opts, grpc.WithTransportCredentials(insecure.NewCredentials()))
-	conn, err := grpc.NewClient("unix://"+s.Addr, opts...)
+	if s.TLS.Enabled() {
+		tlsConfig, err := s.TLS.Load()
+		if err != nil {
+			return err
+		}
+		opts = append(opts, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
+	} else {
+		opts = append(opts, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	}
+	conn, err := grpc.NewClient(s.target(), opts...)
 	if err != nil {
 		return err
 	}
 	s.conn = conn
 	s.diagnosticClient = pb.NewDiagnosticServiceClient(s.conn)
+	s.detectorClient = pb.NewDetectorServiceClient(s.conn)
 	s.serviceClient = pb.NewTraceeServiceClient(s.conn)
 	return nil
 }
 func (s *Server) Close() error {
 	return s.conn.Close()
 }
+
+// target returns the gRPC dial target of the server
+func (s *Server) target() string {
+	if s.Protocol == ProtocolTCP {
+		return "passthrough:///" + s.Addr
+	}
+	return "unix://" + s.Addr
+}
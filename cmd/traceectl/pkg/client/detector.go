@@ -0,0 +1,23 @@
+package client
+
+import (
+	"context"
+
+	pb "github.com/aquasecurity/tracee/api/v1beta1"
+)
+
+func (tc *Server) ListDetectors(ctx context.Context, req *pb.ListDetectorsRequest) (*pb.ListDetectorsResponse, error) {
+	return tc.detectorClient.ListDetectors(ctx, req)
+}
+
+func (tc *Server) EnableDetector(ctx context.Context, req *pb.EnableDetectorRequest) (*pb.EnableDetectorResponse, error) {
+	return tc.detectorClient.EnableDetector(ctx, req)
+}
+
+func (tc *Server) DisableDetector(ctx context.Context, req *pb.DisableDetectorRequest) (*pb.DisableDetectorResponse, error) {
+	return tc.detectorClient.DisableDetector(ctx, req)
+}
+
+func (tc *Server) LoadDetector(ctx context.Context, req *pb.LoadDetectorRequest) (*pb.LoadDetectorResponse, error) {
+	return tc.detectorClient.LoadDetector(ctx, req)
+}
@@ -24,3 +24,19 @@ func (tc *Server) StreamEvents(ctx context.Context, req *pb.StreamEventsRequest)
 func (tc *Server) GetEventDefinitions(ctx context.Context, req *pb.GetEventDefinitionsRequest) (*pb.GetEventDefinitionsResponse, error) {
 	return tc.serviceClient.GetEventDefinitions(ctx, req)
 }
+
+func (tc *Server) CreatePolicy(ctx context.Context, req *pb.CreatePolicyRequest) (*pb.CreatePolicyResponse, error) {
+	return tc.serviceClient.CreatePolicy(ctx, req)
+}
+
+func (tc *Server) UpdatePolicy(ctx context.Context, req *pb.UpdatePolicyRequest) (*pb.UpdatePolicyResponse, error) {
+	return tc.serviceClient.UpdatePolicy(ctx, req)
+}
+
+func (tc *Server) DeletePolicy(ctx context.Context, req *pb.DeletePolicyRequest) (*pb.DeletePolicyResponse, error) {
+	return tc.serviceClient.DeletePolicy(ctx, req)
+}
+
+func (tc *Server) ListPolicies(ctx context.Context, req *pb.ListPoliciesRequest) (*pb.ListPoliciesResponse, error) {
+	return tc.serviceClient.ListPolicies(ctx, req)
+}
@@ -0,0 +1,54 @@
+package client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+)
+
+// TLSConfig is the TLS configuration used to connect to a tracee gRPC server
+type TLSConfig struct {
+	Enable     bool   // connect with TLS, even without any of the options below
+	CertFile   string // client certificate (PEM), for servers requiring mutual TLS
+	KeyFile    string // client certificate private key (PEM)
+	CAFile     string // CA bundle verifying the server certificate (PEM), system roots if empty
+	ServerName string // server name to verify, the dialed host if empty
+}
+
+// Enabled returns true if TLS is configured
+func (c TLSConfig) Enabled() bool {
+	return c.Enable || c.CertFile != "" || c.KeyFile != "" || c.CAFile != "" || c.ServerName != ""
+}
+
+// Load loads the certificates into a TLS configuration
+func (c TLSConfig) Load() (*tls.Config, error) {
+	config := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: c.ServerName,
+	}
+
+	if c.CertFile != "" || c.KeyFile != "" {
+		if c.CertFile == "" || c.KeyFile == "" {
+			return nil, fmt.Errorf("tls client certificate requires both a certificate and a key")
+		}
+		cert, err := tls.LoadX509KeyPair(c.CertFile, c.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load tls client certificate: %w", err)
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+
+	if c.CAFile != "" {
+		pem, err := os.ReadFile(c.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read tls ca: %w", err)
+		}
+		config.RootCAs = x509.NewCertPool()
+		if !config.RootCAs.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no valid certificates in tls ca %s", c.CAFile)
+		}
+	}
+
+	return config, nil
+}
@@ -0,0 +1,153 @@
+package cobra
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/aquasecurity/tracee/cmd/traceectl/pkg/cmd"
+	"github.com/aquasecurity/tracee/cmd/traceectl/pkg/cmd/flags"
+	"github.com/aquasecurity/tracee/cmd/traceectl/pkg/cmd/printer"
+	"github.com/aquasecurity/tracee/cmd/traceectl/pkg/config"
+)
+
+func GetEnableDetector(cmdCobra *cobra.Command) (cmd.EnableDetector, error) {
+	var detector cmd.EnableDetector
+
+	serverValue, err := cmdCobra.Flags().GetString(flags.ServerFlag)
+	if err != nil {
+		return detector, fmt.Errorf("failed to read server flag: %w", err)
+	}
+	server, err := prepareServer(cmdCobra, serverValue)
+	if err != nil {
+		return detector, err
+	}
+
+	outputValue, err := cmdCobra.Flags().GetString(flags.OutputFlag)
+	if err != nil {
+		return detector, fmt.Errorf("failed to read output flag: %w", err)
+	}
+	output, err := flags.PrepareOutput(cmdCobra, outputValue)
+	if err != nil {
+		return detector, err
+	}
+
+	detector.Printer = cmdCobra
+	detector.Server = server
+	detector.Config.Printer = config.PrinterConfig{
+		Kind:    flags.DefaultFormat,
+		OutPath: output.Path,
+		OutFile: output.Writer,
+	}
+	detector.Config.Server = serverConfig(server)
+	return detector, nil
+}
+
+func GetDisableDetector(cmdCobra *cobra.Command) (cmd.DisableDetector, error) {
+	var detector cmd.DisableDetector
+
+	serverValue, err := cmdCobra.Flags().GetString(flags.ServerFlag)
+	if err != nil {
+		return detector, fmt.Errorf("failed to read server flag: %w", err)
+	}
+	server, err := prepareServer(cmdCobra, serverValue)
+	if err != nil {
+		return detector, err
+	}
+
+	outputValue, err := cmdCobra.Flags().GetString(flags.OutputFlag)
+	if err != nil {
+		return detector, fmt.Errorf("failed to read output flag: %w", err)
+	}
+	output, err := flags.PrepareOutput(cmdCobra, outputValue)
+	if err != nil {
+		return detector, err
+	}
+
+	detector.Printer = cmdCobra
+	detector.Server = server
+	detector.Config.Printer = config.PrinterConfig{
+		Kind:    flags.DefaultFormat,
+		OutPath: output.Path,
+		OutFile: output.Writer,
+	}
+	detector.Config.Server = serverConfig(server)
+	return detector, nil
+}
+
+func GetLoadDetector(cmdCobra *cobra.Command) (cmd.LoadDetector, error) {
+	var detector cmd.LoadDetector
+
+	serverValue, err := cmdCobra.Flags().GetString(flags.ServerFlag)
+	if err != nil {
+		return detector, fmt.Errorf("failed to read server flag: %w", err)
+	}
+	server, err := prepareServer(cmdCobra, serverValue)
+	if err != nil {
+		return detector, err
+	}
+
+	outputValue, err := cmdCobra.Flags().GetString(flags.OutputFlag)
+	if err != nil {
+		return detector, fmt.Errorf("failed to read output flag: %w", err)
+	}
+	output, err := flags.PrepareOutput(cmdCobra, outputValue)
+	if err != nil {
+		return detector, err
+	}
+
+	detector.Printer = cmdCobra
+	detector.Server = server
+	detector.Config.Printer = config.PrinterConfig{
+		Kind:    flags.DefaultFormat,
+		OutPath: output.Path,
+		OutFile: output.Writer,
+	}
+	detector.Config.Server = serverConfig(server)
+	return detector, nil
+}
+
+func GetListDetectors(cmdCobra *cobra.Command) (cmd.ListDetectors, error) {
+	var detectors cmd.ListDetectors
+
+	serverValue, err := cmdCobra.Flags().GetString(flags.ServerFlag)
+	if err != nil {
+		return detectors, fmt.Errorf("failed to read server flag: %w", err)
+	}
+	server, err := prepareServer(cmdCobra, serverValue)
+	if err != nil {
+		return detectors, err
+	}
+
+	outputValue, err := cmdCobra.Flags().GetString(flags.OutputFlag)
+	if err != nil {
+		return detectors, fmt.Errorf("failed to read output flag: %w", err)
+	}
+	output, err := flags.PrepareOutput(cmdCobra, outputValue)
+	if err != nil {
+		return detectors, err
+	}
+
+	formatValue, err := cmdCobra.Flags().GetString(flags.FormatFlag)
+	if err != nil {
+		return detectors, fmt.Errorf("failed to read format flag: %w", err)
+	}
+	format, err := flags.PrepareFormat(formatValue)
+	if err != nil {
+		return detectors, err
+	}
+
+	p, err := printer.NewDetectorPrinter(cmdCobra, format)
+	if err != nil {
+		return detectors, err
+	}
+	detectors.Printer = p
+	detectors.Server = server
+	detectors.Config.Printer = config.PrinterConfig{
+		Kind:    format,
+		OutPath: output.Path,
+		OutFile: output.Writer,
+	}
+	detectors.Config.Server = serverConfig(server)
+	return detectors, nil
+}
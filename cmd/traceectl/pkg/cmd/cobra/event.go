@@ -21,7 +21,7 @@ func GetDescribeEvent(cmdCobra *cobra.Command) (cmd.DescribeEvent, error) {
 	if err != nil {
 		return event, fmt.Errorf("failed to read server flag: %w", err)
 	}
-	server, err := flags.PrepareServer(serverValue)
+	server, err := prepareServer(cmdCobra, serverValue)
 	if err != nil {
 		return event, err
 	}
@@ -55,10 +55,7 @@ func GetDescribeEvent(cmdCobra *cobra.Command) (cmd.DescribeEvent, error) {
 		OutPath: output.Path,
 		OutFile: output.Writer,
 	}
-	event.Config.Server = config.ServerConfig{
-		Protocol: "unix",
-		Address:  server.Addr,
-	}
+	event.Config.Server = serverConfig(server)
 	return event, nil
 }
 
@@ -72,7 +69,7 @@ func GetEnableEvent(cmdCobra *cobra.Command) (cmd.EnableEvent, error) {
 	if err != nil {
 		return event, fmt.Errorf("failed to read server flag: %w", err)
 	}
-	server, err := flags.PrepareServer(serverValue)
+	server, err := prepareServer(cmdCobra, serverValue)
 	if err != nil {
 		return event, err
 	}
@@ -93,10 +90,7 @@ func GetEnableEvent(cmdCobra *cobra.Command) (cmd.EnableEvent, error) {
 		OutPath: output.Path,
 		OutFile: output.Writer,
 	}
-	event.Config.Server = config.ServerConfig{
-		Protocol: "unix",
-		Address:  server.Addr,
-	}
+	event.Config.Server = serverConfig(server)
 	return event, nil
 }
 
@@ -107,7 +101,7 @@ func GetDisableEvent(cmdCobra *cobra.Command) (cmd.DisableEvent, error) {
 	if err != nil {
 		return event, fmt.Errorf("failed to read server flag: %w", err)
 	}
-	server, err := flags.PrepareServer(serverValue)
+	server, err := prepareServer(cmdCobra, serverValue)
 	if err != nil {
 		return event, err
 	}
@@ -128,9 +122,6 @@ func GetDisableEvent(cmdCobra *cobra.Command) (cmd.DisableEvent, error) {
 		OutPath: output.Path,
 		OutFile: output.Writer,
 	}
-	event.Config.Server = config.ServerConfig{
-		Protocol: "unix",
-		Address:  server.Addr,
-	}
+	event.Config.Server = serverConfig(server)
 	return event, nil
 }
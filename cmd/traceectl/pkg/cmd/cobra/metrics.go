@@ -20,7 +20,7 @@ func GetMetrics(cmdCobra *cobra.Command) (cmd.Metrics, error) {
 	if err != nil {
 		return metrics, fmt.Errorf("failed to read server flag: %w", err)
 	}
-	server, err := flags.PrepareServer(serverValue)
+	server, err := prepareServer(cmdCobra, serverValue)
 	if err != nil {
 		return metrics, err
 	}
@@ -32,10 +32,7 @@ func GetMetrics(cmdCobra *cobra.Command) (cmd.Metrics, error) {
 		OutPath: flags.DefaultOutput,
 		OutFile: cmdCobra.OutOrStdout(),
 	}
-	metrics.Config.Server = config.ServerConfig{
-		Protocol: "unix",
-		Address:  server.Addr,
-	}
+	metrics.Config.Server = serverConfig(server)
 
 	return metrics, nil
 }
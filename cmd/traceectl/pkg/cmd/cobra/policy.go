@@ -0,0 +1,153 @@
+package cobra
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/aquasecurity/tracee/cmd/traceectl/pkg/cmd"
+	"github.com/aquasecurity/tracee/cmd/traceectl/pkg/cmd/flags"
+	"github.com/aquasecurity/tracee/cmd/traceectl/pkg/cmd/printer"
+	"github.com/aquasecurity/tracee/cmd/traceectl/pkg/config"
+)
+
+func GetCreatePolicy(cmdCobra *cobra.Command) (cmd.CreatePolicy, error) {
+	var policy cmd.CreatePolicy
+
+	serverValue, err := cmdCobra.Flags().GetString(flags.ServerFlag)
+	if err != nil {
+		return policy, fmt.Errorf("failed to read server flag: %w", err)
+	}
+	server, err := prepareServer(cmdCobra, serverValue)
+	if err != nil {
+		return policy, err
+	}
+
+	outputValue, err := cmdCobra.Flags().GetString(flags.OutputFlag)
+	if err != nil {
+		return policy, fmt.Errorf("failed to read output flag: %w", err)
+	}
+	output, err := flags.PrepareOutput(cmdCobra, outputValue)
+	if err != nil {
+		return policy, err
+	}
+
+	policy.Printer = cmdCobra
+	policy.Server = server
+	policy.Config.Printer = config.PrinterConfig{
+		Kind:    flags.DefaultFormat,
+		OutPath: output.Path,
+		OutFile: output.Writer,
+	}
+	policy.Config.Server = serverConfig(server)
+	return policy, nil
+}
+
+func GetUpdatePolicy(cmdCobra *cobra.Command) (cmd.UpdatePolicy, error) {
+	var policy cmd.UpdatePolicy
+
+	serverValue, err := cmdCobra.Flags().GetString(flags.ServerFlag)
+	if err != nil {
+		return policy, fmt.Errorf("failed to read server flag: %w", err)
+	}
+	server, err := prepareServer(cmdCobra, serverValue)
+	if err != nil {
+		return policy, err
+	}
+
+	outputValue, err := cmdCobra.Flags().GetString(flags.OutputFlag)
+	if err != nil {
+		return policy, fmt.Errorf("failed to read output flag: %w", err)
+	}
+	output, err := flags.PrepareOutput(cmdCobra, outputValue)
+	if err != nil {
+		return policy, err
+	}
+
+	policy.Printer = cmdCobra
+	policy.Server = server
+	policy.Config.Printer = config.PrinterConfig{
+		Kind:    flags.DefaultFormat,
+		OutPath: output.Path,
+		OutFile: output.Writer,
+	}
+	policy.Config.Server = serverConfig(server)
+	return policy, nil
+}
+
+func GetDeletePolicy(cmdCobra *cobra.Command) (cmd.DeletePolicy, error) {
+	var policy cmd.DeletePolicy
+
+	serverValue, err := cmdCobra.Flags().GetString(flags.ServerFlag)
+	if err != nil {
+		return policy, fmt.Errorf("failed to read server flag: %w", err)
+	}
+	server, err := prepareServer(cmdCobra, serverValue)
+	if err != nil {
+		return policy, err
+	}
+
+	outputValue, err := cmdCobra.Flags().GetString(flags.OutputFlag)
+	if err != nil {
+		return policy, fmt.Errorf("failed to read output flag: %w", err)
+	}
+	output, err := flags.PrepareOutput(cmdCobra, outputValue)
+	if err != nil {
+		return policy, err
+	}
+
+	policy.Printer = cmdCobra
+	policy.Server = server
+	policy.Config.Printer = config.PrinterConfig{
+		Kind:    flags.DefaultFormat,
+		OutPath: output.Path,
+		OutFile: output.Writer,
+	}
+	policy.Config.Server = serverConfig(server)
+	return policy, nil
+}
+
+func GetListPolicies(cmdCobra *cobra.Command) (cmd.ListPolicies, error) {
+	var policies cmd.ListPolicies
+
+	serverValue, err := cmdCobra.Flags().GetString(flags.ServerFlag)
+	if err != nil {
+		return policies, fmt.Errorf("failed to read server flag: %w", err)
+	}
+	server, err := prepareServer(cmdCobra, serverValue)
+	if err != nil {
+		return policies, err
+	}
+
+	outputValue, err := cmdCobra.Flags().GetString(flags.OutputFlag)
+	if err != nil {
+		return policies, fmt.Errorf("failed to read output flag: %w", err)
+	}
+	output, err := flags.PrepareOutput(cmdCobra, outputValue)
+	if err != nil {
+		return policies, err
+	}
+
+	formatValue, err := cmdCobra.Flags().GetString(flags.FormatFlag)
+	if err != nil {
+		return policies, fmt.Errorf("failed to read format flag: %w", err)
+	}
+	format, err := flags.PrepareFormat(formatValue)
+	if err != nil {
+		return policies, err
+	}
+
+	p, err := printer.NewPolicyPrinter(cmdCobra, format)
+	if err != nil {
+		return policies, err
+	}
+	policies.Printer = p
+	policies.Server = server
+	policies.Config.Printer = config.PrinterConfig{
+		Kind:    format,
+		OutPath: output.Path,
+		OutFile: output.Writer,
+	}
+	policies.Config.Server = serverConfig(server)
+	return policies, nil
+}
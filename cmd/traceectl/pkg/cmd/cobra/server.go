@@ -0,0 +1,42 @@
+package cobra
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/aquasecurity/tracee/cmd/traceectl/pkg/client"
+	"github.com/aquasecurity/tracee/cmd/traceectl/pkg/cmd/flags"
+	"github.com/aquasecurity/tracee/cmd/traceectl/pkg/config"
+)
+
+// prepareServer prepares the server client from the server address and the
+// TLS flags of the command
+func prepareServer(cmdCobra *cobra.Command, address string) (*client.Server, error) {
+	var tlsConfig client.TLSConfig
+	var err error
+
+	if tlsConfig.Enable, err = cmdCobra.Flags().GetBool(flags.TLSFlag); err != nil {
+		return nil, fmt.Errorf("failed to read %s flag: %w", flags.TLSFlag, err)
+	}
+	for flag, value := range map[string]*string{
+		flags.TLSCertFlag:       &tlsConfig.CertFile,
+		flags.TLSKeyFlag:        &tlsConfig.KeyFile,
+		flags.TLSCAFlag:         &tlsConfig.CAFile,
+		flags.TLSServerNameFlag: &tlsConfig.ServerName,
+	} {
+		if *value, err = cmdCobra.Flags().GetString(flag); err != nil {
+			return nil, fmt.Errorf("failed to read %s flag: %w", flag, err)
+		}
+	}
+
+	return flags.PrepareServer(address, tlsConfig)
+}
+
+// serverConfig returns the server configuration of a server client
+func serverConfig(server *client.Server) config.ServerConfig {
+	return config.ServerConfig{
+		Protocol: server.Protocol,
+		Address:  server.Addr,
+	}
+}
@@ -17,7 +17,7 @@ func GetStream(cmdCobra *cobra.Command) (cmd.Stream, error) {
 	// Prepare Flags
 	//
 
-	server, err := flags.PrepareServer(viper.GetString(flags.ServerFlag))
+	server, err := prepareServer(cmdCobra, viper.GetString(flags.ServerFlag))
 	if err != nil {
 		return stream, err
 	}
@@ -32,6 +32,17 @@ func GetStream(cmdCobra *cobra.Command) (cmd.Stream, error) {
 		return stream, err
 	}
 
+	filters, err := flags.PrepareFilters(
+		viper.GetStringSlice(flags.EventsFlag),
+		viper.GetString(flags.FilterFlag),
+		viper.GetStringSlice(flags.ContainerFlag),
+		viper.GetStringSlice(flags.PodFlag),
+		viper.GetStringSlice(flags.NamespaceFlag),
+	)
+	if err != nil {
+		return stream, err
+	}
+
 	//
 	//	Create stream runner
 	//
@@ -42,14 +53,12 @@ func GetStream(cmdCobra *cobra.Command) (cmd.Stream, error) {
 	}
 	stream.Printer = p
 	stream.Server = server
+	stream.Filters = filters
 	stream.Config.Printer = config.PrinterConfig{
 		Kind:    format,
 		OutPath: output.Path,
 		OutFile: output.Writer,
 	}
-	stream.Config.Server = config.ServerConfig{
-		Protocol: "unix",
-		Address:  server.Addr,
-	}
+	stream.Config.Server = serverConfig(server)
 	return stream, nil
 }
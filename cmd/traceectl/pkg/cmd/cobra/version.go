@@ -21,7 +21,7 @@ func GetVersion(cmdCobra *cobra.Command) (cmd.Version, error) {
 	if err != nil {
 		return version, fmt.Errorf("failed to read server flag: %w", err)
 	}
-	server, err := flags.PrepareServer(serverValue)
+	server, err := prepareServer(cmdCobra, serverValue)
 	if err != nil {
 		return version, err
 	}
@@ -33,9 +33,6 @@ func GetVersion(cmdCobra *cobra.Command) (cmd.Version, error) {
 		OutPath: viper.GetString(flags.OutputFlag),
 		OutFile: cmdCobra.OutOrStdout(),
 	}
-	version.Config.Server = config.ServerConfig{
-		Protocol: "unix",
-		Address:  server.Addr,
-	}
+	version.Config.Server = serverConfig(server)
 	return version, nil
 }
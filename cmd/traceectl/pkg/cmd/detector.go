@@ -0,0 +1,100 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	pb "github.com/aquasecurity/tracee/api/v1beta1"
+	"github.com/aquasecurity/tracee/cmd/traceectl/pkg/client"
+	"github.com/aquasecurity/tracee/cmd/traceectl/pkg/cmd/printer"
+	"github.com/aquasecurity/tracee/cmd/traceectl/pkg/config"
+)
+
+type ListDetectors struct {
+	Config  config.Config
+	Printer printer.DetectorPrinter
+	Server  *client.Server
+}
+
+func (d ListDetectors) Run(args []string) error {
+	if err := d.Server.Connect(); err != nil {
+		return fmt.Errorf("error running list detectors: %s", err)
+	}
+	defer d.Server.Close()
+
+	response, err := d.Server.ListDetectors(context.Background(), &pb.ListDetectorsRequest{Ids: args})
+	if err != nil {
+		return err
+	}
+
+	d.Printer.Preamble()
+	for _, detector := range response.Detectors {
+		d.Printer.Print(detector)
+	}
+	d.Printer.Epilogue()
+	d.Printer.Close()
+
+	return nil
+}
+
+type EnableDetector struct {
+	Config  config.Config
+	Printer *cobra.Command
+	Server  *client.Server
+}
+
+func (d EnableDetector) Run(args []string) error {
+	if err := d.Server.Connect(); err != nil {
+		return fmt.Errorf("error running enable detector: %s", err)
+	}
+	defer d.Server.Close()
+	if _, err := d.Server.EnableDetector(context.Background(), &pb.EnableDetectorRequest{Id: args[0]}); err != nil {
+		return fmt.Errorf("error enabling detector: %s", err)
+	}
+	d.Printer.Printf("Enabled detector: %s\n", args[0])
+	return nil
+}
+
+type DisableDetector struct {
+	Config  config.Config
+	Printer *cobra.Command
+	Server  *client.Server
+}
+
+func (d DisableDetector) Run(args []string) error {
+	if err := d.Server.Connect(); err != nil {
+		return fmt.Errorf("error running disable detector: %s", err)
+	}
+	defer d.Server.Close()
+	if _, err := d.Server.DisableDetector(context.Background(), &pb.DisableDetectorRequest{Id: args[0]}); err != nil {
+		return fmt.Errorf("error disabling detector: %s", err)
+	}
+	d.Printer.Printf("Disabled detector: %s\n", args[0])
+	return nil
+}
+
+type LoadDetector struct {
+	Config  config.Config
+	Printer *cobra.Command
+	Server  *client.Server
+}
+
+func (d LoadDetector) Run(args []string) error {
+	document, err := os.ReadFile(args[0])
+	if err != nil {
+		return fmt.Errorf("error reading detector file: %s", err)
+	}
+	if err := d.Server.Connect(); err != nil {
+		return fmt.Errorf("error running load detector: %s", err)
+	}
+	defer d.Server.Close()
+	response, err := d.Server.LoadDetector(context.Background(), &pb.LoadDetectorRequest{Detector: string(document)})
+	if err != nil {
+		return fmt.Errorf("error loading detector: %s", err)
+	}
+	d.Printer.Printf("Loaded detector: %s (event %s)\n", response.Detector.GetId(), response.Detector.GetProducedEvent().GetName())
+	return nil
+}
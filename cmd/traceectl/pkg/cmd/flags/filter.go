@@ -0,0 +1,52 @@
+package flags
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aquasecurity/tracee/cmd/traceectl/pkg/config"
+)
+
+const (
+	EventsFlag    = "events"
+	FilterFlag    = "filter"
+	ContainerFlag = "container"
+	PodFlag       = "pod"
+	NamespaceFlag = "namespace"
+)
+
+// PrepareFilters prepares the server-side filters of a stream. The filter
+// expression is validated by the server.
+func PrepareFilters(events []string, expression string, containers, pods, namespaces []string) (config.StreamFilters, error) {
+	var filters config.StreamFilters
+	var err error
+
+	if filters.Events, err = prepareSelector(EventsFlag, events); err != nil {
+		return filters, err
+	}
+	if filters.Containers, err = prepareSelector(ContainerFlag, containers); err != nil {
+		return filters, err
+	}
+	if filters.Pods, err = prepareSelector(PodFlag, pods); err != nil {
+		return filters, err
+	}
+	if filters.Namespaces, err = prepareSelector(NamespaceFlag, namespaces); err != nil {
+		return filters, err
+	}
+	filters.Expression = strings.TrimSpace(expression)
+
+	return filters, nil
+}
+
+// prepareSelector trims the values of a selector flag, which can't be empty
+func prepareSelector(flag string, values []string) ([]string, error) {
+	var selector []string
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			return nil, fmt.Errorf("--%s value cannot be empty", flag)
+		}
+		selector = append(selector, value)
+	}
+	return selector, nil
+}
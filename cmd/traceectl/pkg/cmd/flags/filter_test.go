@@ -0,0 +1,68 @@
+package flags
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aquasecurity/tracee/cmd/traceectl/pkg/config"
+)
+
+func TestPrepareFilters(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name            string
+		events          []string
+		expression      string
+		containers      []string
+		pods            []string
+		namespaces      []string
+		expectedFilters config.StreamFilters
+		expectedError   string
+	}{
+		{
+			name:            "no filters",
+			expectedFilters: config.StreamFilters{},
+		},
+		{
+			name:       "all filters",
+			events:     []string{"openat", " execve "},
+			expression: ` event.name == "openat" `,
+			containers: []string{"0123abcd"},
+			pods:       []string{"web"},
+			namespaces: []string{"prod", "staging"},
+			expectedFilters: config.StreamFilters{
+				Events:     []string{"openat", "execve"},
+				Expression: `event.name == "openat"`,
+				Containers: []string{"0123abcd"},
+				Pods:       []string{"web"},
+				Namespaces: []string{"prod", "staging"},
+			},
+		},
+		{
+			name:          "empty event",
+			events:        []string{"openat", ""},
+			expectedError: "--events value cannot be empty",
+		},
+		{
+			name:          "empty namespace",
+			namespaces:    []string{" "},
+			expectedError: "--namespace value cannot be empty",
+		},
+	}
+
+	for _, testcase := range testCases {
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			filters, err := PrepareFilters(testcase.events, testcase.expression, testcase.containers, testcase.pods, testcase.namespaces)
+			if testcase.expectedError != "" {
+				assert.ErrorContains(t, err, testcase.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, testcase.expectedFilters, filters)
+		})
+	}
+}
@@ -3,6 +3,7 @@ package flags
 import (
 	"errors"
 	"fmt"
+	"net"
 	"os"
 	"strings"
 
@@ -12,18 +13,62 @@ import (
 const ServerFlag = "server"
 const DefaultServer = client.DefaultSocket
 
-func PrepareServer(serverSlice string) (*client.Server, error) {
+const (
+	TLSFlag           = "tls"
+	TLSCertFlag       = "tls-cert"
+	TLSKeyFlag        = "tls-key"
+	TLSCAFlag         = "tls-ca"
+	TLSServerNameFlag = "tls-server-name"
+)
+
+// PrepareServer prepares the server client from the server address, which is
+// either unix:<socket path>, tcp:<host:port> or a unix socket path.
+func PrepareServer(serverSlice string, tlsConfig client.TLSConfig) (*client.Server, error) {
 	var server *client.Server
 	var err error
 	address := strings.TrimSpace(serverSlice)
 	if len(address) == 0 {
 		return server, errors.New("server address cannot be empty")
 	}
-	if _, ok := os.Stat(address); ok != nil {
-		return server, fmt.Errorf("failed to get gRPC listening address (%s): %v", address, ok)
+
+	protocol := client.ProtocolUnix
+	switch {
+	case strings.HasPrefix(address, client.ProtocolUnix+":"):
+		address = strings.TrimPrefix(address, client.ProtocolUnix+":")
+	case strings.HasPrefix(address, client.ProtocolTCP+":"):
+		protocol = client.ProtocolTCP
+		address = strings.TrimPrefix(address, client.ProtocolTCP+":")
 	}
-	if server, err = client.NewClient(address); err != nil {
+
+	switch protocol {
+	case client.ProtocolUnix:
+		if _, ok := os.Stat(address); ok != nil {
+			return server, fmt.Errorf("failed to get gRPC listening address (%s): %v", address, ok)
+		}
+	case client.ProtocolTCP:
+		if _, _, err := net.SplitHostPort(address); err != nil {
+			return server, fmt.Errorf("invalid gRPC tcp address (%s), expected tcp:<host:port>", address)
+		}
+	}
+
+	if err := validateTLS(tlsConfig); err != nil {
+		return server, err
+	}
+
+	if server, err = client.NewClient(protocol, address, tlsConfig); err != nil {
 		return server, err
 	}
 	return server, nil
 }
+
+// validateTLS validates that the TLS files are usable
+func validateTLS(tlsConfig client.TLSConfig) error {
+	if (tlsConfig.CertFile == "") != (tlsConfig.KeyFile == "") {
+		return fmt.Errorf("--%s and --%s must be set together", TLSCertFlag, TLSKeyFlag)
+	}
+	if !tlsConfig.Enabled() {
+		return nil
+	}
+	_, err := tlsConfig.Load()
+	return err
+}
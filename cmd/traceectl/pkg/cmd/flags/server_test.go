@@ -16,13 +16,53 @@ func TestPrepareServer(t *testing.T) {
 	testCases := []struct {
 		name           string
 		serverSlice    string
+		tlsConfig      client.TLSConfig
 		expectedServer *client.Server
 		expectedError  error
 	}{
 		{
 			name:           "valid server address",
 			serverSlice:    test.DefaultSocket,
-			expectedServer: &client.Server{Addr: test.DefaultSocket},
+			expectedServer: &client.Server{Protocol: client.ProtocolUnix, Addr: test.DefaultSocket},
+			expectedError:  nil,
+		},
+		{
+			name:           "valid unix server address",
+			serverSlice:    "unix:" + test.DefaultSocket,
+			expectedServer: &client.Server{Protocol: client.ProtocolUnix, Addr: test.DefaultSocket},
+			expectedError:  nil,
+		},
+		{
+			name:           "valid tcp server address",
+			serverSlice:    "tcp:localhost:4466",
+			expectedServer: &client.Server{Protocol: client.ProtocolTCP, Addr: "localhost:4466"},
+			expectedError:  nil,
+		},
+		{
+			name:           "tcp server address without port",
+			serverSlice:    "tcp:localhost",
+			expectedServer: nil,
+			expectedError:  errors.New("invalid gRPC tcp address (localhost)"),
+		},
+		{
+			name:           "tls client certificate without key",
+			serverSlice:    "tcp:localhost:4466",
+			tlsConfig:      client.TLSConfig{CertFile: "/etc/traceectl/tls.crt"},
+			expectedServer: nil,
+			expectedError:  errors.New("--tls-cert and --tls-key must be set together"),
+		},
+		{
+			name:           "tls ca not found",
+			serverSlice:    "tcp:localhost:4466",
+			tlsConfig:      client.TLSConfig{CAFile: "invalid/path/ca.crt"},
+			expectedServer: nil,
+			expectedError:  errors.New("failed to read tls ca"),
+		},
+		{
+			name:           "tls with system CAs",
+			serverSlice:    "tcp:localhost:4466",
+			tlsConfig:      client.TLSConfig{Enable: true, ServerName: "tracee"},
+			expectedServer: &client.Server{Protocol: client.ProtocolTCP, Addr: "localhost:4466", TLS: client.TLSConfig{Enable: true, ServerName: "tracee"}},
 			expectedError:  nil,
 		},
 		{
@@ -45,7 +85,7 @@ func TestPrepareServer(t *testing.T) {
 	}
 	for _, testcase := range testCases {
 		t.Run(testcase.name, func(t *testing.T) {
-			server, err := PrepareServer(testcase.serverSlice)
+			server, err := PrepareServer(testcase.serverSlice, testcase.tlsConfig)
 			if testcase.expectedError != nil {
 				if assert.ErrorContains(t, err, testcase.expectedError.Error()) {
 					return
@@ -0,0 +1,105 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	pb "github.com/aquasecurity/tracee/api/v1beta1"
+	"github.com/aquasecurity/tracee/cmd/traceectl/pkg/client"
+	"github.com/aquasecurity/tracee/cmd/traceectl/pkg/cmd/printer"
+	"github.com/aquasecurity/tracee/cmd/traceectl/pkg/config"
+)
+
+type CreatePolicy struct {
+	Config  config.Config
+	Printer *cobra.Command
+	Server  *client.Server
+}
+
+func (p CreatePolicy) Run(args []string) error {
+	document, err := os.ReadFile(args[0])
+	if err != nil {
+		return fmt.Errorf("error reading policy file: %s", err)
+	}
+	if err := p.Server.Connect(); err != nil {
+		return fmt.Errorf("error running create policy: %s", err)
+	}
+	defer p.Server.Close()
+	response, err := p.Server.CreatePolicy(context.Background(), &pb.CreatePolicyRequest{Policy: string(document)})
+	if err != nil {
+		return fmt.Errorf("error creating policy: %s", err)
+	}
+	p.Printer.Printf("Created policy: %s\n", response.Policy.GetName())
+	return nil
+}
+
+type UpdatePolicy struct {
+	Config  config.Config
+	Printer *cobra.Command
+	Server  *client.Server
+}
+
+func (p UpdatePolicy) Run(args []string) error {
+	document, err := os.ReadFile(args[0])
+	if err != nil {
+		return fmt.Errorf("error reading policy file: %s", err)
+	}
+	if err := p.Server.Connect(); err != nil {
+		return fmt.Errorf("error running update policy: %s", err)
+	}
+	defer p.Server.Close()
+	response, err := p.Server.UpdatePolicy(context.Background(), &pb.UpdatePolicyRequest{Policy: string(document)})
+	if err != nil {
+		return fmt.Errorf("error updating policy: %s", err)
+	}
+	p.Printer.Printf("Updated policy: %s\n", response.Policy.GetName())
+	return nil
+}
+
+type DeletePolicy struct {
+	Config  config.Config
+	Printer *cobra.Command
+	Server  *client.Server
+}
+
+func (p DeletePolicy) Run(args []string) error {
+	if err := p.Server.Connect(); err != nil {
+		return fmt.Errorf("error running delete policy: %s", err)
+	}
+	defer p.Server.Close()
+	if _, err := p.Server.DeletePolicy(context.Background(), &pb.DeletePolicyRequest{Name: args[0]}); err != nil {
+		return fmt.Errorf("error deleting policy: %s", err)
+	}
+	p.Printer.Printf("Deleted policy: %s\n", args[0])
+	return nil
+}
+
+type ListPolicies struct {
+	Config  config.Config
+	Printer printer.PolicyPrinter
+	Server  *client.Server
+}
+
+func (p ListPolicies) Run(args []string) error {
+	if err := p.Server.Connect(); err != nil {
+		return fmt.Errorf("error running list policies: %s", err)
+	}
+	defer p.Server.Close()
+
+	response, err := p.Server.ListPolicies(context.Background(), &pb.ListPoliciesRequest{})
+	if err != nil {
+		return err
+	}
+
+	p.Printer.Preamble()
+	for _, policy := range response.Policies {
+		p.Printer.Print(policy)
+	}
+	p.Printer.Epilogue()
+	p.Printer.Close()
+
+	return nil
+}
@@ -0,0 +1,109 @@
+package printer
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/aquasecurity/table"
+
+	pb "github.com/aquasecurity/tracee/api/v1beta1"
+)
+
+type DetectorPrinter interface {
+	// Init serves as the initializer method for every detector Printer type
+	Init() error
+	// Preamble prints something before detector printing begins (one time)
+	Preamble()
+	// Epilogue prints something after detector printing ends (one time)
+	Epilogue()
+	// Print prints a single detector
+	Print(detector *pb.Detector)
+	// dispose of resources
+	Close()
+}
+
+func NewDetectorPrinter(cmd *cobra.Command, format string) (DetectorPrinter, error) {
+	var res DetectorPrinter
+	switch format {
+	case TableFormat:
+		res = &tableDetectorPrinter{
+			cmd: cmd,
+		}
+	case JsonFormat:
+		res = &jsonDetectorPrinter{
+			cmd: cmd,
+		}
+	default:
+		return nil, fmt.Errorf("unsupported output type: %s", format)
+	}
+	err := res.Init()
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// table format
+type tableDetectorPrinter struct {
+	tbl *table.Table
+	cmd *cobra.Command
+}
+
+func (p *tableDetectorPrinter) Preamble() {
+	p.tbl.SetHeaders(
+		"ID",
+		"EVENT",
+		"ENABLED",
+		"SELECTED",
+		"PROCESSED",
+		"PRODUCED",
+		"ERRORS",
+	)
+}
+
+func (p *tableDetectorPrinter) Print(detector *pb.Detector) {
+	health := detector.GetHealth()
+	p.tbl.AddRow(
+		detector.Id,
+		detector.GetProducedEvent().GetName(),
+		strconv.FormatBool(detector.Enabled),
+		strconv.FormatBool(detector.Selected),
+		strconv.FormatUint(health.GetEventsProcessed(), 10),
+		strconv.FormatUint(health.GetEventsProduced(), 10),
+		strconv.FormatUint(health.GetErrors(), 10),
+	)
+}
+
+func (p *tableDetectorPrinter) Init() error {
+	p.tbl = table.New(p.cmd.OutOrStdout())
+	return nil
+}
+
+func (p *tableDetectorPrinter) Epilogue() {
+	p.tbl.Render()
+}
+
+func (p *tableDetectorPrinter) Close() {}
+
+// json format
+type jsonDetectorPrinter struct {
+	cmd *cobra.Command
+}
+
+func (p *jsonDetectorPrinter) Print(detector *pb.Detector) {
+	dBytes, err := detector.MarshalJSON()
+	if err != nil {
+		p.cmd.PrintErrf("error marshaling detector to json: %s\n", err)
+	}
+	p.cmd.Printf("%s\n", string(dBytes))
+}
+
+func (p *jsonDetectorPrinter) Init() error { return nil }
+
+func (p *jsonDetectorPrinter) Preamble() {}
+
+func (p *jsonDetectorPrinter) Epilogue() {}
+
+func (p *jsonDetectorPrinter) Close() {}
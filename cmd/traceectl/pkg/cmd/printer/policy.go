@@ -0,0 +1,101 @@
+package printer
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/aquasecurity/table"
+
+	pb "github.com/aquasecurity/tracee/api/v1beta1"
+)
+
+type PolicyPrinter interface {
+	// Init serves as the initializer method for every policy Printer type
+	Init() error
+	// Preamble prints something before policy printing begins (one time)
+	Preamble()
+	// Epilogue prints something after policy printing ends (one time)
+	Epilogue()
+	// Print prints a single policy
+	Print(policy *pb.PolicyInfo)
+	// dispose of resources
+	Close()
+}
+
+func NewPolicyPrinter(cmd *cobra.Command, format string) (PolicyPrinter, error) {
+	var res PolicyPrinter
+	switch format {
+	case TableFormat:
+		res = &tablePolicyPrinter{
+			cmd: cmd,
+		}
+	case JsonFormat:
+		res = &jsonPolicyPrinter{
+			cmd: cmd,
+		}
+	default:
+		return nil, fmt.Errorf("unsupported output type: %s", format)
+	}
+	err := res.Init()
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// table format
+type tablePolicyPrinter struct {
+	tbl *table.Table
+	cmd *cobra.Command
+}
+
+func (p *tablePolicyPrinter) Preamble() {
+	p.tbl.SetHeaders(
+		"ID",
+		"NAME",
+		"EVENTS",
+	)
+}
+
+func (p *tablePolicyPrinter) Print(policy *pb.PolicyInfo) {
+	p.tbl.AddRow(
+		strconv.Itoa(int(policy.Id)),
+		policy.Name,
+		strings.Join(policy.Events, ", "),
+	)
+}
+
+func (p *tablePolicyPrinter) Init() error {
+	p.tbl = table.New(p.cmd.OutOrStdout())
+	return nil
+}
+
+func (p *tablePolicyPrinter) Epilogue() {
+	p.tbl.Render()
+}
+
+func (p *tablePolicyPrinter) Close() {}
+
+// json format
+type jsonPolicyPrinter struct {
+	cmd *cobra.Command
+}
+
+func (p *jsonPolicyPrinter) Print(policy *pb.PolicyInfo) {
+	pBytes, err := policy.MarshalJSON()
+	if err != nil {
+		p.cmd.PrintErrf("error marshaling policy to json: %s\n", err)
+	}
+	p.cmd.Printf("%s\n", string(pBytes))
+}
+
+func (p *jsonPolicyPrinter) Init() error { return nil }
+
+func (p *jsonPolicyPrinter) Preamble() {}
+
+func (p *jsonPolicyPrinter) Epilogue() {}
+
+func (p *jsonPolicyPrinter) Close() {}
@@ -19,6 +19,7 @@ import (
 
 type Stream struct {
 	Config  config.Config
+	Filters config.StreamFilters
 	Server  *client.Server
 	Printer printer.EventPrinter
 }
@@ -41,7 +42,14 @@ func (s Stream) Run(policies []string) error {
 	stopChan := make(chan struct{}) // Channel to trigger stop routine
 
 	go func() {
-		stream, err := s.Server.StreamEvents(ctx, &pb.StreamEventsRequest{Policies: policies})
+		stream, err := s.Server.StreamEvents(ctx, &pb.StreamEventsRequest{
+			Policies:   policies,
+			Events:     s.Filters.Events,
+			Filter:     s.Filters.Expression,
+			Containers: s.Filters.Containers,
+			Pods:       s.Filters.Pods,
+			Namespaces: s.Filters.Namespaces,
+		})
 		if err != nil {
 			errChan <- fmt.Errorf("error calling Stream: %s", err)
 			return
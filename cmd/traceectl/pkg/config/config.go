@@ -7,6 +7,15 @@ type Config struct {
 	Server  ServerConfig
 }
 
+// StreamFilters are the filters of a stream applied by the server
+type StreamFilters struct {
+	Events     []string
+	Expression string
+	Containers []string
+	Pods       []string
+	Namespaces []string
+}
+
 type ServerConfig struct {
 	Protocol string
 	Address  string
@@ -0,0 +1,123 @@
+package bitwise
+
+import "math/bits"
+
+const (
+	// BitmapWords is the number of 64-bit words of a Bitmap.
+	BitmapWords = 4
+	// BitmapBits is the number of bits of a Bitmap.
+	BitmapBits = BitmapWords * 64
+)
+
+// Bitmap is a fixed size bitmap made of 64-bit words, least significant word first.
+// Being an array, it is copied by value and compared with ==.
+type Bitmap [BitmapWords]uint64
+
+// NewBitmap returns a bitmap with the bits at the given offsets set.
+func NewBitmap(offsets ...uint) Bitmap {
+	var b Bitmap
+	for _, offset := range offsets {
+		b.Set(offset)
+	}
+	return b
+}
+
+// FullBitmap returns a bitmap with all the bits set.
+func FullBitmap() Bitmap {
+	var b Bitmap
+	for i := range b {
+		b[i] = ^uint64(0)
+	}
+	return b
+}
+
+// Has returns true if the bit at the given offset is set.
+func (b Bitmap) Has(offset uint) bool {
+	if offset >= BitmapBits {
+		return false
+	}
+	return HasBit(b[offset/64], offset%64)
+}
+
+// Set sets the bit at the given offset.
+func (b *Bitmap) Set(offset uint) {
+	if offset >= BitmapBits {
+		return
+	}
+	SetBit(&b[offset/64], offset%64)
+}
+
+// Clear clears the bit at the given offset.
+func (b *Bitmap) Clear(offset uint) {
+	if offset >= BitmapBits {
+		return
+	}
+	ClearBit(&b[offset/64], offset%64)
+}
+
+// ClearBits clears all bits specified by the mask.
+func (b *Bitmap) ClearBits(mask Bitmap) {
+	for i := range b {
+		ClearBits(&b[i], mask[i])
+	}
+}
+
+// SetBits sets all bits specified by the mask.
+func (b *Bitmap) SetBits(mask Bitmap) {
+	for i := range b {
+		b[i] |= mask[i]
+	}
+}
+
+// And returns the bits set in both bitmaps.
+func (b Bitmap) And(o Bitmap) Bitmap {
+	for i := range b {
+		b[i] &= o[i]
+	}
+	return b
+}
+
+// Or returns the bits set in any of the bitmaps.
+func (b Bitmap) Or(o Bitmap) Bitmap {
+	for i := range b {
+		b[i] |= o[i]
+	}
+	return b
+}
+
+// AndNot returns the bits set in b and not set in o.
+func (b Bitmap) AndNot(o Bitmap) Bitmap {
+	for i := range b {
+		b[i] &^= o[i]
+	}
+	return b
+}
+
+// Intersects returns true if any bit is set in both bitmaps.
+func (b Bitmap) Intersects(o Bitmap) bool {
+	for i := range b {
+		if b[i]&o[i] != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// IsZero returns true if no bit is set.
+func (b Bitmap) IsZero() bool {
+	for i := range b {
+		if b[i] != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Count returns the number of bits set.
+func (b Bitmap) Count() int {
+	n := 0
+	for i := range b {
+		n += bits.OnesCount64(b[i])
+	}
+	return n
+}
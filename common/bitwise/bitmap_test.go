@@ -0,0 +1,114 @@
+package bitwise
+
+import "testing"
+
+func TestBitmapSetClearHas(t *testing.T) {
+	tests := []struct {
+		name   string
+		offset uint
+		word   int
+		value  uint64
+	}{
+		{
+			name:   "first bit",
+			offset: 0,
+			word:   0,
+			value:  1,
+		},
+		{
+			name:   "last bit of the first word",
+			offset: 63,
+			word:   0,
+			value:  1 << 63,
+		},
+		{
+			name:   "first bit of the second word",
+			offset: 64,
+			word:   1,
+			value:  1,
+		},
+		{
+			name:   "last bit",
+			offset: BitmapBits - 1,
+			word:   BitmapWords - 1,
+			value:  1 << 63,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var b Bitmap
+			b.Set(tt.offset)
+			if b[tt.word] != tt.value {
+				t.Errorf("Set(%d) resulted in word %d = %d, expected %d", tt.offset, tt.word, b[tt.word], tt.value)
+			}
+			if !b.Has(tt.offset) {
+				t.Errorf("Has(%d) = false after Set", tt.offset)
+			}
+			if b.Count() != 1 {
+				t.Errorf("Count() = %d after Set(%d), expected 1", b.Count(), tt.offset)
+			}
+			b.Clear(tt.offset)
+			if !b.IsZero() {
+				t.Errorf("Clear(%d) resulted in %v, expected an empty bitmap", tt.offset, b)
+			}
+		})
+	}
+}
+
+func TestBitmapOutOfRange(t *testing.T) {
+	b := FullBitmap()
+	b.Clear(BitmapBits)
+	if b != FullBitmap() {
+		t.Errorf("Clear(%d) modified the bitmap: %v", BitmapBits, b)
+	}
+	if b.Has(BitmapBits) {
+		t.Errorf("Has(%d) = true, expected false", BitmapBits)
+	}
+
+	b = Bitmap{}
+	b.Set(BitmapBits)
+	if !b.IsZero() {
+		t.Errorf("Set(%d) modified the bitmap: %v", BitmapBits, b)
+	}
+}
+
+func TestBitmapOperations(t *testing.T) {
+	a := NewBitmap(1, 64, 130)
+	b := NewBitmap(64, 200)
+
+	if got, expected := a.And(b), NewBitmap(64); got != expected {
+		t.Errorf("And resulted in %v, expected %v", got, expected)
+	}
+	if got, expected := a.Or(b), NewBitmap(1, 64, 130, 200); got != expected {
+		t.Errorf("Or resulted in %v, expected %v", got, expected)
+	}
+	if got, expected := a.AndNot(b), NewBitmap(1, 130); got != expected {
+		t.Errorf("AndNot resulted in %v, expected %v", got, expected)
+	}
+	if !a.Intersects(b) {
+		t.Errorf("Intersects(%v, %v) = false, expected true", a, b)
+	}
+	if a.Intersects(NewBitmap(0, 65, 255)) {
+		t.Errorf("Intersects(%v, bits 0, 65, 255) = true, expected false", a)
+	}
+
+	// operations return new bitmaps
+	if a != NewBitmap(1, 64, 130) {
+		t.Errorf("operations modified the receiver: %v", a)
+	}
+
+	c := a
+	c.ClearBits(b)
+	if expected := NewBitmap(1, 130); c != expected {
+		t.Errorf("ClearBits resulted in %v, expected %v", c, expected)
+	}
+	c.SetBits(b)
+	if expected := NewBitmap(1, 64, 130, 200); c != expected {
+		t.Errorf("SetBits resulted in %v, expected %v", c, expected)
+	}
+
+	if got := FullBitmap().Count(); got != BitmapBits {
+		t.Errorf("FullBitmap().Count() = %d, expected %d", got, BitmapBits)
+	}
+}
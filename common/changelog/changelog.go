@@ -59,3 +59,14 @@ func (c *Changelog[T]) GetAll() []T {
 func (c *Changelog[T]) Count() int {
 	return len(c.list.entries)
 }
+
+// Entry is a value of a Changelog with the time it was set.
+type Entry[T comparable] struct {
+	Timestamp time.Time
+	Value     T
+}
+
+// GetEntries retrieves all entries, from the oldest to the newest.
+func (c *Changelog[T]) GetEntries() []Entry[T] {
+	return c.list.getEntries()
+}
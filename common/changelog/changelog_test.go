@@ -129,6 +129,28 @@ func TestChangelog_InsertSameValueWithNewTimestamp(t *testing.T) {
 	assert.Equal(t, 3, changelog.Count(), "Expected 3 entries")
 }
 
+func TestChangelog_GetEntries(t *testing.T) {
+	changelog := NewChangelog[string](3)
+	assert.Empty(t, changelog.GetEntries())
+
+	changelog.Set("first", getTimeFromSec(1))
+	changelog.Set("second", getTimeFromSec(2))
+
+	// Entries are returned from the oldest to the newest, with their timestamps
+	entries := changelog.GetEntries()
+	assert.Equal(t, []Entry[string]{
+		{Timestamp: getTimeFromSec(1), Value: "first"},
+		{Timestamp: getTimeFromSec(2), Value: "second"},
+	}, entries)
+
+	// Setting the entries back in a new changelog reproduces it
+	restored := NewChangelog[string](3)
+	for _, entry := range entries {
+		restored.Set(entry.Value, entry.Timestamp)
+	}
+	assert.Equal(t, changelog.GetAll(), restored.GetAll())
+}
+
 func TestChangelog_StructType(t *testing.T) {
 	type testStruct struct {
 		A int
@@ -115,6 +115,19 @@ func (el *entryList[T]) getAll() []T {
 	return values
 }
 
+func (el *entryList[T]) getEntries() []Entry[T] {
+	entries := el.entries
+	timestamped := make([]Entry[T], 0, len(entries))
+	for _, e := range entries {
+		timestamped = append(timestamped, Entry[T]{
+			Timestamp: time.Unix(0, e.tsUnixNano),
+			Value:     e.value,
+		})
+	}
+
+	return timestamped
+}
+
 func (el *entryList[T]) noEntries() bool {
 	return len(el.entries) == 0
 }
@@ -9,6 +9,7 @@ import (
 	"path"
 	"runtime/debug"
 	"strings"
+	"time"
 
 	"golang.org/x/sys/unix"
 
@@ -192,6 +193,28 @@ func IsRegularFile(name string) (bool, error) {
 	return info.Mode().IsRegular(), nil
 }
 
+// Stamp identifies a version of a file, to detect when the file changes.
+// Stamps are comparable.
+type Stamp struct {
+	modTime time.Time
+	size    int64
+}
+
+// NewStamp returns the stamp of a file from its info
+func NewStamp(info os.FileInfo) Stamp {
+	return Stamp{modTime: info.ModTime(), size: info.Size()}
+}
+
+// StatStamp returns the stamp of the given file
+func StatStamp(name string) (Stamp, error) {
+	info, err := os.Stat(name)
+	if err != nil {
+		return Stamp{}, errfmt.WrapError(err)
+	}
+
+	return NewStamp(info), nil
+}
+
 // IsDirEmpty returns true if directory contains no files
 func IsDirEmpty(pathname string) (bool, error) {
 	dir, err := os.Open(pathname)
@@ -891,6 +891,33 @@ func TestIsRegularFile(t *testing.T) {
 	}
 }
 
+func TestStatStamp(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "feed.txt")
+	if err := os.WriteFile(file, []byte("v1"), 0644); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+
+	stamp, err := StatStamp(file)
+	if err != nil {
+		t.Fatalf("StatStamp(%q) unexpected error: %v", file, err)
+	}
+	if again, _ := StatStamp(file); again != stamp {
+		t.Errorf("StatStamp(%q) changed while the file didn't", file)
+	}
+
+	// a change of size is detected even within the modification time granularity
+	if err := os.WriteFile(file, []byte("v22"), 0644); err != nil {
+		t.Fatalf("Failed to update test file: %v", err)
+	}
+	if changed, _ := StatStamp(file); changed == stamp {
+		t.Errorf("StatStamp(%q) didn't change with the file", file)
+	}
+
+	if _, err := StatStamp(filepath.Join(t.TempDir(), "nonexistent.txt")); err == nil {
+		t.Errorf("StatStamp expected error for a non-existent file but got none")
+	}
+}
+
 // Test IsDirEmpty
 func TestIsDirEmpty(t *testing.T) {
 	tempDir, err := os.MkdirTemp("", "test_isdirempty_*")
@@ -65,6 +65,9 @@ func (m *MockDataStoreRegistry) System() datastores.SystemStore { return nil }
 // Syscalls returns nil (no syscall store available).
 func (m *MockDataStoreRegistry) Syscalls() datastores.SyscallStore { return nil }
 
+// IPReputation returns nil (no IP reputation store available).
+func (m *MockDataStoreRegistry) IPReputation() datastores.IPReputationStore { return nil }
+
 // GetCustom always returns ErrNotFound.
 func (m *MockDataStoreRegistry) GetCustom(name string) (datastores.DataStore, error) {
 	return nil, datastores.ErrNotFound
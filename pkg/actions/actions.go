@@ -0,0 +1,199 @@
+// Package actions implements the response actions that policy rules can run
+// when an event matches them (kill the process, capture artifacts, forward the
+// event, ...).
+//
+// Actions are given in policy rules as strings:
+//
+//	<action>[=<argument>][,rate=<count>/<duration>][,dry-run]
+//
+// for example "kill", "signal=SIGSTOP,rate=1/1m" or "pcap=30s,dry-run".
+package actions
+
+import (
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"golang.org/x/sys/unix"
+
+	"github.com/aquasecurity/tracee/common/errfmt"
+)
+
+// Type is the type of an action
+type Type string
+
+const (
+	Log         Type = "log"          // no-op, the event is logged by the streams
+	Print       Type = "print"        // no-op, the event is printed by the streams
+	Kill        Type = "kill"         // send SIGKILL to the triggering process
+	Signal      Type = "signal"       // send a given signal to the triggering process
+	CaptureExec Type = "capture-exec" // copy the triggering process executable
+	CaptureMem  Type = "capture-mem"  // dump the triggering process memory
+	Pcap        Type = "pcap"         // capture the process (or container) traffic for a while
+	Forward     Type = "forward"      // send the event to a named destination
+)
+
+const (
+	rateModifier   = "rate"
+	dryRunModifier = "dry-run"
+
+	// maxPcapDuration limits how long a single pcap action captures traffic
+	maxPcapDuration = time.Hour
+)
+
+// RateLimit limits an action to Count executions per Window.
+// The zero value means no limit.
+type RateLimit struct {
+	Count  int
+	Window time.Duration
+}
+
+// Enabled returns true if the rate limit is set
+func (r RateLimit) Enabled() bool {
+	return r.Count > 0
+}
+
+// Action is a parsed policy rule action
+type Action struct {
+	Type        Type
+	Signal      syscall.Signal // kill and signal actions
+	Duration    time.Duration  // pcap action
+	Destination string         // forward action
+	RateLimit   RateLimit
+	DryRun      bool
+
+	raw string
+}
+
+// String returns the action as it was given in the policy
+func (a Action) String() string {
+	return a.raw
+}
+
+// IsResponse returns true if the action does something besides logging the event
+func (a Action) IsResponse() bool {
+	return a.Type != Log && a.Type != Print
+}
+
+// Parse parses a single action string
+func Parse(action string) (Action, error) {
+	parts := strings.Split(action, ",")
+
+	name, arg, hasArg := strings.Cut(strings.TrimSpace(parts[0]), "=")
+	a := Action{
+		Type: Type(name),
+		raw:  action,
+	}
+
+	switch a.Type {
+	case Log, Print, Kill, CaptureExec, CaptureMem:
+		if hasArg {
+			return Action{}, errfmt.Errorf("action %s does not take an argument", name)
+		}
+		if a.Type == Kill {
+			a.Signal = unix.SIGKILL
+		}
+	case Signal:
+		sig, err := parseSignal(arg)
+		if err != nil {
+			return Action{}, err
+		}
+		a.Signal = sig
+	case Pcap:
+		duration, err := time.ParseDuration(arg)
+		if err != nil {
+			return Action{}, errfmt.Errorf("invalid pcap duration '%s': %v", arg, err)
+		}
+		if duration <= 0 || duration > maxPcapDuration {
+			return Action{}, errfmt.Errorf("pcap duration must be between 0 and %v, got %v", maxPcapDuration, duration)
+		}
+		a.Duration = duration
+	case Forward:
+		if arg == "" {
+			return Action{}, errfmt.Errorf("forward action requires a destination name")
+		}
+		a.Destination = arg
+	default:
+		return Action{}, errfmt.Errorf("unknown action '%s'", name)
+	}
+
+	for _, modifier := range parts[1:] {
+		modifier = strings.TrimSpace(modifier)
+		switch {
+		case modifier == dryRunModifier:
+			a.DryRun = true
+		case strings.HasPrefix(modifier, rateModifier+"="):
+			rate, err := parseRateLimit(strings.TrimPrefix(modifier, rateModifier+"="))
+			if err != nil {
+				return Action{}, err
+			}
+			a.RateLimit = rate
+		default:
+			return Action{}, errfmt.Errorf("unknown modifier '%s' in action '%s'", modifier, action)
+		}
+	}
+
+	if !a.IsResponse() && (a.DryRun || a.RateLimit.Enabled()) {
+		return Action{}, errfmt.Errorf("action %s does not take modifiers", name)
+	}
+
+	return a, nil
+}
+
+// ParseList parses a list of action strings
+func ParseList(list []string) ([]Action, error) {
+	parsed := make([]Action, 0, len(list))
+	for _, action := range list {
+		a, err := Parse(action)
+		if err != nil {
+			return nil, err
+		}
+		parsed = append(parsed, a)
+	}
+	return parsed, nil
+}
+
+// parseSignal parses a signal given by name (SIGTERM or TERM) or number
+func parseSignal(value string) (syscall.Signal, error) {
+	if value == "" {
+		return 0, errfmt.Errorf("signal action requires a signal name or number")
+	}
+
+	if num, err := strconv.Atoi(value); err == nil {
+		if unix.SignalName(syscall.Signal(num)) == "" {
+			return 0, errfmt.Errorf("invalid signal number %d", num)
+		}
+		return syscall.Signal(num), nil
+	}
+
+	name := strings.ToUpper(value)
+	if !strings.HasPrefix(name, "SIG") {
+		name = "SIG" + name
+	}
+	sig := unix.SignalNum(name)
+	if sig == 0 {
+		return 0, errfmt.Errorf("invalid signal name '%s'", value)
+	}
+	return sig, nil
+}
+
+// parseRateLimit parses a <count>/<duration> rate limit
+func parseRateLimit(value string) (RateLimit, error) {
+	countStr, windowStr, found := strings.Cut(value, "/")
+	if !found {
+		return RateLimit{}, errfmt.Errorf("invalid rate '%s', expected <count>/<duration>", value)
+	}
+
+	count, err := strconv.Atoi(countStr)
+	if err != nil || count <= 0 {
+		return RateLimit{}, errfmt.Errorf("invalid rate count '%s', must be a positive integer", countStr)
+	}
+
+	window, err := time.ParseDuration(windowStr)
+	if err != nil || window <= 0 {
+		return RateLimit{}, errfmt.Errorf("invalid rate window '%s', must be a positive duration", windowStr)
+	}
+
+	return RateLimit{Count: count, Window: window}, nil
+}
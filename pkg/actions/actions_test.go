@@ -0,0 +1,109 @@
+package actions
+
+import (
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		action   string
+		expected Action
+		wantErr  string
+	}{
+		{
+			name:     "log",
+			action:   "log",
+			expected: Action{Type: Log, raw: "log"},
+		},
+		{
+			name:     "kill",
+			action:   "kill",
+			expected: Action{Type: Kill, Signal: syscall.SIGKILL, raw: "kill"},
+		},
+		{
+			name:     "signal by name",
+			action:   "signal=SIGSTOP",
+			expected: Action{Type: Signal, Signal: syscall.SIGSTOP, raw: "signal=SIGSTOP"},
+		},
+		{
+			name:     "signal by short name",
+			action:   "signal=term",
+			expected: Action{Type: Signal, Signal: syscall.SIGTERM, raw: "signal=term"},
+		},
+		{
+			name:     "signal by number",
+			action:   "signal=15",
+			expected: Action{Type: Signal, Signal: syscall.SIGTERM, raw: "signal=15"},
+		},
+		{
+			name:     "pcap",
+			action:   "pcap=30s",
+			expected: Action{Type: Pcap, Duration: 30 * time.Second, raw: "pcap=30s"},
+		},
+		{
+			name:     "forward",
+			action:   "forward=webhook1",
+			expected: Action{Type: Forward, Destination: "webhook1", raw: "forward=webhook1"},
+		},
+		{
+			name:   "modifiers",
+			action: "capture-mem,rate=2/1m,dry-run",
+			expected: Action{
+				Type:      CaptureMem,
+				RateLimit: RateLimit{Count: 2, Window: time.Minute},
+				DryRun:    true,
+				raw:       "capture-mem,rate=2/1m,dry-run",
+			},
+		},
+		{name: "unknown action", action: "audit", wantErr: "unknown action 'audit'"},
+		{name: "unexpected argument", action: "kill=9", wantErr: "does not take an argument"},
+		{name: "missing signal", action: "signal", wantErr: "requires a signal"},
+		{name: "invalid signal name", action: "signal=SIGFOO", wantErr: "invalid signal name"},
+		{name: "invalid signal number", action: "signal=1000", wantErr: "invalid signal number"},
+		{name: "invalid pcap duration", action: "pcap=soon", wantErr: "invalid pcap duration"},
+		{name: "pcap duration too long", action: "pcap=2h", wantErr: "pcap duration must be between"},
+		{name: "missing destination", action: "forward", wantErr: "requires a destination"},
+		{name: "invalid rate", action: "kill,rate=5", wantErr: "expected <count>/<duration>"},
+		{name: "invalid rate count", action: "kill,rate=0/1s", wantErr: "invalid rate count"},
+		{name: "invalid rate window", action: "kill,rate=1/forever", wantErr: "invalid rate window"},
+		{name: "unknown modifier", action: "kill,now", wantErr: "unknown modifier 'now'"},
+		{name: "modifier on log", action: "log,dry-run", wantErr: "does not take modifiers"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			action, err := Parse(tt.action)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, action)
+			assert.Equal(t, tt.action, action.String())
+		})
+	}
+}
+
+func TestParseList(t *testing.T) {
+	t.Parallel()
+
+	parsed, err := ParseList([]string{"print", "kill,dry-run"})
+	require.NoError(t, err)
+	require.Len(t, parsed, 2)
+	assert.False(t, parsed[0].IsResponse())
+	assert.True(t, parsed[1].IsResponse())
+	assert.True(t, parsed[1].DryRun)
+
+	_, err = ParseList([]string{"print", "audit"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,192 @@
+package actions
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/aquasecurity/tracee/api/v1beta1"
+	"github.com/aquasecurity/tracee/common/errfmt"
+	"github.com/aquasecurity/tracee/common/logger"
+	"github.com/aquasecurity/tracee/types/trace"
+)
+
+const (
+	defaultQueueSize = 1000
+
+	// pcapExpireInterval is how often expired pcap windows are closed
+	pcapExpireInterval = time.Second
+
+	// Audit event results
+	ResultExecuted = "executed"
+	ResultDryRun   = "dry_run"
+	ResultFailed   = "failed"
+
+	auditEventName = "policy_action"
+)
+
+// Request is a response action to run for an event
+type Request struct {
+	PolicyID   int
+	PolicyName string
+	Action     Action
+	Event      *pb.Event // owned by the executor once submitted
+}
+
+// Config is the configuration of the actions executor
+type Config struct {
+	// OutDir is the artifacts output directory
+	OutDir *os.File
+	// QueueSize is the number of pending requests (default 1000)
+	QueueSize int
+	// Forward sends an event to the streams of a destination
+	Forward func(destination string, event *pb.Event) error
+	// Audit receives a policy_action event for each executed action
+	Audit func(event *pb.Event, policyID int)
+}
+
+// Executor runs the response actions of policy rules, in a goroutine of its own,
+// so the events pipeline is never blocked by them.
+type Executor struct {
+	config  Config
+	queue   chan Request
+	limiter *rateLimiter
+	pcaps   *pcapWindows
+	selfPid int
+}
+
+// NewExecutor creates a new actions executor
+func NewExecutor(config Config) *Executor {
+	if config.QueueSize <= 0 {
+		config.QueueSize = defaultQueueSize
+	}
+
+	return &Executor{
+		config:  config,
+		queue:   make(chan Request, config.QueueSize),
+		limiter: newRateLimiter(),
+		pcaps:   newPcapWindows(config.OutDir),
+		selfPid: os.Getpid(),
+	}
+}
+
+// Submit queues an action request. It never blocks: requests over the rate
+// limit of their action, or arriving while the queue is full, are dropped.
+func (e *Executor) Submit(req Request) bool {
+	key := fmt.Sprintf("%d:%d:%s", req.PolicyID, req.Event.GetId(), req.Action.String())
+	if !e.limiter.allow(key, req.Action.RateLimit, time.Now()) {
+		logger.Debugw("Action rate limited", "policy", req.PolicyName, "action", req.Action.String())
+		return false
+	}
+
+	select {
+	case e.queue <- req:
+		return true
+	default:
+		logger.Warnw("Actions queue full, dropping action", "policy", req.PolicyName, "action", req.Action.String())
+		return false
+	}
+}
+
+// Run executes the queued actions until the context is done
+func (e *Executor) Run(ctx context.Context) {
+	logger.Debugw("Starting actions executor goroutine")
+	defer logger.Debugw("Stopped actions executor goroutine")
+
+	ticker := time.NewTicker(pcapExpireInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case req := <-e.queue:
+			e.execute(req)
+		case now := <-ticker.C:
+			e.pcaps.expire(now)
+		case <-ctx.Done():
+			e.pcaps.closeAll()
+			return
+		}
+	}
+}
+
+// WritePacket writes a captured network packet to the running pcap actions of
+// its process or container, if any.
+func (e *Executor) WritePacket(event *trace.Event, payload []byte) {
+	e.pcaps.write(event, payload)
+}
+
+// execute runs an action and emits its audit event
+func (e *Executor) execute(req Request) {
+	if req.Action.DryRun {
+		e.audit(req, ResultDryRun, nil, "")
+		return
+	}
+
+	artifact, err := e.run(req)
+	if err != nil {
+		logger.Debugw("Action failed", "policy", req.PolicyName, "action", req.Action.String(), "error", err)
+		e.audit(req, ResultFailed, err, artifact)
+		return
+	}
+
+	e.audit(req, ResultExecuted, nil, artifact)
+}
+
+// run runs an action, returning the artifact it produced (if any)
+func (e *Executor) run(req Request) (string, error) {
+	switch req.Action.Type {
+	case Kill, Signal:
+		return "", e.signalProcess(req.Event, req.Action.Signal)
+	case CaptureExec:
+		return e.captureExec(req.Event)
+	case CaptureMem:
+		return e.captureMem(req.Event)
+	case Pcap:
+		pid, err := e.targetPid(req.Event)
+		if err != nil {
+			return "", err
+		}
+		dir, err := e.artifactDir(req.Event)
+		if err != nil {
+			return "", err
+		}
+		return e.pcaps.start(req.Event, pid, dir, req.Action.Duration)
+	case Forward:
+		if e.config.Forward == nil {
+			return "", errfmt.Errorf("forwarding is not available")
+		}
+		return "", e.config.Forward(req.Action.Destination, req.Event)
+	}
+
+	return "", nil
+}
+
+// audit emits the policy_action event of an action
+func (e *Executor) audit(req Request, result string, err error, artifact string) {
+	if e.config.Audit == nil {
+		return
+	}
+
+	errMsg := ""
+	if err != nil {
+		errMsg = err.Error()
+	}
+
+	e.config.Audit(&pb.Event{
+		Timestamp: timestamppb.Now(),
+		Id:        pb.EventId_policy_action,
+		Name:      auditEventName,
+		Policies:  &pb.Policies{Matched: []string{req.PolicyName}},
+		Workload:  req.Event.GetWorkload(),
+		Data: []*pb.EventValue{
+			pb.NewStringValue("action", req.Action.String()),
+			pb.NewStringValue("trigger_event", req.Event.GetName()),
+			pb.NewStringValue("result", result),
+			pb.NewStringValue("error", errMsg),
+			pb.NewStringValue("artifact", artifact),
+		},
+	}, req.PolicyID)
+}
@@ -0,0 +1,169 @@
+package actions
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	pb "github.com/aquasecurity/tracee/api/v1beta1"
+	"github.com/aquasecurity/tracee/types/trace"
+)
+
+func newTestEvent(hostPid uint32, containerID string) *pb.Event {
+	return &pb.Event{
+		Id:        pb.EventId_security_file_open,
+		Name:      "security_file_open",
+		Timestamp: timestamppb.New(time.Unix(1700000000, 0)),
+		Workload: &pb.Workload{
+			Process:   &pb.Process{HostPid: wrapperspb.UInt32(hostPid)},
+			Container: &pb.Container{Id: containerID},
+		},
+	}
+}
+
+func auditData(event *pb.Event) map[string]string {
+	data := make(map[string]string)
+	for _, value := range event.Data {
+		data[value.Name] = value.GetStr()
+	}
+	return data
+}
+
+func TestRateLimiter(t *testing.T) {
+	t.Parallel()
+
+	limiter := newRateLimiter()
+	limit := RateLimit{Count: 2, Window: time.Minute}
+	base := time.Unix(1700000000, 0)
+
+	assert.True(t, limiter.allow("a", limit, base))
+	assert.True(t, limiter.allow("a", limit, base.Add(time.Second)))
+	assert.False(t, limiter.allow("a", limit, base.Add(2*time.Second)))
+	assert.True(t, limiter.allow("b", limit, base.Add(2*time.Second)), "keys are limited independently")
+	assert.True(t, limiter.allow("a", limit, base.Add(time.Minute)), "a new window starts")
+	assert.True(t, limiter.allow("a", RateLimit{}, base), "no limit")
+}
+
+func TestExecutor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("dry run is audited without running", func(t *testing.T) {
+		t.Parallel()
+
+		var audited []*pb.Event
+		e := NewExecutor(Config{
+			Audit: func(event *pb.Event, policyID int) {
+				assert.Equal(t, 3, policyID)
+				audited = append(audited, event)
+			},
+		})
+
+		action, err := Parse("kill,dry-run")
+		require.NoError(t, err)
+		e.execute(Request{PolicyID: 3, PolicyName: "p3", Action: action, Event: newTestEvent(4242, "")})
+
+		require.Len(t, audited, 1)
+		assert.Equal(t, pb.EventId_policy_action, audited[0].Id)
+		assert.Equal(t, []string{"p3"}, audited[0].Policies.Matched)
+		assert.Equal(t, uint32(4242), audited[0].Workload.Process.HostPid.GetValue())
+		assert.Equal(t, map[string]string{
+			"action":        "kill,dry-run",
+			"trigger_event": "security_file_open",
+			"result":        ResultDryRun,
+			"error":         "",
+			"artifact":      "",
+		}, auditData(audited[0]))
+	})
+
+	t.Run("tracee and init are never acted upon", func(t *testing.T) {
+		t.Parallel()
+
+		var audited []*pb.Event
+		e := NewExecutor(Config{
+			Audit: func(event *pb.Event, _ int) { audited = append(audited, event) },
+		})
+
+		action, err := Parse("kill")
+		require.NoError(t, err)
+		e.execute(Request{Action: action, Event: newTestEvent(uint32(os.Getpid()), "")})
+		e.execute(Request{Action: action, Event: newTestEvent(1, "")})
+
+		require.Len(t, audited, 2)
+		assert.Equal(t, ResultFailed, auditData(audited[0])["result"])
+		assert.Contains(t, auditData(audited[0])["error"], "refusing to act on tracee")
+		assert.Equal(t, ResultFailed, auditData(audited[1])["result"])
+	})
+
+	t.Run("forward", func(t *testing.T) {
+		t.Parallel()
+
+		var forwarded []string
+		var audited []*pb.Event
+		e := NewExecutor(Config{
+			Forward: func(destination string, event *pb.Event) error {
+				forwarded = append(forwarded, destination+":"+event.Name)
+				return nil
+			},
+			Audit: func(event *pb.Event, _ int) { audited = append(audited, event) },
+		})
+
+		action, err := Parse("forward=siem")
+		require.NoError(t, err)
+		e.execute(Request{Action: action, Event: newTestEvent(4242, "")})
+
+		assert.Equal(t, []string{"siem:security_file_open"}, forwarded)
+		require.Len(t, audited, 1)
+		assert.Equal(t, ResultExecuted, auditData(audited[0])["result"])
+	})
+
+	t.Run("submit is rate limited and never blocks", func(t *testing.T) {
+		t.Parallel()
+
+		e := NewExecutor(Config{QueueSize: 2})
+
+		limited, err := Parse("kill,rate=1/1h")
+		require.NoError(t, err)
+		assert.True(t, e.Submit(Request{Action: limited, Event: newTestEvent(4242, "")}))
+		assert.False(t, e.Submit(Request{Action: limited, Event: newTestEvent(4242, "")}))
+
+		unlimited, err := Parse("kill")
+		require.NoError(t, err)
+		assert.True(t, e.Submit(Request{Action: unlimited, Event: newTestEvent(4242, "")}))
+		assert.False(t, e.Submit(Request{Action: unlimited, Event: newTestEvent(4242, "")}), "queue is full")
+	})
+}
+
+func TestPcapWindows(t *testing.T) {
+	t.Parallel()
+
+	outDir, err := os.Open(t.TempDir())
+	require.NoError(t, err)
+	defer outDir.Close()
+
+	windows := newPcapWindows(outDir)
+
+	artifact, err := windows.start(newTestEvent(4242, "abc"), 4242, ".", time.Minute)
+	require.NoError(t, err)
+
+	again, err := windows.start(newTestEvent(4343, "abc"), 4343, ".", time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, artifact, again, "container is captured to a single file")
+
+	packet := &trace.Event{HostProcessID: 4343, Timestamp: 1}
+	packet.Container.ID = "abc"
+	windows.write(packet, []byte{2, 0, 0, 0, 0x45})
+	windows.write(&trace.Event{HostProcessID: 1000, Timestamp: 2}, []byte{2, 0, 0, 0, 0x45})
+
+	windows.expire(time.Now().Add(2 * time.Minute))
+	assert.Empty(t, windows.containers)
+
+	info, err := os.Stat(outDir.Name() + "/" + artifact)
+	require.NoError(t, err)
+	// pcap file header (24 bytes) and a single packet (16 bytes header + 5 bytes)
+	assert.Equal(t, int64(24+16+5), info.Size())
+}
@@ -0,0 +1,158 @@
+package actions
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	"github.com/google/gopacket/pcapgo"
+
+	pb "github.com/aquasecurity/tracee/api/v1beta1"
+	"github.com/aquasecurity/tracee/common/errfmt"
+	"github.com/aquasecurity/tracee/common/fileutil"
+	"github.com/aquasecurity/tracee/common/logger"
+	"github.com/aquasecurity/tracee/types/trace"
+)
+
+// pcapSnaplen is the snapshot length written to the pcap file header
+const pcapSnaplen = 65535
+
+// pcapWindow is a pcap file receiving the packets of a process (or of a
+// container) until it expires
+type pcapWindow struct {
+	artifact string
+	file     *os.File
+	writer   *pcapgo.Writer
+	expires  time.Time
+}
+
+// pcapWindows tracks the running pcap actions
+type pcapWindows struct {
+	mutex      sync.Mutex
+	outDir     *os.File
+	containers map[string]*pcapWindow
+	processes  map[uint32]*pcapWindow
+}
+
+func newPcapWindows(outDir *os.File) *pcapWindows {
+	return &pcapWindows{
+		outDir:     outDir,
+		containers: make(map[string]*pcapWindow),
+		processes:  make(map[uint32]*pcapWindow),
+	}
+}
+
+// start starts (or extends) a capture window for the workload of the event.
+// Containerized workloads are captured per container, host processes per process.
+func (p *pcapWindows) start(event *pb.Event, pid int, dir string, duration time.Duration) (string, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	expires := time.Now().Add(duration)
+	containerID := event.GetWorkload().GetContainer().GetId()
+
+	var existing *pcapWindow
+	if containerID != "" {
+		existing = p.containers[containerID]
+	} else {
+		existing = p.processes[uint32(pid)]
+	}
+	if existing != nil {
+		if expires.After(existing.expires) {
+			existing.expires = expires
+		}
+		return existing.artifact, nil
+	}
+
+	artifact := filepath.Join(dir, artifactName("pcap", event, pid)+".pcap")
+	file, err := fileutil.CreateAt(p.outDir, artifact)
+	if err != nil {
+		return "", errfmt.WrapError(err)
+	}
+
+	// Packets carry the same fake BSD loopback header used by the net capture
+	writer := pcapgo.NewWriter(file)
+	if err := writer.WriteFileHeader(pcapSnaplen, layers.LinkTypeNull); err != nil {
+		_ = file.Close()
+		return "", errfmt.WrapError(err)
+	}
+
+	window := &pcapWindow{artifact: artifact, file: file, writer: writer, expires: expires}
+	if containerID != "" {
+		p.containers[containerID] = window
+	} else {
+		p.processes[uint32(pid)] = window
+	}
+
+	return artifact, nil
+}
+
+// write writes a captured packet to the window of its process or container, if any
+func (p *pcapWindows) write(event *trace.Event, payload []byte) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if len(p.containers) == 0 && len(p.processes) == 0 {
+		return
+	}
+
+	window := p.processes[uint32(event.HostProcessID)]
+	if window == nil && event.Container.ID != "" {
+		window = p.containers[event.Container.ID]
+	}
+	if window == nil {
+		return
+	}
+
+	info := gopacket.CaptureInfo{
+		Timestamp:     time.Unix(0, int64(event.Timestamp)),
+		CaptureLength: len(payload),
+		Length:        len(payload),
+	}
+	if err := window.writer.WritePacket(info, payload); err != nil {
+		logger.Errorw("Could not write pcap action data", "error", err)
+	}
+}
+
+// expire closes the windows that expired before the given time
+func (p *pcapWindows) expire(now time.Time) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	for id, window := range p.containers {
+		if now.After(window.expires) {
+			closePcapWindow(window)
+			delete(p.containers, id)
+		}
+	}
+	for pid, window := range p.processes {
+		if now.After(window.expires) {
+			closePcapWindow(window)
+			delete(p.processes, pid)
+		}
+	}
+}
+
+// closeAll closes all the windows
+func (p *pcapWindows) closeAll() {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	for id, window := range p.containers {
+		closePcapWindow(window)
+		delete(p.containers, id)
+	}
+	for pid, window := range p.processes {
+		closePcapWindow(window)
+		delete(p.processes, pid)
+	}
+}
+
+func closePcapWindow(window *pcapWindow) {
+	if err := window.file.Close(); err != nil {
+		logger.Errorw("Closing file", "error", err, "file", window.artifact)
+	}
+}
@@ -0,0 +1,318 @@
+package actions
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"golang.org/x/sys/unix"
+	"kernel.org/pub/linux/libs/security/libcap/cap"
+
+	pb "github.com/aquasecurity/tracee/api/v1beta1"
+	"github.com/aquasecurity/tracee/common/capabilities"
+	"github.com/aquasecurity/tracee/common/errfmt"
+	"github.com/aquasecurity/tracee/common/fileutil"
+	"github.com/aquasecurity/tracee/common/logger"
+	"github.com/aquasecurity/tracee/common/proc"
+	"github.com/aquasecurity/tracee/common/timeutil"
+	"github.com/aquasecurity/tracee/pkg/datastores/process"
+)
+
+// maxMemCaptureSize limits the size of a single process memory dump
+const maxMemCaptureSize = 256 * 1024 * 1024
+
+// eventPid returns the host pid of the process that triggered the event.
+// The init process and tracee itself are never acted upon.
+func (e *Executor) eventPid(event *pb.Event) (int, error) {
+	pid := int(event.GetWorkload().GetProcess().GetHostPid().GetValue())
+	if pid <= 1 {
+		return 0, errfmt.Errorf("event has no valid process (pid %d)", pid)
+	}
+	if pid == e.selfPid {
+		return 0, errfmt.Errorf("refusing to act on tracee itself (pid %d)", pid)
+	}
+	return pid, nil
+}
+
+// targetPid returns the host pid of the process that triggered the event, as
+// long as it still belongs to that process. Actions run after the event, when
+// the process might have exited and its pid been reused.
+func (e *Executor) targetPid(event *pb.Event) (int, error) {
+	pid, err := e.eventPid(event)
+	if err != nil {
+		return 0, err
+	}
+	if err := verifyProcess(pid, event.GetWorkload().GetProcess().GetUniqueId().GetValue()); err != nil {
+		return 0, err
+	}
+	return pid, nil
+}
+
+// verifyProcess checks that pid belongs to the process with the given entity
+// id, which is the hash of its pid and start time (as in the process tree).
+func verifyProcess(pid int, entityID uint32) error {
+	if entityID == 0 {
+		return errfmt.Errorf("event has no process identity (pid %d)", pid)
+	}
+
+	stat, err := proc.NewProcStatFields(int32(pid), []proc.StatField{proc.StatStartTime})
+	if err != nil {
+		return errfmt.Errorf("process %d is gone: %v", pid, err)
+	}
+	startTime := timeutil.ProcfsStartTimeToEpochNS(stat.GetStartTime())
+	if process.HashTaskID(uint32(pid), startTime) != entityID {
+		return errfmt.Errorf("pid %d was reused by another process", pid)
+	}
+
+	return nil
+}
+
+// artifactDir returns (and creates) the artifacts directory for the event
+// workload, using the same layout as the other captured artifacts.
+func (e *Executor) artifactDir(event *pb.Event) (string, error) {
+	dir := event.GetWorkload().GetContainer().GetId()
+	if dir == "" {
+		dir = "host"
+	}
+	if err := fileutil.MkdirAtExist(e.config.OutDir, dir, 0755); err != nil {
+		return "", errfmt.WrapError(err)
+	}
+	return dir, nil
+}
+
+// artifactName returns the artifact file name for a process of the event
+func artifactName(kind string, event *pb.Event, pid int) string {
+	return fmt.Sprintf("action.%s.%d.%d", kind, event.GetTimestamp().AsTime().UnixNano(), pid)
+}
+
+// signalProcess sends a signal to the process that triggered the event. The
+// signal goes through a pidfd, opened before the process is verified, so it
+// can't reach another process reusing the pid in between.
+func (e *Executor) signalProcess(event *pb.Event, sig syscall.Signal) error {
+	pid, err := e.eventPid(event)
+	if err != nil {
+		return err
+	}
+	entityID := event.GetWorkload().GetProcess().GetUniqueId().GetValue()
+
+	return capabilities.GetInstance().Specific(
+		func() error {
+			pidfd, err := unix.PidfdOpen(pid, 0)
+			if errors.Is(err, unix.ENOSYS) {
+				// pidfd_open is not available before kernel 5.3
+				if err := verifyProcess(pid, entityID); err != nil {
+					return err
+				}
+				return syscall.Kill(pid, sig)
+			}
+			if err != nil {
+				return errfmt.Errorf("process %d is gone: %v", pid, err)
+			}
+			defer func() {
+				if err := unix.Close(pidfd); err != nil {
+					logger.Errorw("Closing pidfd", "error", err)
+				}
+			}()
+
+			if err := verifyProcess(pid, entityID); err != nil {
+				return err
+			}
+			return unix.PidfdSendSignal(pidfd, sig, nil, 0)
+		},
+		cap.KILL,
+	)
+}
+
+// captureExec copies the executable of the process that triggered the event
+func (e *Executor) captureExec(event *pb.Event) (string, error) {
+	pid, err := e.targetPid(event)
+	if err != nil {
+		return "", err
+	}
+
+	dir, err := e.artifactDir(event)
+	if err != nil {
+		return "", err
+	}
+
+	exePath := event.GetWorkload().GetProcess().GetExecutable().GetPath()
+	artifact := filepath.Join(dir, artifactName("exec", event, pid)+"."+filepath.Base(exePath))
+
+	err = capabilities.GetInstance().Specific(
+		func() error {
+			return fileutil.CopyRegularFileByRelativePath(proc.GetProcExePath(int32(pid)), e.config.OutDir, artifact)
+		},
+		cap.SYS_PTRACE,
+	)
+	if err != nil {
+		return "", errfmt.WrapError(err)
+	}
+
+	return artifact, nil
+}
+
+// memRegion is a memory mapping of a process
+type memRegion struct {
+	start uint64
+	end   uint64
+	perms string
+	path  string
+}
+
+// captureMem dumps the executable and anonymous memory regions of the process
+// that triggered the event. The dump is written next to a ".maps" index file
+// listing the offset of each region in the dump.
+func (e *Executor) captureMem(event *pb.Event) (string, error) {
+	pid, err := e.targetPid(event)
+	if err != nil {
+		return "", err
+	}
+
+	dir, err := e.artifactDir(event)
+	if err != nil {
+		return "", err
+	}
+	artifact := filepath.Join(dir, artifactName("mem", event, pid))
+
+	err = capabilities.GetInstance().Specific(
+		func() error {
+			return e.dumpMemory(pid, artifact)
+		},
+		cap.SYS_PTRACE,
+	)
+	if err != nil {
+		return "", errfmt.WrapError(err)
+	}
+
+	return artifact, nil
+}
+
+// dumpMemory writes the memory dump and its index. This function needs needed
+// capabilities to be set before it is called.
+func (e *Executor) dumpMemory(pid int, artifact string) error {
+	regions, err := readMemRegions(fmt.Sprintf("/proc/%d/maps", pid))
+	if err != nil {
+		return err
+	}
+
+	mem, err := os.Open(fmt.Sprintf("/proc/%d/mem", pid))
+	if err != nil {
+		return errfmt.WrapError(err)
+	}
+	defer func() {
+		if err := mem.Close(); err != nil {
+			logger.Errorw("Closing file", "error", err)
+		}
+	}()
+
+	dump, err := fileutil.CreateAt(e.config.OutDir, artifact)
+	if err != nil {
+		return errfmt.WrapError(err)
+	}
+	defer func() {
+		if err := dump.Close(); err != nil {
+			logger.Errorw("Closing file", "error", err)
+		}
+	}()
+
+	index, err := fileutil.CreateAt(e.config.OutDir, artifact+".maps")
+	if err != nil {
+		return errfmt.WrapError(err)
+	}
+	defer func() {
+		if err := index.Close(); err != nil {
+			logger.Errorw("Closing file", "error", err)
+		}
+	}()
+
+	var written uint64
+	for _, region := range regions {
+		size := region.end - region.start
+		if written+size > maxMemCaptureSize {
+			logger.Debugw("Memory capture size limit reached", "pid", pid, "written", written)
+			break
+		}
+
+		n, err := io.Copy(dump, io.NewSectionReader(mem, int64(region.start), int64(size)))
+		if err != nil {
+			// some regions (e.g. guard pages) can't be read, skip them
+			logger.Debugw("Memory capture: could not read region", "pid", pid, "start", region.start, "error", err)
+		}
+		if n == 0 {
+			continue
+		}
+
+		_, err = fmt.Fprintf(index, "%x-%x %s %x %s\n", region.start, region.end, region.perms, written, region.path)
+		if err != nil {
+			return errfmt.WrapError(err)
+		}
+		written += uint64(n)
+	}
+
+	return nil
+}
+
+// readMemRegions returns the readable executable and anonymous regions of a
+// /proc/<pid>/maps file
+func readMemRegions(mapsPath string) ([]memRegion, error) {
+	f, err := os.Open(mapsPath)
+	if err != nil {
+		return nil, errfmt.WrapError(err)
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			logger.Errorw("Closing file", "error", err)
+		}
+	}()
+
+	return parseMemRegions(f)
+}
+
+// parseMemRegions parses the lines of a maps file:
+//
+//	start-end perms offset dev inode [path]
+func parseMemRegions(r io.Reader) ([]memRegion, error) {
+	regions := make([]memRegion, 0)
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 5 {
+			continue
+		}
+
+		region := memRegion{perms: fields[1]}
+		if len(fields) > 5 {
+			region.path = fields[5]
+		}
+
+		readable := strings.HasPrefix(region.perms, "r")
+		executable := len(region.perms) > 2 && region.perms[2] == 'x'
+		anonymous := region.path == "" || region.path == "[heap]" || region.path == "[stack]"
+		if !readable || (!executable && !anonymous) || region.path == "[vvar]" || region.path == "[vsyscall]" {
+			continue
+		}
+
+		start, end, found := strings.Cut(fields[0], "-")
+		if !found {
+			continue
+		}
+		var err error
+		if region.start, err = strconv.ParseUint(start, 16, 64); err != nil {
+			continue
+		}
+		if region.end, err = strconv.ParseUint(end, 16, 64); err != nil || region.end <= region.start {
+			continue
+		}
+
+		regions = append(regions, region)
+	}
+
+	return regions, errfmt.WrapError(scanner.Err())
+}
@@ -0,0 +1,53 @@
+package actions
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aquasecurity/tracee/common/proc"
+	"github.com/aquasecurity/tracee/common/timeutil"
+	"github.com/aquasecurity/tracee/pkg/datastores/process"
+)
+
+func TestParseMemRegions(t *testing.T) {
+	t.Parallel()
+
+	maps := `55d0c8a00000-55d0c8a02000 r--p 00000000 08:01 1234 /usr/bin/cat
+55d0c8a02000-55d0c8a06000 r-xp 00002000 08:01 1234 /usr/bin/cat
+55d0c8a06000-55d0c8a08000 rw-p 00006000 08:01 1234 /usr/bin/cat
+55d0c9a00000-55d0c9a21000 rw-p 00000000 00:00 0 [heap]
+7f0a1c000000-7f0a1c021000 rw-p 00000000 00:00 0
+7f0a1d000000-7f0a1d001000 ---p 00000000 00:00 0
+7ffd4b8f0000-7ffd4b911000 rw-p 00000000 00:00 0 [stack]
+7ffd4b9d4000-7ffd4b9d8000 r--p 00000000 00:00 0 [vvar]
+ffffffffff600000-ffffffffff601000 --xp 00000000 00:00 0 [vsyscall]
+invalid line
+`
+
+	regions, err := parseMemRegions(strings.NewReader(maps))
+	require.NoError(t, err)
+
+	assert.Equal(t, []memRegion{
+		{start: 0x55d0c8a02000, end: 0x55d0c8a06000, perms: "r-xp", path: "/usr/bin/cat"},
+		{start: 0x55d0c9a00000, end: 0x55d0c9a21000, perms: "rw-p", path: "[heap]"},
+		{start: 0x7f0a1c000000, end: 0x7f0a1c021000, perms: "rw-p"},
+		{start: 0x7ffd4b8f0000, end: 0x7ffd4b911000, perms: "rw-p", path: "[stack]"},
+	}, regions)
+}
+
+func TestVerifyProcess(t *testing.T) {
+	t.Parallel()
+
+	pid := os.Getpid()
+	stat, err := proc.NewProcStatFields(int32(pid), []proc.StatField{proc.StatStartTime})
+	require.NoError(t, err)
+	entityID := process.HashTaskID(uint32(pid), timeutil.ProcfsStartTimeToEpochNS(stat.GetStartTime()))
+
+	assert.NoError(t, verifyProcess(pid, entityID))
+	assert.ErrorContains(t, verifyProcess(pid, entityID+1), "was reused")
+	assert.ErrorContains(t, verifyProcess(pid, 0), "no process identity")
+}
@@ -0,0 +1,46 @@
+package actions
+
+import (
+	"sync"
+	"time"
+)
+
+// rateLimiter counts action executions in fixed time windows, per policy rule action
+type rateLimiter struct {
+	mutex   sync.Mutex
+	windows map[string]*rateWindow
+}
+
+type rateWindow struct {
+	start time.Time
+	count int
+}
+
+func newRateLimiter() *rateLimiter {
+	return &rateLimiter{
+		windows: make(map[string]*rateWindow),
+	}
+}
+
+// allow returns true if the action identified by key may run at the given time
+func (r *rateLimiter) allow(key string, limit RateLimit, now time.Time) bool {
+	if !limit.Enabled() {
+		return true
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	w, ok := r.windows[key]
+	if !ok || now.Sub(w.start) >= limit.Window {
+		r.windows[key] = &rateWindow{start: now, count: 1}
+		return true
+	}
+
+	if w.count >= limit.Count {
+		return false
+	}
+	w.count++
+
+	return true
+}
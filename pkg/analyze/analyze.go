@@ -28,6 +28,9 @@ type Config struct {
 	LegacyOut       *os.File
 	SignatureDirs   []string
 	SignatureEvents []string
+	DetectorDirs    []string                  // YAML detector search directories (default search paths if empty)
+	DetectorEvents  []string                  // Detector events to select (all detectors if empty)
+	DetectorsConfig map[string]map[string]any // Per-detector parameters, keyed by lowercase detector ID
 }
 
 func Analyze(cfg Config) {
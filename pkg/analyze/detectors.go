@@ -0,0 +1,210 @@
+package analyze
+
+import (
+	"bufio"
+	"context"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	pb "github.com/aquasecurity/tracee/api/v1beta1"
+	"github.com/aquasecurity/tracee/api/v1beta1/detection"
+	"github.com/aquasecurity/tracee/common/errfmt"
+	"github.com/aquasecurity/tracee/common/logger"
+	"github.com/aquasecurity/tracee/pkg/datastores"
+	"github.com/aquasecurity/tracee/pkg/detectors"
+	"github.com/aquasecurity/tracee/pkg/events"
+	"github.com/aquasecurity/tracee/pkg/events/dependencies"
+	"github.com/aquasecurity/tracee/pkg/policy"
+)
+
+// maxDetectorChainDepth mirrors the limit used by the runtime detector pipeline stage
+// (raw event → derived event → threat event → threat event).
+const maxDetectorChainDepth = 5
+
+// maxEventLineSize is the largest recorded event line accepted from the source file.
+const maxEventLineSize = 16 * 1024 * 1024
+
+// unmarshalOpts decodes events written by the json printer. Unknown fields are
+// discarded so recordings from newer Tracee versions can still be replayed.
+var unmarshalOpts = protojson.UnmarshalOptions{DiscardUnknown: true}
+
+// AnalyzeDetectors replays recorded v1beta1 events (as written by the json printer)
+// through the detector engine, using the same built-in detectors, YAML detectors and
+// shared lists that Tracee loads at runtime. Detections are sent to cfg.Printer.
+func AnalyzeDetectors(cfg Config) {
+	allDetectors := detectors.CollectAllDetectors(cfg.DetectorDirs)
+	if len(allDetectors) == 0 {
+		logger.Fatalw("No detectors loaded")
+	}
+
+	if _, err := detectors.CreateEventsFromDetectors(events.StartDetectorID, allDetectors); err != nil {
+		logger.Fatalw("Failed to create detector events", "err", err)
+	}
+
+	engine, err := newDetectorEngine(allDetectors, cfg.DetectorEvents, cfg.DetectorsConfig)
+	if err != nil {
+		logger.Fatalw("Failed to create detector engine", "err", err)
+	}
+
+	logger.Infow(
+		"Detectors loaded",
+		"total", engine.GetDetectorCount(),
+		"detectors", engine.ListDetectors(),
+	)
+
+	signalCtx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	cfg.Printer.Preamble()
+	defer cfg.Printer.Close()
+
+	count, err := replay(signalCtx, cfg.Source, engine, cfg.Printer.Print)
+	if err != nil {
+		logger.Fatalw("Failed to analyze events", "err", err)
+	}
+
+	logger.Debugw("Analysis complete", "detections", count)
+}
+
+// newDetectorEngine builds a detector engine whose policy manager selects the output
+// events of the given detectors. If selectedEvents is not empty, only detectors producing
+// one of those events (and the detectors they depend on) are selected. detectorsConfig
+// holds the per-detector parameters, keyed by lowercase detector ID.
+func newDetectorEngine(allDetectors []detection.EventDetector, selectedEvents []string, detectorsConfig map[string]map[string]any) (*detectors.Engine, error) {
+	depsManager := dependencies.NewDependenciesManager(
+		func(id events.ID) events.DependencyStrategy {
+			return events.Core.GetDefinitionByID(id).GetDependencies()
+		})
+
+	policyManager, err := policy.NewManager(policy.ManagerConfig{}, depsManager)
+	if err != nil {
+		return nil, errfmt.WrapError(err)
+	}
+
+	toSelect := make(map[string]struct{}, len(selectedEvents))
+	for _, name := range selectedEvents {
+		toSelect[name] = struct{}{}
+	}
+
+	for _, d := range allDetectors {
+		eventName := d.GetDefinition().ProducedEvent.Name
+		if len(toSelect) > 0 {
+			if _, ok := toSelect[eventName]; !ok {
+				continue
+			}
+		}
+		id, ok := events.Core.GetDefinitionIDByName(eventName)
+		if !ok {
+			return nil, errfmt.Errorf("detector event %s not defined", eventName)
+		}
+		selectWithDependencies(policyManager, id)
+	}
+
+	// Analyze mode has no running tracee: datastores fall back to the null stores
+	engine := detectors.NewEngine(policyManager, nil)
+	registry := datastores.NewRegistry().Registry()
+
+	for _, d := range allDetectors {
+		params := detection.DetectorParams{
+			Logger:     logger.Current(),
+			DataStores: registry,
+			Config:     detection.NewDetectorConfig(detectorsConfig[strings.ToLower(d.GetDefinition().ID)]),
+		}
+		if err := engine.RegisterDetector(d, params); err != nil {
+			// Don't fail the analysis for one detector, same as runtime registration
+			logger.Errorw("Failed to register detector",
+				"detector", d.GetDefinition().ID,
+				"error", err)
+		}
+	}
+
+	return engine, nil
+}
+
+// selectWithDependencies enables an event and, recursively, the detector events it
+// depends on, so that detector chains are dispatched in analyze mode as well.
+func selectWithDependencies(policyManager *policy.Manager, id events.ID) {
+	if policyManager.IsEventSelected(id) {
+		return
+	}
+	policyManager.EnableEvent(id)
+
+	for _, depID := range events.Core.GetDefinitionByID(id).GetDependencies().GetPrimaryDependencies().GetIDs() {
+		if events.Core.GetDefinitionByID(depID).IsDetector() {
+			selectWithDependencies(policyManager, depID)
+		}
+	}
+}
+
+// replay reads recorded events line by line, dispatches them to the detector engine
+// (following detector chains breadth-first) and hands each detection to print.
+// Returns the number of detections produced.
+func replay(ctx context.Context, source *os.File, engine *detectors.Engine, print func(*pb.Event)) (int, error) {
+	scanner := bufio.NewScanner(source)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxEventLineSize)
+
+	count := 0
+	line := 0
+	for scanner.Scan() {
+		select {
+		case <-ctx.Done():
+			return count, nil
+		default:
+		}
+		line++
+
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+
+		event := &pb.Event{}
+		if err := unmarshalOpts.Unmarshal(scanner.Bytes(), event); err != nil {
+			return count, errfmt.Errorf("failed to unmarshal event at line %d: %v", line, err)
+		}
+
+		// Event IDs are not stable across Tracee builds (detector events are allocated
+		// dynamically), so resolve the ID by name against this build's definitions.
+		id, ok := events.Core.GetDefinitionIDByName(event.Name)
+		if !ok {
+			logger.Debugw("Skipping unknown event", "event", event.Name, "line", line)
+			continue
+		}
+		event.Id = pb.EventId(id)
+
+		queue := []*pb.Event{event}
+		for depth := 0; depth <= maxDetectorChainDepth && len(queue) > 0; depth++ {
+			var nextDepth []*pb.Event
+
+			for _, e := range queue {
+				outputs, err := engine.DispatchToDetectors(ctx, e)
+				if err != nil {
+					logger.Errorw("Failed to dispatch event to detectors", "event", e.Name, "line", line, "error", err)
+				}
+				for _, output := range outputs {
+					count++
+					print(output)
+				}
+				nextDepth = append(nextDepth, outputs...)
+			}
+
+			queue = nextDepth
+		}
+
+		if len(queue) > 0 {
+			logger.Errorw("Exceeded max detector chain depth",
+				"max_depth", maxDetectorChainDepth,
+				"remaining_events", len(queue),
+				"line", line)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return count, errfmt.Errorf("error while scanning input file at line %d: %v", line, err)
+	}
+
+	return count, nil
+}
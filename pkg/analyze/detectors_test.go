@@ -0,0 +1,130 @@
+package analyze
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/aquasecurity/tracee/api/v1beta1"
+	"github.com/aquasecurity/tracee/api/v1beta1/detection"
+	"github.com/aquasecurity/tracee/pkg/detectors"
+	"github.com/aquasecurity/tracee/pkg/events"
+)
+
+// execDetector produces one detection for every execve event whose pathname matches
+type execDetector struct {
+	id        string
+	eventName string
+	pathname  string
+}
+
+func (d *execDetector) GetDefinition() detection.DetectorDefinition {
+	return detection.DetectorDefinition{
+		ID: d.id,
+		Requirements: detection.DetectorRequirements{
+			Events: []detection.EventRequirement{
+				{Name: "execve", Dependency: detection.DependencyRequired},
+			},
+		},
+		ProducedEvent: pb.EventDefinition{Name: d.eventName},
+	}
+}
+
+func (d *execDetector) Init(params detection.DetectorParams) error {
+	return nil
+}
+
+func (d *execDetector) OnEvent(ctx context.Context, event *pb.Event) ([]detection.DetectorOutput, error) {
+	for _, v := range event.Data {
+		if v.Name == "pathname" && v.GetStr() == d.pathname {
+			return []detection.DetectorOutput{{Data: []*pb.EventValue{pb.NewStringValue("pathname", v.GetStr())}}}, nil
+		}
+	}
+	return nil, nil
+}
+
+func (d *execDetector) Close() error {
+	return nil
+}
+
+func writeRecording(t *testing.T, evts ...*pb.Event) *os.File {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "events.json")
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	for _, e := range evts {
+		b, err := e.MarshalJSON()
+		require.NoError(t, err)
+		_, err = f.Write(append(b, '\n'))
+		require.NoError(t, err)
+	}
+	require.NoError(t, f.Close())
+
+	f, err = os.Open(path)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = f.Close() })
+
+	return f
+}
+
+func TestReplay(t *testing.T) {
+	detector := &execDetector{
+		id:        "TEST-ANALYZE-1",
+		eventName: "test_analyze_shell_exec",
+		pathname:  "/bin/sh",
+	}
+	_, err := detectors.CreateEventsFromDetectors(events.StartDetectorID+400, []detection.EventDetector{detector})
+	require.NoError(t, err)
+
+	engine, err := newDetectorEngine([]detection.EventDetector{detector}, nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, 1, engine.GetDetectorCount())
+
+	execve := func(pathname string) *pb.Event {
+		return &pb.Event{
+			Timestamp: timestamppb.Now(),
+			Id:        12345, // recorded IDs are ignored, names are resolved
+			Name:      "execve",
+			Data:      []*pb.EventValue{pb.NewStringValue("pathname", pathname)},
+		}
+	}
+
+	source := writeRecording(t,
+		execve("/bin/ls"),
+		execve("/bin/sh"),
+		&pb.Event{Timestamp: timestamppb.Now(), Name: "not_a_real_event"},
+		execve("/bin/sh"),
+	)
+
+	var printed []*pb.Event
+	count, err := replay(context.Background(), source, engine, func(e *pb.Event) {
+		printed = append(printed, e)
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	require.Len(t, printed, 2)
+	for _, e := range printed {
+		assert.Equal(t, "test_analyze_shell_exec", e.Name)
+		assert.Equal(t, "/bin/sh", e.Data[0].GetStr())
+	}
+}
+
+func TestReplay_MalformedLine(t *testing.T) {
+	engine, err := newDetectorEngine(nil, nil, nil)
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "events.json")
+	require.NoError(t, os.WriteFile(path, []byte("{not json\n"), 0o600))
+	source, err := os.Open(path)
+	require.NoError(t, err)
+	defer source.Close()
+
+	_, err = replay(context.Background(), source, engine, func(*pb.Event) {})
+	assert.ErrorContains(t, err, "line 1")
+}
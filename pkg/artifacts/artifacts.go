@@ -0,0 +1,82 @@
+package artifacts
+
+import (
+	"time"
+
+	"github.com/aquasecurity/tracee/common/errfmt"
+)
+
+//
+// Artifacts (written, read and executed files, kernel modules, bpf programs,
+// memory regions and pcap files) are captured into the artifacts output
+// directory. Once an artifact is final, the Uploader hashes it and stores it
+// into a Sink (a local directory or an S3 compatible object store), so the
+// artifacts outlive the node (or pod) tracee runs on. Stored artifacts are
+// removed once their retention expires.
+//
+// The artifacts kept in the output directory are indexed in a JSONL manifest
+// next to them, and bound by quotas (removing the oldest ones first).
+// Executables and kernel modules are deduplicated by content hash.
+//
+
+// Type is the type of a captured artifact, named after its --artifacts option
+type Type string
+
+const (
+	FileWrite    Type = "file-write"
+	FileRead     Type = "file-read"
+	Executable   Type = "executable"
+	KernelModule Type = "kernel-modules"
+	BpfProgram   Type = "bpf-programs"
+	MemoryRegion Type = "memory-regions"
+	Network      Type = "network"
+)
+
+// Types returns all the artifact types
+func Types() []Type {
+	return []Type{
+		FileWrite,
+		FileRead,
+		Executable,
+		KernelModule,
+		BpfProgram,
+		MemoryRegion,
+		Network,
+	}
+}
+
+// ParseType returns the artifact type of the given --artifacts option name
+func ParseType(name string) (Type, error) {
+	for _, t := range Types() {
+		if string(t) == name {
+			return t, nil
+		}
+	}
+
+	return "", errfmt.Errorf("unknown artifact type: %s", name)
+}
+
+// Context is the process and container context an artifact was captured in,
+// and the event it was captured on.
+type Context struct {
+	ContainerID string `json:"container_id,omitempty"`
+	HostPid     uint32 `json:"host_pid,omitempty"`
+	EventID     int32  `json:"event_id"`
+	EventName   string `json:"event_name,omitempty"`
+}
+
+// Artifact is a captured artifact
+type Artifact struct {
+	Type Type `json:"type"`
+	// Path is the artifact path, relative to the artifacts output directory.
+	// It is also the artifact key in the sink.
+	Path    string  `json:"path"`
+	Context Context `json:"context"`
+	// Hash is the hex encoded sha256 of the artifact content
+	Hash string `json:"sha256"`
+	Size int64  `json:"size"`
+	// URI is the final location of the artifact, in the sink if it was
+	// stored there, or in the output directory otherwise.
+	URI  string    `json:"uri"`
+	Time time.Time `json:"timestamp"`
+}
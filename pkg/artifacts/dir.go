@@ -0,0 +1,87 @@
+package artifacts
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/aquasecurity/tracee/common/errfmt"
+	"github.com/aquasecurity/tracee/common/logger"
+)
+
+// DirSink stores artifacts in a local directory, usually a persistent volume
+// mounted into the tracee pod.
+type DirSink struct {
+	path string
+}
+
+// NewDirSink creates a sink storing artifacts under the given directory
+func NewDirSink(path string) (*DirSink, error) {
+	if err := os.MkdirAll(path, 0755); err != nil {
+		return nil, errfmt.Errorf("error creating artifacts storage dir: %v", err)
+	}
+
+	return &DirSink{path: path}, nil
+}
+
+func (s *DirSink) Name() string {
+	return "dir:" + s.path
+}
+
+// Put copies the artifact content into a temporary file and renames it into
+// place, so the directory never holds a partially stored artifact.
+func (s *DirSink) Put(_ context.Context, key string, content io.Reader, size int64, _ string) error {
+	if err := checkKey(key); err != nil {
+		return err
+	}
+
+	name := filepath.Join(s.path, key)
+	if err := os.MkdirAll(filepath.Dir(name), 0755); err != nil {
+		return errfmt.WrapError(err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(name), "."+filepath.Base(name)+".*")
+	if err != nil {
+		return errfmt.WrapError(err)
+	}
+	defer func() {
+		// no-op once renamed
+		if err := os.Remove(tmp.Name()); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			logger.Debugw("Removing temporary artifact", "file", tmp.Name(), "error", err)
+		}
+	}()
+
+	written, err := io.Copy(tmp, content)
+	if err != nil {
+		_ = tmp.Close()
+		return errfmt.WrapError(err)
+	}
+	if err := tmp.Close(); err != nil {
+		return errfmt.WrapError(err)
+	}
+	if written != size {
+		return errfmt.Errorf("artifact %s: stored %d bytes, expected %d", key, written, size)
+	}
+
+	return errfmt.WrapError(os.Rename(tmp.Name(), name))
+}
+
+func (s *DirSink) Delete(_ context.Context, key string) error {
+	if err := checkKey(key); err != nil {
+		return err
+	}
+
+	err := os.Remove(filepath.Join(s.path, key))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+
+	return errfmt.WrapError(err)
+}
+
+func (s *DirSink) URI(key string) string {
+	return fileURI(filepath.Join(s.path, key))
+}
@@ -0,0 +1,50 @@
+package artifacts
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDirSink(t *testing.T) {
+	t.Parallel()
+
+	dir := filepath.Join(t.TempDir(), "storage")
+	sink, err := NewDirSink(dir)
+	require.NoError(t, err)
+
+	content := "captured module"
+	key := "host/module.dev-1.inode-2"
+
+	err = sink.Put(context.Background(), key, strings.NewReader(content), int64(len(content)), sha256Hex([]byte(content)))
+	require.NoError(t, err)
+
+	stored, err := os.ReadFile(filepath.Join(dir, key))
+	require.NoError(t, err)
+	assert.Equal(t, content, string(stored))
+	assert.Equal(t, "file://"+filepath.Join(dir, key), sink.URI(key))
+
+	// no temporary files are left behind
+	entries, err := os.ReadDir(filepath.Join(dir, "host"))
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+
+	// a short read is not stored
+	err = sink.Put(context.Background(), "host/short", strings.NewReader(content), int64(len(content))+1, "")
+	require.Error(t, err)
+	assert.NoFileExists(t, filepath.Join(dir, "host/short"))
+
+	require.NoError(t, sink.Delete(context.Background(), key))
+	assert.NoFileExists(t, filepath.Join(dir, key))
+	require.NoError(t, sink.Delete(context.Background(), key)) // already deleted
+
+	for _, key := range []string{"", "/etc/passwd", "../escape", "host/../../escape"} {
+		err := sink.Put(context.Background(), key, strings.NewReader(content), int64(len(content)), "")
+		assert.Error(t, err, key)
+	}
+}
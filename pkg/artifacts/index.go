@@ -0,0 +1,423 @@
+package artifacts
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/aquasecurity/tracee/common/errfmt"
+	"github.com/aquasecurity/tracee/common/fileutil"
+	"github.com/aquasecurity/tracee/common/logger"
+)
+
+// ManifestName is the name of the artifacts manifest, in the output directory
+const ManifestName = "manifest.jsonl"
+
+// maxManifestLine bounds the size of a manifest line when loading it
+const maxManifestLine = 64 * 1024
+
+// dedupTypes are the artifact types deduplicated by content hash, as the same
+// executables and kernel modules are usually captured over and over.
+var dedupTypes = map[Type]bool{
+	Executable:   true,
+	KernelModule: true,
+}
+
+// Quotas bound the size of the artifacts kept in the output directory. Once
+// exceeded, the oldest artifacts are removed first.
+type Quotas struct {
+	// Total is the maximum size of all the artifacts (0: unlimited)
+	Total int64
+	// PerType is the maximum size of the artifacts of a type
+	PerType map[Type]int64
+}
+
+// TypeStats are the stats of the artifacts of a type
+type TypeStats struct {
+	Files        uint64 // artifacts kept in the output directory
+	Bytes        uint64 // size of the artifacts kept in the output directory
+	Evicted      uint64 // artifacts removed from the output directory to stay within the quotas
+	Expired      uint64 // artifacts removed once their retention expired
+	Deduplicated uint64 // artifacts dropped for having the content of a kept one
+}
+
+// indexedFile is a captured artifact file, kept in the output directory, in
+// the sink, or both.
+type indexedFile struct {
+	Artifact
+	local  bool // kept in the output directory
+	stored bool // stored into a sink other than the output directory
+}
+
+// index tracks the artifact files and records every captured artifact in a
+// JSONL manifest next to them. Artifacts deduplicated by content share the
+// file of the first one captured, so a file might have several entries.
+type index struct {
+	mu       sync.Mutex
+	outDir   *os.File
+	manifest *os.File
+	entries  []Artifact              // manifest entries
+	files    map[string]*indexedFile // by path
+	hashes   map[string]string       // path of the deduplicated files kept locally, by hash
+	bytes    map[Type]int64          // size of the files kept locally, by type
+	total    int64
+	stats    map[Type]*TypeStats
+}
+
+// openIndex opens the index of the given output directory, loading the
+// manifest of a previous run (if any) for the artifacts still there.
+func openIndex(outDir *os.File, sink Sink) (*index, error) {
+	idx := &index{
+		outDir: outDir,
+		files:  make(map[string]*indexedFile),
+		hashes: make(map[string]string),
+		bytes:  make(map[Type]int64),
+		stats:  make(map[Type]*TypeStats),
+	}
+	for _, t := range Types() {
+		idx.stats[t] = &TypeStats{}
+	}
+
+	if err := idx.load(sink); err != nil {
+		return nil, err
+	}
+	if err := idx.writeManifest(); err != nil {
+		return nil, err
+	}
+
+	return idx, nil
+}
+
+// load indexes the manifest entries of the artifacts still kept locally or in
+// the sink. Artifacts are considered stored in the sink if their URI is the
+// sink one.
+func (idx *index) load(sink Sink) error {
+	f, err := fileutil.OpenAt(idx.outDir, ManifestName, os.O_RDONLY, 0)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return errfmt.WrapError(err)
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			logger.Errorw("Closing file", "error", err)
+		}
+	}()
+
+	_, localSink := sink.(*localSink)
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 4096), maxManifestLine)
+	for scanner.Scan() {
+		var artifact Artifact
+		if err := json.Unmarshal(scanner.Bytes(), &artifact); err != nil {
+			logger.Debugw("skipping invalid artifacts manifest entry", "error", err)
+			continue
+		}
+
+		file, ok := idx.files[artifact.Path]
+		if ok && file.Hash == artifact.Hash {
+			idx.entries = append(idx.entries, artifact) // deduplicated
+			continue
+		}
+
+		local := false
+		if info, err := idx.stat(artifact.Path); err == nil {
+			local = true
+			artifact.Size = info.Size()
+		}
+		stored := !localSink && artifact.URI == sink.URI(artifact.Path)
+		if !local && !stored {
+			continue
+		}
+
+		if ok {
+			idx.unindex(file) // rewritten since (e.g. a pcap file)
+		}
+		idx.index(&indexedFile{Artifact: artifact, local: local, stored: stored})
+		idx.entries = append(idx.entries, artifact)
+	}
+
+	return errfmt.WrapError(scanner.Err())
+}
+
+// stat returns the file info of an artifact kept in the output directory
+func (idx *index) stat(path string) (fs.FileInfo, error) {
+	f, err := fileutil.OpenAt(idx.outDir, path, os.O_RDONLY, 0)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = f.Close() }()
+
+	return f.Stat()
+}
+
+// duplicate returns the artifact, pointed at the kept file with the same
+// content, if its type is deduplicated and such a file exists. The artifact is
+// recorded in the manifest.
+func (idx *index) duplicate(artifact Artifact) (Artifact, bool) {
+	if !dedupTypes[artifact.Type] {
+		return artifact, false
+	}
+
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	path, ok := idx.hashes[artifact.Hash]
+	if !ok || path == artifact.Path {
+		return artifact, false
+	}
+	kept := idx.files[path]
+
+	artifact.Path = kept.Path
+	artifact.URI = kept.URI
+	idx.append(artifact)
+	idx.stats[artifact.Type].Deduplicated++
+
+	return artifact, true
+}
+
+// add indexes an artifact file kept locally, and possibly stored into the
+// sink, and records it in the manifest. It returns whether a quota is
+// exceeded.
+func (idx *index) add(artifact Artifact, stored bool, quotas Quotas) bool {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	if file, ok := idx.files[artifact.Path]; ok {
+		idx.unindex(file) // captured again (e.g. a pcap file)
+	}
+	idx.index(&indexedFile{Artifact: artifact, local: true, stored: stored})
+	idx.append(artifact)
+
+	return idx.overQuota(quotas, artifact.Type)
+}
+
+// evict removes the oldest files from the index until the quotas are met, and
+// returns them to be removed from the output directory. Evicted files stored
+// into the sink stay indexed until their retention expires.
+func (idx *index) evict(quotas Quotas) []indexedFile {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	if !idx.overQuota(quotas, "") {
+		return nil
+	}
+
+	var local []*indexedFile
+	for _, file := range idx.files {
+		if file.local {
+			local = append(local, file)
+		}
+	}
+	sort.Slice(local, func(i, j int) bool {
+		return local[i].Time.Before(local[j].Time)
+	})
+
+	var evicted []indexedFile
+	removed := make(map[string]bool)
+	for _, file := range local {
+		quota, hasQuota := quotas.PerType[file.Type]
+		typeExceeded := hasQuota && idx.bytes[file.Type] > quota
+		totalExceeded := quotas.Total > 0 && idx.total > quotas.Total
+		if !typeExceeded && !totalExceeded {
+			continue
+		}
+
+		idx.unindex(file)
+		if file.stored {
+			idx.index(&indexedFile{Artifact: file.Artifact, stored: true})
+		} else {
+			removed[file.Path] = true
+		}
+		idx.stats[file.Type].Evicted++
+		evicted = append(evicted, *file)
+	}
+
+	idx.removeEntries(removed)
+
+	return evicted
+}
+
+// expire removes the files whose retention expired from the index, and
+// returns them to be removed from the output directory and the sink.
+func (idx *index) expire(now time.Time, retention map[Type]time.Duration) []indexedFile {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	var expired []indexedFile
+	removed := make(map[string]bool)
+	for _, file := range idx.files {
+		period, ok := retention[file.Type]
+		if !ok || period <= 0 || now.Before(file.Time.Add(period)) {
+			continue
+		}
+
+		idx.unindex(file)
+		removed[file.Path] = true
+		idx.stats[file.Type].Expired++
+		expired = append(expired, *file)
+	}
+
+	idx.removeEntries(removed)
+
+	return expired
+}
+
+// typeStats returns the stats of the artifacts, by type
+func (idx *index) typeStats() map[Type]TypeStats {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	stats := make(map[Type]TypeStats, len(idx.stats))
+	for t, s := range idx.stats {
+		stats[t] = *s
+	}
+
+	return stats
+}
+
+// close closes the manifest
+func (idx *index) close() {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	if idx.manifest == nil {
+		return
+	}
+	if err := idx.manifest.Close(); err != nil {
+		logger.Errorw("Closing artifacts manifest", "error", err)
+	}
+	idx.manifest = nil
+}
+
+// overQuota returns whether the total quota, or the one of the given type (or
+// of any type if empty), is exceeded. The lock must be held.
+func (idx *index) overQuota(quotas Quotas, t Type) bool {
+	if quotas.Total > 0 && idx.total > quotas.Total {
+		return true
+	}
+	for quotaType, quota := range quotas.PerType {
+		if (t == "" || t == quotaType) && idx.bytes[quotaType] > quota {
+			return true
+		}
+	}
+
+	return false
+}
+
+// index adds a file to the index, the lock must be held
+func (idx *index) index(file *indexedFile) {
+	idx.files[file.Path] = file
+	if !file.local {
+		return
+	}
+
+	if dedupTypes[file.Type] {
+		idx.hashes[file.Hash] = file.Path
+	}
+	idx.bytes[file.Type] += file.Size
+	idx.total += file.Size
+	idx.stats[file.Type].Files++
+	idx.stats[file.Type].Bytes += uint64(file.Size)
+}
+
+// unindex removes a file from the index, the lock must be held
+func (idx *index) unindex(file *indexedFile) {
+	delete(idx.files, file.Path)
+	if !file.local {
+		return
+	}
+
+	if idx.hashes[file.Hash] == file.Path {
+		delete(idx.hashes, file.Hash)
+	}
+	idx.bytes[file.Type] -= file.Size
+	idx.total -= file.Size
+	idx.stats[file.Type].Files--
+	idx.stats[file.Type].Bytes -= uint64(file.Size)
+}
+
+// append records an artifact in the manifest, the lock must be held
+func (idx *index) append(artifact Artifact) {
+	idx.entries = append(idx.entries, artifact)
+	if idx.manifest == nil {
+		return
+	}
+
+	line, err := json.Marshal(artifact)
+	if err != nil {
+		logger.Warnw("Encoding artifacts manifest entry", "path", artifact.Path, "error", err)
+		return
+	}
+	if _, err := idx.manifest.Write(append(line, '\n')); err != nil {
+		logger.Warnw("Writing artifacts manifest", "error", err)
+	}
+}
+
+// removeEntries drops the manifest entries of the given paths, rewriting the
+// manifest. The lock must be held.
+func (idx *index) removeEntries(paths map[string]bool) {
+	if len(paths) == 0 {
+		return
+	}
+
+	entries := idx.entries[:0]
+	for _, entry := range idx.entries {
+		if !paths[entry.Path] {
+			entries = append(entries, entry)
+		}
+	}
+	idx.entries = entries
+
+	if err := idx.writeManifest(); err != nil {
+		logger.Warnw("Writing artifacts manifest", "error", err)
+	}
+}
+
+// writeManifest (re)writes the manifest with the current entries, replacing
+// it at once, and opens it for appending. The lock must be held (or the index
+// not shared yet).
+func (idx *index) writeManifest() error {
+	tmpName := ManifestName + ".tmp"
+	tmp, err := fileutil.OpenAt(idx.outDir, tmpName, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0640)
+	if err != nil {
+		return errfmt.WrapError(err)
+	}
+
+	writer := bufio.NewWriter(tmp)
+	encoder := json.NewEncoder(writer)
+	for _, entry := range idx.entries {
+		if err := encoder.Encode(entry); err != nil {
+			_ = tmp.Close()
+			return errfmt.WrapError(err)
+		}
+	}
+	if err := writer.Flush(); err != nil {
+		_ = tmp.Close()
+		return errfmt.WrapError(err)
+	}
+	if err := tmp.Close(); err != nil {
+		return errfmt.WrapError(err)
+	}
+	if err := fileutil.RenameAt(idx.outDir, tmpName, idx.outDir, ManifestName); err != nil {
+		return errfmt.WrapError(err)
+	}
+
+	manifest, err := fileutil.OpenAt(idx.outDir, ManifestName, os.O_WRONLY|os.O_APPEND, 0)
+	if err != nil {
+		return errfmt.WrapError(err)
+	}
+	if idx.manifest != nil {
+		_ = idx.manifest.Close()
+	}
+	idx.manifest = manifest
+
+	return nil
+}
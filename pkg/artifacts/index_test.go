@@ -0,0 +1,107 @@
+package artifacts
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aquasecurity/tracee/common/fileutil"
+)
+
+// readManifest returns the entries of the manifest in the given directory
+func readManifest(t *testing.T, outPath string) []Artifact {
+	t.Helper()
+
+	f, err := os.Open(filepath.Join(outPath, ManifestName))
+	require.NoError(t, err)
+	defer f.Close()
+
+	var entries []Artifact
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var entry Artifact
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &entry))
+		entries = append(entries, entry)
+	}
+	require.NoError(t, scanner.Err())
+
+	return entries
+}
+
+func TestIndexManifest(t *testing.T) {
+	t.Parallel()
+
+	outPath := t.TempDir()
+	outDir, err := fileutil.OpenExistingDir(outPath)
+	require.NoError(t, err)
+	defer outDir.Close()
+
+	sink, err := NewDirSink(t.TempDir())
+	require.NoError(t, err)
+
+	idx, err := openIndex(outDir, sink)
+	require.NoError(t, err)
+
+	now := time.Now().UTC().Truncate(time.Second)
+	module := Artifact{
+		Type:    KernelModule,
+		Path:    "host/module.dev-1.inode-2.pid-3.abc",
+		Context: Context{HostPid: 3, EventID: 7, EventName: "capture_module"},
+		Hash:    "abc",
+		Size:    6,
+		URI:     sink.URI("host/module.dev-1.inode-2.pid-3.abc"),
+		Time:    now,
+	}
+	pcap := Artifact{
+		Type: Network,
+		Path: "pcap/single.pcap",
+		Hash: "def",
+		Size: 7,
+		URI:  fileURI(filepath.Join(outPath, "pcap/single.pcap")),
+		Time: now,
+	}
+	writeArtifact(t, outPath, module.Path, "module")
+	writeArtifact(t, outPath, pcap.Path, "packets")
+
+	idx.add(module, true, Quotas{})
+	idx.add(pcap, false, Quotas{})
+	copied := module
+	copied.Path = "host/module.dev-1.inode-2.pid-4.abc"
+	copied.Context.HostPid = 4
+	copied, ok := idx.duplicate(copied)
+	require.True(t, ok)
+	idx.close()
+
+	entries := readManifest(t, outPath)
+	require.Len(t, entries, 3)
+	assert.Equal(t, module, entries[0])
+	assert.Equal(t, pcap, entries[1])
+	assert.Equal(t, module.Path, entries[2].Path)
+	assert.Equal(t, uint32(4), entries[2].Context.HostPid)
+
+	// artifacts removed since are dropped when loading the manifest, unless
+	// stored into the sink
+	require.NoError(t, os.Remove(filepath.Join(outPath, module.Path)))
+	require.NoError(t, os.Remove(filepath.Join(outPath, pcap.Path)))
+
+	idx, err = openIndex(outDir, sink)
+	require.NoError(t, err)
+	defer idx.close()
+
+	require.Contains(t, idx.files, module.Path)
+	assert.False(t, idx.files[module.Path].local)
+	assert.True(t, idx.files[module.Path].stored)
+	assert.NotContains(t, idx.files, pcap.Path)
+	assert.Equal(t, []Artifact{module, copied}, readManifest(t, outPath))
+
+	// stored artifacts are still removed from the sink once expired
+	expired := idx.expire(now.Add(time.Hour), map[Type]time.Duration{KernelModule: time.Hour})
+	require.Len(t, expired, 1)
+	assert.Empty(t, readManifest(t, outPath))
+}
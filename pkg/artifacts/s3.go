@@ -0,0 +1,286 @@
+package artifacts
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aquasecurity/tracee/common/errfmt"
+)
+
+const (
+	defaultS3Region  = "us-east-1"
+	s3RequestTimeout = 5 * time.Minute
+
+	// sha256 of an empty payload
+	emptyPayloadHash = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+
+	sigV4Algorithm  = "AWS4-HMAC-SHA256"
+	sigV4TimeFormat = "20060102T150405Z"
+	sigV4DateFormat = "20060102"
+)
+
+// S3Config is the configuration of an S3 compatible object store sink
+type S3Config struct {
+	// Endpoint is the object store URL (default: the AWS S3 endpoint of the region)
+	Endpoint string
+	Bucket   string
+	// Prefix is prepended to the artifact keys (e.g. the node name)
+	Prefix string
+	// Region is the region the requests are signed for (default: us-east-1)
+	Region string
+	// AccessKey, SecretKey and SessionToken are the credentials of the object
+	// store. When not set, they are read from the AWS_ACCESS_KEY_ID,
+	// AWS_SECRET_ACCESS_KEY and AWS_SESSION_TOKEN environment variables.
+	AccessKey    string
+	SecretKey    string
+	SessionToken string
+}
+
+// S3Sink stores artifacts in an S3 compatible object store (AWS S3, MinIO,
+// Ceph, ...). Objects are addressed path style (endpoint/bucket/key), which
+// all of them support, and requests are signed with AWS signature version 4.
+type S3Sink struct {
+	config   S3Config
+	endpoint *url.URL
+	client   *http.Client
+	now      func() time.Time
+}
+
+// NewS3Sink creates a sink storing artifacts in the given bucket
+func NewS3Sink(config S3Config) (*S3Sink, error) {
+	if config.Bucket == "" {
+		return nil, errfmt.Errorf("artifacts s3 storage requires a bucket")
+	}
+	if config.Region == "" {
+		config.Region = defaultS3Region
+	}
+	if config.Endpoint == "" {
+		config.Endpoint = fmt.Sprintf("https://s3.%s.amazonaws.com", config.Region)
+	}
+	if config.AccessKey == "" && config.SecretKey == "" {
+		config.AccessKey = os.Getenv("AWS_ACCESS_KEY_ID")
+		config.SecretKey = os.Getenv("AWS_SECRET_ACCESS_KEY")
+		config.SessionToken = os.Getenv("AWS_SESSION_TOKEN")
+	}
+	if config.AccessKey == "" || config.SecretKey == "" {
+		return nil, errfmt.Errorf("artifacts s3 storage requires credentials (AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY)")
+	}
+	config.Prefix = strings.Trim(config.Prefix, "/")
+
+	endpoint, err := url.Parse(config.Endpoint)
+	if err != nil || endpoint.Host == "" || (endpoint.Scheme != "http" && endpoint.Scheme != "https") {
+		return nil, errfmt.Errorf("invalid artifacts s3 endpoint: %s", config.Endpoint)
+	}
+
+	return &S3Sink{
+		config:   config,
+		endpoint: endpoint,
+		client:   &http.Client{Timeout: s3RequestTimeout},
+		now:      time.Now,
+	}, nil
+}
+
+func (s *S3Sink) Name() string {
+	return "s3:" + s.config.Bucket
+}
+
+// Put uploads the artifact content. The content hash is both signed (so the
+// store rejects a corrupted upload) and kept as the object sha256 metadata.
+func (s *S3Sink) Put(ctx context.Context, key string, content io.Reader, size int64, hash string) error {
+	req, err := s.newRequest(ctx, http.MethodPut, key, content)
+	if err != nil {
+		return err
+	}
+	req.ContentLength = size
+	if size == 0 {
+		req.Body = http.NoBody
+	}
+	req.Header.Set("x-amz-meta-sha256", hash)
+
+	return s.do(req, hash)
+}
+
+func (s *S3Sink) Delete(ctx context.Context, key string) error {
+	req, err := s.newRequest(ctx, http.MethodDelete, key, nil)
+	if err != nil {
+		return err
+	}
+
+	return s.do(req, emptyPayloadHash)
+}
+
+func (s *S3Sink) URI(key string) string {
+	return fmt.Sprintf("s3://%s/%s", s.config.Bucket, s.objectKey(key))
+}
+
+// objectKey returns the object key of an artifact
+func (s *S3Sink) objectKey(key string) string {
+	if s.config.Prefix == "" {
+		return key
+	}
+
+	return s.config.Prefix + "/" + key
+}
+
+func (s *S3Sink) newRequest(ctx context.Context, method string, key string, body io.Reader) (*http.Request, error) {
+	if err := checkKey(key); err != nil {
+		return nil, err
+	}
+
+	objectURL := *s.endpoint
+	objectURL.Path = path.Join("/", s.endpoint.Path, s.config.Bucket, s.objectKey(key))
+	objectURL.RawPath = s3EscapePath(objectURL.Path)
+
+	req, err := http.NewRequestWithContext(ctx, method, objectURL.String(), body)
+	if err != nil {
+		return nil, errfmt.WrapError(err)
+	}
+
+	return req, nil
+}
+
+// do signs and sends a request, checking the store accepted it
+func (s *S3Sink) do(req *http.Request, payloadHash string) error {
+	if s.config.SessionToken != "" {
+		req.Header.Set("x-amz-security-token", s.config.SessionToken)
+	}
+	signV4(req, payloadHash, s.config.AccessKey, s.config.SecretKey, s.config.Region, s.now())
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return errfmt.WrapError(err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return errfmt.Errorf("s3 %s %s: %s: %s", req.Method, req.URL.Path, resp.Status, strings.TrimSpace(string(body)))
+	}
+
+	return nil
+}
+
+// signV4 signs an S3 request with AWS signature version 4. The host and all
+// the headers already set in the request are signed.
+func signV4(req *http.Request, payloadHash, accessKey, secretKey, region string, now time.Time) {
+	now = now.UTC()
+	amzDate := now.Format(sigV4TimeFormat)
+	scope := fmt.Sprintf("%s/%s/s3/aws4_request", now.Format(sigV4DateFormat), region)
+
+	req.Header.Del("Authorization") // when signed again
+	req.Header.Set("x-amz-date", amzDate)
+	req.Header.Set("x-amz-content-sha256", payloadHash)
+
+	// canonical headers (lowercase names, sorted, trimmed values)
+	headers := map[string]string{"host": req.URL.Host}
+	for name, values := range req.Header {
+		headers[strings.ToLower(name)] = strings.TrimSpace(strings.Join(values, ","))
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		s3EscapePath(req.URL.Path),
+		canonicalQuery(req.URL.Query()),
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	stringToSign := strings.Join([]string{
+		sigV4Algorithm,
+		amzDate,
+		scope,
+		sha256Hex([]byte(canonicalRequest)),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+secretKey), now.Format(sigV4DateFormat))
+	key = hmacSHA256(key, region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf(
+		"%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		sigV4Algorithm, accessKey, scope, signedHeaders, signature,
+	))
+}
+
+// canonicalQuery returns the query string sorted by key, as signed
+func canonicalQuery(query url.Values) string {
+	keys := make([]string, 0, len(query))
+	for key := range query {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	parts := make([]string, 0, len(keys))
+	for _, key := range keys {
+		values := query[key]
+		sort.Strings(values)
+		for _, value := range values {
+			parts = append(parts, s3Escape(key, true)+"="+s3Escape(value, true))
+		}
+	}
+
+	return strings.Join(parts, "&")
+}
+
+// s3EscapePath escapes an object path, keeping its slashes
+func s3EscapePath(p string) string {
+	return s3Escape(p, false)
+}
+
+// s3Escape percent encodes all but the unreserved characters, as required by
+// the signature (url.PathEscape keeps some reserved characters unescaped).
+func s3Escape(s string, escapeSlash bool) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z', '0' <= c && c <= '9',
+			c == '-', c == '_', c == '.', c == '~':
+			b.WriteByte(c)
+		case c == '/' && !escapeSlash:
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+
+	return b.String()
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,85 @@
+package artifacts
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/aquasecurity/tracee/common/errfmt"
+	"github.com/aquasecurity/tracee/common/fileutil"
+)
+
+// Sink is where artifacts are stored once captured
+type Sink interface {
+	// Name describes the sink (used in logs)
+	Name() string
+	// Put stores the content of an artifact under the given key. Hash is the
+	// hex encoded sha256 of the content, and size its length.
+	Put(ctx context.Context, key string, content io.Reader, size int64, hash string) error
+	// Delete removes the artifact stored under the given key
+	Delete(ctx context.Context, key string) error
+	// URI returns the location of the artifact stored under the given key
+	URI(key string) string
+}
+
+// checkKey makes sure an artifact key is a relative path not escaping the sink
+func checkKey(key string) error {
+	if key == "" || path.IsAbs(key) || key != path.Clean(key) ||
+		key == ".." || strings.HasPrefix(key, "../") {
+		return errfmt.Errorf("invalid artifact key: %s", key)
+	}
+
+	return nil
+}
+
+// fileURI returns the file URI of a local path
+func fileURI(name string) string {
+	if abs, err := filepath.Abs(name); err == nil {
+		name = abs
+	}
+
+	return (&url.URL{Scheme: "file", Path: name}).String()
+}
+
+// localSink keeps the artifacts in the output directory they were captured
+// into. It is used when no other sink is configured, so retention still
+// applies to the captured artifacts.
+type localSink struct {
+	outDir *os.File
+}
+
+func newLocalSink(outDir *os.File) *localSink {
+	return &localSink{outDir: outDir}
+}
+
+func (s *localSink) Name() string {
+	return "local"
+}
+
+// Put does nothing: the artifact content already is in the output directory
+func (s *localSink) Put(_ context.Context, key string, _ io.Reader, _ int64, _ string) error {
+	return checkKey(key)
+}
+
+func (s *localSink) Delete(_ context.Context, key string) error {
+	if err := checkKey(key); err != nil {
+		return err
+	}
+
+	err := fileutil.RemoveAt(s.outDir, key, 0)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+
+	return err
+}
+
+func (s *localSink) URI(key string) string {
+	return fileURI(filepath.Join(s.outDir.Name(), key))
+}
@@ -0,0 +1,379 @@
+package artifacts
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/aquasecurity/tracee/common/errfmt"
+	"github.com/aquasecurity/tracee/common/fileutil"
+	"github.com/aquasecurity/tracee/common/logger"
+)
+
+const (
+	defaultQueueSize = 1000
+	defaultWorkers   = 2
+	defaultSettle    = 5 * time.Second
+
+	// settleInterval is how often artifacts done being written are queued
+	settleInterval = time.Second
+	// cleanupInterval is how often expired artifacts, and artifacts over the
+	// quotas, are removed
+	cleanupInterval = time.Minute
+)
+
+// Config is the configuration of the artifacts uploader
+type Config struct {
+	// OutDir is the artifacts output directory
+	OutDir *os.File
+	// Sink is where the artifacts are stored (default: kept in OutDir)
+	Sink Sink
+	// Retention is how long artifacts are kept, per artifact type, in the
+	// sink and in OutDir (default: forever)
+	Retention map[Type]time.Duration
+	// Quotas bound the size of the artifacts kept in OutDir (default: none)
+	Quotas Quotas
+	// Settle is how long an artifact still being captured must go without
+	// writes before it is stored (default: 5 seconds)
+	Settle time.Duration
+	// Workers is the number of concurrent uploads (default: 2)
+	Workers int
+	// QueueSize is the number of pending uploads (default: 1000)
+	QueueSize int
+	// Stored is called once an artifact was stored, with its final URI
+	Stored func(artifact Artifact)
+}
+
+// pendingArtifact is an artifact still being captured
+type pendingArtifact struct {
+	artifact  Artifact
+	lastWrite time.Time
+}
+
+// Uploader hashes the captured artifacts and stores them into the sink, in
+// goroutines of its own, so the captures are never blocked by uploads. It
+// indexes the artifacts in the output directory manifest, deduplicates them,
+// and removes them in the background once over the quotas or expired.
+type Uploader struct {
+	config    Config
+	local     bool // the sink is the output directory
+	index     *index
+	queue     chan Artifact
+	ctx       context.Context // cancels in flight uploads when closing times out
+	cancel    context.CancelFunc
+	mu        sync.Mutex
+	pending   map[string]pendingArtifact // artifacts being captured, by path
+	started   bool
+	closed    bool
+	workers   sync.WaitGroup
+	overQuota chan struct{} // triggers a cleanup
+	stop      chan struct{}
+	stopped   chan struct{}
+}
+
+// NewUploader creates a new artifacts uploader
+func NewUploader(config Config) (*Uploader, error) {
+	if config.OutDir == nil {
+		return nil, errfmt.Errorf("artifacts uploader requires an output directory")
+	}
+	if config.Sink == nil {
+		config.Sink = newLocalSink(config.OutDir)
+	}
+	if config.Settle <= 0 {
+		config.Settle = defaultSettle
+	}
+	if config.Workers <= 0 {
+		config.Workers = defaultWorkers
+	}
+	if config.QueueSize <= 0 {
+		config.QueueSize = defaultQueueSize
+	}
+	_, local := config.Sink.(*localSink)
+
+	idx, err := openIndex(config.OutDir, config.Sink)
+	if err != nil {
+		return nil, errfmt.Errorf("error opening artifacts manifest: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &Uploader{
+		config:    config,
+		local:     local,
+		index:     idx,
+		queue:     make(chan Artifact, config.QueueSize),
+		ctx:       ctx,
+		cancel:    cancel,
+		pending:   make(map[string]pendingArtifact),
+		overQuota: make(chan struct{}, 1),
+		stop:      make(chan struct{}),
+		stopped:   make(chan struct{}),
+	}, nil
+}
+
+// Stats returns the stats of the artifacts, by type
+func (u *Uploader) Stats() map[Type]TypeStats {
+	return u.index.typeStats()
+}
+
+// Start starts the upload workers and the retention routine
+func (u *Uploader) Start() {
+	logger.Debugw("Starting artifacts uploader", "sink", u.config.Sink.Name())
+
+	u.mu.Lock()
+	u.started = true
+	u.mu.Unlock()
+
+	for i := 0; i < u.config.Workers; i++ {
+		u.workers.Add(1)
+		go func() {
+			defer u.workers.Done()
+			for artifact := range u.queue {
+				u.store(artifact)
+			}
+		}()
+	}
+
+	go u.run()
+}
+
+// Submit queues a captured artifact to be stored. It never blocks: artifacts
+// arriving while the queue is full are left in the output directory only.
+func (u *Uploader) Submit(artifact Artifact) bool {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	if u.closed {
+		return false
+	}
+	delete(u.pending, artifact.Path)
+
+	return u.enqueue(artifact)
+}
+
+// SubmitWhenSettled records a write to an artifact still being captured (a
+// written file, a memory region). It is queued once no write happened to it
+// for the settle period.
+func (u *Uploader) SubmitWhenSettled(artifact Artifact) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	if u.closed {
+		return
+	}
+	u.pending[artifact.Path] = pendingArtifact{
+		artifact:  artifact,
+		lastWrite: time.Now(),
+	}
+}
+
+// Close queues the artifacts still being captured and waits for the pending
+// uploads, or for the context to be done (cancelling the uploads in flight).
+func (u *Uploader) Close(ctx context.Context) {
+	u.mu.Lock()
+	if u.closed {
+		u.mu.Unlock()
+		return
+	}
+	u.closed = true
+	started := u.started
+	close(u.stop)
+	u.mu.Unlock()
+
+	if !started {
+		close(u.queue)
+		u.cancel()
+		u.index.close()
+		return
+	}
+	<-u.stopped
+
+	u.mu.Lock()
+	for path, p := range u.pending {
+		u.enqueue(p.artifact)
+		delete(u.pending, path)
+	}
+	close(u.queue)
+	u.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		u.workers.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-ctx.Done():
+		logger.Warnw("Artifacts uploads not done, cancelling them", "error", ctx.Err())
+		u.cancel()
+		<-done
+	}
+	u.cancel()
+	u.index.close()
+
+	logger.Debugw("Stopped artifacts uploader")
+}
+
+// run queues the settled artifacts, and removes the expired artifacts and the
+// ones over the quotas, until closed.
+func (u *Uploader) run() {
+	defer close(u.stopped)
+
+	settleTicker := time.NewTicker(settleInterval)
+	defer settleTicker.Stop()
+	cleanupTicker := time.NewTicker(cleanupInterval)
+	defer cleanupTicker.Stop()
+
+	for {
+		select {
+		case now := <-settleTicker.C:
+			u.queueSettled(now)
+		case now := <-cleanupTicker.C:
+			u.expire(now)
+			u.evict()
+		case <-u.overQuota:
+			u.evict()
+		case <-u.stop:
+			return
+		}
+	}
+}
+
+// enqueue queues an artifact, the lock must be held
+func (u *Uploader) enqueue(artifact Artifact) bool {
+	if artifact.Time.IsZero() {
+		artifact.Time = time.Now()
+	}
+
+	select {
+	case u.queue <- artifact:
+		return true
+	default:
+		logger.Warnw("Artifacts upload queue full, keeping artifact local", "path", artifact.Path)
+		return false
+	}
+}
+
+// queueSettled queues the artifacts not written since the settle period
+func (u *Uploader) queueSettled(now time.Time) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	for path, p := range u.pending {
+		if now.Sub(p.lastWrite) < u.config.Settle {
+			continue
+		}
+		if !u.enqueue(p.artifact) {
+			return // retried on next tick
+		}
+		delete(u.pending, path)
+	}
+}
+
+// expire removes the artifacts whose retention expired, from the sink and
+// from the output directory
+func (u *Uploader) expire(now time.Time) {
+	for _, file := range u.index.expire(now, u.config.Retention) {
+		if file.stored {
+			if err := u.config.Sink.Delete(u.ctx, file.Path); err != nil {
+				logger.Warnw("Deleting expired artifact", "sink", u.config.Sink.Name(), "path", file.Path, "error", err)
+			}
+		}
+		if file.local {
+			u.removeLocal(file.Path)
+		}
+		logger.Debugw("expired artifact deleted", "path", file.Path)
+	}
+}
+
+// evict removes the oldest artifacts from the output directory until the
+// quotas are met. Artifacts stored into the sink are kept there.
+func (u *Uploader) evict() {
+	for _, file := range u.index.evict(u.config.Quotas) {
+		u.removeLocal(file.Path)
+		logger.Debugw("artifact evicted", "path", file.Path, "size", file.Size)
+	}
+}
+
+// removeLocal removes an artifact from the output directory
+func (u *Uploader) removeLocal(path string) {
+	err := fileutil.RemoveAt(u.config.OutDir, path, 0)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		logger.Warnw("Removing artifact", "path", path, "error", err)
+	}
+}
+
+// store hashes an artifact and stores it into the sink, unless it has the
+// content of an artifact already kept (then removed in favor of the kept one).
+// The artifact is reported as stored even if the sink failed, with its local
+// URI.
+func (u *Uploader) store(artifact Artifact) {
+	f, err := fileutil.OpenAt(u.config.OutDir, artifact.Path, os.O_RDONLY, 0)
+	if err != nil {
+		// e.g. renamed (a kernel module or bpf program) before being stored
+		logger.Debugw("artifact not stored", "path", artifact.Path, "error", err)
+		return
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			logger.Errorw("Closing file", "error", err)
+		}
+	}()
+
+	info, err := f.Stat()
+	if err != nil {
+		logger.Warnw("Storing artifact", "path", artifact.Path, "error", err)
+		return
+	}
+	artifact.Size = info.Size()
+
+	// the size is fixed first, as the artifact might still grow
+	hash := sha256.New()
+	if _, err := io.CopyN(hash, f, artifact.Size); err != nil {
+		logger.Warnw("Hashing artifact", "path", artifact.Path, "error", err)
+		return
+	}
+	artifact.Hash = hex.EncodeToString(hash.Sum(nil))
+	artifact.URI = fileURI(filepath.Join(u.config.OutDir.Name(), artifact.Path))
+
+	if kept, ok := u.index.duplicate(artifact); ok {
+		u.removeLocal(artifact.Path)
+		logger.Debugw("artifact deduplicated", "path", artifact.Path, "kept", kept.Path)
+		if u.config.Stored != nil {
+			u.config.Stored(kept)
+		}
+		return
+	}
+
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		logger.Warnw("Storing artifact", "path", artifact.Path, "error", err)
+		return
+	}
+
+	err = u.config.Sink.Put(u.ctx, artifact.Path, io.LimitReader(f, artifact.Size), artifact.Size, artifact.Hash)
+	if err != nil {
+		logger.Warnw("Storing artifact, keeping it local", "sink", u.config.Sink.Name(), "path", artifact.Path, "error", err)
+	} else {
+		artifact.URI = u.config.Sink.URI(artifact.Path)
+		logger.Debugw("artifact stored", "uri", artifact.URI, "size", artifact.Size)
+	}
+
+	if u.index.add(artifact, err == nil && !u.local, u.config.Quotas) {
+		select {
+		case u.overQuota <- struct{}{}:
+		default: // a cleanup is already due
+		}
+	}
+
+	if u.config.Stored != nil {
+		u.config.Stored(artifact)
+	}
+}
@@ -0,0 +1,277 @@
+package artifacts
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aquasecurity/tracee/common/fileutil"
+)
+
+// newTestUploader returns an uploader capturing into a temporary output
+// directory, and the channel its stored artifacts are reported to.
+func newTestUploader(t *testing.T, config Config) (*Uploader, string, <-chan Artifact) {
+	t.Helper()
+
+	outPath := t.TempDir()
+	outDir, err := fileutil.OpenExistingDir(outPath)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = outDir.Close() })
+
+	stored := make(chan Artifact, 10)
+	config.OutDir = outDir
+	config.Stored = func(artifact Artifact) { stored <- artifact }
+
+	uploader, err := NewUploader(config)
+	require.NoError(t, err)
+
+	return uploader, outPath, stored
+}
+
+func writeArtifact(t *testing.T, outPath, path, content string) {
+	t.Helper()
+
+	require.NoError(t, os.MkdirAll(filepath.Dir(filepath.Join(outPath, path)), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(outPath, path), []byte(content), 0640))
+}
+
+func receive(t *testing.T, stored <-chan Artifact) Artifact {
+	t.Helper()
+
+	select {
+	case artifact := <-stored:
+		return artifact
+	case <-time.After(5 * time.Second):
+		t.Fatal("artifact was not stored")
+	}
+
+	return Artifact{}
+}
+
+func TestUploaderStore(t *testing.T) {
+	t.Parallel()
+
+	storage := t.TempDir()
+	sink, err := NewDirSink(storage)
+	require.NoError(t, err)
+
+	uploader, outPath, stored := newTestUploader(t, Config{Sink: sink})
+	uploader.Start()
+
+	content := "captured executable"
+	writeArtifact(t, outPath, "host/exec.1.ls", content)
+
+	require.True(t, uploader.Submit(Artifact{
+		Type:    Executable,
+		Path:    "host/exec.1.ls",
+		Context: Context{HostPid: 1},
+	}))
+
+	artifact := receive(t, stored)
+	assert.Equal(t, Executable, artifact.Type)
+	assert.Equal(t, sha256Hex([]byte(content)), artifact.Hash)
+	assert.Equal(t, int64(len(content)), artifact.Size)
+	assert.Equal(t, "file://"+filepath.Join(storage, "host/exec.1.ls"), artifact.URI)
+	assert.Equal(t, uint32(1), artifact.Context.HostPid)
+	assert.False(t, artifact.Time.IsZero())
+	assert.FileExists(t, filepath.Join(storage, "host/exec.1.ls"))
+
+	uploader.Close(context.Background())
+	assert.False(t, uploader.Submit(Artifact{Type: Executable, Path: "host/exec.1.ls"}))
+}
+
+// rejectingSink is a sink rejecting all uploads
+type rejectingSink struct{}
+
+func (s *rejectingSink) Name() string { return "rejecting" }
+
+func (s *rejectingSink) Put(context.Context, string, io.Reader, int64, string) error {
+	return os.ErrPermission
+}
+
+func (s *rejectingSink) Delete(context.Context, string) error { return nil }
+
+func (s *rejectingSink) URI(key string) string { return "rejecting://" + key }
+
+func TestUploaderSinkFailure(t *testing.T) {
+	t.Parallel()
+
+	outPath := t.TempDir()
+	outDir, err := fileutil.OpenExistingDir(outPath)
+	require.NoError(t, err)
+	defer outDir.Close()
+
+	var mu sync.Mutex
+	var reported []Artifact
+
+	uploader, err := NewUploader(Config{
+		OutDir: outDir,
+		Sink:   &rejectingSink{},
+		Stored: func(artifact Artifact) {
+			mu.Lock()
+			reported = append(reported, artifact)
+			mu.Unlock()
+		},
+	})
+	require.NoError(t, err)
+	uploader.Start()
+
+	writeArtifact(t, outPath, "host/module.dev-1", "module")
+	require.True(t, uploader.Submit(Artifact{Type: KernelModule, Path: "host/module.dev-1"}))
+	uploader.Close(context.Background())
+
+	// failed uploads are reported with their local URI
+	mu.Lock()
+	defer mu.Unlock()
+	require.Len(t, reported, 1)
+	assert.Equal(t, "file://"+filepath.Join(outPath, "host/module.dev-1"), reported[0].URI)
+	assert.Equal(t, sha256Hex([]byte("module")), reported[0].Hash)
+}
+
+func TestUploaderSettle(t *testing.T) {
+	t.Parallel()
+
+	uploader, outPath, stored := newTestUploader(t, Config{Settle: time.Minute})
+
+	writeArtifact(t, outPath, "host/write.dev-1.inode-2", "first chunk")
+	uploader.SubmitWhenSettled(Artifact{Type: FileWrite, Path: "host/write.dev-1.inode-2"})
+	writeArtifact(t, outPath, "host/write.dev-1.inode-2", "first chunk, second chunk")
+	uploader.SubmitWhenSettled(Artifact{Type: FileWrite, Path: "host/write.dev-1.inode-2"})
+
+	// still being written: not queued
+	uploader.queueSettled(time.Now())
+	assert.Empty(t, uploader.queue)
+
+	// settled: queued once
+	uploader.queueSettled(time.Now().Add(time.Minute))
+	assert.Len(t, uploader.queue, 1)
+	assert.Empty(t, uploader.pending)
+
+	uploader.Start()
+	artifact := receive(t, stored)
+	assert.Equal(t, sha256Hex([]byte("first chunk, second chunk")), artifact.Hash)
+
+	// artifacts still being written are stored on close
+	writeArtifact(t, outPath, "host/bin.pid-1.ts-2", "memory region")
+	uploader.SubmitWhenSettled(Artifact{Type: MemoryRegion, Path: "host/bin.pid-1.ts-2"})
+	uploader.Close(context.Background())
+	artifact = receive(t, stored)
+	assert.Equal(t, "host/bin.pid-1.ts-2", artifact.Path)
+}
+
+func TestUploaderRetention(t *testing.T) {
+	t.Parallel()
+
+	uploader, outPath, stored := newTestUploader(t, Config{
+		Retention: map[Type]time.Duration{
+			Network: time.Hour,
+		},
+	})
+	uploader.Start()
+	defer uploader.Close(context.Background())
+
+	writeArtifact(t, outPath, "pcap/single.pcap", "packets")
+	writeArtifact(t, outPath, "host/exec.1.ls", "executable")
+
+	now := time.Now()
+	require.True(t, uploader.Submit(Artifact{Type: Network, Path: "pcap/single.pcap", Time: now}))
+	require.True(t, uploader.Submit(Artifact{Type: Executable, Path: "host/exec.1.ls", Time: now}))
+	receive(t, stored)
+	receive(t, stored)
+
+	uploader.expire(now.Add(time.Minute))
+	assert.FileExists(t, filepath.Join(outPath, "pcap/single.pcap"))
+
+	// only types with a retention expire
+	uploader.expire(now.Add(time.Hour))
+	assert.NoFileExists(t, filepath.Join(outPath, "pcap/single.pcap"))
+	assert.FileExists(t, filepath.Join(outPath, "host/exec.1.ls"))
+}
+
+func TestUploaderDeduplicate(t *testing.T) {
+	t.Parallel()
+
+	uploader, outPath, stored := newTestUploader(t, Config{})
+	uploader.Start()
+	defer uploader.Close(context.Background())
+
+	writeArtifact(t, outPath, "host/exec.1.ls", "executable")
+	writeArtifact(t, outPath, "abcdef/exec.2.ls", "executable")
+	writeArtifact(t, outPath, "host/write.dev-1.inode-2", "executable")
+
+	require.True(t, uploader.Submit(Artifact{Type: Executable, Path: "host/exec.1.ls"}))
+	first := receive(t, stored)
+	require.True(t, uploader.Submit(Artifact{
+		Type:    Executable,
+		Path:    "abcdef/exec.2.ls",
+		Context: Context{ContainerID: "abcdef", HostPid: 2},
+	}))
+	second := receive(t, stored)
+
+	// the copy is dropped in favor of the kept executable
+	assert.Equal(t, "host/exec.1.ls", second.Path)
+	assert.Equal(t, first.URI, second.URI)
+	assert.Equal(t, "abcdef", second.Context.ContainerID)
+	assert.NoFileExists(t, filepath.Join(outPath, "abcdef/exec.2.ls"))
+
+	// only executables and kernel modules are deduplicated
+	require.True(t, uploader.Submit(Artifact{Type: FileWrite, Path: "host/write.dev-1.inode-2"}))
+	third := receive(t, stored)
+	assert.Equal(t, "host/write.dev-1.inode-2", third.Path)
+
+	stats := uploader.Stats()
+	assert.Equal(t, TypeStats{Files: 1, Bytes: 10, Deduplicated: 1}, stats[Executable])
+	assert.Equal(t, TypeStats{Files: 1, Bytes: 10}, stats[FileWrite])
+}
+
+func TestUploaderQuotas(t *testing.T) {
+	t.Parallel()
+
+	uploader, outPath, stored := newTestUploader(t, Config{
+		Quotas: Quotas{
+			Total:   30,
+			PerType: map[Type]int64{Network: 10},
+		},
+	})
+
+	// the cleanup is triggered by the artifacts over the quotas
+	now := time.Now()
+	for i, path := range []string{"pcap/1.pcap", "pcap/2.pcap", "host/exec.1.a", "host/exec.2.b", "host/exec.3.c"} {
+		artifactType := Network
+		if filepath.Dir(path) == "host" {
+			artifactType = Executable
+		}
+		writeArtifact(t, outPath, path, strings.Repeat(path[len(path)-1:], 10))
+		require.True(t, uploader.Submit(Artifact{
+			Type: artifactType,
+			Path: path,
+			Time: now.Add(time.Duration(i) * time.Second),
+		}))
+	}
+	uploader.Start()
+	for i := 0; i < 5; i++ {
+		receive(t, stored)
+	}
+	uploader.Close(context.Background())
+	uploader.evict()
+
+	// oldest first: the network quota evicts the first pcap, and the total
+	// quota the oldest of what is left
+	assert.NoFileExists(t, filepath.Join(outPath, "pcap/1.pcap"))
+	assert.NoFileExists(t, filepath.Join(outPath, "pcap/2.pcap"))
+	assert.FileExists(t, filepath.Join(outPath, "host/exec.1.a"))
+	assert.FileExists(t, filepath.Join(outPath, "host/exec.2.b"))
+	assert.FileExists(t, filepath.Join(outPath, "host/exec.3.c"))
+
+	stats := uploader.Stats()
+	assert.Equal(t, TypeStats{Evicted: 2}, stats[Network])
+	assert.Equal(t, TypeStats{Files: 3, Bytes: 30}, stats[Executable])
+}
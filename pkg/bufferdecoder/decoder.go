@@ -159,7 +159,10 @@ func (decoder *EbpfDecoder) DecodeContext(eCtx *EventContext) error {
 	eCtx.StackID = binary.LittleEndian.Uint32(decoder.buffer[offset+120 : offset+124])
 	eCtx.ProcessorId = binary.LittleEndian.Uint16(decoder.buffer[offset+124 : offset+126])
 	eCtx.PoliciesVersion = binary.LittleEndian.Uint16(decoder.buffer[offset+126 : offset+128])
-	eCtx.MatchedPolicies = binary.LittleEndian.Uint64(decoder.buffer[offset+128 : offset+136])
+	for i := range eCtx.MatchedPolicies {
+		wordOffset := offset + 128 + i*8
+		eCtx.MatchedPolicies[i] = binary.LittleEndian.Uint64(decoder.buffer[wordOffset : wordOffset+8])
+	}
 	// event_context end
 
 	decoder.cursor += eCtx.GetSizeBytes()
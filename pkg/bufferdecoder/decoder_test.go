@@ -7,6 +7,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/aquasecurity/tracee/common/bitwise"
 	"github.com/aquasecurity/tracee/pkg/events"
 	"github.com/aquasecurity/tracee/types/trace"
 )
@@ -40,7 +41,7 @@ func TestDecodeContext(t *testing.T) {
 		StackID:         0,
 		ProcessorId:     5,
 		PoliciesVersion: 11,
-		MatchedPolicies: 1917,
+		MatchedPolicies: bitwise.NewBitmap(0, 5, 70, bitwise.BitmapBits-1),
 	}
 	err := binary.Write(buf, binary.LittleEndian, eCtxExpected)
 	assert.Equal(t, nil, err)
@@ -2,7 +2,10 @@
 // between code eBPF running in the Kernel and the Tracee user-space application.
 package bufferdecoder
 
-import "github.com/aquasecurity/tracee/pkg/events"
+import (
+	"github.com/aquasecurity/tracee/common/bitwise"
+	"github.com/aquasecurity/tracee/pkg/events"
+)
 
 // BinType is an enum that specifies the type of binary data sent in the file perf map
 // binary types should match defined values in ebpf code
@@ -48,13 +51,13 @@ type EventContext struct {
 	StackID         uint32
 	ProcessorId     uint16
 	PoliciesVersion uint16
-	MatchedPolicies uint64
+	MatchedPolicies bitwise.Bitmap
 }
 
 // GetSizeBytes returns the size of the EventContext struct in bytes.
 // This must match the size of event_context_t in the eBPF code (tracee.bpf.c).
 func (EventContext) GetSizeBytes() int {
-	return 136
+	return 128 + bitwise.BitmapWords*8
 }
 
 type ChunkMeta struct {
@@ -7,6 +7,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
+	"github.com/aquasecurity/tracee/api/v1beta1/detection"
 	"github.com/aquasecurity/tracee/common/environment"
 	"github.com/aquasecurity/tracee/common/errfmt"
 	"github.com/aquasecurity/tracee/common/logger"
@@ -101,8 +102,9 @@ func GetTraceeRunner(c *cobra.Command, version string) (cmd.Runner, error) {
 
 	sigs.CreateEventsFromSignatures(events.StartSignatureID, signatures)
 
-	// Get YAML detector search directories from config or CLI
+	// Get YAML detector search directories and per-detector config from config or CLI
 	var yamlDetectorDirs []string
+	var detectorParamsConfig map[string]map[string]any
 	if viper.IsSet(flags.DetectorsFlag) {
 		detectorsFlags, err := flags.GetFlagsFromViper(flags.DetectorsFlag)
 		if err != nil {
@@ -113,6 +115,7 @@ func GetTraceeRunner(c *cobra.Command, version string) (cmd.Runner, error) {
 			return runner, err
 		}
 		yamlDetectorDirs = detectorsConfig.Paths
+		detectorParamsConfig = detectorsConfig.Config
 	}
 
 	// Pre-register detector events in events.Core before policy initialization
@@ -188,6 +191,7 @@ func GetTraceeRunner(c *cobra.Command, version string) (cmd.Runner, error) {
 
 	cfg.ProcessStore = stores.GetProcessStoreConfig()
 	cfg.DNSStore = stores.GetDNSStoreConfig()
+	cfg.IPReputationStore = stores.GetIPReputationStoreConfig()
 
 	// Artifacts command line flags - via viper
 
@@ -245,19 +249,27 @@ func GetTraceeRunner(c *cobra.Command, version string) (cmd.Runner, error) {
 
 	// Try to get policies from kubernetes CRD, policy files and CLI in that order
 
+	var k8sPolicyList *v1beta1.PolicyList
 	var k8sPolicies []v1beta1.PolicyInterface
 	var initialPolicies []*policy.Policy
 
 	k8sClient, err := k8s.New()
 	if err == nil {
-		k8sPolicies, err = k8sClient.GetPolicy(c.Context())
+		k8sPolicyList, err = k8sClient.ListPolicies(c.Context())
 	}
 	if err != nil {
 		logger.Debugw("kubernetes cluster", "error", err)
 	}
+	if k8sPolicyList != nil {
+		for _, item := range k8sPolicyList.Items {
+			k8sPolicies = append(k8sPolicies, item)
+		}
+	}
 	if len(k8sPolicies) > 0 {
 		logger.Debugw("using policies from kubernetes crd")
 		initialPolicies, err = createPoliciesFromK8SPolicy(k8sPolicies, allDetectors)
+		// changes of the policies are watched and applied live
+		runner.PolicyWatcher = k8s.NewPolicyWatcher(k8sClient, k8s.NodeName(), k8sPolicyList.Items)
 	} else if len(policyFlags) > 0 {
 		logger.Debugw("using policies from --policy flag")
 		initialPolicies, err = createPoliciesFromPolicyFiles(policyFlags, allDetectors)
@@ -359,11 +371,15 @@ func GetTraceeRunner(c *cobra.Command, version string) (cmd.Runner, error) {
 
 	runner.HTTP = serverConfig.GetHTTPServer()
 	runner.GRPC = serverConfig.GetGRPCServer()
+	runner.MetricsExporter = serverConfig.GetMetricsExporter()
 
 	if runner.HTTP != nil {
 		cfg.MetricsEnabled = runner.HTTP.IsMetricsEnabled()
 		cfg.HealthzEnabled = runner.HTTP.IsHealthzEnabled()
 	}
+	if runner.MetricsExporter != nil {
+		cfg.MetricsEnabled = true
+	}
 
 	runner.TraceeConfig = cfg
 
@@ -372,17 +388,28 @@ func GetTraceeRunner(c *cobra.Command, version string) (cmd.Runner, error) {
 		logger.Debugw("No-signatures mode enabled, using same signature selection as normal mode for fair comparison")
 	}
 
+	selectedSignatures := selectSignaturesBasedOnPolicies(signatures, initialPolicies)
+
 	runner.TraceeConfig.EngineConfig = engine.Config{
 		Mode:                engine.ModeSingleBinary,
 		NoSignatures:        noSignaturesMode,
 		AvailableSignatures: signatures,
-		SelectedSignatures:  selectSignaturesBasedOnPolicies(signatures, initialPolicies),
+		SelectedSignatures:  selectedSignatures,
 		DataSources:         dataSources,
 	}
 
+	runner.PolicyBuilder = newRuntimePolicyBuilder(allDetectors, selectedSignatures)
+	if runner.GRPC != nil {
+		runner.GRPC.SetPolicyBuilder(runner.PolicyBuilder)
+		runner.GRPC.SetDetectorLoader(func(document []byte) (detection.EventDetector, error) {
+			return detectors.LoadYAMLDetector(document, yamlDetectorDirs)
+		})
+	}
+
 	runner.TraceeConfig.DetectorConfig = config.DetectorConfig{
 		Detectors:      allDetectors,
 		YAMLSearchDirs: yamlDetectorDirs,
+		Config:         detectorParamsConfig,
 	}
 
 	return runner, nil
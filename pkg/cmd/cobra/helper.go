@@ -2,10 +2,13 @@ package cobra
 
 import (
 	"github.com/aquasecurity/tracee/api/v1beta1/detection"
+	"github.com/aquasecurity/tracee/common/errfmt"
 	"github.com/aquasecurity/tracee/pkg/cmd/flags"
+	"github.com/aquasecurity/tracee/pkg/events"
 	k8s "github.com/aquasecurity/tracee/pkg/k8s/apis/tracee.aquasec.com/v1beta1"
 	"github.com/aquasecurity/tracee/pkg/policy"
 	"github.com/aquasecurity/tracee/pkg/policy/v1beta1"
+	"github.com/aquasecurity/tracee/types/detect"
 )
 
 func createPoliciesFromK8SPolicy(policies []k8s.PolicyInterface, detectors []detection.EventDetector) ([]*policy.Policy, error) {
@@ -44,3 +47,40 @@ func createPoliciesFromCLIFlags(scopeFlags, eventFlags []string, detectors []det
 
 	return flags.CreatePolicies(policyScopeMap, policyEventsMap)
 }
+
+// newRuntimePolicyBuilder returns a builder of the policies created at runtime.
+// Signatures are loaded at startup only, so policies selecting signatures which
+// were not loaded are refused.
+func newRuntimePolicyBuilder(detectors []detection.EventDetector, loadedSignatures []detect.Signature) func(k8s.PolicyInterface) (*policy.Policy, error) {
+	loadedEvents := make(map[string]struct{}, len(loadedSignatures))
+	for _, sig := range loadedSignatures {
+		metadata, err := sig.GetMetadata()
+		if err != nil {
+			continue
+		}
+		loadedEvents[metadata.EventName] = struct{}{}
+	}
+
+	return func(policyFile k8s.PolicyInterface) (*policy.Policy, error) {
+		policies, err := createPoliciesFromK8SPolicy([]k8s.PolicyInterface{policyFile}, detectors)
+		if err != nil {
+			return nil, err
+		}
+		if len(policies) != 1 {
+			return nil, errfmt.Errorf("policy %s could not be created", policyFile.GetName())
+		}
+
+		p := policies[0]
+		for eventID := range p.Rules {
+			eventDef := events.Core.GetDefinitionByID(eventID)
+			if !eventDef.IsSignature() {
+				continue
+			}
+			if _, ok := loadedEvents[eventDef.GetName()]; !ok {
+				return nil, errfmt.Errorf("policy %s selects signature %s, which was not loaded at startup", p.Name, eventDef.GetName())
+			}
+		}
+
+		return p, nil
+	}
+}
@@ -5,11 +5,14 @@ import (
 	"math"
 	"os"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/aquasecurity/tracee/common/errfmt"
 	"github.com/aquasecurity/tracee/common/logger"
+	"github.com/aquasecurity/tracee/pkg/artifacts"
 	"github.com/aquasecurity/tracee/pkg/config"
 )
 
@@ -25,6 +28,9 @@ const (
 	memoryRegions = "memory-regions"
 	network       = "network"
 	dir           = "dir"
+	storage       = "storage"
+	retention     = "retention"
+	quota         = "quota"
 
 	// Artifact sub-options
 	enabled    = "enabled"
@@ -38,6 +44,16 @@ const (
 	pcapOptions = "options"
 	pcapSnaplen = "snaplen"
 
+	// Artifacts storage options
+	storageS3  = "s3"
+	s3Endpoint = "endpoint"
+	s3Bucket   = "bucket"
+	s3Prefix   = "prefix"
+	s3Region   = "region"
+
+	// Artifacts quota of all the artifact types
+	quotaTotal = "total"
+
 	// Default values
 	defaultArtifactsDir = "/tmp/tracee"
 	defaultPcapLength   = 96
@@ -52,14 +68,17 @@ func invalidArtifactsOptionError(opt string) error {
 
 // ArtifactsConfig is the configuration for artifacts capture.
 type ArtifactsConfig struct {
-	FileWrite     FileWriteConfig `mapstructure:"file-write"`
-	FileRead      FileReadConfig  `mapstructure:"file-read"`
-	Executable    bool            `mapstructure:"executable"`
-	KernelModules bool            `mapstructure:"kernel-modules"`
-	BpfPrograms   bool            `mapstructure:"bpf-programs"`
-	MemoryRegions bool            `mapstructure:"memory-regions"`
-	Network       NetworkConfig   `mapstructure:"network"`
-	Dir           DirConfig       `mapstructure:"dir"`
+	FileWrite     FileWriteConfig   `mapstructure:"file-write"`
+	FileRead      FileReadConfig    `mapstructure:"file-read"`
+	Executable    bool              `mapstructure:"executable"`
+	KernelModules bool              `mapstructure:"kernel-modules"`
+	BpfPrograms   bool              `mapstructure:"bpf-programs"`
+	MemoryRegions bool              `mapstructure:"memory-regions"`
+	Network       NetworkConfig     `mapstructure:"network"`
+	Dir           DirConfig         `mapstructure:"dir"`
+	Storage       StorageConfig     `mapstructure:"storage"`
+	Retention     map[string]string `mapstructure:"retention"`
+	Quota         map[string]string `mapstructure:"quota"`
 }
 
 // FileWriteConfig is the configuration for file write capture.
@@ -103,6 +122,25 @@ type DirConfig struct {
 	Clear bool   `mapstructure:"clear"`
 }
 
+// StorageConfig is the configuration of where artifacts are stored.
+type StorageConfig struct {
+	Dir StorageDirConfig `mapstructure:"dir"`
+	S3  StorageS3Config  `mapstructure:"s3"`
+}
+
+// StorageDirConfig is the configuration for artifacts stored in a directory.
+type StorageDirConfig struct {
+	Path string `mapstructure:"path"`
+}
+
+// StorageS3Config is the configuration for artifacts stored in an S3 compatible object store.
+type StorageS3Config struct {
+	Endpoint string `mapstructure:"endpoint"`
+	Bucket   string `mapstructure:"bucket"`
+	Prefix   string `mapstructure:"prefix"`
+	Region   string `mapstructure:"region"`
+}
+
 // GetArtifactsConfig returns the artifacts configuration.
 func (a *ArtifactsConfig) GetArtifactsConfig() config.ArtifactsConfig {
 	artifacts := config.ArtifactsConfig{}
@@ -150,6 +188,39 @@ func (a *ArtifactsConfig) GetArtifactsConfig() config.ArtifactsConfig {
 		artifacts.Net.CaptureLength = a.Network.CaptureLength
 	}
 
+	// Storage
+	artifacts.Storage = config.ArtifactsStorageConfig{
+		Dir: a.Storage.Dir.Path,
+		S3: config.S3StorageConfig{
+			Endpoint: a.Storage.S3.Endpoint,
+			Bucket:   a.Storage.S3.Bucket,
+			Prefix:   a.Storage.S3.Prefix,
+			Region:   a.Storage.S3.Region,
+		},
+	}
+
+	// Retention - already validated
+	if len(a.Retention) > 0 {
+		artifacts.Retention = make(map[string]time.Duration, len(a.Retention))
+		for artifactType, value := range a.Retention {
+			period, _ := time.ParseDuration(value)
+			artifacts.Retention[artifactType] = period
+		}
+	}
+
+	// Quota - already validated
+	for key, value := range a.Quota {
+		size, _ := parseFileSize(value)
+		if key == quotaTotal {
+			artifacts.Quota = size
+			continue
+		}
+		if artifacts.TypeQuota == nil {
+			artifacts.TypeQuota = make(map[string]int64, len(a.Quota))
+		}
+		artifacts.TypeQuota[key] = size
+	}
+
 	// Clear dir if needed
 	if a.Dir.Clear {
 		if err := os.RemoveAll(artifacts.OutputPath); err != nil {
@@ -276,6 +347,45 @@ func (a *ArtifactsConfig) flags() []string {
 		flags = append(flags, fmt.Sprintf("%s.%s", dir, clear))
 	}
 
+	// storage
+	if a.Storage.Dir.Path != "" {
+		flags = append(flags, fmt.Sprintf("%s.%s.%s=%s", storage, dir, pathKey, a.Storage.Dir.Path))
+	}
+	s3Options := []struct {
+		key   string
+		value string
+	}{
+		{s3Endpoint, a.Storage.S3.Endpoint},
+		{s3Bucket, a.Storage.S3.Bucket},
+		{s3Prefix, a.Storage.S3.Prefix},
+		{s3Region, a.Storage.S3.Region},
+	}
+	for _, opt := range s3Options {
+		if opt.value != "" {
+			flags = append(flags, fmt.Sprintf("%s.%s.%s=%s", storage, storageS3, opt.key, opt.value))
+		}
+	}
+
+	// retention (sorted, for a stable output)
+	retentionTypes := make([]string, 0, len(a.Retention))
+	for artifactType := range a.Retention {
+		retentionTypes = append(retentionTypes, artifactType)
+	}
+	sort.Strings(retentionTypes)
+	for _, artifactType := range retentionTypes {
+		flags = append(flags, fmt.Sprintf("%s.%s=%s", retention, artifactType, a.Retention[artifactType]))
+	}
+
+	// quota (sorted, for a stable output)
+	quotaKeys := make([]string, 0, len(a.Quota))
+	for key := range a.Quota {
+		quotaKeys = append(quotaKeys, key)
+	}
+	sort.Strings(quotaKeys)
+	for _, key := range quotaKeys {
+		flags = append(flags, fmt.Sprintf("%s.%s=%s", quota, key, a.Quota[key]))
+	}
+
 	return flags
 }
 
@@ -354,6 +464,21 @@ func PrepareArtifacts(artifactsSlice []string) (ArtifactsConfig, error) {
 			if err != nil {
 				return ArtifactsConfig{}, err
 			}
+		case storage:
+			err := parseStorageArtifactOption(&artifacts.Storage, subOpt)
+			if err != nil {
+				return ArtifactsConfig{}, err
+			}
+		case retention:
+			err := parseRetentionArtifactOption(&artifacts, subOpt)
+			if err != nil {
+				return ArtifactsConfig{}, err
+			}
+		case quota:
+			err := parseQuotaArtifactOption(&artifacts, subOpt)
+			if err != nil {
+				return ArtifactsConfig{}, err
+			}
 		default:
 			return ArtifactsConfig{}, invalidArtifactsOptionError(opt)
 		}
@@ -427,6 +552,10 @@ func PrepareArtifacts(artifactsSlice []string) (ArtifactsConfig, error) {
 		artifacts.Network.CaptureLength = uint32(amount)
 	}
 
+	if err := validateArtifactsStorage(artifacts.Storage); err != nil {
+		return ArtifactsConfig{}, err
+	}
+
 	return artifacts, nil
 }
 
@@ -688,3 +817,95 @@ func parseDirArtifactOption(dirConfig *DirConfig, subOpt string) error {
 
 	return errfmt.Errorf("invalid dir option: %s", subOpt)
 }
+
+// parseStorageArtifactOption parses artifacts storage options.
+func parseStorageArtifactOption(storageConfig *StorageConfig, subOpt string) error {
+	optAndValue := strings.SplitN(subOpt, "=", 2)
+	if len(optAndValue) != 2 || optAndValue[1] == "" {
+		return errfmt.Errorf("invalid artifacts storage option: %s", subOpt)
+	}
+	value := optAndValue[1]
+
+	switch optAndValue[0] {
+	case dir + "." + pathKey:
+		storageConfig.Dir.Path = value
+	case storageS3 + "." + s3Endpoint:
+		storageConfig.S3.Endpoint = value
+	case storageS3 + "." + s3Bucket:
+		storageConfig.S3.Bucket = value
+	case storageS3 + "." + s3Prefix:
+		storageConfig.S3.Prefix = value
+	case storageS3 + "." + s3Region:
+		storageConfig.S3.Region = value
+	default:
+		return errfmt.Errorf("invalid artifacts storage option: %s", subOpt)
+	}
+
+	return nil
+}
+
+// validateArtifactsStorage checks a single, complete, artifacts storage was given.
+func validateArtifactsStorage(storageConfig StorageConfig) error {
+	s3Set := storageConfig.S3 != StorageS3Config{}
+
+	if storageConfig.Dir.Path != "" && s3Set {
+		return errfmt.Errorf("artifacts storage can either be a dir or s3, not both")
+	}
+	if s3Set && storageConfig.S3.Bucket == "" {
+		return errfmt.Errorf("artifacts s3 storage requires a bucket (storage.s3.bucket)")
+	}
+
+	return nil
+}
+
+// parseRetentionArtifactOption parses artifacts retention options in the format
+// '<artifact-type>=<duration>'.
+func parseRetentionArtifactOption(artifactsConfig *ArtifactsConfig, subOpt string) error {
+	optAndValue := strings.SplitN(subOpt, "=", 2)
+	if len(optAndValue) != 2 {
+		return errfmt.Errorf("invalid artifacts retention option: %s", subOpt)
+	}
+	artifactType, value := optAndValue[0], optAndValue[1]
+
+	if _, err := artifacts.ParseType(artifactType); err != nil {
+		return errfmt.Errorf("invalid artifacts retention type: %s", artifactType)
+	}
+	period, err := time.ParseDuration(value)
+	if err != nil || period <= 0 {
+		return errfmt.Errorf("invalid artifacts retention period: %s (must be a positive duration, e.g. 24h)", value)
+	}
+
+	if artifactsConfig.Retention == nil {
+		artifactsConfig.Retention = make(map[string]string)
+	}
+	artifactsConfig.Retention[artifactType] = value
+
+	return nil
+}
+
+// parseQuotaArtifactOption parses artifacts quota options in the format
+// '<artifact-type>=<size>', or 'total=<size>' for all the artifact types.
+func parseQuotaArtifactOption(artifactsConfig *ArtifactsConfig, subOpt string) error {
+	optAndValue := strings.SplitN(subOpt, "=", 2)
+	if len(optAndValue) != 2 {
+		return errfmt.Errorf("invalid artifacts quota option: %s", subOpt)
+	}
+	key, value := optAndValue[0], optAndValue[1]
+
+	if key != quotaTotal {
+		if _, err := artifacts.ParseType(key); err != nil {
+			return errfmt.Errorf("invalid artifacts quota type: %s", key)
+		}
+	}
+	size, err := parseFileSize(value)
+	if err != nil || size <= 0 {
+		return errfmt.Errorf("invalid artifacts quota size: %s (must be a positive size, e.g. 512M)", value)
+	}
+
+	if artifactsConfig.Quota == nil {
+		artifactsConfig.Quota = make(map[string]string)
+	}
+	artifactsConfig.Quota[key] = value
+
+	return nil
+}
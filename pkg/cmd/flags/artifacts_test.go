@@ -6,6 +6,7 @@ import (
 	"os"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -349,6 +350,111 @@ func TestPrepareArtifacts(t *testing.T) {
 					OutputPath: "/custom/path/out",
 				},
 			},
+			{
+				testName: "artifacts s3 storage",
+				artifactsSlice: []string{
+					"executable",
+					"storage.s3.endpoint=http://minio:9000",
+					"storage.s3.bucket=artifacts",
+					"storage.s3.prefix=node-1",
+					"storage.s3.region=eu-west-1",
+				},
+				expectedArtifacts: config.ArtifactsConfig{
+					OutputPath: "/tmp/tracee/out",
+					Exec:       true,
+					Storage: config.ArtifactsStorageConfig{
+						S3: config.S3StorageConfig{
+							Endpoint: "http://minio:9000",
+							Bucket:   "artifacts",
+							Prefix:   "node-1",
+							Region:   "eu-west-1",
+						},
+					},
+				},
+			},
+			{
+				testName:       "artifacts dir storage",
+				artifactsSlice: []string{"kernel-modules", "storage.dir.path=/mnt/artifacts"},
+				expectedArtifacts: config.ArtifactsConfig{
+					OutputPath: "/tmp/tracee/out",
+					Module:     true,
+					Storage: config.ArtifactsStorageConfig{
+						Dir: "/mnt/artifacts",
+					},
+				},
+			},
+			{
+				testName:       "artifacts retention",
+				artifactsSlice: []string{"executable", "network", "retention.executable=24h", "retention.network=30m"},
+				expectedArtifacts: config.ArtifactsConfig{
+					OutputPath: "/tmp/tracee/out",
+					Exec:       true,
+					Net: config.PcapsConfig{
+						CaptureSingle: true,
+						CaptureLength: 96,
+					},
+					Retention: map[string]time.Duration{
+						"executable": 24 * time.Hour,
+						"network":    30 * time.Minute,
+					},
+				},
+			},
+			{
+				testName:       "artifacts quotas",
+				artifactsSlice: []string{"executable", "network", "quota.total=1G", "quota.network=512M"},
+				expectedArtifacts: config.ArtifactsConfig{
+					OutputPath: "/tmp/tracee/out",
+					Exec:       true,
+					Net: config.PcapsConfig{
+						CaptureSingle: true,
+						CaptureLength: 96,
+					},
+					Quota: 1 << 30,
+					TypeQuota: map[string]int64{
+						"network": 512 << 20,
+					},
+				},
+			},
+			{
+				testName:       "invalid artifacts storage - both dir and s3",
+				artifactsSlice: []string{"storage.dir.path=/mnt/artifacts", "storage.s3.bucket=artifacts"},
+				expectedError:  errfmt.Errorf("artifacts storage can either be a dir or s3, not both"),
+			},
+			{
+				testName:       "invalid artifacts storage - s3 without bucket",
+				artifactsSlice: []string{"storage.s3.endpoint=http://minio:9000"},
+				expectedError:  errfmt.Errorf("artifacts s3 storage requires a bucket (storage.s3.bucket)"),
+			},
+			{
+				testName:       "invalid artifacts storage option",
+				artifactsSlice: []string{"storage.s3.acl=private"},
+				expectedError:  errfmt.Errorf("invalid artifacts storage option: s3.acl=private"),
+			},
+			{
+				testName:       "invalid artifacts storage - empty value",
+				artifactsSlice: []string{"storage.dir.path="},
+				expectedError:  errfmt.Errorf("invalid artifacts storage option: dir.path="),
+			},
+			{
+				testName:       "invalid artifacts retention type",
+				artifactsSlice: []string{"retention.binaries=24h"},
+				expectedError:  errfmt.Errorf("invalid artifacts retention type: binaries"),
+			},
+			{
+				testName:       "invalid artifacts retention period",
+				artifactsSlice: []string{"retention.executable=1day"},
+				expectedError:  errfmt.Errorf("invalid artifacts retention period: 1day (must be a positive duration, e.g. 24h)"),
+			},
+			{
+				testName:       "invalid artifacts quota type",
+				artifactsSlice: []string{"quota.pcaps=1G"},
+				expectedError:  errfmt.Errorf("invalid artifacts quota type: pcaps"),
+			},
+			{
+				testName:       "invalid artifacts quota size",
+				artifactsSlice: []string{"quota.total=lots"},
+				expectedError:  errfmt.Errorf("invalid artifacts quota size: lots (must be a positive size, e.g. 512M)"),
+			},
 			{
 				testName:       "artifacts dir clear",
 				artifactsSlice: []string{"dir.clear"},
@@ -120,6 +120,57 @@ stores:
 				"process.max-threads=4096",
 			},
 		},
+		{
+			name: "Test stores configuration (structured flags - ip reputation)",
+			yamlContent: `
+stores:
+    ip-reputation:
+        feeds:
+            - /etc/tracee/blocklist.txt
+            - /etc/tracee/feed.csv
+        reload-interval: 30s
+`,
+			key: "stores",
+			expectedFlags: []string{
+				"ip-reputation",
+				"ip-reputation.feed=/etc/tracee/blocklist.txt",
+				"ip-reputation.feed=/etc/tracee/feed.csv",
+				"ip-reputation.reload-interval=30s",
+			},
+		},
+		{
+			name: "Test stores configuration (structured flags - process snapshot)",
+			yamlContent: `
+stores:
+    process:
+        snapshot: /var/lib/tracee/proctree.json
+        snapshot-interval: 1m
+`,
+			key: "stores",
+			expectedFlags: []string{
+				"process",
+				"process.snapshot=/var/lib/tracee/proctree.json",
+				"process.snapshot-interval=1m",
+			},
+		},
+		{
+			name: "Test stores configuration (structured flags - process command line)",
+			yamlContent: `
+stores:
+    process:
+        cmdline-max-size: 4096
+        env:
+            - LD_PRELOAD
+            - KUBERNETES_*
+`,
+			key: "stores",
+			expectedFlags: []string{
+				"process",
+				"process.cmdline-max-size=4096",
+				"process.env=LD_PRELOAD",
+				"process.env=KUBERNETES_*",
+			},
+		},
 		{
 			name: "Test capabilities configuration (cli flags)",
 			yamlContent: `
@@ -383,11 +434,19 @@ server:
     metrics: true
     pprof: true
     healthz: true
-    pyroscope: true`,
+    pyroscope: true
+    grpc:
+        tls:
+            cert: /etc/tracee/tls.crt
+            key: /etc/tracee/tls.key
+            ca: /etc/tracee/ca.crt`,
 			key: "server",
 			expectedFlags: []string{
 				"http-address=localhost:8080",
 				"grpc-address=unix:/var/run/tracee.sock",
+				"grpc.tls.cert=/etc/tracee/tls.crt",
+				"grpc.tls.key=/etc/tracee/tls.key",
+				"grpc.tls.ca=/etc/tracee/ca.crt",
 				"metrics",
 				"pprof",
 				"healthz",
@@ -486,6 +545,10 @@ artifacts:
     - network.pcap.snaplen=1kb
     - dir.path=/custom/path
     - dir.clear
+    - storage.s3.endpoint=http://minio:9000
+    - storage.s3.bucket=artifacts
+    - retention.executable=24h
+    - quota.total=10G
 `,
 			key: "artifacts",
 			expectedFlags: []string{
@@ -504,6 +567,10 @@ artifacts:
 				"network.pcap.snaplen=1kb",
 				"dir.path=/custom/path",
 				"dir.clear",
+				"storage.s3.endpoint=http://minio:9000",
+				"storage.s3.bucket=artifacts",
+				"retention.executable=24h",
+				"quota.total=10G",
 			},
 		},
 		{
@@ -532,6 +599,17 @@ artifacts:
     dir:
         path: /custom/path
         clear: true
+    storage:
+        s3:
+            endpoint: http://minio:9000
+            bucket: artifacts
+            prefix: node-1
+    retention:
+        network: 1h
+        executable: 24h
+    quota:
+        total: 10G
+        network: 2G
 `,
 			key: "artifacts",
 			expectedFlags: []string{
@@ -550,6 +628,46 @@ artifacts:
 				"network.pcap.snaplen=1kb",
 				"dir.path=/custom/path",
 				"dir.clear",
+				"storage.s3.endpoint=http://minio:9000",
+				"storage.s3.bucket=artifacts",
+				"storage.s3.prefix=node-1",
+				"retention.executable=24h",
+				"retention.network=1h",
+				"quota.network=2G",
+				"quota.total=10G",
+			},
+		},
+		{
+			name: "Test detectors configuration (cli flags)",
+			yamlContent: `
+detectors:
+    - /custom/detectors
+    - config.TRC-YAML-001.threshold=20
+`,
+			key: "detectors",
+			expectedFlags: []string{
+				"/custom/detectors",
+				"config.TRC-YAML-001.threshold=20",
+			},
+		},
+		{
+			name: "Test detectors configuration (structured flags)",
+			yamlContent: `
+detectors:
+    paths:
+        - /custom/detectors
+    config:
+        TRC-YAML-001:
+            threshold: 20
+            allowlist:
+                - /etc/hosts
+                - /etc/resolv.conf
+`,
+			key: "detectors",
+			expectedFlags: []string{
+				"/custom/detectors",
+				"config.trc-yaml-001.allowlist=/etc/hosts,/etc/resolv.conf",
+				"config.trc-yaml-001.threshold=20",
 			},
 		},
 	}
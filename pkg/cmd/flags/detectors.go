@@ -2,6 +2,7 @@ package flags
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/aquasecurity/tracee/common/errfmt"
@@ -10,26 +11,82 @@ import (
 const (
 	DetectorsFlag = "detectors"
 
+	detectorsConfigPrefix = "config."
+
 	invalidDetectorsFlagError = "invalid detectors flag: '%s', use 'tracee man detectors' for more info"
 )
 
 // DetectorsConfig is the configuration for detectors
 type DetectorsConfig struct {
-	Paths []string `mapstructure:",remain"`
+	Paths []string `mapstructure:"paths"`
+	// Config holds per-detector parameters, keyed by lowercase detector ID and then
+	// by lowercase parameter name (config file keys are case-insensitive)
+	Config map[string]map[string]any `mapstructure:"config"`
 }
 
 // flags returns the flags for the detectors config
 func (c *DetectorsConfig) flags() []string {
-	return c.Paths
+	flags := make([]string, 0, len(c.Paths))
+	flags = append(flags, c.Paths...)
+
+	detectorIDs := make([]string, 0, len(c.Config))
+	for id := range c.Config {
+		detectorIDs = append(detectorIDs, id)
+	}
+	sort.Strings(detectorIDs)
+
+	for _, id := range detectorIDs {
+		keys := make([]string, 0, len(c.Config[id]))
+		for key := range c.Config[id] {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			flags = append(flags, fmt.Sprintf("%s%s.%s=%s", detectorsConfigPrefix, id, key, configValueString(c.Config[id][key])))
+		}
+	}
+
+	return flags
 }
 
-// PrepareDetectors prepares the detectors configuration from a list of flags
+// configValueString formats a config file value as a flag value.
+// Lists are joined with commas, matching the command line list syntax.
+func configValueString(value any) string {
+	if list, ok := value.([]any); ok {
+		items := make([]string, 0, len(list))
+		for _, item := range list {
+			items = append(items, fmt.Sprintf("%v", item))
+		}
+		return strings.Join(items, ",")
+	}
+	return fmt.Sprintf("%v", value)
+}
+
+// PrepareDetectors prepares the detectors configuration from a list of flags.
+// Flags are either search paths or per-detector parameters in the form
+// config.<detector-id>.<key>=<value>.
 func PrepareDetectors(flags []string) (DetectorsConfig, error) {
 	config := DetectorsConfig{
 		Paths: make([]string, 0),
 	}
 
 	for _, flag := range flags {
+		if strings.HasPrefix(flag, detectorsConfigPrefix) {
+			id, key, value, err := parseDetectorConfigFlag(flag)
+			if err != nil {
+				return DetectorsConfig{}, err
+			}
+			if config.Config == nil {
+				config.Config = make(map[string]map[string]any)
+			}
+			if config.Config[id] == nil {
+				config.Config[id] = make(map[string]any)
+			}
+			config.Config[id][key] = value
+			continue
+		}
+
 		if flag == "" || strings.Contains(flag, "=") {
 			return DetectorsConfig{}, errfmt.Errorf(invalidDetectorsFlagError, flag)
 		}
@@ -40,6 +97,22 @@ func PrepareDetectors(flags []string) (DetectorsConfig, error) {
 	return config, nil
 }
 
+// parseDetectorConfigFlag parses a config.<detector-id>.<key>=<value> flag.
+// Detector IDs and keys are lowercased, so command line and config file values match.
+func parseDetectorConfigFlag(flag string) (string, string, string, error) {
+	name, value, found := strings.Cut(strings.TrimPrefix(flag, detectorsConfigPrefix), "=")
+	if !found {
+		return "", "", "", errfmt.Errorf(invalidDetectorsFlagError, flag)
+	}
+
+	id, key, found := strings.Cut(name, ".")
+	if !found || id == "" || key == "" {
+		return "", "", "", errfmt.Errorf(invalidDetectorsFlagError, flag)
+	}
+
+	return strings.ToLower(id), strings.ToLower(key), value, nil
+}
+
 // invalidDetectorsFlagErrorMsg formats the error message for an invalid detectors flag
 func invalidDetectorsFlagErrorMsg(flag string) string {
 	return fmt.Sprintf(invalidDetectorsFlagError, flag)
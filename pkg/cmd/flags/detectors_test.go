@@ -40,7 +40,54 @@ func TestPrepareDetectors(t *testing.T) {
 				Paths: []string{"/etc/tracee/detectors", "/custom/path"},
 			},
 		},
+		// per-detector config
+		{
+			testName: "detector config",
+			flags: []string{
+				"/etc/tracee/detectors",
+				"config.TRC-YAML-001.max_opens=20",
+				"config.TRC-YAML-001.allowed=/etc/hosts,/etc/resolv.conf",
+				"config.trc-102.Enabled=true",
+			},
+			expectedReturn: DetectorsConfig{
+				Paths: []string{"/etc/tracee/detectors"},
+				Config: map[string]map[string]any{
+					"trc-yaml-001": {
+						"max_opens": "20",
+						"allowed":   "/etc/hosts,/etc/resolv.conf",
+					},
+					"trc-102": {
+						"enabled": "true",
+					},
+				},
+			},
+		},
+		{
+			testName: "detector config with empty value",
+			flags:    []string{"config.TRC-1.suffix="},
+			expectedReturn: DetectorsConfig{
+				Paths: []string{},
+				Config: map[string]map[string]any{
+					"trc-1": {"suffix": ""},
+				},
+			},
+		},
 		// invalid flags
+		{
+			testName:      "invalid detector config - missing value",
+			flags:         []string{"config.TRC-1.threshold"},
+			expectedError: invalidDetectorsFlagErrorMsg("config.TRC-1.threshold"),
+		},
+		{
+			testName:      "invalid detector config - missing key",
+			flags:         []string{"config.TRC-1=5"},
+			expectedError: invalidDetectorsFlagErrorMsg("config.TRC-1=5"),
+		},
+		{
+			testName:      "invalid detector config - empty id",
+			flags:         []string{"config..threshold=5"},
+			expectedError: invalidDetectorsFlagErrorMsg("config..threshold=5"),
+		},
 		{
 			testName:      "invalid flag format - empty",
 			flags:         []string{""},
@@ -109,6 +156,25 @@ func TestDetectorsConfigFlags(t *testing.T) {
 				"./local",
 			},
 		},
+		{
+			testName: "paths and detector config",
+			config: DetectorsConfig{
+				Paths: []string{"/etc/tracee/detectors"},
+				Config: map[string]map[string]any{
+					"trc-yaml-002": {"threshold": 20},
+					"trc-yaml-001": {
+						"enabled": true,
+						"allowed": []any{"/etc/hosts", "/etc/resolv.conf"},
+					},
+				},
+			},
+			expectedFlags: []string{
+				"/etc/tracee/detectors",
+				"config.trc-yaml-001.allowed=/etc/hosts,/etc/resolv.conf",
+				"config.trc-yaml-001.enabled=true",
+				"config.trc-yaml-002.threshold=20",
+			},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -99,6 +99,14 @@ func MandatoryDestinationFieldError(destinationType, destinationName string) err
 	return fmt.Errorf("validation error: url is mandatory for %s in destination %s", destinationName, destinationType)
 }
 
+func RotationNotSupportedError(destinationName string) error {
+	return fmt.Errorf("validation error: rotation is only supported for files, not for destination %s", destinationName)
+}
+
+func RotationNotEnabledError(field, destinationName string) error {
+	return fmt.Errorf("validation error: %s requires max-size or max-age in destination %s", field, destinationName)
+}
+
 func StreamFlagIncorrect(flag string) error {
 	return fmt.Errorf("stream flag format incorrect %s", flag)
 }
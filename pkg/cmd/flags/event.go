@@ -5,6 +5,7 @@ import (
 
 	"github.com/aquasecurity/tracee/api/v1beta1/detection"
 	"github.com/aquasecurity/tracee/common/errfmt"
+	"github.com/aquasecurity/tracee/pkg/actions"
 )
 
 func eventsHelp() string {
@@ -54,6 +55,13 @@ type policyEvents struct {
 	policyName string
 	eventFlags []eventFlag
 	detectors  []detection.EventDetector // Available detectors for threat pattern expansion
+	actions    []ruleActions             // Response actions of the policy rules
+}
+
+// ruleActions holds the response actions of one policy rule
+type ruleActions struct {
+	eventFlags []eventFlag // the rule event, expanded to event IDs when the policy is created
+	actions    []actions.Action
 }
 
 // eventFlag holds pre-parsed event flag fields
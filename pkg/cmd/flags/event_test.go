@@ -205,6 +205,24 @@ func TestParseEventFlag(t *testing.T) {
 			},
 			expectedError: nil,
 		},
+		{
+			name:      "ValidEventFlag",
+			eventFlag: "openat.data.pathname!=~/etc/[^<>]+", // regex value (with operator chars) parsed correctly
+			expected: []eventFlag{
+				{
+					full:              "openat.data.pathname!=~/etc/[^<>]+",
+					eventFilter:       "openat.data.pathname",
+					eventName:         "openat",
+					eventOptionType:   "data",
+					eventOptionName:   "pathname",
+					operator:          "!=",
+					values:            "~/etc/[^<>]+",
+					operatorAndValues: "!=~/etc/[^<>]+",
+					filter:            "data.pathname!=~/etc/[^<>]+",
+				},
+			},
+			expectedError: nil,
+		},
 		{
 			name:      "ValidEventFlag",
 			eventFlag: "open.scope.container",
@@ -7,6 +7,7 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/aquasecurity/tracee/common/errfmt"
 	"github.com/aquasecurity/tracee/pkg/config"
@@ -24,11 +25,22 @@ const (
 	jsonFlag         = "json"
 	forwardFlag      = "forward"
 	webhookFlag      = "webhook"
+	kafkaFlag        = "kafka"
+	otlpFlag         = "otlp"
+	syslogFlag       = "syslog"
+	cefFlag          = "cef"
+	leefFlag         = "leef"
 	gotemplatePrefix = "gotemplate="
 
 	// destination flags
 	destinationsPrefix = "destinations."
 
+	// file destination rotation flags
+	maxSizeFlag  = "max-size"
+	maxAgeFlag   = "max-age"
+	maxFilesFlag = "max-files"
+	compressFlag = "compress"
+
 	// stream flags
 	streamsPrefix = "streams."
 )
@@ -71,6 +83,12 @@ type DestinationsConfig struct {
 	Format string `mapstructure:"format"`
 	Path   string `mapstructure:"path"`
 	Url    string `mapstructure:"url"`
+
+	// file rotation
+	MaxSize  string `mapstructure:"max-size"`
+	MaxAge   string `mapstructure:"max-age"`
+	MaxFiles int    `mapstructure:"max-files"`
+	Compress bool   `mapstructure:"compress"`
 }
 
 // OutputConfig is the config of the output.
@@ -110,6 +128,22 @@ func (c *OutputConfig) flags() []string {
 		if destination.Url != "" {
 			flags = append(flags, fmt.Sprintf("%s%s.url=%s", destinationsPrefix, destination.Name, destination.Url))
 		}
+
+		if destination.MaxSize != "" {
+			flags = append(flags, fmt.Sprintf("%s%s.%s=%s", destinationsPrefix, destination.Name, maxSizeFlag, destination.MaxSize))
+		}
+
+		if destination.MaxAge != "" {
+			flags = append(flags, fmt.Sprintf("%s%s.%s=%s", destinationsPrefix, destination.Name, maxAgeFlag, destination.MaxAge))
+		}
+
+		if destination.MaxFiles > 0 {
+			flags = append(flags, fmt.Sprintf("%s%s.%s=%d", destinationsPrefix, destination.Name, maxFilesFlag, destination.MaxFiles))
+		}
+
+		if destination.Compress {
+			flags = append(flags, fmt.Sprintf("%s%s.%s=true", destinationsPrefix, destination.Name, compressFlag))
+		}
 	}
 
 	// streams
@@ -188,7 +222,7 @@ func PrepareOutput(outputSlice []string, containerMode config.ContainerMode) (*c
 				return nil, InvalidOutputFlagError(outputParts[0])
 			}
 			traceeConfig.EventsSorting = true
-		case tableFlag, jsonFlag:
+		case tableFlag, jsonFlag, cefFlag, leefFlag:
 			err := parseFormat(outputParts, destinationMap)
 			if err != nil {
 				return nil, err
@@ -207,6 +241,27 @@ func PrepareOutput(outputSlice []string, containerMode config.ContainerMode) (*c
 			}
 
 			destinationMap[outputParts[1]] = webhookFlag
+		case kafkaFlag:
+			err := validateURL(outputParts, kafkaFlag)
+			if err != nil {
+				return nil, err
+			}
+
+			destinationMap[outputParts[1]] = kafkaFlag
+		case otlpFlag:
+			err := validateURL(outputParts, otlpFlag)
+			if err != nil {
+				return nil, err
+			}
+
+			destinationMap[outputParts[1]] = otlpFlag
+		case syslogFlag:
+			err := validateURL(outputParts, syslogFlag)
+			if err != nil {
+				return nil, err
+			}
+
+			destinationMap[outputParts[1]] = syslogFlag
 		default:
 			return nil, InvalidOutputFlagError(outputParts[0])
 		}
@@ -308,6 +363,30 @@ func parseDestinationFlag(flag string, existing map[string]*config.Destination)
 		destinationConfig.Path = flagValue
 	case "url":
 		destinationConfig.Url = flagValue
+	case maxSizeFlag:
+		size, err := parseFileSize(flagValue)
+		if err != nil || size <= 0 {
+			return InvalidDestinationFieldError(maxSizeFlag, flagValue, destinationName)
+		}
+		destinationConfig.Rotation.MaxSize = size
+	case maxAgeFlag:
+		age, err := time.ParseDuration(flagValue)
+		if err != nil || age <= 0 {
+			return InvalidDestinationFieldError(maxAgeFlag, flagValue, destinationName)
+		}
+		destinationConfig.Rotation.MaxAge = age
+	case maxFilesFlag:
+		files, err := strconv.Atoi(flagValue)
+		if err != nil || files < 0 {
+			return InvalidDestinationFieldError(maxFilesFlag, flagValue, destinationName)
+		}
+		destinationConfig.Rotation.MaxFiles = files
+	case compressFlag:
+		compress, err := strconv.ParseBool(flagValue)
+		if err != nil {
+			return InvalidDestinationFieldError(compressFlag, flagValue, destinationName)
+		}
+		destinationConfig.Rotation.Compress = compress
 	default:
 		return DestinationFlagIncorrectError(flag)
 	}
@@ -315,6 +394,30 @@ func parseDestinationFlag(flag string, existing map[string]*config.Destination)
 	return nil
 }
 
+// parseFileSize parses a size in bytes, with an optional K, M or G suffix (powers of 1024)
+func parseFileSize(value string) (int64, error) {
+	multiplier := int64(1)
+	number := strings.TrimSuffix(strings.ToUpper(value), "B")
+	switch {
+	case strings.HasSuffix(number, "K"):
+		multiplier = 1 << 10
+	case strings.HasSuffix(number, "M"):
+		multiplier = 1 << 20
+	case strings.HasSuffix(number, "G"):
+		multiplier = 1 << 30
+	}
+	if multiplier > 1 {
+		number = number[:len(number)-1]
+	}
+
+	size, err := strconv.ParseInt(number, 10, 64)
+	if err != nil {
+		return 0, errfmt.WrapError(err)
+	}
+
+	return size * multiplier, nil
+}
+
 // validateOrDefaults validates the given destinations and sets the default values if needed.
 func validateOrDefaults(destinations map[string]*config.Destination) error {
 	for _, d := range destinations {
@@ -330,26 +433,61 @@ func validateOrDefaults(destinations map[string]*config.Destination) error {
 			d.Path = "stdout"
 		}
 
-		if (d.Type == webhookFlag || d.Type == forwardFlag) &&
+		if (d.Type == webhookFlag || d.Type == forwardFlag || d.Type == kafkaFlag ||
+			d.Type == otlpFlag || d.Type == syslogFlag) &&
 			d.Format == "" {
 			d.Format = jsonFlag
 		}
 
-		if (d.Type == webhookFlag || d.Type == forwardFlag) &&
+		if (d.Type == webhookFlag || d.Type == forwardFlag || d.Type == kafkaFlag ||
+			d.Type == otlpFlag || d.Type == syslogFlag) &&
 			d.Url == "" {
 			return MandatoryDestinationFieldError(d.Type, d.Name)
 		}
 
-		if d.Format != jsonFlag && d.Format != tableFlag &&
+		if d.Format != jsonFlag && d.Format != tableFlag && d.Format != cefFlag && d.Format != leefFlag &&
 			!strings.HasPrefix(d.Format, gotemplatePrefix) {
 			return InvalidDestinationFieldError("format", d.Format, d.Name)
 		}
 
-		if d.Type != "file" && d.Type != webhookFlag && d.Type != forwardFlag {
+		if d.Type != "file" && d.Type != webhookFlag && d.Type != forwardFlag && d.Type != kafkaFlag &&
+			d.Type != otlpFlag && d.Type != syslogFlag {
 			return InvalidDestinationFieldError("type", d.Type, d.Name)
 		}
 
+		// The cef and leef formats are lines for files and syslog, and syslog messages
+		// can't carry tables
+		if (d.Format == cefFlag || d.Format == leefFlag) && d.Type != "file" && d.Type != syslogFlag {
+			return InvalidDestinationFieldError("format", d.Format, d.Name)
+		}
+		if d.Type == syslogFlag && d.Format == tableFlag {
+			return InvalidDestinationFieldError("format", d.Format, d.Name)
+		}
+
+		// The otlp log records carry the events in json
+		if d.Type == otlpFlag && d.Format != jsonFlag {
+			return InvalidDestinationFieldError("format", d.Format, d.Name)
+		}
+
+		if d.Rotation.Enabled() && (d.Type != "file" || d.Path == "stdout") {
+			return RotationNotSupportedError(d.Name)
+		}
+
+		if !d.Rotation.Enabled() {
+			if d.Rotation.MaxFiles > 0 {
+				return RotationNotEnabledError(maxFilesFlag, d.Name)
+			}
+			if d.Rotation.Compress {
+				return RotationNotEnabledError(compressFlag, d.Name)
+			}
+		}
+
 		if d.Type == "file" {
+			if d.Rotation.Enabled() {
+				// The printer opens the file, as it reopens it on every rotation
+				continue
+			}
+
 			d.File = os.Stdout
 
 			if d.Path != "stdout" && d.Path != "" {
@@ -474,12 +612,17 @@ func PreparePrinterConfig(printerKind string, outputPath string) (config.Destina
 	outFile := os.Stdout
 	var err error
 
-	isFile := outputPath != "" && printerKind != forwardFlag && printerKind != webhookFlag
+	isFile := outputPath != "" && printerKind != forwardFlag && printerKind != webhookFlag &&
+		printerKind != kafkaFlag && printerKind != otlpFlag && printerKind != syslogFlag
 
 	if printerKind == webhookFlag {
 		dest.Format = getWebhookFormat(outputPath)
 	}
 
+	if printerKind == kafkaFlag || printerKind == otlpFlag || printerKind == syslogFlag {
+		dest.Format = jsonFlag
+	}
+
 	dest.Type = printerKind
 	dest.Url = outputPath
 	dest.Name = outputPath + printerKind
@@ -5,6 +5,7 @@ import (
 	"path"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -280,6 +281,83 @@ func TestPrepareOutput(t *testing.T) {
 				},
 			},
 		},
+		// kafka
+		{
+			testName:      "empty kafka flag",
+			outputSlice:   []string{"kafka:"},
+			expectedError: EmptyOutputFlagError("kafka"),
+		},
+		{
+			testName:    "kafka",
+			outputSlice: []string{"kafka:kafka://localhost:9092?topic=events"},
+			expectedOutput: config.OutputConfig{
+				Streams: []config.Stream{
+					{
+						Name: "default-stream",
+						Destinations: []config.Destination{
+							{Name: "kafka://localhost:9092?topic=eventskafka", Type: "kafka",
+								Url: "kafka://localhost:9092?topic=events", Format: "json"},
+						},
+					},
+				},
+			},
+		},
+		// otlp
+		{
+			testName:      "empty otlp flag",
+			outputSlice:   []string{"otlp:"},
+			expectedError: EmptyOutputFlagError("otlp"),
+		},
+		{
+			testName:    "otlp",
+			outputSlice: []string{"otlp:grpc://localhost:4317"},
+			expectedOutput: config.OutputConfig{
+				Streams: []config.Stream{
+					{
+						Name: "default-stream",
+						Destinations: []config.Destination{
+							{Name: "grpc://localhost:4317otlp", Type: "otlp",
+								Url: "grpc://localhost:4317", Format: "json"},
+						},
+					},
+				},
+			},
+		},
+		// syslog
+		{
+			testName:      "empty syslog flag",
+			outputSlice:   []string{"syslog:"},
+			expectedError: EmptyOutputFlagError("syslog"),
+		},
+		{
+			testName:    "syslog",
+			outputSlice: []string{"syslog:udp://localhost:514"},
+			expectedOutput: config.OutputConfig{
+				Streams: []config.Stream{
+					{
+						Name: "default-stream",
+						Destinations: []config.Destination{
+							{Name: "udp://localhost:514syslog", Type: "syslog",
+								Url: "udp://localhost:514", Format: "json"},
+						},
+					},
+				},
+			},
+		},
+		{
+			testName:    "cef to stdout",
+			outputSlice: []string{"cef"},
+			expectedOutput: config.OutputConfig{
+				Streams: []config.Stream{
+					{
+						Name: "default-stream",
+						Destinations: []config.Destination{
+							{Name: "stdoutcef", Type: "file", Format: "cef", Path: "stdout"},
+						},
+					},
+				},
+			},
+		},
 		{
 			testName:    "sort-events",
 			outputSlice: []string{"sort-events"},
@@ -509,6 +587,106 @@ func TestPrepareOutput(t *testing.T) {
 			},
 			expectedError: MandatoryDestinationFieldError("webhook", "d2"),
 		},
+		{
+			testName: "define a kafka destination",
+			outputSlice: []string{
+				"destinations.d2.type=kafka",
+				"destinations.d2.url=kafka://localhost:9092?topicBy=event&key=container",
+			},
+			expectedOutput: config.OutputConfig{
+				Streams: []config.Stream{
+					{
+						Name: "default-stream",
+						Destinations: []config.Destination{
+							{Name: "d2", Type: "kafka", Format: "json", Url: "kafka://localhost:9092?topicBy=event&key=container"},
+						},
+					},
+				},
+			},
+		},
+		{
+			testName: "kafka without url",
+			outputSlice: []string{
+				"destinations.d2.type=kafka",
+			},
+			expectedError: MandatoryDestinationFieldError("kafka", "d2"),
+		},
+		{
+			testName: "define an otlp destination",
+			outputSlice: []string{
+				"destinations.d2.type=otlp",
+				"destinations.d2.url=http://localhost:4318?batchSize=100",
+			},
+			expectedOutput: config.OutputConfig{
+				Streams: []config.Stream{
+					{
+						Name: "default-stream",
+						Destinations: []config.Destination{
+							{Name: "d2", Type: "otlp", Format: "json", Url: "http://localhost:4318?batchSize=100"},
+						},
+					},
+				},
+			},
+		},
+		{
+			testName: "otlp without url",
+			outputSlice: []string{
+				"destinations.d2.type=otlp",
+			},
+			expectedError: MandatoryDestinationFieldError("otlp", "d2"),
+		},
+		{
+			testName: "otlp with table format",
+			outputSlice: []string{
+				"destinations.d2.type=otlp",
+				"destinations.d2.format=table",
+				"destinations.d2.url=grpc://localhost:4317",
+			},
+			expectedError: InvalidDestinationFieldError("format", "table", "d2"),
+		},
+		{
+			testName: "define a syslog destination with the leef format",
+			outputSlice: []string{
+				"destinations.d2.type=syslog",
+				"destinations.d2.format=leef",
+				"destinations.d2.url=tcp+tls://localhost:6514",
+			},
+			expectedOutput: config.OutputConfig{
+				Streams: []config.Stream{
+					{
+						Name: "default-stream",
+						Destinations: []config.Destination{
+							{Name: "d2", Type: "syslog", Format: "leef", Url: "tcp+tls://localhost:6514"},
+						},
+					},
+				},
+			},
+		},
+		{
+			testName: "syslog without url",
+			outputSlice: []string{
+				"destinations.d2.type=syslog",
+			},
+			expectedError: MandatoryDestinationFieldError("syslog", "d2"),
+		},
+		{
+			testName: "syslog with table format",
+			outputSlice: []string{
+				"destinations.d2.type=syslog",
+				"destinations.d2.format=table",
+				"destinations.d2.url=udp://localhost:514",
+			},
+			expectedError: InvalidDestinationFieldError("format", "table", "d2"),
+		},
+		{
+			testName: "webhook with cef format",
+			outputSlice: []string{
+				"destinations.d2.type=webhook",
+				"destinations.d2.format=cef",
+				"destinations.d2.url=http://localhost:8080",
+			},
+			expectedError: InvalidDestinationFieldError("format", "cef", "d2"),
+		},
 		{
 			testName: "forward without url",
 			outputSlice: []string{
@@ -573,6 +751,79 @@ func TestPrepareOutput(t *testing.T) {
 				},
 			},
 		},
+		{
+			testName: "file destination with rotation",
+			outputSlice: []string{
+				"destinations.d1.format=json",
+				"destinations.d1.path=/tmp/tracee-rotated.json",
+				"destinations.d1.max-size=100M",
+				"destinations.d1.max-age=24h",
+				"destinations.d1.max-files=5",
+				"destinations.d1.compress=true",
+			},
+			expectedOutput: config.OutputConfig{
+				Streams: []config.Stream{
+					{
+						Name: "default-stream",
+						Destinations: []config.Destination{
+							{
+								Name:   "d1",
+								Format: "json",
+								Path:   "/tmp/tracee-rotated.json",
+								Type:   "file",
+								Rotation: config.FileRotation{
+									MaxSize:  100 << 20,
+									MaxAge:   24 * time.Hour,
+									MaxFiles: 5,
+									Compress: true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			testName: "invalid destination max-size field",
+			outputSlice: []string{
+				"destinations.d1.path=/tmp/tracee-rotated.json",
+				"destinations.d1.max-size=10X",
+			},
+			expectedError: InvalidDestinationFieldError("max-size", "10X", "d1"),
+		},
+		{
+			testName: "invalid destination max-age field",
+			outputSlice: []string{
+				"destinations.d1.path=/tmp/tracee-rotated.json",
+				"destinations.d1.max-age=-1h",
+			},
+			expectedError: InvalidDestinationFieldError("max-age", "-1h", "d1"),
+		},
+		{
+			testName: "rotation of stdout",
+			outputSlice: []string{
+				"destinations.d1.format=json",
+				"destinations.d1.max-size=1G",
+			},
+			expectedError: RotationNotSupportedError("d1"),
+		},
+		{
+			testName: "rotation of webhook destination",
+			outputSlice: []string{
+				"destinations.d1.type=webhook",
+				"destinations.d1.url=http://localhost:8080",
+				"destinations.d1.max-age=1h",
+			},
+			expectedError: RotationNotSupportedError("d1"),
+		},
+		{
+			testName: "max-files without rotation",
+			outputSlice: []string{
+				"destinations.d1.path=/tmp/tracee-rotated.json",
+				"destinations.d1.max-files=3",
+			},
+			expectedError: RotationNotEnabledError("max-files", "d1"),
+		},
 		{
 			testName: "wrong destination flag format",
 			outputSlice: []string{
@@ -670,6 +921,7 @@ func assertPrinterConfigs(t *testing.T, expected []config.Stream, actual []confi
 			}
 			assert.Equal(t, expectedDest.Format, actualDest.Format)
 			assert.Equal(t, expectedDest.ContainerMode, actualDest.ContainerMode)
+			assert.Equal(t, expectedDest.Rotation, actualDest.Rotation)
 		}
 	}
 }
@@ -805,6 +1057,28 @@ func TestOutputConfig_flags(t *testing.T) {
 				"destinations.d2.url=http://localhost:8080",
 			},
 		},
+		{
+			name: "file destination with rotation",
+			config: OutputConfig{
+				Destinations: []DestinationsConfig{
+					{
+						Name:     "d1",
+						Path:     "/tmp/file1",
+						MaxSize:  "100M",
+						MaxAge:   "24h",
+						MaxFiles: 5,
+						Compress: true,
+					},
+				},
+			},
+			expected: []string{
+				"destinations.d1.path=/tmp/file1",
+				"destinations.d1.max-size=100M",
+				"destinations.d1.max-age=24h",
+				"destinations.d1.max-files=5",
+				"destinations.d1.compress=true",
+			},
+		},
 		{
 			name: "single stream with destinations",
 			config: OutputConfig{
@@ -7,6 +7,7 @@ import (
 	"github.com/aquasecurity/tracee/api/v1beta1"
 	"github.com/aquasecurity/tracee/api/v1beta1/detection"
 	"github.com/aquasecurity/tracee/common/errfmt"
+	"github.com/aquasecurity/tracee/pkg/actions"
 	"github.com/aquasecurity/tracee/pkg/events"
 	"github.com/aquasecurity/tracee/pkg/filters"
 	k8s "github.com/aquasecurity/tracee/pkg/k8s/apis/tracee.aquasec.com/v1beta1"
@@ -62,6 +63,7 @@ func PrepareFilterMapsFromPolicies(policies []k8s.PolicyInterface, detectors []d
 		}
 
 		eventFlags := make([]eventFlag, 0)
+		var policyActions []ruleActions
 
 		for _, r := range p.GetRules() {
 			evtFlags, err := parseEventFlag(r.Event)
@@ -70,6 +72,22 @@ func PrepareFilterMapsFromPolicies(policies []k8s.PolicyInterface, detectors []d
 			}
 			eventFlags = append(eventFlags, evtFlags...)
 
+			// rules without actions use the policy default actions
+			actionList := r.Actions
+			if len(actionList) == 0 {
+				actionList = p.GetDefaultActions()
+			}
+			responseActions, err := parseResponseActions(actionList)
+			if err != nil {
+				return nil, nil, errfmt.Errorf("policy %s, %v", p.GetName(), err)
+			}
+			if len(responseActions) > 0 && !isExcludedEvent(evtFlags) {
+				policyActions = append(policyActions, ruleActions{
+					eventFlags: evtFlags,
+					actions:    responseActions,
+				})
+			}
+
 			for _, f := range r.Filters {
 				// event data or return value filter
 				// option "args." will be deprecate in future
@@ -98,6 +116,7 @@ func PrepareFilterMapsFromPolicies(policies []k8s.PolicyInterface, detectors []d
 			policyName: p.GetName(),
 			eventFlags: eventFlags,
 			detectors:  detectors,
+			actions:    policyActions,
 		}
 	}
 
@@ -137,9 +156,59 @@ func createSinglePolicy(policyIdx int, policyScope policyScopes, policyEvents po
 		return nil, err
 	}
 
+	if err := parseRuleActions(p, policyEvents.actions, policyEvents.detectors); err != nil {
+		return nil, err
+	}
+
 	return p, nil
 }
 
+// parseResponseActions parses a rule action list, returning only the response
+// actions (log and print are handled by the streams).
+func parseResponseActions(actionList []string) ([]actions.Action, error) {
+	parsed, err := actions.ParseList(actionList)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseActions []actions.Action
+	for _, action := range parsed {
+		if action.IsResponse() {
+			responseActions = append(responseActions, action)
+		}
+	}
+
+	return responseActions, nil
+}
+
+// isExcludedEvent returns true if the rule event is an exclusion (e.g. -openat)
+func isExcludedEvent(evtFlags []eventFlag) bool {
+	return len(evtFlags) == 1 && evtFlags[0].eventOptionType == "" && evtFlags[0].operator == "-"
+}
+
+// parseRuleActions attaches the response actions of each rule to the policy
+// rules of the events the rule selected (a rule might select several events,
+// e.g. through a tag or a threat pattern).
+func parseRuleActions(p *policy.Policy, policyActions []ruleActions, detectors []detection.EventDetector) error {
+	for _, ra := range policyActions {
+		selected := policy.NewPolicy()
+		if err := parseEventFilters(selected, ra.eventFlags, detectors); err != nil {
+			return err
+		}
+
+		for eventID := range selected.Rules {
+			rule, ok := p.Rules[eventID]
+			if !ok {
+				continue // excluded by another rule
+			}
+			rule.Actions = append(rule.Actions, ra.actions...)
+			p.Rules[eventID] = rule
+		}
+	}
+
+	return nil
+}
+
 func parseScopeFilters(p *policy.Policy, scopeFlags []scopeFlag) error {
 	for _, scopeFlag := range scopeFlags {
 		switch scopeFlag.scopeName {
@@ -239,7 +308,16 @@ func parseScopeFilters(p *policy.Policy, scopeFlags []scopeFlag) error {
 			p.Follow = true
 
 		default:
-			return InvalidScopeOptionError(scopeFlag.full)
+			// container selectors: k8s.namespace, k8s.label.<key> and container.image
+			if !filters.IsContainerSelector(scopeFlag.scopeName) {
+				return InvalidScopeOptionError(scopeFlag.full)
+			}
+			if strings.ContainsAny(scopeFlag.operator, "<>") {
+				return filters.InvalidExpression(scopeFlag.operatorAndValues)
+			}
+			if err := p.ContSelector.Parse(scopeFlag.scopeName, scopeFlag.operatorAndValues); err != nil {
+				return err
+			}
 		}
 	}
 	return nil
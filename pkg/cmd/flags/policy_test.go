@@ -2257,6 +2257,48 @@ func TestCreateSinglePolicy(t *testing.T) {
 	}
 }
 
+func TestPolicyRuleActions(t *testing.T) {
+	t.Parallel()
+
+	policyFile := v1beta1.PolicyFile{
+		Metadata: v1beta1.Metadata{
+			Name: "response",
+		},
+		Spec: k8s.PolicySpec{
+			Scope:          []string{"global"},
+			DefaultActions: []string{"log", "capture-exec"},
+			Rules: []k8s.Rule{
+				{Event: "write", Actions: []string{"print", "kill,rate=1/1m"}},
+				{Event: "read"},
+				{Event: "open*", Actions: []string{"log"}},
+				{Event: "-openat"},
+			},
+		},
+	}
+
+	scopeMap, eventsMap, err := PrepareFilterMapsFromPolicies([]k8s.PolicyInterface{policyFile}, nil)
+	require.NoError(t, err)
+
+	policies, err := CreatePolicies(scopeMap, eventsMap)
+	require.NoError(t, err)
+	require.Len(t, policies, 1)
+	p := policies[0]
+
+	require.Len(t, p.Rules[events.Write].Actions, 1)
+	assert.Equal(t, "kill,rate=1/1m", p.Rules[events.Write].Actions[0].String())
+
+	require.Len(t, p.Rules[events.Read].Actions, 1, "rule without actions uses the default actions")
+	assert.Equal(t, "capture-exec", p.Rules[events.Read].Actions[0].String())
+
+	assert.Empty(t, p.Rules[events.Open].Actions, "log is not a response action")
+	_, ok := p.Rules[events.Openat]
+	assert.False(t, ok)
+
+	policyFile.Spec.Rules[0].Actions = []string{"signal=SIGFOO"}
+	_, _, err = PrepareFilterMapsFromPolicies([]k8s.PolicyInterface{policyFile}, nil)
+	assert.ErrorContains(t, err, "invalid signal name")
+}
+
 func TestParseScopeFilters(t *testing.T) {
 	t.Parallel()
 
@@ -2332,6 +2374,48 @@ func TestParseScopeFilters(t *testing.T) {
 				assert.False(t, p.NewPidFilter.Value(), "NewPidFilter should be false to exclude new pids")
 			},
 		},
+		{
+			name:   "container selectors",
+			policy: policy.NewPolicy(),
+			scopeFlags: []scopeFlag{
+				{
+					full:              "k8s.namespace=prod",
+					scopeName:         "k8s.namespace",
+					operator:          "=",
+					operatorAndValues: "=prod",
+				},
+				{
+					full:              "k8s.label.app.kubernetes.io/name=web",
+					scopeName:         "k8s.label.app.kubernetes.io/name",
+					operator:          "=",
+					operatorAndValues: "=web",
+				},
+				{
+					full:              "container.image!=*-debug*",
+					scopeName:         "container.image",
+					operator:          "!=",
+					operatorAndValues: "!=*-debug*",
+				},
+			},
+			validate: func(t *testing.T, p *policy.Policy) {
+				assert.True(t, p.ContSelector.Enabled())
+				assert.True(t, p.ContainerFilterEnabled())
+				assert.True(t, p.ContSelector.Match("nginx", "prod", map[string]string{"app.kubernetes.io/name": "web"}))
+				assert.False(t, p.ContSelector.Match("nginx-debug", "prod", map[string]string{"app.kubernetes.io/name": "web"}))
+				assert.False(t, p.ContSelector.Match("nginx", "dev", map[string]string{"app.kubernetes.io/name": "web"}))
+			},
+		},
+		{
+			name:   "container selector with range operator",
+			policy: policy.NewPolicy(),
+			scopeFlags: []scopeFlag{{
+				full:              "k8s.namespace>prod",
+				scopeName:         "k8s.namespace",
+				operator:          ">",
+				operatorAndValues: ">prod",
+			}},
+			wantErr: filters.InvalidExpression(">prod"),
+		},
 		{
 			name:   "invalid scope filter",
 			policy: policy.NewPolicy(),
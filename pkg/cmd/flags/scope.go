@@ -21,6 +21,9 @@ Filter Types:
    - comm: Process command name
    - container: Container ID
    - executable: Full path to executable
+   - k8s.namespace: Kubernetes namespace of the container pod
+   - k8s.label.<key>: Kubernetes label of the container pod
+   - container.image: Container image
    Note: Multiple values can be comma-separated with '=' (OR) or '!=' (AND)
 
 3. Boolean Flags:
@@ -47,6 +50,9 @@ Examples:
     --scope container=ab355bc4dd554                   | trace specific container
     --scope container                                 | trace all containers
     --scope not-container                             | trace host only
+    --scope k8s.namespace=prod                        | trace containers of pods in namespace prod
+    --scope k8s.label.app=web                         | trace containers of pods labeled app=web
+    --scope 'container.image=*nginx*'                 | trace containers of nginx images
 
   Namespace Filters:
     --scope mntns=4026531839                          | trace specific mount namespace
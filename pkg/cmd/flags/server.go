@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/aquasecurity/tracee/common/errfmt"
+	"github.com/aquasecurity/tracee/pkg/otlp"
 	"github.com/aquasecurity/tracee/pkg/server/grpc"
 	"github.com/aquasecurity/tracee/pkg/server/http"
 )
@@ -17,6 +18,9 @@ const (
 
 	httpAddressFlag    = "http-address"
 	grpcAddressFlag    = "grpc-address"
+	grpcTLSCertFlag    = "grpc.tls.cert"
+	grpcTLSKeyFlag     = "grpc.tls.key"
+	grpcTLSCAFlag      = "grpc.tls.ca"
 	defaultHTTPAddress = ":3366"
 	defaultGRPCPort    = "4466"
 	defaultGRPCPath    = "/var/run/tracee.sock"
@@ -27,8 +31,13 @@ const (
 	pprofFlag     = "pprof"
 	pyroscopeFlag = "pyroscope"
 
+	// metrics export to an otlp collector
+	metricsOTLPFlag = "metrics-otlp"
+
 	invalidServerFlagError      = "invalid server flag: '%s', use 'trace man server' for more info"
 	invalidGRPCProtocolError    = "invalid grpc protocol: '%s', use 'trace man server' for more info"
+	invalidGRPCTLSError         = "invalid grpc tls configuration: %s, use 'trace man server' for more info"
+	invalidMetricsOTLPError     = "invalid metrics-otlp configuration: %s, use 'trace man server' for more info"
 	invalidHTTPAddressError     = "invalid http address: '%s', use 'trace man server' for more info"
 	invalidHTTPHostError        = "invalid http host: '%s', use 'trace man server' for more info"
 	invalidPortNumberError      = "invalid port number '%s', use 'trace man server' for more info"
@@ -37,15 +46,30 @@ const (
 
 // ServerConfig represents the server configuration
 type ServerConfig struct {
-	HttpAddress string `mapstructure:"http-address"`
-	GrpcAddress string `mapstructure:"grpc-address"`
-	Metrics     bool   `mapstructure:"metrics"`
-	Pprof       bool   `mapstructure:"pprof"`
-	Healthz     bool   `mapstructure:"healthz"`
-	Pyroscope   bool   `mapstructure:"pyroscope"`
-
-	http *http.Server
-	grpc *grpc.Server
+	HttpAddress string     `mapstructure:"http-address"`
+	GrpcAddress string     `mapstructure:"grpc-address"`
+	Metrics     bool       `mapstructure:"metrics"`
+	Pprof       bool       `mapstructure:"pprof"`
+	Healthz     bool       `mapstructure:"healthz"`
+	Pyroscope   bool       `mapstructure:"pyroscope"`
+	MetricsOTLP string     `mapstructure:"metrics-otlp"`
+	GRPC        GRPCConfig `mapstructure:"grpc"`
+
+	http            *http.Server
+	grpc            *grpc.Server
+	metricsExporter *otlp.MetricsExporter
+}
+
+// GRPCConfig represents the gRPC server options
+type GRPCConfig struct {
+	TLS GRPCTLSConfig `mapstructure:"tls"`
+}
+
+// GRPCTLSConfig represents the gRPC server TLS configuration
+type GRPCTLSConfig struct {
+	Cert string `mapstructure:"cert"`
+	Key  string `mapstructure:"key"`
+	CA   string `mapstructure:"ca"`
 }
 
 // GetHTTPServer returns the HTTP server
@@ -58,6 +82,11 @@ func (s *ServerConfig) GetGRPCServer() *grpc.Server {
 	return s.grpc
 }
 
+// GetMetricsExporter returns the exporter of the metrics to an otlp collector
+func (s *ServerConfig) GetMetricsExporter() *otlp.MetricsExporter {
+	return s.metricsExporter
+}
+
 // flags returns the server flags
 func (s *ServerConfig) flags() []string {
 	flags := make([]string, 0)
@@ -65,6 +94,15 @@ func (s *ServerConfig) flags() []string {
 	if s.GrpcAddress != "" {
 		flags = append(flags, fmt.Sprintf("%s=%s", grpcAddressFlag, s.GrpcAddress))
 	}
+	if s.GRPC.TLS.Cert != "" {
+		flags = append(flags, fmt.Sprintf("%s=%s", grpcTLSCertFlag, s.GRPC.TLS.Cert))
+	}
+	if s.GRPC.TLS.Key != "" {
+		flags = append(flags, fmt.Sprintf("%s=%s", grpcTLSKeyFlag, s.GRPC.TLS.Key))
+	}
+	if s.GRPC.TLS.CA != "" {
+		flags = append(flags, fmt.Sprintf("%s=%s", grpcTLSCAFlag, s.GRPC.TLS.CA))
+	}
 	if s.HttpAddress != "" {
 		flags = append(flags, fmt.Sprintf("%s=%s", httpAddressFlag, s.HttpAddress))
 	}
@@ -80,6 +118,9 @@ func (s *ServerConfig) flags() []string {
 	if s.Pyroscope {
 		flags = append(flags, pyroscopeFlag)
 	}
+	if s.MetricsOTLP != "" {
+		flags = append(flags, fmt.Sprintf("%s=%s", metricsOTLPFlag, s.MetricsOTLP))
+	}
 	return flags
 }
 
@@ -114,6 +155,12 @@ func PrepareServer(serverSlice []string) (ServerConfig, error) {
 				return server, err
 			}
 			server.grpc = grpc.New(protocol, address)
+		case grpcTLSCertFlag: // grpc.tls.cert=<file>
+			server.GRPC.TLS.Cert = values[1]
+		case grpcTLSKeyFlag: // grpc.tls.key=<file>
+			server.GRPC.TLS.Key = values[1]
+		case grpcTLSCAFlag: // grpc.tls.ca=<file>
+			server.GRPC.TLS.CA = values[1]
 		case metricsFlag:
 			server.Metrics = true
 		case healthzFlag:
@@ -122,11 +169,22 @@ func PrepareServer(serverSlice []string) (ServerConfig, error) {
 			server.Pprof = true
 		case pyroscopeFlag:
 			server.Pyroscope = true
+		case metricsOTLPFlag: // metrics-otlp=<url>
+			server.MetricsOTLP = values[1]
+			exporter, err := otlp.NewMetricsExporter(values[1])
+			if err != nil {
+				return server, errfmt.Errorf(invalidMetricsOTLPError, err)
+			}
+			server.metricsExporter = exporter
 		default:
 			return server, errfmt.Errorf(invalidServerFlagError, flagName)
 		}
 	}
 
+	if err := server.enableGRPCTLS(); err != nil {
+		return server, err
+	}
+
 	if err := server.enableHttpEndpoints(); err != nil {
 		return server, err
 	}
@@ -134,6 +192,31 @@ func PrepareServer(serverSlice []string) (ServerConfig, error) {
 	return server, nil
 }
 
+// enableGRPCTLS enables TLS on the gRPC server, if configured
+func (s *ServerConfig) enableGRPCTLS() error {
+	tlsConfig := grpc.TLSConfig{
+		CertFile: s.GRPC.TLS.Cert,
+		KeyFile:  s.GRPC.TLS.Key,
+		CAFile:   s.GRPC.TLS.CA,
+	}
+	if !tlsConfig.Enabled() {
+		return nil
+	}
+
+	if s.grpc == nil {
+		return errfmt.Errorf(invalidGRPCTLSError, "grpc tls requires a grpc-address")
+	}
+	if tlsConfig.CertFile == "" || tlsConfig.KeyFile == "" {
+		return errfmt.Errorf(invalidGRPCTLSError, fmt.Sprintf("both %s and %s are required", grpcTLSCertFlag, grpcTLSKeyFlag))
+	}
+
+	if err := s.grpc.EnableTLS(tlsConfig); err != nil {
+		return errfmt.Errorf(invalidGRPCTLSError, err)
+	}
+
+	return nil
+}
+
 // hasAnyHttpEndpointEnabled checks if any HTTP endpoint is enabled
 func (s *ServerConfig) hasAnyHttpEndpointEnabled() bool {
 	return s.Metrics || s.Healthz || s.Pprof || s.Pyroscope
@@ -256,6 +339,11 @@ func invalidGRPCProtocolErrorMsg(protocol string) string {
 	return fmt.Sprintf(invalidGRPCProtocolError, protocol)
 }
 
+// invalidGRPCTLSErrorMsg formats the error message for an invalid gRPC TLS configuration.
+func invalidGRPCTLSErrorMsg(reason string) string {
+	return fmt.Sprintf(invalidGRPCTLSError, reason)
+}
+
 // invalidHTTPAddressErrorMsg formats the error message for an invalid HTTP address.
 func invalidHTTPAddressErrorMsg(addr string) string {
 	return fmt.Sprintf(invalidHTTPAddressError, addr)
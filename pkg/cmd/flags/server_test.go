@@ -1,8 +1,18 @@
 package flags
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
 	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -358,6 +368,151 @@ func TestPrepareServer(t *testing.T) {
 	}
 }
 
+// writeTestCertificate writes a self-signed certificate and its key
+func writeTestCertificate(t *testing.T, dir string) (certFile, keyFile string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "tracee"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certFile = filepath.Join(dir, "tls.crt")
+	keyFile = filepath.Join(dir, "tls.key")
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0600))
+
+	return certFile, keyFile
+}
+
+func TestPrepareServer_GRPCTLS(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	certFile, keyFile := writeTestCertificate(t, dir)
+	missing := filepath.Join(dir, "missing.crt")
+
+	testCases := []struct {
+		testName      string
+		serverFlags   []string
+		expectedTLS   GRPCTLSConfig
+		expectedError string
+	}{
+		{
+			testName:    "tls",
+			serverFlags: []string{"grpc-address=tcp:4466", "grpc.tls.cert=" + certFile, "grpc.tls.key=" + keyFile},
+			expectedTLS: GRPCTLSConfig{Cert: certFile, Key: keyFile},
+		},
+		{
+			testName:    "mutual tls",
+			serverFlags: []string{"grpc-address=tcp:4466", "grpc.tls.cert=" + certFile, "grpc.tls.key=" + keyFile, "grpc.tls.ca=" + certFile},
+			expectedTLS: GRPCTLSConfig{Cert: certFile, Key: keyFile, CA: certFile},
+		},
+		{
+			testName:      "tls without grpc address",
+			serverFlags:   []string{"grpc.tls.cert=" + certFile, "grpc.tls.key=" + keyFile},
+			expectedError: invalidGRPCTLSErrorMsg("grpc tls requires a grpc-address"),
+		},
+		{
+			testName:      "tls without key",
+			serverFlags:   []string{"grpc-address=tcp:4466", "grpc.tls.cert=" + certFile},
+			expectedError: invalidGRPCTLSErrorMsg("both grpc.tls.cert and grpc.tls.key are required"),
+		},
+		{
+			testName:      "ca without certificate",
+			serverFlags:   []string{"grpc-address=tcp:4466", "grpc.tls.ca=" + certFile},
+			expectedError: invalidGRPCTLSErrorMsg("both grpc.tls.cert and grpc.tls.key are required"),
+		},
+		{
+			testName:      "missing certificate file",
+			serverFlags:   []string{"grpc-address=tcp:4466", "grpc.tls.cert=" + missing, "grpc.tls.key=" + keyFile},
+			expectedError: "no such file or directory",
+		},
+		{
+			testName:      "invalid tls flag",
+			serverFlags:   []string{"grpc-address=tcp:4466", "grpc.tls.cert"},
+			expectedError: invalidServerFlagErrorMsg("grpc.tls.cert"),
+		},
+	}
+
+	for _, testcase := range testCases {
+		t.Run(testcase.testName, func(t *testing.T) {
+			t.Parallel()
+
+			server, err := PrepareServer(testcase.serverFlags)
+			if testcase.expectedError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testcase.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testcase.expectedTLS, server.GRPC.TLS)
+			require.NotNil(t, server.GetGRPCServer())
+			assert.True(t, server.GetGRPCServer().TLSEnabled())
+		})
+	}
+}
+
+func TestPrepareServer_MetricsOTLP(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		testName      string
+		serverFlags   []string
+		expectedError string
+	}{
+		{
+			testName:    "grpc collector",
+			serverFlags: []string{"metrics-otlp=grpc://localhost:4317"},
+		},
+		{
+			testName:    "http collector with interval",
+			serverFlags: []string{"metrics-otlp=https://collector:4318/otlp?interval=10s&header=Authorization:Bearer%20token"},
+		},
+		{
+			testName:      "invalid protocol",
+			serverFlags:   []string{"metrics-otlp=tcp://localhost:4317"},
+			expectedError: "unsupported protocol",
+		},
+		{
+			testName:      "invalid interval",
+			serverFlags:   []string{"metrics-otlp=grpc://localhost:4317?interval=often"},
+			expectedError: "interval",
+		},
+		{
+			testName:      "missing url",
+			serverFlags:   []string{"metrics-otlp"},
+			expectedError: invalidServerFlagErrorMsg("metrics-otlp"),
+		},
+	}
+
+	for _, testcase := range testCases {
+		t.Run(testcase.testName, func(t *testing.T) {
+			t.Parallel()
+
+			server, err := PrepareServer(testcase.serverFlags)
+			if testcase.expectedError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testcase.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.NotNil(t, server.GetMetricsExporter())
+			// Exporting metrics doesn't need the http server
+			assert.Nil(t, server.GetHTTPServer())
+		})
+	}
+}
+
 func TestServerConfig_flags(t *testing.T) {
 	t.Parallel()
 
@@ -417,6 +572,34 @@ func TestServerConfig_flags(t *testing.T) {
 				"http-address=localhost:8080",
 			},
 		},
+		{
+			name: "grpc with tls",
+			config: ServerConfig{
+				GrpcAddress: "tcp:4466",
+				GRPC: GRPCConfig{
+					TLS: GRPCTLSConfig{
+						Cert: "/etc/tracee/tls.crt",
+						Key:  "/etc/tracee/tls.key",
+						CA:   "/etc/tracee/ca.crt",
+					},
+				},
+			},
+			expected: []string{
+				"grpc-address=tcp:4466",
+				"grpc.tls.cert=/etc/tracee/tls.crt",
+				"grpc.tls.key=/etc/tracee/tls.key",
+				"grpc.tls.ca=/etc/tracee/ca.crt",
+			},
+		},
+		{
+			name: "metrics over otlp",
+			config: ServerConfig{
+				MetricsOTLP: "grpc://localhost:4317?interval=10s",
+			},
+			expected: []string{
+				"metrics-otlp=grpc://localhost:4317?interval=10s",
+			},
+		},
 		{
 			name: "both http and grpc with options",
 			config: ServerConfig{
@@ -4,9 +4,11 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/aquasecurity/tracee/common/errfmt"
 	"github.com/aquasecurity/tracee/pkg/datastores/dns"
+	"github.com/aquasecurity/tracee/pkg/datastores/ipreputation"
 	"github.com/aquasecurity/tracee/pkg/datastores/process"
 )
 
@@ -20,6 +22,15 @@ const (
 	processMaxThreads = "process.max-threads"
 	processSource     = "process.source"
 
+	processSnapshot         = "process.snapshot"
+	processSnapshotInterval = "process.snapshot-interval"
+	processCmdLineMaxSize   = "process.cmdline-max-size"
+	processEnv              = "process.env"
+
+	ipReputationFlag           = "ip-reputation"
+	ipReputationFeed           = "ip-reputation.feed"
+	ipReputationReloadInterval = "ip-reputation.reload-interval"
+
 	processSourceEvents  = "events"
 	processSourceSignals = "signals"
 	processSourceBoth    = "both"
@@ -29,10 +40,14 @@ const (
 
 // ProcessConfig is the config for the process tree
 type ProcessConfig struct {
-	Enabled      bool   `mapstructure:"enabled"`
-	MaxProcesses int    `mapstructure:"max-processes"`
-	MaxThreads   int    `mapstructure:"max-threads"`
-	Source       string `mapstructure:"source"`
+	Enabled          bool     `mapstructure:"enabled"`
+	MaxProcesses     int      `mapstructure:"max-processes"`
+	MaxThreads       int      `mapstructure:"max-threads"`
+	Source           string   `mapstructure:"source"`
+	Snapshot         string   `mapstructure:"snapshot"`
+	SnapshotInterval string   `mapstructure:"snapshot-interval"`
+	CmdLineMaxSize   int      `mapstructure:"cmdline-max-size"`
+	Env              []string `mapstructure:"env"`
 }
 
 // DNSConfig is the config for the DNS cache
@@ -41,10 +56,18 @@ type DNSConfig struct {
 	MaxEntries int  `mapstructure:"max-entries"`
 }
 
+// IPReputationConfig is the config for the IP reputation store
+type IPReputationConfig struct {
+	Enabled        bool     `mapstructure:"enabled"`
+	Feeds          []string `mapstructure:"feeds"`
+	ReloadInterval string   `mapstructure:"reload-interval"`
+}
+
 // StoresConfig is the config for the stores
 type StoresConfig struct {
-	DNS     DNSConfig     `mapstructure:"dns"`
-	Process ProcessConfig `mapstructure:"process"`
+	DNS          DNSConfig          `mapstructure:"dns"`
+	Process      ProcessConfig      `mapstructure:"process"`
+	IPReputation IPReputationConfig `mapstructure:"ip-reputation"`
 }
 
 // flags returns the flags for the stores config
@@ -61,7 +84,9 @@ func (s *StoresConfig) flags() []string {
 
 	// Process: if Enabled is true OR any Process field is set, add process flag
 	// Note: Source is deprecated and ignored, so we don't include it in the output
-	if s.Process.Enabled || s.Process.MaxProcesses != 0 || s.Process.MaxThreads != 0 {
+	if s.Process.Enabled || s.Process.MaxProcesses != 0 || s.Process.MaxThreads != 0 ||
+		s.Process.Snapshot != "" || s.Process.SnapshotInterval != "" ||
+		s.Process.CmdLineMaxSize != 0 || len(s.Process.Env) > 0 {
 		flags = append(flags, processFlag)
 	}
 	if s.Process.MaxProcesses != 0 {
@@ -70,6 +95,29 @@ func (s *StoresConfig) flags() []string {
 	if s.Process.MaxThreads != 0 {
 		flags = append(flags, fmt.Sprintf("%s=%d", processMaxThreads, s.Process.MaxThreads))
 	}
+	if s.Process.Snapshot != "" {
+		flags = append(flags, fmt.Sprintf("%s=%s", processSnapshot, s.Process.Snapshot))
+	}
+	if s.Process.SnapshotInterval != "" {
+		flags = append(flags, fmt.Sprintf("%s=%s", processSnapshotInterval, s.Process.SnapshotInterval))
+	}
+	if s.Process.CmdLineMaxSize != 0 {
+		flags = append(flags, fmt.Sprintf("%s=%d", processCmdLineMaxSize, s.Process.CmdLineMaxSize))
+	}
+	for _, env := range s.Process.Env {
+		flags = append(flags, fmt.Sprintf("%s=%s", processEnv, env))
+	}
+
+	// IP reputation: if Enabled is true OR any IP reputation field is set, add ip-reputation flag
+	if s.IPReputation.Enabled || len(s.IPReputation.Feeds) > 0 || s.IPReputation.ReloadInterval != "" {
+		flags = append(flags, ipReputationFlag)
+	}
+	for _, feed := range s.IPReputation.Feeds {
+		flags = append(flags, fmt.Sprintf("%s=%s", ipReputationFeed, feed))
+	}
+	if s.IPReputation.ReloadInterval != "" {
+		flags = append(flags, fmt.Sprintf("%s=%s", ipReputationReloadInterval, s.IPReputation.ReloadInterval))
+	}
 
 	return flags
 }
@@ -96,11 +144,21 @@ func (s *StoresConfig) GetProcessStoreConfig() process.ProcTreeConfig {
 		source = process.SourceBoth
 	}
 
+	// the snapshot interval is validated by PrepareStores
+	snapshotInterval := process.DefaultSnapshotInterval
+	if s.Process.SnapshotInterval != "" {
+		snapshotInterval, _ = time.ParseDuration(s.Process.SnapshotInterval)
+	}
+
 	return process.ProcTreeConfig{
 		Enabled:                  s.Process.Enabled,
 		Source:                   source,
 		ProcessCacheSize:         s.Process.MaxProcesses,
 		ThreadCacheSize:          s.Process.MaxThreads,
+		SnapshotPath:             s.Process.Snapshot,
+		SnapshotInterval:         snapshotInterval,
+		CmdLineMaxSize:           s.Process.CmdLineMaxSize,
+		EnvAllowlist:             s.Process.Env,
 		SkipProcfsInitForTesting: false,
 	}
 }
@@ -113,6 +171,18 @@ func (s *StoresConfig) GetDNSStoreConfig() dns.Config {
 	}
 }
 
+// GetIPReputationStoreConfig returns the IP reputation store config
+func (s *StoresConfig) GetIPReputationStoreConfig() ipreputation.Config {
+	// the reload interval is validated by PrepareStores
+	reloadInterval, _ := time.ParseDuration(s.IPReputation.ReloadInterval)
+
+	return ipreputation.Config{
+		Enable:         s.IPReputation.Enabled,
+		Feeds:          s.IPReputation.Feeds,
+		ReloadInterval: reloadInterval,
+	}
+}
+
 // PrepareStores prepares the stores config from the command line flags
 // and returns the stores config and an error if the flags are invalid
 func PrepareStores(storeSlice []string) (StoresConfig, error) {
@@ -122,10 +192,11 @@ func PrepareStores(storeSlice []string) (StoresConfig, error) {
 			MaxEntries: dns.DefaultCacheSize,
 		},
 		Process: ProcessConfig{
-			Enabled:      true, // Enabled by default for better UX and complete detector outputs
-			MaxProcesses: process.DefaultProcessCacheSize,
-			MaxThreads:   process.DefaultThreadCacheSize,
-			Source:       "", // Deprecated field, kept only for backward compatibility with old configs
+			Enabled:        true, // Enabled by default for better UX and complete detector outputs
+			MaxProcesses:   process.DefaultProcessCacheSize,
+			MaxThreads:     process.DefaultThreadCacheSize,
+			CmdLineMaxSize: process.DefaultCmdLineMaxSize,
+			Source:         "", // Deprecated field, kept only for backward compatibility with old configs
 		},
 	}
 
@@ -178,6 +249,48 @@ func PrepareStores(storeSlice []string) (StoresConfig, error) {
 			}
 			config.Process.Source = values[1]
 			config.Process.Enabled = true // Setting source enables process
+		case processSnapshot:
+			if values[1] == "" {
+				return config, errfmt.Errorf(storesInvalidFlag, flag)
+			}
+			config.Process.Snapshot = values[1]
+			config.Process.Enabled = true // Setting snapshot enables process
+		case processSnapshotInterval:
+			interval, err := time.ParseDuration(values[1])
+			if err != nil || interval <= 0 {
+				return config, errfmt.Errorf(storesInvalidFlag, flag)
+			}
+			config.Process.SnapshotInterval = values[1]
+			config.Process.Enabled = true // Setting snapshot-interval enables process
+		case processCmdLineMaxSize:
+			size, err := parseSize(values[1], flag)
+			if err != nil {
+				return config, err
+			}
+			config.Process.CmdLineMaxSize = size
+			config.Process.Enabled = true // Setting cmdline-max-size enables process
+		case processEnv:
+			name := strings.TrimSuffix(values[1], "*")
+			if name == "" || strings.ContainsAny(name, "=*") {
+				return config, errfmt.Errorf(storesInvalidFlag, flag)
+			}
+			config.Process.Env = append(config.Process.Env, values[1])
+			config.Process.Enabled = true // Setting env enables process
+		case ipReputationFlag:
+			config.IPReputation.Enabled = true
+		case ipReputationFeed:
+			if values[1] == "" {
+				return config, errfmt.Errorf(storesInvalidFlag, flag)
+			}
+			config.IPReputation.Feeds = append(config.IPReputation.Feeds, values[1])
+			config.IPReputation.Enabled = true // Setting a feed enables ip-reputation
+		case ipReputationReloadInterval:
+			interval, err := time.ParseDuration(values[1])
+			if err != nil || interval <= 0 {
+				return config, errfmt.Errorf(storesInvalidFlag, flag)
+			}
+			config.IPReputation.ReloadInterval = values[1]
+			config.IPReputation.Enabled = true // Setting reload-interval enables ip-reputation
 		default:
 			return config, errfmt.Errorf(storesInvalidFlag, flag)
 		}
@@ -197,7 +310,7 @@ func parseSize(value, flag string) (int, error) {
 
 // isStoresBoolFlag checks if a flag is a boolean flag for the stores config
 func isStoresBoolFlag(flagName string) bool {
-	return flagName == dnsFlag || flagName == processFlag
+	return flagName == dnsFlag || flagName == processFlag || flagName == ipReputationFlag
 }
 
 // invalidStoresFlagError formats the error message for an invalid stores flag.
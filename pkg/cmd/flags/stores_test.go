@@ -2,11 +2,13 @@ package flags
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/aquasecurity/tracee/pkg/datastores/dns"
+	"github.com/aquasecurity/tracee/pkg/datastores/ipreputation"
 	"github.com/aquasecurity/tracee/pkg/datastores/process"
 )
 
@@ -29,10 +31,11 @@ func TestPrepareStores(t *testing.T) {
 					MaxEntries: dns.DefaultCacheSize,
 				},
 				Process: ProcessConfig{
-					Enabled:      true, // Enabled by default
-					MaxProcesses: process.DefaultProcessCacheSize,
-					MaxThreads:   process.DefaultThreadCacheSize,
-					Source:       "",
+					Enabled:        true, // Enabled by default
+					MaxProcesses:   process.DefaultProcessCacheSize,
+					MaxThreads:     process.DefaultThreadCacheSize,
+					CmdLineMaxSize: process.DefaultCmdLineMaxSize,
+					Source:         "",
 				},
 			},
 		},
@@ -46,10 +49,11 @@ func TestPrepareStores(t *testing.T) {
 					MaxEntries: dns.DefaultCacheSize,
 				},
 				Process: ProcessConfig{
-					Enabled:      true, // Enabled by default
-					MaxProcesses: process.DefaultProcessCacheSize,
-					MaxThreads:   process.DefaultThreadCacheSize,
-					Source:       "",
+					Enabled:        true, // Enabled by default
+					MaxProcesses:   process.DefaultProcessCacheSize,
+					MaxThreads:     process.DefaultThreadCacheSize,
+					CmdLineMaxSize: process.DefaultCmdLineMaxSize,
+					Source:         "",
 				},
 			},
 		},
@@ -62,10 +66,11 @@ func TestPrepareStores(t *testing.T) {
 					MaxEntries: 2048,
 				},
 				Process: ProcessConfig{
-					Enabled:      true, // Enabled by default
-					MaxProcesses: process.DefaultProcessCacheSize,
-					MaxThreads:   process.DefaultThreadCacheSize,
-					Source:       "",
+					Enabled:        true, // Enabled by default
+					MaxProcesses:   process.DefaultProcessCacheSize,
+					MaxThreads:     process.DefaultThreadCacheSize,
+					CmdLineMaxSize: process.DefaultCmdLineMaxSize,
+					Source:         "",
 				},
 			},
 		},
@@ -79,10 +84,11 @@ func TestPrepareStores(t *testing.T) {
 					MaxEntries: dns.DefaultCacheSize,
 				},
 				Process: ProcessConfig{
-					Enabled:      true,
-					MaxProcesses: process.DefaultProcessCacheSize,
-					MaxThreads:   process.DefaultThreadCacheSize,
-					Source:       "",
+					Enabled:        true,
+					MaxProcesses:   process.DefaultProcessCacheSize,
+					MaxThreads:     process.DefaultThreadCacheSize,
+					CmdLineMaxSize: process.DefaultCmdLineMaxSize,
+					Source:         "",
 				},
 			},
 		},
@@ -95,10 +101,11 @@ func TestPrepareStores(t *testing.T) {
 					MaxEntries: dns.DefaultCacheSize,
 				},
 				Process: ProcessConfig{
-					Enabled:      true, // Setting max-processes enables process
-					MaxProcesses: 100,
-					MaxThreads:   process.DefaultThreadCacheSize,
-					Source:       "",
+					Enabled:        true, // Setting max-processes enables process
+					MaxProcesses:   100,
+					MaxThreads:     process.DefaultThreadCacheSize,
+					CmdLineMaxSize: process.DefaultCmdLineMaxSize,
+					Source:         "",
 				},
 			},
 		},
@@ -111,10 +118,11 @@ func TestPrepareStores(t *testing.T) {
 					MaxEntries: dns.DefaultCacheSize,
 				},
 				Process: ProcessConfig{
-					Enabled:      true, // Setting max-threads enables process
-					MaxProcesses: process.DefaultProcessCacheSize,
-					MaxThreads:   50,
-					Source:       "",
+					Enabled:        true, // Setting max-threads enables process
+					MaxProcesses:   process.DefaultProcessCacheSize,
+					MaxThreads:     50,
+					CmdLineMaxSize: process.DefaultCmdLineMaxSize,
+					Source:         "",
 				},
 			},
 		},
@@ -132,10 +140,11 @@ func TestPrepareStores(t *testing.T) {
 					MaxEntries: dns.DefaultCacheSize,
 				},
 				Process: ProcessConfig{
-					Enabled:      true, // Setting source enables process
-					MaxProcesses: process.DefaultProcessCacheSize,
-					MaxThreads:   process.DefaultThreadCacheSize,
-					Source:       "events",
+					Enabled:        true, // Setting source enables process
+					MaxProcesses:   process.DefaultProcessCacheSize,
+					MaxThreads:     process.DefaultThreadCacheSize,
+					CmdLineMaxSize: process.DefaultCmdLineMaxSize,
+					Source:         "events",
 				},
 			},
 		},
@@ -148,10 +157,11 @@ func TestPrepareStores(t *testing.T) {
 					MaxEntries: dns.DefaultCacheSize,
 				},
 				Process: ProcessConfig{
-					Enabled:      true, // Setting source enables process
-					MaxProcesses: process.DefaultProcessCacheSize,
-					MaxThreads:   process.DefaultThreadCacheSize,
-					Source:       "both",
+					Enabled:        true, // Setting source enables process
+					MaxProcesses:   process.DefaultProcessCacheSize,
+					MaxThreads:     process.DefaultThreadCacheSize,
+					CmdLineMaxSize: process.DefaultCmdLineMaxSize,
+					Source:         "both",
 				},
 			},
 		},
@@ -165,10 +175,11 @@ func TestPrepareStores(t *testing.T) {
 					MaxEntries: 4096,
 				},
 				Process: ProcessConfig{
-					Enabled:      true, // Enabled by default
-					MaxProcesses: process.DefaultProcessCacheSize,
-					MaxThreads:   process.DefaultThreadCacheSize,
-					Source:       "",
+					Enabled:        true, // Enabled by default
+					MaxProcesses:   process.DefaultProcessCacheSize,
+					MaxThreads:     process.DefaultThreadCacheSize,
+					CmdLineMaxSize: process.DefaultCmdLineMaxSize,
+					Source:         "",
 				},
 			},
 		},
@@ -181,10 +192,11 @@ func TestPrepareStores(t *testing.T) {
 					MaxEntries: dns.DefaultCacheSize,
 				},
 				Process: ProcessConfig{
-					Enabled:      true,
-					MaxProcesses: 200,
-					MaxThreads:   100,
-					Source:       "",
+					Enabled:        true,
+					MaxProcesses:   200,
+					MaxThreads:     100,
+					CmdLineMaxSize: process.DefaultCmdLineMaxSize,
+					Source:         "",
 				},
 			},
 		},
@@ -197,10 +209,11 @@ func TestPrepareStores(t *testing.T) {
 					MaxEntries: 2048,
 				},
 				Process: ProcessConfig{
-					Enabled:      true,
-					MaxProcesses: 150,
-					MaxThreads:   75,
-					Source:       "both",
+					Enabled:        true,
+					MaxProcesses:   150,
+					MaxThreads:     75,
+					CmdLineMaxSize: process.DefaultCmdLineMaxSize,
+					Source:         "both",
 				},
 			},
 		},
@@ -213,10 +226,11 @@ func TestPrepareStores(t *testing.T) {
 					MaxEntries: 512,
 				},
 				Process: ProcessConfig{
-					Enabled:      true, // Setting any process field enables process
-					MaxProcesses: process.DefaultProcessCacheSize,
-					MaxThreads:   25,
-					Source:       "events",
+					Enabled:        true, // Setting any process field enables process
+					MaxProcesses:   process.DefaultProcessCacheSize,
+					MaxThreads:     25,
+					CmdLineMaxSize: process.DefaultCmdLineMaxSize,
+					Source:         "events",
 				},
 			},
 		},
@@ -230,10 +244,11 @@ func TestPrepareStores(t *testing.T) {
 					MaxEntries: dns.DefaultCacheSize,
 				},
 				Process: ProcessConfig{
-					Enabled:      true, // Enabled by default
-					MaxProcesses: process.DefaultProcessCacheSize,
-					MaxThreads:   process.DefaultThreadCacheSize,
-					Source:       "",
+					Enabled:        true, // Enabled by default
+					MaxProcesses:   process.DefaultProcessCacheSize,
+					MaxThreads:     process.DefaultThreadCacheSize,
+					CmdLineMaxSize: process.DefaultCmdLineMaxSize,
+					Source:         "",
 				},
 			},
 			expectedError: invalidStoresFlagError("dnstrue"),
@@ -247,10 +262,11 @@ func TestPrepareStores(t *testing.T) {
 					MaxEntries: dns.DefaultCacheSize,
 				},
 				Process: ProcessConfig{
-					Enabled:      true, // Enabled by default
-					MaxProcesses: process.DefaultProcessCacheSize,
-					MaxThreads:   process.DefaultThreadCacheSize,
-					Source:       "",
+					Enabled:        true, // Enabled by default
+					MaxProcesses:   process.DefaultProcessCacheSize,
+					MaxThreads:     process.DefaultThreadCacheSize,
+					CmdLineMaxSize: process.DefaultCmdLineMaxSize,
+					Source:         "",
 				},
 			},
 			expectedError: invalidStoresFlagError("dns.max-entries"),
@@ -264,10 +280,11 @@ func TestPrepareStores(t *testing.T) {
 					MaxEntries: dns.DefaultCacheSize,
 				},
 				Process: ProcessConfig{
-					Enabled:      true, // Enabled by default
-					MaxProcesses: process.DefaultProcessCacheSize,
-					MaxThreads:   process.DefaultThreadCacheSize,
-					Source:       "",
+					Enabled:        true, // Enabled by default
+					MaxProcesses:   process.DefaultProcessCacheSize,
+					MaxThreads:     process.DefaultThreadCacheSize,
+					CmdLineMaxSize: process.DefaultCmdLineMaxSize,
+					Source:         "",
 				},
 			},
 			expectedError: invalidStoresFlagError("dns.max-entries="),
@@ -282,10 +299,11 @@ func TestPrepareStores(t *testing.T) {
 					MaxEntries: dns.DefaultCacheSize,
 				},
 				Process: ProcessConfig{
-					Enabled:      true, // Enabled by default
-					MaxProcesses: process.DefaultProcessCacheSize,
-					MaxThreads:   process.DefaultThreadCacheSize,
-					Source:       "",
+					Enabled:        true, // Enabled by default
+					MaxProcesses:   process.DefaultProcessCacheSize,
+					MaxThreads:     process.DefaultThreadCacheSize,
+					CmdLineMaxSize: process.DefaultCmdLineMaxSize,
+					Source:         "",
 				},
 			},
 			expectedError: invalidStoresFlagError("invalid-flag=true"),
@@ -299,10 +317,11 @@ func TestPrepareStores(t *testing.T) {
 					MaxEntries: dns.DefaultCacheSize,
 				},
 				Process: ProcessConfig{
-					Enabled:      true, // Enabled by default
-					MaxProcesses: process.DefaultProcessCacheSize,
-					MaxThreads:   process.DefaultThreadCacheSize,
-					Source:       "",
+					Enabled:        true, // Enabled by default
+					MaxProcesses:   process.DefaultProcessCacheSize,
+					MaxThreads:     process.DefaultThreadCacheSize,
+					CmdLineMaxSize: process.DefaultCmdLineMaxSize,
+					Source:         "",
 				},
 			},
 			expectedError: invalidStoresFlagError("dns.enable=true"),
@@ -317,10 +336,11 @@ func TestPrepareStores(t *testing.T) {
 					MaxEntries: dns.DefaultCacheSize,
 				},
 				Process: ProcessConfig{
-					Enabled:      true, // Enabled by default
-					MaxProcesses: process.DefaultProcessCacheSize,
-					MaxThreads:   process.DefaultThreadCacheSize,
-					Source:       "",
+					Enabled:        true, // Enabled by default
+					MaxProcesses:   process.DefaultProcessCacheSize,
+					MaxThreads:     process.DefaultThreadCacheSize,
+					CmdLineMaxSize: process.DefaultCmdLineMaxSize,
+					Source:         "",
 				},
 			},
 			expectedError: invalidStoresFlagError("dns.max-entries=invalid"),
@@ -334,10 +354,11 @@ func TestPrepareStores(t *testing.T) {
 					MaxEntries: dns.DefaultCacheSize,
 				},
 				Process: ProcessConfig{
-					Enabled:      true, // Enabled by default
-					MaxProcesses: process.DefaultProcessCacheSize,
-					MaxThreads:   process.DefaultThreadCacheSize,
-					Source:       "",
+					Enabled:        true, // Enabled by default
+					MaxProcesses:   process.DefaultProcessCacheSize,
+					MaxThreads:     process.DefaultThreadCacheSize,
+					CmdLineMaxSize: process.DefaultCmdLineMaxSize,
+					Source:         "",
 				},
 			},
 			expectedError: invalidStoresFlagError("dns.max-entries=-100"),
@@ -352,10 +373,11 @@ func TestPrepareStores(t *testing.T) {
 					MaxEntries: dns.DefaultCacheSize,
 				},
 				Process: ProcessConfig{
-					Enabled:      true, // Enabled by default
-					MaxProcesses: process.DefaultProcessCacheSize,
-					MaxThreads:   process.DefaultThreadCacheSize,
-					Source:       "",
+					Enabled:        true, // Enabled by default
+					MaxProcesses:   process.DefaultProcessCacheSize,
+					MaxThreads:     process.DefaultThreadCacheSize,
+					CmdLineMaxSize: process.DefaultCmdLineMaxSize,
+					Source:         "",
 				},
 			},
 			expectedError: invalidStoresFlagError("process.max-processes=invalid"),
@@ -369,10 +391,11 @@ func TestPrepareStores(t *testing.T) {
 					MaxEntries: dns.DefaultCacheSize,
 				},
 				Process: ProcessConfig{
-					Enabled:      true, // Enabled by default
-					MaxProcesses: process.DefaultProcessCacheSize,
-					MaxThreads:   process.DefaultThreadCacheSize,
-					Source:       "",
+					Enabled:        true, // Enabled by default
+					MaxProcesses:   process.DefaultProcessCacheSize,
+					MaxThreads:     process.DefaultThreadCacheSize,
+					CmdLineMaxSize: process.DefaultCmdLineMaxSize,
+					Source:         "",
 				},
 			},
 			expectedError: invalidStoresFlagError("process.max-threads=invalid"),
@@ -387,10 +410,11 @@ func TestPrepareStores(t *testing.T) {
 					MaxEntries: dns.DefaultCacheSize,
 				},
 				Process: ProcessConfig{
-					Enabled:      true, // Enabled by default
-					MaxProcesses: process.DefaultProcessCacheSize,
-					MaxThreads:   process.DefaultThreadCacheSize,
-					Source:       "",
+					Enabled:        true, // Enabled by default
+					MaxProcesses:   process.DefaultProcessCacheSize,
+					MaxThreads:     process.DefaultThreadCacheSize,
+					CmdLineMaxSize: process.DefaultCmdLineMaxSize,
+					Source:         "",
 				},
 			},
 			expectedError: invalidStoresFlagError("dns.max-entries=0"),
@@ -404,10 +428,11 @@ func TestPrepareStores(t *testing.T) {
 					MaxEntries: 999999,
 				},
 				Process: ProcessConfig{
-					Enabled:      true, // Setting max-processes or max-threads enables process
-					MaxProcesses: 999999,
-					MaxThreads:   999999,
-					Source:       "",
+					Enabled:        true, // Setting max-processes or max-threads enables process
+					MaxProcesses:   999999,
+					MaxThreads:     999999,
+					CmdLineMaxSize: process.DefaultCmdLineMaxSize,
+					Source:         "",
 				},
 			},
 		},
@@ -421,14 +446,152 @@ func TestPrepareStores(t *testing.T) {
 					MaxEntries: dns.DefaultCacheSize,
 				},
 				Process: ProcessConfig{
-					Enabled:      true, // Enabled by default
-					MaxProcesses: process.DefaultProcessCacheSize,
-					MaxThreads:   process.DefaultThreadCacheSize,
-					Source:       "",
+					Enabled:        true, // Enabled by default
+					MaxProcesses:   process.DefaultProcessCacheSize,
+					MaxThreads:     process.DefaultThreadCacheSize,
+					CmdLineMaxSize: process.DefaultCmdLineMaxSize,
+					Source:         "",
 				},
 			},
 			expectedError: invalidStoresFlagError("invalid-flag=value"),
 		},
+		// process snapshot flags
+		{
+			testName: "valid process.snapshot",
+			flags:    []string{"process.snapshot=/var/lib/tracee/proctree.json", "process.snapshot-interval=1m"},
+			expectedReturn: StoresConfig{
+				DNS: DNSConfig{
+					Enabled:    false,
+					MaxEntries: dns.DefaultCacheSize,
+				},
+				Process: ProcessConfig{
+					Enabled:          true,
+					MaxProcesses:     process.DefaultProcessCacheSize,
+					MaxThreads:       process.DefaultThreadCacheSize,
+					CmdLineMaxSize:   process.DefaultCmdLineMaxSize,
+					Source:           "",
+					Snapshot:         "/var/lib/tracee/proctree.json",
+					SnapshotInterval: "1m",
+				},
+			},
+		},
+		{
+			testName:      "invalid process.snapshot empty value",
+			flags:         []string{"process.snapshot="},
+			expectedError: invalidStoresFlagError("process.snapshot="),
+		},
+		{
+			testName:      "invalid process.snapshot-interval",
+			flags:         []string{"process.snapshot-interval=often"},
+			expectedError: invalidStoresFlagError("process.snapshot-interval=often"),
+		},
+		{
+			testName:      "invalid process.snapshot-interval zero",
+			flags:         []string{"process.snapshot-interval=0s"},
+			expectedError: invalidStoresFlagError("process.snapshot-interval=0s"),
+		},
+		// process command line flags
+		{
+			testName: "valid process.cmdline-max-size and process.env",
+			flags:    []string{"process.cmdline-max-size=4096", "process.env=LD_PRELOAD", "process.env=KUBERNETES_*"},
+			expectedReturn: StoresConfig{
+				DNS: DNSConfig{
+					Enabled:    false,
+					MaxEntries: dns.DefaultCacheSize,
+				},
+				Process: ProcessConfig{
+					Enabled:        true,
+					MaxProcesses:   process.DefaultProcessCacheSize,
+					MaxThreads:     process.DefaultThreadCacheSize,
+					CmdLineMaxSize: 4096,
+					Source:         "",
+					Env:            []string{"LD_PRELOAD", "KUBERNETES_*"},
+				},
+			},
+		},
+		{
+			testName:      "invalid process.cmdline-max-size zero",
+			flags:         []string{"process.cmdline-max-size=0"},
+			expectedError: invalidStoresFlagError("process.cmdline-max-size=0"),
+		},
+		{
+			testName:      "invalid process.env empty value",
+			flags:         []string{"process.env="},
+			expectedError: invalidStoresFlagError("process.env="),
+		},
+		{
+			testName:      "invalid process.env with value",
+			flags:         []string{"process.env=PATH=/bin"},
+			expectedError: invalidStoresFlagError("process.env=PATH=/bin"),
+		},
+		{
+			testName:      "invalid process.env all variables",
+			flags:         []string{"process.env=*"},
+			expectedError: invalidStoresFlagError("process.env=*"),
+		},
+		// ip reputation flags
+		{
+			testName: "valid ip-reputation with feeds",
+			flags:    []string{"ip-reputation.feed=/etc/tracee/blocklist.txt", "ip-reputation.feed=/etc/tracee/feed.csv", "ip-reputation.reload-interval=30s"},
+			expectedReturn: StoresConfig{
+				DNS: DNSConfig{
+					Enabled:    false,
+					MaxEntries: dns.DefaultCacheSize,
+				},
+				Process: ProcessConfig{
+					Enabled:        true, // Enabled by default
+					MaxProcesses:   process.DefaultProcessCacheSize,
+					MaxThreads:     process.DefaultThreadCacheSize,
+					CmdLineMaxSize: process.DefaultCmdLineMaxSize,
+					Source:         "",
+				},
+				IPReputation: IPReputationConfig{
+					Enabled:        true, // Setting a feed enables ip-reputation
+					Feeds:          []string{"/etc/tracee/blocklist.txt", "/etc/tracee/feed.csv"},
+					ReloadInterval: "30s",
+				},
+			},
+		},
+		{
+			testName: "valid ip-reputation",
+			flags:    []string{"ip-reputation"},
+			expectedReturn: StoresConfig{
+				DNS: DNSConfig{
+					Enabled:    false,
+					MaxEntries: dns.DefaultCacheSize,
+				},
+				Process: ProcessConfig{
+					Enabled:        true, // Enabled by default
+					MaxProcesses:   process.DefaultProcessCacheSize,
+					MaxThreads:     process.DefaultThreadCacheSize,
+					CmdLineMaxSize: process.DefaultCmdLineMaxSize,
+					Source:         "",
+				},
+				IPReputation: IPReputationConfig{
+					Enabled: true,
+				},
+			},
+		},
+		{
+			testName:      "invalid ip-reputation with value",
+			flags:         []string{"ip-reputation=true"},
+			expectedError: invalidStoresFlagError("ip-reputation=true"),
+		},
+		{
+			testName:      "invalid ip-reputation.feed empty value",
+			flags:         []string{"ip-reputation.feed="},
+			expectedError: invalidStoresFlagError("ip-reputation.feed="),
+		},
+		{
+			testName:      "invalid ip-reputation.reload-interval",
+			flags:         []string{"ip-reputation.reload-interval=often"},
+			expectedError: invalidStoresFlagError("ip-reputation.reload-interval=often"),
+		},
+		{
+			testName:      "invalid ip-reputation.reload-interval zero",
+			flags:         []string{"ip-reputation.reload-interval=0s"},
+			expectedError: invalidStoresFlagError("ip-reputation.reload-interval=0s"),
+		},
 	}
 
 	for _, tc := range testCases {
@@ -449,3 +612,49 @@ func TestPrepareStores(t *testing.T) {
 		})
 	}
 }
+
+func TestGetIPReputationStoreConfig(t *testing.T) {
+	t.Parallel()
+
+	stores, err := PrepareStores([]string{"ip-reputation.feed=/etc/tracee/blocklist.txt", "ip-reputation.reload-interval=5m"})
+	require.NoError(t, err)
+	assert.Equal(t, ipreputation.Config{
+		Enable:         true,
+		Feeds:          []string{"/etc/tracee/blocklist.txt"},
+		ReloadInterval: 5 * time.Minute,
+	}, stores.GetIPReputationStoreConfig())
+
+	stores, err = PrepareStores([]string{})
+	require.NoError(t, err)
+	assert.Equal(t, ipreputation.Config{}, stores.GetIPReputationStoreConfig())
+}
+
+func TestGetProcessStoreConfig(t *testing.T) {
+	t.Parallel()
+
+	// Snapshots are written at the default interval unless given
+	stores, err := PrepareStores([]string{"process.snapshot=/var/lib/tracee/proctree.json"})
+	require.NoError(t, err)
+	config := stores.GetProcessStoreConfig()
+	assert.Equal(t, "/var/lib/tracee/proctree.json", config.SnapshotPath)
+	assert.Equal(t, process.DefaultSnapshotInterval, config.SnapshotInterval)
+
+	stores, err = PrepareStores([]string{"process.snapshot=/var/lib/tracee/proctree.json", "process.snapshot-interval=30s"})
+	require.NoError(t, err)
+	assert.Equal(t, 30*time.Second, stores.GetProcessStoreConfig().SnapshotInterval)
+
+	stores, err = PrepareStores([]string{})
+	require.NoError(t, err)
+	assert.Empty(t, stores.GetProcessStoreConfig().SnapshotPath)
+
+	// Command lines are kept by default, environment variables only if allowed
+	config = stores.GetProcessStoreConfig()
+	assert.Equal(t, process.DefaultCmdLineMaxSize, config.CmdLineMaxSize)
+	assert.Empty(t, config.EnvAllowlist)
+
+	stores, err = PrepareStores([]string{"process.cmdline-max-size=64", "process.env=LD_*"})
+	require.NoError(t, err)
+	config = stores.GetProcessStoreConfig()
+	assert.Equal(t, 64, config.CmdLineMaxSize)
+	assert.Equal(t, []string{"LD_*"}, config.EnvAllowlist)
+}
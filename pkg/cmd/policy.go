@@ -0,0 +1,40 @@
+package cmd
+
+import (
+	"errors"
+
+	tracee "github.com/aquasecurity/tracee/pkg/ebpf"
+	"github.com/aquasecurity/tracee/pkg/k8s/apis/tracee.aquasec.com/v1beta1"
+	"github.com/aquasecurity/tracee/pkg/policy"
+	"github.com/aquasecurity/tracee/pkg/server/grpc"
+)
+
+// k8sPolicyApplier applies the changes of the kubernetes policies to tracee
+type k8sPolicyApplier struct {
+	tracee *tracee.Tracee
+	build  grpc.PolicyBuilder
+}
+
+func (a k8sPolicyApplier) ApplyPolicy(p v1beta1.PolicyInterface) error {
+	built, err := a.build(p)
+	if err != nil {
+		return err
+	}
+
+	err = a.tracee.UpdatePolicy(built)
+	if errors.Is(err, policy.ErrPolicyNotFound) {
+		return a.tracee.CreatePolicy(built)
+	}
+
+	return err
+}
+
+func (a k8sPolicyApplier) DeletePolicy(name string) error {
+	err := a.tracee.DeletePolicy(name)
+	if errors.Is(err, policy.ErrPolicyNotFound) {
+		// the policy was refused when created
+		return nil
+	}
+
+	return err
+}
@@ -0,0 +1,265 @@
+package printer
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/aquasecurity/tracee/common/errfmt"
+)
+
+const diskQueueFileSuffix = ".batch"
+
+var errDiskQueueFull = errors.New("disk queue is full")
+
+// diskQueue is a bounded FIFO of event batches stored as files in a directory, one file
+// per batch, so batches survive an outage of the destination and a restart of tracee.
+// Files are named after the batch sequence number, giving their order.
+type diskQueue struct {
+	mutex    sync.Mutex
+	dir      string
+	maxBytes int64
+	bytes    int64
+	events   int
+	// sequence numbers of the queued batches, oldest first
+	seqs  []int64
+	sizes map[int64]int64
+	count map[int64]int
+}
+
+// newDiskQueue opens the queue stored in dir, creating dir if needed and loading the
+// batches left by a previous run
+func newDiskQueue(dir string, maxBytes int64) (*diskQueue, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, errfmt.Errorf("failed to create queue directory %s: %v", dir, err)
+	}
+
+	q := &diskQueue{
+		dir:      dir,
+		maxBytes: maxBytes,
+		sizes:    make(map[int64]int64),
+		count:    make(map[int64]int),
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, errfmt.Errorf("failed to read queue directory %s: %v", dir, err)
+	}
+	for _, entry := range entries {
+		if strings.HasSuffix(entry.Name(), diskQueueFileSuffix+".tmp") {
+			// Left by a batch write interrupted when tracee stopped
+			_ = os.Remove(filepath.Join(dir, entry.Name()))
+			continue
+		}
+		name, ok := strings.CutSuffix(entry.Name(), diskQueueFileSuffix)
+		if !ok || entry.IsDir() {
+			continue
+		}
+		seq, err := strconv.ParseInt(name, 10, 64)
+		if err != nil {
+			continue
+		}
+		batch, err := readBatchFile(q.path(seq))
+		if err != nil {
+			// A batch partially written when tracee stopped can't be recovered
+			_ = os.Remove(q.path(seq))
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			continue
+		}
+		q.seqs = append(q.seqs, seq)
+		q.sizes[seq] = info.Size()
+		q.count[seq] = len(batch)
+		q.bytes += info.Size()
+		q.events += len(batch)
+	}
+	slices.Sort(q.seqs)
+
+	return q, nil
+}
+
+// Len returns the number of queued batches
+func (q *diskQueue) Len() int {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	return len(q.seqs)
+}
+
+// Events returns the number of queued events
+func (q *diskQueue) Events() int {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	return q.events
+}
+
+// PushBack queues a batch after the queued batches
+func (q *diskQueue) PushBack(batch [][]byte) error {
+	return q.push(batch, false)
+}
+
+// PushFront queues a batch before the queued batches, used for a batch older than them
+func (q *diskQueue) PushFront(batch [][]byte) error {
+	return q.push(batch, true)
+}
+
+func (q *diskQueue) push(batch [][]byte, front bool) error {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	size := int64(0)
+	for _, payload := range batch {
+		size += int64(binary.MaxVarintLen64 + len(payload))
+	}
+	if q.bytes+size > q.maxBytes {
+		return errDiskQueueFull
+	}
+
+	var seq int64
+	switch {
+	case len(q.seqs) == 0:
+		seq = 1 << 32 // leave room for batches pushed to the front
+	case front:
+		seq = q.seqs[0] - 1
+	default:
+		seq = q.seqs[len(q.seqs)-1] + 1
+	}
+
+	written, err := writeBatchFile(q.path(seq), batch)
+	if err != nil {
+		return err
+	}
+
+	if front {
+		q.seqs = slices.Insert(q.seqs, 0, seq)
+	} else {
+		q.seqs = append(q.seqs, seq)
+	}
+	q.sizes[seq] = written
+	q.count[seq] = len(batch)
+	q.bytes += written
+	q.events += len(batch)
+
+	return nil
+}
+
+// Peek returns the oldest batch without removing it, nil if the queue is empty
+func (q *diskQueue) Peek() ([][]byte, error) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	if len(q.seqs) == 0 {
+		return nil, nil
+	}
+
+	batch, err := readBatchFile(q.path(q.seqs[0]))
+	if err != nil {
+		// Drop the unreadable batch so it doesn't block the queue
+		q.removeOldest()
+		return nil, err
+	}
+
+	return batch, nil
+}
+
+// Pop removes the oldest batch
+func (q *diskQueue) Pop() {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	if len(q.seqs) > 0 {
+		q.removeOldest()
+	}
+}
+
+func (q *diskQueue) removeOldest() {
+	seq := q.seqs[0]
+	_ = os.Remove(q.path(seq))
+
+	q.bytes -= q.sizes[seq]
+	q.events -= q.count[seq]
+	delete(q.sizes, seq)
+	delete(q.count, seq)
+	q.seqs = q.seqs[1:]
+}
+
+func (q *diskQueue) path(seq int64) string {
+	return filepath.Join(q.dir, fmt.Sprintf("%020d%s", seq, diskQueueFileSuffix))
+}
+
+// writeBatchFile writes the batch payloads, each prefixed by its length. The file is
+// written under a temporary name first, so a batch file is always complete.
+func writeBatchFile(path string, batch [][]byte) (int64, error) {
+	tmpPath := path + ".tmp"
+
+	f, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return 0, errfmt.WrapError(err)
+	}
+
+	w := bufio.NewWriter(f)
+	written := int64(0)
+	lenBuf := make([]byte, binary.MaxVarintLen64)
+	for _, payload := range batch {
+		n := binary.PutUvarint(lenBuf, uint64(len(payload)))
+		if _, err = w.Write(lenBuf[:n]); err != nil {
+			break
+		}
+		if _, err = w.Write(payload); err != nil {
+			break
+		}
+		written += int64(n + len(payload))
+	}
+	if err == nil {
+		err = w.Flush()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmpPath, path)
+	}
+	if err != nil {
+		_ = os.Remove(tmpPath)
+		return 0, errfmt.WrapError(err)
+	}
+
+	return written, nil
+}
+
+// readBatchFile reads the batch payloads written by writeBatchFile
+func readBatchFile(path string) ([][]byte, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, errfmt.WrapError(err)
+	}
+	defer func() { _ = f.Close() }()
+
+	r := bufio.NewReader(f)
+	var batch [][]byte
+	for {
+		size, err := binary.ReadUvarint(r)
+		if err == io.EOF {
+			return batch, nil
+		}
+		if err != nil {
+			return nil, errfmt.WrapError(err)
+		}
+		payload := make([]byte, size)
+		if _, err := io.ReadFull(r, payload); err != nil {
+			return nil, errfmt.WrapError(err)
+		}
+		batch = append(batch, payload)
+	}
+}
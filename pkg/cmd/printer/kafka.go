@@ -0,0 +1,373 @@
+package printer
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/Masterminds/sprig/v3"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"github.com/twmb/franz-go/pkg/sasl/plain"
+	"github.com/twmb/franz-go/pkg/sasl/scram"
+
+	pb "github.com/aquasecurity/tracee/api/v1beta1"
+	"github.com/aquasecurity/tracee/common/errfmt"
+	"github.com/aquasecurity/tracee/common/logger"
+	"github.com/aquasecurity/tracee/pkg/metrics"
+	"github.com/aquasecurity/tracee/pkg/streams"
+)
+
+const (
+	kafkaTopicByNone     = "none"
+	kafkaTopicByEvent    = "event"
+	kafkaTopicBySeverity = "severity"
+
+	kafkaKeyNone      = "none"
+	kafkaKeyContainer = "container"
+	kafkaKeyHost      = "host"
+)
+
+// kafkaProducer produces records asynchronously, calling promise once a record is
+// acknowledged or failed. It is implemented by the kafka client, and by an in-process
+// broker stand-in in tests.
+type kafkaProducer interface {
+	Produce(ctx context.Context, record *kgo.Record, promise func(*kgo.Record, error))
+	Flush(ctx context.Context) error
+	Close()
+}
+
+// kafkaEventPrinter produces events to kafka topics.
+//
+// Events are produced asynchronously: the client batches them per partition and the
+// acknowledgements are accounted for in the destination metrics as they arrive.
+type kafkaEventPrinter struct {
+	name        string
+	outPath     string
+	format      string
+	templateObj *template.Template
+
+	topic    string
+	topicBy  string
+	key      string
+	hostname string
+
+	producer kafkaProducer
+	stats    *metrics.DestinationStats
+}
+
+// Init initializes the kafkaEventPrinter by parsing the URL and its options, and creates
+// the kafka client. Brokers that aren't reachable yet are retried when producing.
+//
+// --output kafka://[user:pass@]host:port[?option=value&...]
+func (p *kafkaEventPrinter) Init() error {
+	u, err := parseKafkaURL(p.outPath)
+	if err != nil {
+		return err
+	}
+	parameters := u.Query()
+
+	if gotemplate, ok := strings.CutPrefix(p.format, "gotemplate="); ok {
+		tmpl, err := template.New(filepath.Base(gotemplate)).
+			Funcs(sprig.TxtFuncMap()).
+			ParseFiles(gotemplate)
+		if err != nil {
+			return errfmt.WrapError(err)
+		}
+		p.templateObj = tmpl
+	}
+
+	p.topic = getParameterValue(parameters, "topic", "tracee")
+	p.topicBy = getParameterValue(parameters, "topicBy", kafkaTopicByNone)
+	switch p.topicBy {
+	case kafkaTopicByNone, kafkaTopicByEvent, kafkaTopicBySeverity:
+	default:
+		return errfmt.Errorf("invalid kafka topicBy value %q, expected %s, %s or %s",
+			p.topicBy, kafkaTopicByNone, kafkaTopicByEvent, kafkaTopicBySeverity)
+	}
+
+	p.key = getParameterValue(parameters, "key", kafkaKeyNone)
+	switch p.key {
+	case kafkaKeyNone, kafkaKeyContainer, kafkaKeyHost:
+	default:
+		return errfmt.Errorf("invalid kafka key value %q, expected %s, %s or %s",
+			p.key, kafkaKeyNone, kafkaKeyContainer, kafkaKeyHost)
+	}
+	if p.key != kafkaKeyNone {
+		if p.hostname, err = os.Hostname(); err != nil {
+			return errfmt.Errorf("unable to get the hostname for the kafka key: %v", err)
+		}
+	}
+
+	opts, err := kafkaClientOptions(u)
+	if err != nil {
+		return err
+	}
+	// The producer is only set beforehand by tests
+	if p.producer == nil {
+		client, err := kgo.NewClient(opts...)
+		if err != nil {
+			return errfmt.Errorf("unable to create kafka client: %v", err)
+		}
+		p.producer = client
+	}
+
+	p.stats = metrics.NewDestinationStats(p.name, p.Kind())
+
+	return nil
+}
+
+// parseKafkaURL parses the destination URL
+func parseKafkaURL(rawURL string) (*url.URL, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, errfmt.Errorf("unable to parse URL %q: %v", rawURL, err)
+	}
+	if u.Scheme != "kafka" {
+		return nil, errfmt.Errorf("unsupported protocol for kafka destination: %s", u.Scheme)
+	}
+	if u.Host == "" {
+		return nil, errfmt.Errorf("missing broker address for kafka destination")
+	}
+
+	return u, nil
+}
+
+// kafkaClientOptions returns the options of the kafka client configured by the URL
+func kafkaClientOptions(u *url.URL) ([]kgo.Opt, error) {
+	parameters := u.Query()
+
+	timeout, err := getDurationParameter(parameters, "timeout", "30s")
+	if err != nil {
+		return nil, err
+	}
+
+	opts := []kgo.Opt{
+		kgo.SeedBrokers(append([]string{u.Host}, parameters["broker"]...)...),
+		kgo.ClientID(getParameterValue(parameters, "clientId", "tracee")),
+		// Records not acknowledged in time are failed, so closing doesn't hang on
+		// unreachable brokers
+		kgo.RecordDeliveryTimeout(timeout),
+	}
+
+	compression := getParameterValue(parameters, "compression", "none")
+	switch compression {
+	case "none":
+		opts = append(opts, kgo.ProducerBatchCompression(kgo.NoCompression()))
+	case "gzip":
+		opts = append(opts, kgo.ProducerBatchCompression(kgo.GzipCompression()))
+	case "snappy":
+		opts = append(opts, kgo.ProducerBatchCompression(kgo.SnappyCompression()))
+	case "lz4":
+		opts = append(opts, kgo.ProducerBatchCompression(kgo.Lz4Compression()))
+	case "zstd":
+		opts = append(opts, kgo.ProducerBatchCompression(kgo.ZstdCompression()))
+	default:
+		return nil, errfmt.Errorf("invalid kafka compression value %q, expected none, gzip, snappy, lz4 or zstd", compression)
+	}
+
+	acks := getParameterValue(parameters, "acks", "all")
+	switch acks {
+	case "all":
+		opts = append(opts, kgo.RequiredAcks(kgo.AllISRAcks()))
+	case "leader":
+		// Idempotent writes require acknowledgements from all the in-sync replicas
+		opts = append(opts, kgo.RequiredAcks(kgo.LeaderAck()), kgo.DisableIdempotentWrite())
+	case "none":
+		opts = append(opts, kgo.RequiredAcks(kgo.NoAck()), kgo.DisableIdempotentWrite())
+	default:
+		return nil, errfmt.Errorf("invalid kafka acks value %q, expected all, leader or none", acks)
+	}
+
+	if mechanism := getParameterValue(parameters, "sasl", ""); mechanism != "" {
+		user := u.User.Username()
+		pass, ok := u.User.Password()
+		if user == "" || !ok {
+			return nil, errfmt.Errorf("missing user and password in the URL for kafka sasl")
+		}
+		switch mechanism {
+		case "plain":
+			opts = append(opts, kgo.SASL(plain.Auth{User: user, Pass: pass}.AsMechanism()))
+		case "scram-sha-256":
+			opts = append(opts, kgo.SASL(scram.Auth{User: user, Pass: pass}.AsSha256Mechanism()))
+		case "scram-sha-512":
+			opts = append(opts, kgo.SASL(scram.Auth{User: user, Pass: pass}.AsSha512Mechanism()))
+		default:
+			return nil, errfmt.Errorf("invalid kafka sasl value %q, expected plain, scram-sha-256 or scram-sha-512", mechanism)
+		}
+	}
+
+	tlsConfig, err := kafkaTLSConfig(parameters)
+	if err != nil {
+		return nil, err
+	}
+	if tlsConfig != nil {
+		opts = append(opts, kgo.DialTLSConfig(tlsConfig))
+	}
+
+	return opts, nil
+}
+
+// kafkaTLSConfig returns the TLS configuration of the connections to the brokers, nil if
+// TLS isn't enabled
+func kafkaTLSConfig(parameters url.Values) (*tls.Config, error) {
+	enabled := getParameterValue(parameters, "tls", "false")
+	tlsEnabled, err := strconv.ParseBool(enabled)
+	if err != nil {
+		return nil, errfmt.Errorf("unable to convert tls value %q: %v", enabled, err)
+	}
+	if !tlsEnabled {
+		return nil, nil
+	}
+
+	return clientTLSConfig(parameters, "kafka")
+}
+
+// Preamble prints the preamble for the kafka format (no-op).
+func (p *kafkaEventPrinter) Preamble() {}
+
+// Print produces a single event. The event is accounted for once the brokers acknowledge it.
+func (p *kafkaEventPrinter) Print(event *pb.Event) {
+	var (
+		value []byte
+		err   error
+	)
+
+	if p.templateObj != nil {
+		buf := bytes.Buffer{}
+		if err := p.templateObj.Execute(&buf, event); err != nil {
+			logger.Errorw("error writing to the template", "error", err)
+			return
+		}
+		value = buf.Bytes()
+	} else {
+		value, err = event.MarshalJSON()
+		if err != nil {
+			logger.Errorw("Error marshalling event", "error", err)
+			return
+		}
+	}
+
+	record := &kgo.Record{
+		Topic: p.recordTopic(event),
+		Key:   p.recordKey(event),
+		Value: value,
+	}
+	if event.Timestamp != nil {
+		record.Timestamp = event.Timestamp.AsTime()
+	}
+
+	_ = p.stats.Queued.Increment()
+	// Produce blocks while the client buffers are full, slowing down the stream
+	p.producer.Produce(context.Background(), record, p.acknowledged)
+}
+
+// acknowledged accounts for a record acknowledged or failed by the brokers
+func (p *kafkaEventPrinter) acknowledged(record *kgo.Record, err error) {
+	_ = p.stats.Queued.Decrement()
+	if err != nil {
+		logger.Errorw("Error producing event to kafka", "destination", p.name, "topic", record.Topic, "error", err)
+		_ = p.stats.Failed.Increment()
+		return
+	}
+	_ = p.stats.Delivered.Increment()
+}
+
+// recordTopic returns the topic of an event: the topic option, suffixed by the event
+// name or threat severity if configured
+func (p *kafkaEventPrinter) recordTopic(event *pb.Event) string {
+	switch p.topicBy {
+	case kafkaTopicByEvent:
+		return p.topic + "." + event.Name
+	case kafkaTopicBySeverity:
+		if event.Threat != nil {
+			return p.topic + "." + strings.ToLower(event.Threat.Severity.String())
+		}
+	}
+
+	return p.topic
+}
+
+// recordKey returns the partition key of an event. Events of the host are keyed by the
+// hostname when keying by container.
+func (p *kafkaEventPrinter) recordKey(event *pb.Event) []byte {
+	switch p.key {
+	case kafkaKeyContainer:
+		if event.Workload != nil && event.Workload.Container != nil && event.Workload.Container.Id != "" {
+			return []byte(event.Workload.Container.Id)
+		}
+		return []byte(p.hostname)
+	case kafkaKeyHost:
+		return []byte(p.hostname)
+	}
+
+	// No key: the client spreads the events over the partitions
+	return nil
+}
+
+// Epilogue prints the epilogue for the kafka format (no-op).
+func (p *kafkaEventPrinter) Epilogue(stats metrics.Stats) {}
+
+// FromStream receives events from the stream and produces them to kafka.
+func (p *kafkaEventPrinter) FromStream(stream *streams.Stream) {
+	consumeFromStream(stream, p)
+}
+
+// Kind returns the kind of the kafkaEventPrinter.
+func (p *kafkaEventPrinter) Kind() string {
+	return "kafka"
+}
+
+// Close waits for the produced events to be acknowledged or failed and closes the client.
+func (p *kafkaEventPrinter) Close() {
+	if err := p.producer.Flush(context.Background()); err != nil {
+		logger.Errorw("Error flushing kafka events", "destination", p.name, "error", err)
+	}
+	p.producer.Close()
+}
+
+// clientTLSConfig returns the TLS configuration of the connections of a destination to
+// its servers, configured by the tlsSkipVerify, tlsCA, tlsCert and tlsKey options
+func clientTLSConfig(parameters url.Values, kind string) (*tls.Config, error) {
+	skipVerify := getParameterValue(parameters, "tlsSkipVerify", "false")
+	insecure, err := strconv.ParseBool(skipVerify)
+	if err != nil {
+		return nil, errfmt.Errorf("unable to convert tlsSkipVerify value %q: %v", skipVerify, err)
+	}
+	config := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: insecure, //nolint:gosec // explicitly requested by the user
+	}
+
+	if caFile := getParameterValue(parameters, "tlsCA", ""); caFile != "" {
+		pem, err := os.ReadFile(caFile)
+		if err != nil {
+			return nil, errfmt.Errorf("unable to read %s tlsCA file: %v", kind, err)
+		}
+		config.RootCAs = x509.NewCertPool()
+		if !config.RootCAs.AppendCertsFromPEM(pem) {
+			return nil, errfmt.Errorf("no certificate found in %s tlsCA file %s", kind, caFile)
+		}
+	}
+
+	certFile := getParameterValue(parameters, "tlsCert", "")
+	keyFile := getParameterValue(parameters, "tlsKey", "")
+	if (certFile == "") != (keyFile == "") {
+		return nil, errfmt.Errorf("%s tlsCert and tlsKey must be given together", kind)
+	}
+	if certFile != "" {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, errfmt.Errorf("unable to load %s client certificate: %v", kind, err)
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+
+	return config, nil
+}
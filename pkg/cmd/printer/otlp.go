@@ -0,0 +1,212 @@
+package printer
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	pb "github.com/aquasecurity/tracee/api/v1beta1"
+	"github.com/aquasecurity/tracee/common/errfmt"
+	"github.com/aquasecurity/tracee/common/logger"
+	"github.com/aquasecurity/tracee/pkg/metrics"
+	"github.com/aquasecurity/tracee/pkg/otlp"
+	"github.com/aquasecurity/tracee/pkg/streams"
+)
+
+// otlpPendingBatches are the batches waiting for the sender before Print blocks
+const otlpPendingBatches = 4
+
+// otlpEventPrinter exports events as OTLP log records to an OpenTelemetry collector.
+//
+// Events are exported in batches by a background sender, the client retrying the
+// transient failures. The workload of the events maps to the resource of the records
+// and their threat severity to the record severity.
+type otlpEventPrinter struct {
+	name    string
+	outPath string
+
+	batchSize    int
+	batchTimeout time.Duration
+	hostname     string
+
+	client *otlp.Client
+	stats  *metrics.DestinationStats
+
+	mutex   sync.Mutex
+	batch   []*pb.Event
+	closed  bool
+	batches chan []*pb.Event
+
+	closing     chan struct{}
+	flusherDone chan struct{}
+	senderDone  chan struct{}
+	closeOnce   sync.Once
+}
+
+// Init initializes the otlpEventPrinter by parsing the URL and its options, and starts
+// exporting events. An unreachable collector fails the exports, not the initialization.
+//
+// --output otlp:grpc://host:4317[?option=value&...]
+// --output otlp:http://host:4318[/path][?option=value&...]
+func (p *otlpEventPrinter) Init() error {
+	u, err := url.Parse(p.outPath)
+	if err != nil {
+		return errfmt.Errorf("unable to parse URL %q: %v", p.outPath, err)
+	}
+	parameters := u.Query()
+
+	batchSize := getParameterValue(parameters, "batchSize", "512")
+	if p.batchSize, err = strconv.Atoi(batchSize); err != nil || p.batchSize <= 0 {
+		return errfmt.Errorf("invalid otlp batchSize value %q, expected a positive number", batchSize)
+	}
+	if p.batchTimeout, err = getDurationParameter(parameters, "batchTimeout", "1s"); err != nil {
+		return err
+	}
+
+	if p.hostname, err = os.Hostname(); err != nil {
+		return errfmt.Errorf("unable to get the hostname for the otlp resource: %v", err)
+	}
+
+	if p.client, err = otlp.NewClient(p.outPath); err != nil {
+		return err
+	}
+
+	p.stats = metrics.NewDestinationStats(p.name, p.Kind())
+	p.batches = make(chan []*pb.Event, otlpPendingBatches)
+	p.closing = make(chan struct{})
+	p.flusherDone = make(chan struct{})
+	p.senderDone = make(chan struct{})
+
+	go p.runFlusher()
+	go p.runSender()
+
+	return nil
+}
+
+// Preamble prints the preamble for the otlp format (no-op).
+func (p *otlpEventPrinter) Preamble() {}
+
+// Print adds a single event to the batch exported to the collector.
+func (p *otlpEventPrinter) Print(event *pb.Event) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if p.closed {
+		logger.Errorw("Error exporting event over otlp, destination is closed", "destination", p.name)
+		_ = p.stats.Failed.Increment()
+		return
+	}
+
+	_ = p.stats.Queued.Increment()
+	p.batch = append(p.batch, event)
+	if len(p.batch) >= p.batchSize {
+		// Blocks while the sender is busy, slowing down the stream
+		p.batches <- p.takeBatch()
+	}
+}
+
+// Epilogue prints the epilogue for the otlp format (no-op).
+func (p *otlpEventPrinter) Epilogue(stats metrics.Stats) {}
+
+// FromStream receives events from the stream and exports them to the collector.
+func (p *otlpEventPrinter) FromStream(stream *streams.Stream) {
+	consumeFromStream(stream, p)
+}
+
+// Kind returns the kind of the otlpEventPrinter.
+func (p *otlpEventPrinter) Kind() string {
+	return "otlp"
+}
+
+// Close exports the pending events and closes the connection to the collector.
+func (p *otlpEventPrinter) Close() {
+	p.closeOnce.Do(func() {
+		close(p.closing)
+		<-p.flusherDone
+
+		p.mutex.Lock()
+		p.closed = true
+		if len(p.batch) > 0 {
+			p.batches <- p.takeBatch()
+		}
+		close(p.batches)
+		p.mutex.Unlock()
+
+		<-p.senderDone
+		if err := p.client.Close(); err != nil {
+			logger.Errorw("Error closing otlp client", "destination", p.name, "error", err)
+		}
+	})
+}
+
+// takeBatch returns the current batch and starts a new one, with the mutex held
+func (p *otlpEventPrinter) takeBatch() []*pb.Event {
+	batch := p.batch
+	p.batch = nil
+	return batch
+}
+
+// runFlusher hands the current batch to the sender when it waited for batchTimeout
+func (p *otlpEventPrinter) runFlusher() {
+	defer close(p.flusherDone)
+
+	ticker := time.NewTicker(p.batchTimeout)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-p.closing:
+			return
+		case <-ticker.C:
+			p.mutex.Lock()
+			if len(p.batch) > 0 {
+				p.batches <- p.takeBatch()
+			}
+			p.mutex.Unlock()
+		}
+	}
+}
+
+// runSender exports the batches until the printer is closed
+func (p *otlpEventPrinter) runSender() {
+	defer close(p.senderDone)
+
+	for batch := range p.batches {
+		p.export(batch)
+	}
+}
+
+// export converts a batch to log records and exports them, accounting for the events
+// the collector accepted or rejected
+func (p *otlpEventPrinter) export(batch []*pb.Event) {
+	defer func() { _ = p.stats.Queued.Decrement(uint64(len(batch))) }()
+
+	resourceLogs, failed := otlp.Logs(batch, p.hostname)
+	if failed > 0 {
+		logger.Errorw("Error converting events to otlp log records", "destination", p.name, "events", failed)
+		_ = p.stats.Failed.Increment(uint64(failed))
+	}
+	exported := len(batch) - failed
+	if exported == 0 {
+		return
+	}
+
+	err := p.client.ExportLogs(context.Background(), resourceLogs)
+	var partial *otlp.PartialSuccessError
+	switch {
+	case err == nil:
+		_ = p.stats.Delivered.Increment(uint64(exported))
+	case errors.As(err, &partial):
+		logger.Warnw("Collector rejected some otlp log records", "destination", p.name, "rejected", partial.Rejected, "message", partial.Message)
+		rejected := min(int(partial.Rejected), exported)
+		_ = p.stats.Failed.Increment(uint64(rejected))
+		_ = p.stats.Delivered.Increment(uint64(exported - rejected))
+	default:
+		logger.Errorw("Error exporting events over otlp", "destination", p.name, "endpoint", p.client.Endpoint(), "events", exported, "error", err)
+		_ = p.stats.Failed.Increment(uint64(exported))
+	}
+}
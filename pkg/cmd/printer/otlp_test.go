@@ -0,0 +1,126 @@
+package printer
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/aquasecurity/tracee/api/v1beta1"
+)
+
+// otlpCollector is an in-process stand-in for an OTLP/HTTP collector, answering the
+// requests with the configured status code
+type otlpCollector struct {
+	mutex      sync.Mutex
+	statusCode int
+	requests   []*collogspb.ExportLogsServiceRequest
+}
+
+func (c *otlpCollector) start(t *testing.T) string {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.mutex.Lock()
+		defer c.mutex.Unlock()
+
+		if c.statusCode != 0 {
+			w.WriteHeader(c.statusCode)
+			return
+		}
+
+		body, _ := io.ReadAll(r.Body)
+		req := &collogspb.ExportLogsServiceRequest{}
+		if r.URL.Path != "/v1/logs" || proto.Unmarshal(body, req) != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		c.requests = append(c.requests, req)
+
+		data, _ := proto.Marshal(&collogspb.ExportLogsServiceResponse{})
+		_, _ = w.Write(data)
+	}))
+	t.Cleanup(server.Close)
+
+	return server.URL
+}
+
+// eventNames returns the names of the exported events, per request
+func (c *otlpCollector) eventNames() [][]string {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	var names [][]string
+	for _, req := range c.requests {
+		var request []string
+		for _, resourceLogs := range req.GetResourceLogs() {
+			for _, scopeLogs := range resourceLogs.GetScopeLogs() {
+				for _, record := range scopeLogs.GetLogRecords() {
+					request = append(request, record.GetEventName())
+				}
+			}
+		}
+		names = append(names, request)
+	}
+
+	return names
+}
+
+func TestOTLPEventPrinter_Batches(t *testing.T) {
+	t.Parallel()
+
+	collector := &otlpCollector{}
+	p := &otlpEventPrinter{name: "test", outPath: collector.start(t) + "?batchSize=2&batchTimeout=1h"}
+	require.NoError(t, p.Init())
+
+	for _, name := range []string{"e0", "e1", "e2"} {
+		p.Print(&pb.Event{Name: name})
+	}
+	// The incomplete batch is exported on close
+	p.Close()
+
+	assert.Equal(t, [][]string{{"e0", "e1"}, {"e2"}}, collector.eventNames())
+	assert.Equal(t, uint64(3), p.stats.Delivered.Get())
+	assert.Equal(t, uint64(0), p.stats.Failed.Get())
+	assert.Equal(t, uint64(0), p.stats.Queued.Get())
+
+	// Events printed after close are failed
+	p.Print(&pb.Event{Name: "e3"})
+	assert.Equal(t, uint64(1), p.stats.Failed.Get())
+}
+
+func TestOTLPEventPrinter_Failures(t *testing.T) {
+	t.Parallel()
+
+	collector := &otlpCollector{statusCode: http.StatusServiceUnavailable}
+	p := &otlpEventPrinter{name: "test", outPath: collector.start(t) + "?retries=1&retryBackoff=1ms"}
+	require.NoError(t, p.Init())
+
+	p.Print(&pb.Event{Name: "e0"})
+	p.Print(&pb.Event{Name: "e1"})
+	p.Close()
+
+	assert.Empty(t, collector.eventNames())
+	assert.Equal(t, uint64(0), p.stats.Delivered.Get())
+	assert.Equal(t, uint64(2), p.stats.Failed.Get())
+	assert.Equal(t, uint64(0), p.stats.Queued.Get())
+}
+
+func TestOTLPEventPrinter_InvalidOptions(t *testing.T) {
+	t.Parallel()
+
+	for _, outPath := range []string{
+		"kafka://localhost:9092",
+		"grpc://localhost:4317?batchSize=0",
+		"grpc://localhost:4317?batchTimeout=soon",
+		"grpc://localhost:4317?retries=many",
+		"http://localhost:4318?header=X-Tenant",
+	} {
+		p := &otlpEventPrinter{outPath: outPath}
+		assert.Error(t, p.Init(), outPath)
+	}
+}
@@ -2,10 +2,8 @@ package printer
 
 import (
 	"bufio"
-	"bytes"
 	"fmt"
 	"io"
-	"net/http"
 	"net/url"
 	"os"
 	"path/filepath"
@@ -62,6 +60,14 @@ func newSinglePrinter(dst config.Destination) (EventPrinter, error) {
 	kind := dst.Type
 	format := dst.Format
 
+	if dst.Type == "file" && dst.File == nil && dst.Rotation.Enabled() {
+		file, err := newRotatingFile(dst.Path, dst.Rotation)
+		if err != nil {
+			return nil, err
+		}
+		dst.File = file
+	}
+
 	if dst.Type == "file" && dst.File == nil {
 		return res, errfmt.Errorf("out file is not set")
 	}
@@ -85,6 +91,11 @@ func newSinglePrinter(dst config.Destination) (EventPrinter, error) {
 				out:          dst.File,
 				templatePath: strings.Split(format, "=")[1],
 			}
+		case format == siemFormatCEF || format == siemFormatLEEF:
+			res = &siemEventPrinter{
+				out:    dst.File,
+				format: format,
+			}
 		}
 	case kind == "forward":
 		res = &forwardEventPrinter{
@@ -92,6 +103,24 @@ func newSinglePrinter(dst config.Destination) (EventPrinter, error) {
 		}
 	case kind == "webhook":
 		res = &webhookEventPrinter{
+			name:    dst.Name,
+			outPath: dst.Url,
+			format:  dst.Format,
+		}
+	case kind == "kafka":
+		res = &kafkaEventPrinter{
+			name:    dst.Name,
+			outPath: dst.Url,
+			format:  dst.Format,
+		}
+	case kind == "otlp":
+		res = &otlpEventPrinter{
+			name:    dst.Name,
+			outPath: dst.Url,
+		}
+	case kind == "syslog":
+		res = &syslogEventPrinter{
+			name:    dst.Name,
 			outPath: dst.Url,
 			format:  dst.Format,
 		}
@@ -166,6 +195,8 @@ func (p tableEventPrinter) Print(event *pb.Event) {
 		return
 	}
 
+	rotateOutput(p.out, nil, 0)
+
 	// Extract timestamp
 	timestamp := "N/A"
 	if event.Timestamp != nil {
@@ -373,9 +404,7 @@ func (p *tableEventPrinter) Kind() string {
 // Close closes the tableEventPrinter.
 func (p tableEventPrinter) Close() {
 	// Sync flushes buffered data, ensuring events aren't lost on process exit
-	if f, ok := p.out.(*os.File); ok {
-		_ = f.Sync()
-	}
+	syncOutput(p.out)
 }
 
 // templateEventPrinter is the printer for the template format.
@@ -407,6 +436,8 @@ func (p templateEventPrinter) Preamble() {}
 
 // Print prints a single event in the template format.
 func (p templateEventPrinter) Print(event *pb.Event) {
+	rotateOutput(p.out, nil, 0)
+
 	if p.templateObj != nil {
 		err := (*p.templateObj).Execute(p.out, event)
 		if err != nil {
@@ -433,9 +464,7 @@ func (p *templateEventPrinter) Kind() string {
 // Close closes the templateEventPrinter and flushes buffered data.
 func (p templateEventPrinter) Close() {
 	// Sync flushes buffered data, ensuring events aren't lost on process exit
-	if f, ok := p.out.(*os.File); ok {
-		_ = f.Sync()
-	}
+	syncOutput(p.out)
 }
 
 // jsonEventPrinter is the printer for the JSON format.
@@ -474,6 +503,8 @@ func (p *jsonEventPrinter) Print(event *pb.Event) {
 	}
 
 	eBytes = append(eBytes, '\n')
+	// Rotate before writing, so the event isn't split across files
+	rotateOutput(p.out, p.buffer, len(eBytes))
 	if _, err := p.buffer.Write(eBytes); err != nil {
 		logger.Errorw("Error writing to buffer", "error", err)
 		return
@@ -523,9 +554,7 @@ func (p *jsonEventPrinter) Close() {
 		}
 	}
 	// Sync flushes OS buffers to disk, ensuring events aren't lost on process exit
-	if f, ok := p.out.(*os.File); ok {
-		_ = f.Sync()
-	}
+	syncOutput(p.out)
 }
 
 // ignoreEventPrinter ignores events and discards all output.
@@ -716,114 +745,6 @@ func (p forwardEventPrinter) Close() {
 	}
 }
 
-// webhookEventPrinter sends events to a webhook endpoint via HTTP POST.
-type webhookEventPrinter struct {
-	outPath     string
-	url         *url.URL
-	timeout     time.Duration
-	format      string
-	templateObj *template.Template
-	contentType string
-}
-
-// Init initializes the webhookEventPrinter by parsing the URL and preparing the template if needed.
-func (ws *webhookEventPrinter) Init() error {
-	u, err := url.Parse(ws.outPath)
-	if err != nil {
-		return errfmt.Errorf("unable to parse URL %q: %v", ws.outPath, err)
-	}
-	ws.url = u
-
-	parameters, _ := url.ParseQuery(ws.url.RawQuery)
-
-	timeout := getParameterValue(parameters, "timeout", "10s")
-	t, err := time.ParseDuration(timeout)
-	if err != nil {
-		return errfmt.Errorf("unable to convert timeout value %q: %v", timeout, err)
-	}
-	ws.timeout = t
-
-	if gotemplate, ok := strings.CutPrefix(ws.format, "gotemplate="); ok {
-		tmpl, err := template.New(filepath.Base(gotemplate)).
-			Funcs(sprig.TxtFuncMap()).
-			ParseFiles(gotemplate)
-
-		if err != nil {
-			return errfmt.WrapError(err)
-		}
-		ws.templateObj = tmpl
-	}
-
-	contentType := getParameterValue(parameters, "contentType", "application/json")
-	ws.contentType = contentType
-
-	return nil
-}
-
-// Preamble prints the preamble for the webhook format (no-op).
-func (ws *webhookEventPrinter) Preamble() {}
-
-// Print sends a single event to the webhook endpoint via HTTP POST.
-func (ws *webhookEventPrinter) Print(event *pb.Event) {
-	var (
-		payload []byte
-		err     error
-	)
-
-	if ws.templateObj != nil {
-		buf := bytes.Buffer{}
-		if err := ws.templateObj.Execute(&buf, event); err != nil {
-			logger.Errorw("error writing to the template", "error", err)
-			return
-		}
-		payload = buf.Bytes()
-	} else {
-		payload, err = event.MarshalJSON()
-		if err != nil {
-			logger.Errorw("Error marshalling event", "error", err)
-			return
-		}
-	}
-
-	client := http.Client{Timeout: ws.timeout}
-
-	req, err := http.NewRequest(http.MethodPost, ws.url.String(), bytes.NewReader(payload))
-	if err != nil {
-		logger.Errorw("Error creating request", "error", err)
-		return
-	}
-
-	req.Header.Set("Content-Type", ws.contentType)
-
-	resp, err := client.Do(req)
-	if err != nil {
-		logger.Errorw("Error sending webhook", "error", err)
-		return
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		logger.Errorw(fmt.Sprintf("Error sending webhook, http status: %d", resp.StatusCode))
-	}
-
-	_ = resp.Body.Close()
-}
-
-// Epilogue prints the epilogue for the webhook format (no-op).
-func (ws *webhookEventPrinter) Epilogue(stats metrics.Stats) {}
-
-// FromStream receives events from the stream and sends them to the webhook endpoint.
-func (ws *webhookEventPrinter) FromStream(stream *streams.Stream) {
-	consumeFromStream(stream, ws)
-}
-
-// Kind returns the kind of the webhookEventPrinter.
-func (ws *webhookEventPrinter) Kind() string {
-	return "webhook"
-}
-
-// Close closes the webhookEventPrinter (no-op).
-func (ws *webhookEventPrinter) Close() {}
-
 // consumeFromStream consumes events from a stream and prints them using the provided printer.
 // It runs until the stream's event channel is closed, ensuring all events are drained during shutdown.
 func consumeFromStream(stream *streams.Stream, printer EventPrinter) {
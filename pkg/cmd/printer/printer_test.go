@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/aquasecurity/tracee/common/bitwise"
 	"github.com/aquasecurity/tracee/pkg/cmd/flags"
 	"github.com/aquasecurity/tracee/pkg/cmd/printer"
 	"github.com/aquasecurity/tracee/pkg/config"
@@ -256,11 +257,11 @@ func TestPrinterFromStream(t *testing.T) {
 	pbEvent, err := events.ConvertTraceeEventToProto(trace.Event{
 		ProcessName:         "process_from_stream",
 		EventName:           "event_from_stream",
-		MatchedPoliciesUser: 0b1,
+		MatchedPoliciesUser: [4]uint64{1},
 	})
 	require.NoError(t, err)
 
-	sm.Publish(pbEvent, 0b1)
+	sm.Publish(pbEvent, bitwise.NewBitmap(0))
 
 	time.Sleep(time.Millisecond * 10)
 
@@ -0,0 +1,324 @@
+package printer
+
+import (
+	"bufio"
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aquasecurity/tracee/common/errfmt"
+	"github.com/aquasecurity/tracee/common/logger"
+	"github.com/aquasecurity/tracee/pkg/config"
+)
+
+const (
+	rotatedFileTimeFormat = "20060102T150405.000"
+	compressedFileSuffix  = ".gz"
+)
+
+// rotatingFile is a file output rotated by size or age. The printers rotate it between
+// events, so a line is never split across files: the file is renamed to
+// <path>.<timestamp> and a new file is created at path. Rotated files are optionally
+// gzipped in the background and pruned to keep the newest ones.
+type rotatingFile struct {
+	mutex    sync.Mutex
+	path     string
+	rotation config.FileRotation
+	file     *os.File
+	size     int64
+	openedAt time.Time
+	now      func() time.Time
+	// serializes the compression and pruning of the rotated files
+	archiveMutex sync.Mutex
+	archives     sync.WaitGroup
+}
+
+// newRotatingFile opens the rotating file at path. A non-empty file left at path by a
+// previous run is rotated first, so its events are kept.
+func newRotatingFile(path string, rotation config.FileRotation) (*rotatingFile, error) {
+	fileInfo, err := os.Stat(path)
+	if err == nil && fileInfo.IsDir() {
+		return nil, errfmt.Errorf("cannot use a path of existing directory %s", path)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, errfmt.Errorf("failed to create directory: %v", err)
+	}
+
+	f := &rotatingFile{
+		path:     path,
+		rotation: rotation,
+		now:      time.Now,
+	}
+
+	f.removeStaleArchives()
+	if err == nil && fileInfo.Size() > 0 {
+		rotatedPath, err := f.rename()
+		if err != nil {
+			return nil, err
+		}
+		f.archive(rotatedPath)
+	}
+
+	if err := f.open(); err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+// Write writes to the current file
+func (f *rotatingFile) Write(p []byte) (int, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	n, err := f.file.Write(p)
+	f.size += int64(n)
+
+	return n, err
+}
+
+// Sync commits the current file to disk
+func (f *rotatingFile) Sync() error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	return f.file.Sync()
+}
+
+// Close closes the current file, waiting for the rotated files being compressed
+func (f *rotatingFile) Close() error {
+	f.mutex.Lock()
+	err := f.file.Sync()
+	if closeErr := f.file.Close(); err == nil {
+		err = closeErr
+	}
+	f.mutex.Unlock()
+
+	f.archives.Wait()
+
+	return errfmt.WrapError(err)
+}
+
+// shouldRotate returns true if the file is due for rotation, given the bytes still
+// buffered by the printer and the size of the next event to be written
+func (f *rotatingFile) shouldRotate(buffered, next int) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	contents := f.size + int64(buffered)
+	if contents == 0 {
+		// Don't rotate empty files on age
+		return false
+	}
+	if f.rotation.MaxSize > 0 && contents+int64(next) > f.rotation.MaxSize {
+		return true
+	}
+	if f.rotation.MaxAge > 0 && f.now().Sub(f.openedAt) >= f.rotation.MaxAge {
+		return true
+	}
+
+	return false
+}
+
+// rotate renames the current file and continues in a new file at path
+func (f *rotatingFile) rotate() error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	rotatedPath, err := f.rename()
+	if err != nil {
+		return err
+	}
+
+	// The old descriptor stays valid after the rename, nothing else is written to it
+	old := f.file
+	if err := f.open(); err != nil {
+		f.file = old
+		return err
+	}
+	_ = old.Sync()
+	_ = old.Close()
+
+	f.archives.Add(1)
+	go func() {
+		defer f.archives.Done()
+		f.archive(rotatedPath)
+	}()
+
+	return nil
+}
+
+// rename atomically moves the file at path to a timestamped name
+func (f *rotatingFile) rename() (string, error) {
+	base := f.path + "." + f.now().UTC().Format(rotatedFileTimeFormat)
+	rotatedPath := base
+	for i := 1; exists(rotatedPath) || exists(rotatedPath+compressedFileSuffix); i++ {
+		rotatedPath = base + "-" + strconv.Itoa(i)
+	}
+
+	if err := os.Rename(f.path, rotatedPath); err != nil {
+		return "", errfmt.Errorf("failed to rotate output file %s: %v", f.path, err)
+	}
+
+	return rotatedPath, nil
+}
+
+func (f *rotatingFile) open() error {
+	file, err := os.OpenFile(f.path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+	if err != nil {
+		return errfmt.Errorf("failed to create output path: %v", err)
+	}
+
+	f.file = file
+	f.size = 0
+	f.openedAt = f.now()
+
+	return nil
+}
+
+// archive compresses a rotated file if configured, then prunes the oldest rotated files
+func (f *rotatingFile) archive(rotatedPath string) {
+	f.archiveMutex.Lock()
+	defer f.archiveMutex.Unlock()
+
+	if f.rotation.Compress {
+		if err := compressFile(rotatedPath); err != nil {
+			logger.Errorw("Error compressing rotated output file", "path", rotatedPath, "error", err)
+		}
+	}
+
+	if f.rotation.MaxFiles == 0 {
+		return
+	}
+	rotated := f.rotatedFiles()
+	for len(rotated) > f.rotation.MaxFiles {
+		if err := os.Remove(rotated[0]); err != nil && !os.IsNotExist(err) {
+			logger.Errorw("Error removing rotated output file", "path", rotated[0], "error", err)
+		}
+		rotated = rotated[1:]
+	}
+}
+
+// rotatedFiles returns the paths of the rotated files, oldest first
+func (f *rotatingFile) rotatedFiles() []string {
+	entries, err := os.ReadDir(filepath.Dir(f.path))
+	if err != nil {
+		return nil
+	}
+
+	prefix := filepath.Base(f.path) + "."
+	keys := map[string]string{}
+	var paths []string
+	for _, entry := range entries {
+		key, ok := strings.CutPrefix(entry.Name(), prefix)
+		if !ok || entry.IsDir() || strings.HasSuffix(key, ".tmp") {
+			continue
+		}
+		key = strings.TrimSuffix(key, compressedFileSuffix)
+		if _, err := time.Parse(rotatedFileTimeFormat, strings.SplitN(key, "-", 2)[0]); err != nil {
+			continue
+		}
+		path := filepath.Join(filepath.Dir(f.path), entry.Name())
+		keys[path] = key
+		paths = append(paths, path)
+	}
+	slices.SortFunc(paths, func(a, b string) int {
+		return strings.Compare(keys[a], keys[b])
+	})
+
+	return paths
+}
+
+// removeStaleArchives removes the compressions interrupted when tracee stopped
+func (f *rotatingFile) removeStaleArchives() {
+	matches, _ := filepath.Glob(f.path + ".*" + compressedFileSuffix + ".tmp")
+	for _, match := range matches {
+		_ = os.Remove(match)
+	}
+}
+
+// compressFile gzips path into path.gz and removes path. The compressed file is written
+// under a temporary name first, so a .gz file is always complete.
+func compressFile(path string) error {
+	src, err := os.Open(path)
+	if err != nil {
+		return errfmt.WrapError(err)
+	}
+	defer func() { _ = src.Close() }()
+
+	tmpPath := path + compressedFileSuffix + ".tmp"
+	dst, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+	if err != nil {
+		return errfmt.WrapError(err)
+	}
+
+	w := bufio.NewWriter(dst)
+	zw := gzip.NewWriter(w)
+	_, err = io.Copy(zw, src)
+	if closeErr := zw.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = w.Flush()
+	}
+	if closeErr := dst.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmpPath, path+compressedFileSuffix)
+	}
+	if err != nil {
+		_ = os.Remove(tmpPath)
+		return errfmt.WrapError(err)
+	}
+
+	return errfmt.WrapError(os.Remove(path))
+}
+
+// rotateOutput rotates out between events if it is a rotating file due for rotation.
+// The printer buffer, if any, is flushed first so its events end in the rotated file.
+func rotateOutput(out io.Writer, buffer *bufio.Writer, next int) {
+	f, ok := out.(*rotatingFile)
+	if !ok {
+		return
+	}
+
+	buffered := 0
+	if buffer != nil {
+		buffered = buffer.Buffered()
+	}
+	if !f.shouldRotate(buffered, next) {
+		return
+	}
+
+	if buffer != nil {
+		if err := buffer.Flush(); err != nil {
+			logger.Errorw("Error flushing buffer before rotation", "error", err)
+			return
+		}
+	}
+	if err := f.rotate(); err != nil {
+		logger.Errorw("Error rotating output file", "path", f.path, "error", err)
+	}
+}
+
+// syncOutput commits a file output to disk, closing it if the printer opened it
+func syncOutput(out io.Writer) {
+	switch f := out.(type) {
+	case *os.File:
+		_ = f.Sync()
+	case *rotatingFile:
+		_ = f.Close()
+	}
+}
+
+func exists(path string) bool {
+	_, err := os.Lstat(path)
+	return err == nil
+}
@@ -0,0 +1,146 @@
+package printer
+
+import (
+	"bufio"
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aquasecurity/tracee/pkg/config"
+)
+
+// writeEvents writes lines the way the json printer does: rotating between events and
+// buffering the writes
+func writeEvents(f *rotatingFile, buffer *bufio.Writer, lines ...string) {
+	for _, line := range lines {
+		rotateOutput(f, buffer, len(line))
+		_, _ = buffer.WriteString(line)
+	}
+}
+
+func TestRotatingFile_MaxSize(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "events.json")
+	f, err := newRotatingFile(path, config.FileRotation{MaxSize: 10})
+	require.NoError(t, err)
+	buffer := bufio.NewWriter(f)
+
+	// The buffered events count towards the file size
+	writeEvents(f, buffer, "e0-----\n", "e1-----\n", "e2-----\n")
+	require.NoError(t, buffer.Flush())
+	require.NoError(t, f.Close())
+
+	rotated := f.rotatedFiles()
+	require.Len(t, rotated, 2)
+	assert.Equal(t, "e0-----\n", readFile(t, rotated[0]))
+	assert.Equal(t, "e1-----\n", readFile(t, rotated[1]))
+	assert.Equal(t, "e2-----\n", readFile(t, path))
+}
+
+func TestRotatingFile_MaxAge(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	path := filepath.Join(t.TempDir(), "events.log")
+	f, err := newRotatingFile(path, config.FileRotation{MaxAge: time.Hour})
+	require.NoError(t, err)
+	f.now = func() time.Time { return now }
+	f.openedAt = now
+
+	_, err = f.Write([]byte("e0\n"))
+	require.NoError(t, err)
+	assert.False(t, f.shouldRotate(0, 0))
+
+	now = now.Add(2 * time.Hour)
+	rotateOutput(f, nil, 0)
+
+	// The new file is empty, it isn't rotated on age
+	now = now.Add(2 * time.Hour)
+	assert.False(t, f.shouldRotate(0, 0))
+	rotateOutput(f, nil, 0)
+	require.NoError(t, f.Close())
+
+	rotated := f.rotatedFiles()
+	require.Len(t, rotated, 1)
+	assert.Equal(t, path+".20260101T020000.000", rotated[0])
+	assert.Equal(t, "e0\n", readFile(t, rotated[0]))
+	assert.Empty(t, readFile(t, path))
+}
+
+func TestRotatingFile_CompressAndPrune(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "events.json")
+	f, err := newRotatingFile(path, config.FileRotation{MaxSize: 1, MaxFiles: 2, Compress: true})
+	require.NoError(t, err)
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	f.now = func() time.Time {
+		now = now.Add(time.Second)
+		return now
+	}
+
+	buffer := bufio.NewWriter(f)
+	writeEvents(f, buffer, "e0\n", "e1\n", "e2\n", "e3\n")
+	require.NoError(t, buffer.Flush())
+	require.NoError(t, f.Close())
+
+	rotated := f.rotatedFiles()
+	require.Len(t, rotated, 2)
+	for i, expected := range []string{"e1\n", "e2\n"} {
+		require.True(t, strings.HasSuffix(rotated[i], compressedFileSuffix), rotated[i])
+		assert.Equal(t, expected, readGzipFile(t, rotated[i]))
+	}
+	assert.Equal(t, "e3\n", readFile(t, path))
+
+	matches, err := filepath.Glob(path + ".*.tmp")
+	require.NoError(t, err)
+	assert.Empty(t, matches)
+}
+
+func TestRotatingFile_ExistingFile(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "out", "events.json")
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+	require.NoError(t, os.WriteFile(path, []byte("previous run\n"), 0644))
+
+	f, err := newRotatingFile(path, config.FileRotation{MaxSize: 1 << 20})
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	rotated := f.rotatedFiles()
+	require.Len(t, rotated, 1)
+	assert.Equal(t, "previous run\n", readFile(t, rotated[0]))
+	assert.Empty(t, readFile(t, path))
+
+	_, err = newRotatingFile(filepath.Dir(path), config.FileRotation{MaxSize: 1})
+	assert.Error(t, err)
+}
+
+func readFile(t *testing.T, path string) string {
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	return string(data)
+}
+
+func readGzipFile(t *testing.T, path string) string {
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer func() { _ = f.Close() }()
+
+	zr, err := gzip.NewReader(f)
+	require.NoError(t, err)
+	data, err := io.ReadAll(zr)
+	require.NoError(t, err)
+
+	return string(data)
+}
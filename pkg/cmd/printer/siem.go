@@ -0,0 +1,328 @@
+package printer
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	pb "github.com/aquasecurity/tracee/api/v1beta1"
+	"github.com/aquasecurity/tracee/common/errfmt"
+	"github.com/aquasecurity/tracee/common/logger"
+	"github.com/aquasecurity/tracee/pkg/metrics"
+	"github.com/aquasecurity/tracee/pkg/streams"
+	"github.com/aquasecurity/tracee/pkg/version"
+)
+
+const (
+	siemFormatCEF  = "cef"
+	siemFormatLEEF = "leef"
+
+	siemVendor  = "Aqua Security"
+	siemProduct = "Tracee"
+
+	// leefTimeFormat is the layout of devTime, matching leefDevTimeFormat
+	leefTimeFormat    = "2006-01-02T15:04:05.000Z07:00"
+	leefDevTimeFormat = "yyyy-MM-dd'T'HH:mm:ss.SSSXXX"
+)
+
+var (
+	cefHeaderEscaper    = strings.NewReplacer(`\`, `\\`, `|`, `\|`, "\r\n", " ", "\n", " ", "\r", " ")
+	cefExtensionEscaper = strings.NewReplacer(`\`, `\\`, `=`, `\=`, "\r\n", `\n`, "\n", `\n`, "\r", `\r`)
+	leefHeaderEscaper   = strings.NewReplacer(`\`, `\\`, `|`, `\|`, "\r\n", " ", "\n", " ", "\r", " ")
+	leefValueEscaper    = strings.NewReplacer(`\`, `\\`, "\t", `\t`, "\r\n", `\n`, "\n", `\n`, "\r", `\r`)
+)
+
+// siemField is a key of a CEF extension or LEEF attribute, with its value
+type siemField struct {
+	key   string
+	value string
+}
+
+// siemFields collects the fields of an event, skipping the empty values
+type siemFields []siemField
+
+func (f *siemFields) add(key, value string) {
+	if value != "" {
+		*f = append(*f, siemField{key: key, value: value})
+	}
+}
+
+// siemFormatter formats events as CEF or LEEF lines, without a trailing newline
+type siemFormatter struct {
+	format   string
+	hostname string
+}
+
+// newSIEMFormatter returns the formatter of a cef or leef format
+func newSIEMFormatter(format string) (*siemFormatter, error) {
+	switch format {
+	case siemFormatCEF, siemFormatLEEF:
+	default:
+		return nil, errfmt.Errorf("unsupported siem format %q, expected %s or %s", format, siemFormatCEF, siemFormatLEEF)
+	}
+
+	hostname, err := os.Hostname()
+	if err != nil {
+		return nil, errfmt.Errorf("unable to get the hostname for the %s format: %v", format, err)
+	}
+
+	return &siemFormatter{format: format, hostname: hostname}, nil
+}
+
+// Format formats a single event
+func (f *siemFormatter) Format(event *pb.Event) string {
+	if f.format == siemFormatLEEF {
+		return f.leef(event)
+	}
+
+	return f.cef(event)
+}
+
+// cef formats an event in the ArcSight Common Event Format:
+//
+// CEF:0|Vendor|Product|Version|Signature ID|Name|Severity|key=value key=value...
+//
+// The signature is the event name and the name its threat name, if any. The workload
+// and MITRE fields map to the standard keys and labelled custom strings.
+func (f *siemFormatter) cef(event *pb.Event) string {
+	threat := event.GetThreat()
+	workload := event.GetWorkload()
+
+	name := event.GetName()
+	if threat.GetName() != "" {
+		name = threat.GetName()
+	}
+
+	var fields siemFields
+	if event.GetTimestamp() != nil {
+		fields.add("rt", strconv.FormatInt(event.GetTimestamp().AsTime().UnixMilli(), 10))
+	}
+	fields.add("dvchost", f.hostname)
+	fields.add("cat", threat.GetMitre().GetTactic().GetName())
+	fields.add("msg", siemMessage(event))
+	fields.add("spid", uint32String(workload.GetProcess().GetHostPid()))
+	fields.add("sproc", processName(workload.GetProcess()))
+	fields.add("suid", uint32String(workload.GetProcess().GetRealUser().GetId()))
+	addLabelled := func(key, label, value string) {
+		if value != "" {
+			fields.add(key+"Label", label)
+			fields.add(key, value)
+		}
+	}
+	addLabelled("cs1", "containerId", workload.GetContainer().GetId())
+	addLabelled("cs2", "containerImage", workload.GetContainer().GetImage().GetName())
+	addLabelled("cs3", "podName", workload.GetK8S().GetPod().GetName())
+	addLabelled("cs4", "podNamespace", workload.GetK8S().GetNamespace().GetName())
+	addLabelled("cs5", "mitreTechniqueId", threat.GetMitre().GetTechnique().GetId())
+	addLabelled("cs6", "mitreTechniqueName", threat.GetMitre().GetTechnique().GetName())
+	addLabelled("flexString1", "containerName", workload.GetContainer().GetName())
+
+	var b strings.Builder
+	b.WriteString("CEF:0|")
+	for _, header := range []string{siemVendor, siemProduct, version.GetVersion(), event.GetName(), name} {
+		b.WriteString(cefHeaderEscaper.Replace(header))
+		b.WriteByte('|')
+	}
+	b.WriteString(strconv.Itoa(siemSeverity(event)))
+	b.WriteByte('|')
+	for i, field := range fields {
+		if i > 0 {
+			b.WriteByte(' ')
+		}
+		b.WriteString(field.key)
+		b.WriteByte('=')
+		b.WriteString(cefExtensionEscaper.Replace(field.value))
+	}
+
+	return b.String()
+}
+
+// leef formats an event in the IBM QRadar Log Event Extended Format 1.0, with tab
+// separated attributes:
+//
+// LEEF:1.0|Vendor|Product|Version|EventID|key=value<tab>key=value...
+func (f *siemFormatter) leef(event *pb.Event) string {
+	threat := event.GetThreat()
+	workload := event.GetWorkload()
+
+	var fields siemFields
+	if event.GetTimestamp() != nil {
+		fields.add("devTime", event.GetTimestamp().AsTime().UTC().Format(leefTimeFormat))
+		fields.add("devTimeFormat", leefDevTimeFormat)
+	}
+	fields.add("sev", strconv.Itoa(siemSeverity(event)))
+	fields.add("identHostName", f.hostname)
+	fields.add("cat", threat.GetMitre().GetTactic().GetName())
+	fields.add("threatName", threat.GetName())
+	fields.add("msg", siemMessage(event))
+	fields.add("pid", uint32String(workload.GetProcess().GetHostPid()))
+	fields.add("processName", workload.GetProcess().GetThread().GetName())
+	fields.add("executable", workload.GetProcess().GetExecutable().GetPath())
+	fields.add("uid", uint32String(workload.GetProcess().GetRealUser().GetId()))
+	fields.add("containerId", workload.GetContainer().GetId())
+	fields.add("containerName", workload.GetContainer().GetName())
+	fields.add("containerImage", workload.GetContainer().GetImage().GetName())
+	fields.add("podName", workload.GetK8S().GetPod().GetName())
+	fields.add("podNamespace", workload.GetK8S().GetNamespace().GetName())
+	fields.add("mitreTechniqueId", threat.GetMitre().GetTechnique().GetId())
+	fields.add("mitreTechniqueName", threat.GetMitre().GetTechnique().GetName())
+
+	var b strings.Builder
+	b.WriteString("LEEF:1.0|")
+	for _, header := range []string{siemVendor, siemProduct, version.GetVersion(), event.GetName()} {
+		b.WriteString(leefHeaderEscaper.Replace(header))
+		b.WriteByte('|')
+	}
+	for i, field := range fields {
+		if i > 0 {
+			b.WriteByte('\t')
+		}
+		b.WriteString(field.key)
+		b.WriteByte('=')
+		b.WriteString(leefValueEscaper.Replace(field.value))
+	}
+
+	return b.String()
+}
+
+// siemSeverity maps the threat severity to the 1-10 scale shared by CEF and LEEF. Events
+// without a threat are informational.
+func siemSeverity(event *pb.Event) int {
+	switch event.GetThreat().GetSeverity() {
+	case pb.Severity_LOW:
+		return 3
+	case pb.Severity_MEDIUM:
+		return 5
+	case pb.Severity_HIGH:
+		return 8
+	case pb.Severity_CRITICAL:
+		return 10
+	}
+
+	return 1
+}
+
+// siemMessage returns the threat description of a detection, or the event data as
+// comma separated name=value pairs
+func siemMessage(event *pb.Event) string {
+	if description := event.GetThreat().GetDescription(); description != "" {
+		return description
+	}
+
+	data := make([]string, 0, len(event.GetData()))
+	for _, value := range event.GetData() {
+		data = append(data, value.GetName()+"="+eventValueString(value))
+	}
+
+	return strings.Join(data, ", ")
+}
+
+// eventValueString formats the value of an event data field: scalars as is, byte
+// slices in base64 and the other values in json
+func eventValueString(value *pb.EventValue) string {
+	m := value.ProtoReflect()
+	oneof := m.Descriptor().Oneofs().ByName("value")
+	if oneof == nil {
+		return ""
+	}
+	field := m.WhichOneof(oneof)
+	if field == nil {
+		return ""
+	}
+
+	v := m.Get(field)
+	switch field.Kind() {
+	case protoreflect.MessageKind:
+		data, err := protojson.Marshal(v.Message().Interface())
+		if err != nil {
+			return ""
+		}
+		// protojson randomizes its whitespace
+		compact := bytes.Buffer{}
+		if err := json.Compact(&compact, data); err != nil {
+			return ""
+		}
+		return compact.String()
+	case protoreflect.BytesKind:
+		return base64.StdEncoding.EncodeToString(v.Bytes())
+	}
+
+	return fmt.Sprint(v.Interface())
+}
+
+// processName returns the executable path of a process, or its thread name
+func processName(process *pb.Process) string {
+	if path := process.GetExecutable().GetPath(); path != "" {
+		return path
+	}
+
+	return process.GetThread().GetName()
+}
+
+// uint32String formats an optional number, empty if it isn't set
+func uint32String(value *wrapperspb.UInt32Value) string {
+	if value == nil {
+		return ""
+	}
+
+	return strconv.FormatUint(uint64(value.GetValue()), 10)
+}
+
+// siemEventPrinter is the printer for the cef and leef formats, one event per line.
+type siemEventPrinter struct {
+	out       io.WriteCloser
+	format    string
+	formatter *siemFormatter
+}
+
+// Init initializes the siemEventPrinter.
+func (p *siemEventPrinter) Init() error {
+	formatter, err := newSIEMFormatter(p.format)
+	if err != nil {
+		return err
+	}
+	p.formatter = formatter
+
+	return nil
+}
+
+// Preamble prints the preamble for the siem formats (no-op).
+func (p *siemEventPrinter) Preamble() {}
+
+// Print prints a single event in the cef or leef format.
+func (p *siemEventPrinter) Print(event *pb.Event) {
+	line := p.formatter.Format(event) + "\n"
+
+	// Rotate before writing, so the event isn't split across files
+	rotateOutput(p.out, nil, len(line))
+	if _, err := io.WriteString(p.out, line); err != nil {
+		logger.Errorw("Error writing event", "format", p.format, "error", err)
+	}
+}
+
+// Epilogue prints the epilogue for the siem formats (no-op).
+func (p *siemEventPrinter) Epilogue(stats metrics.Stats) {}
+
+// FromStream receives events from the stream and prints them in the cef or leef format.
+func (p *siemEventPrinter) FromStream(stream *streams.Stream) {
+	consumeFromStream(stream, p)
+}
+
+// Kind returns the kind of the siemEventPrinter.
+func (p *siemEventPrinter) Kind() string {
+	return p.format
+}
+
+// Close closes the siemEventPrinter and flushes written data.
+func (p *siemEventPrinter) Close() {
+	syncOutput(p.out)
+}
@@ -0,0 +1,134 @@
+package printer
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	pb "github.com/aquasecurity/tracee/api/v1beta1"
+	"github.com/aquasecurity/tracee/pkg/version"
+)
+
+func siemTestEvent() *pb.Event {
+	return &pb.Event{
+		Name:      "anti_debugging",
+		Timestamp: timestamppb.New(time.Unix(10, 5_000_000)),
+		Workload: &pb.Workload{
+			Process: &pb.Process{
+				HostPid:    wrapperspb.UInt32(1234),
+				RealUser:   &pb.User{Id: wrapperspb.UInt32(0)},
+				Executable: &pb.Executable{Path: "/usr/bin/gdb"},
+				Thread:     &pb.Thread{Name: "gdb"},
+			},
+			Container: &pb.Container{
+				Id:    "c0ffee",
+				Name:  "web",
+				Image: &pb.ContainerImage{Name: "nginx:1.27"},
+			},
+			K8S: &pb.K8S{
+				Pod:       &pb.Pod{Name: "web-0"},
+				Namespace: &pb.K8SNamespace{Name: "prod"},
+			},
+		},
+		Threat: &pb.Threat{
+			Name:        "Anti|Debugging",
+			Description: "ptrace=PTRACE_TRACEME\nby a\\process",
+			Severity:    pb.Severity_HIGH,
+			Mitre: &pb.Mitre{
+				Tactic:    &pb.MitreTactic{Name: "Defense Evasion"},
+				Technique: &pb.MitreTechnique{Id: "T1622", Name: "Debugger Evasion"},
+			},
+		},
+	}
+}
+
+func TestSIEMFormatter_CEF(t *testing.T) {
+	t.Parallel()
+
+	f := &siemFormatter{format: siemFormatCEF, hostname: "node-1"}
+
+	assert.Equal(t, "CEF:0|Aqua Security|Tracee|"+version.GetVersion()+"|anti_debugging|Anti\\|Debugging|8|"+
+		"rt=10005 dvchost=node-1 cat=Defense Evasion msg=ptrace\\=PTRACE_TRACEME\\nby a\\\\process "+
+		"spid=1234 sproc=/usr/bin/gdb suid=0 cs1Label=containerId cs1=c0ffee cs2Label=containerImage cs2=nginx:1.27 "+
+		"cs3Label=podName cs3=web-0 cs4Label=podNamespace cs4=prod cs5Label=mitreTechniqueId cs5=T1622 "+
+		"cs6Label=mitreTechniqueName cs6=Debugger Evasion flexString1Label=containerName flexString1=web",
+		f.Format(siemTestEvent()))
+
+	// Events without a threat are informational, their data is the message
+	event := &pb.Event{
+		Name: "openat",
+		Data: []*pb.EventValue{
+			{Name: "pathname", Value: &pb.EventValue_Str{Str: "/etc/passwd"}},
+			{Name: "flags", Value: &pb.EventValue_Int32{Int32: 0}},
+			{Name: "argv", Value: &pb.EventValue_StrArray{StrArray: &pb.StringArray{Value: []string{"a", "b"}}}},
+		},
+	}
+	assert.Equal(t, "CEF:0|Aqua Security|Tracee|"+version.GetVersion()+"|openat|openat|1|"+
+		`dvchost=node-1 msg=pathname\=/etc/passwd, flags\=0, argv\={"value":["a","b"]}`,
+		f.Format(event))
+}
+
+func TestSIEMFormatter_LEEF(t *testing.T) {
+	t.Parallel()
+
+	f := &siemFormatter{format: siemFormatLEEF, hostname: "node-1"}
+
+	header, attributes, found := strings.Cut(f.Format(siemTestEvent()), "|anti_debugging|")
+	require.True(t, found)
+	assert.Equal(t, "LEEF:1.0|Aqua Security|Tracee|"+version.GetVersion(), header)
+	assert.Equal(t, []string{
+		"devTime=1970-01-01T00:00:10.005Z",
+		"devTimeFormat=yyyy-MM-dd'T'HH:mm:ss.SSSXXX",
+		"sev=8",
+		"identHostName=node-1",
+		"cat=Defense Evasion",
+		"threatName=Anti|Debugging",
+		"msg=ptrace=PTRACE_TRACEME\\nby a\\\\process",
+		"pid=1234",
+		"processName=gdb",
+		"executable=/usr/bin/gdb",
+		"uid=0",
+		"containerId=c0ffee",
+		"containerName=web",
+		"containerImage=nginx:1.27",
+		"podName=web-0",
+		"podNamespace=prod",
+		"mitreTechniqueId=T1622",
+		"mitreTechniqueName=Debugger Evasion",
+	}, strings.Split(attributes, "\t"))
+
+	event := &pb.Event{Name: "e", Data: []*pb.EventValue{{Name: "s", Value: &pb.EventValue_Str{Str: "a\tb"}}}}
+	assert.Equal(t, "LEEF:1.0|Aqua Security|Tracee|"+version.GetVersion()+"|e|sev=1\tidentHostName=node-1\tmsg=s=a\\tb",
+		f.Format(event))
+}
+
+func TestSIEMEventPrinter(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "events.cef")
+	file, err := os.Create(path)
+	require.NoError(t, err)
+
+	p := &siemEventPrinter{out: file, format: siemFormatCEF}
+	require.NoError(t, p.Init())
+	p.Print(&pb.Event{Name: "e0"})
+	p.Print(&pb.Event{Name: "e1"})
+	p.Close()
+	require.NoError(t, file.Close())
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSuffix(string(data), "\n"), "\n")
+	require.Len(t, lines, 2)
+	assert.True(t, strings.HasPrefix(lines[1], "CEF:0|Aqua Security|Tracee|"), lines[1])
+	assert.Contains(t, lines[1], "|e1|e1|1|")
+
+	assert.Error(t, (&siemEventPrinter{out: file, format: "xml"}).Init())
+}
@@ -0,0 +1,359 @@
+package printer
+
+import (
+	"bytes"
+	"crypto/tls"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+
+	"github.com/Masterminds/sprig/v3"
+
+	pb "github.com/aquasecurity/tracee/api/v1beta1"
+	"github.com/aquasecurity/tracee/common/errfmt"
+	"github.com/aquasecurity/tracee/common/logger"
+	"github.com/aquasecurity/tracee/pkg/metrics"
+	"github.com/aquasecurity/tracee/pkg/streams"
+)
+
+const (
+	syslogFramingOctetCounting  = "octet-counting"
+	syslogFramingNonTransparent = "non-transparent"
+
+	// syslogTimeFormat is the RFC 5424 timestamp, with the microseconds it allows at most
+	syslogTimeFormat = "2006-01-02T15:04:05.000000Z07:00"
+)
+
+// syslogFacilities are the facility names and codes of RFC 5424
+var syslogFacilities = map[string]int{
+	"kern": 0, "user": 1, "mail": 2, "daemon": 3, "auth": 4, "syslog": 5, "lpr": 6, "news": 7,
+	"uucp": 8, "cron": 9, "authpriv": 10, "ftp": 11, "ntp": 12, "security": 13, "console": 14,
+	"solaris-cron": 15, "local0": 16, "local1": 17, "local2": 18, "local3": 19, "local4": 20,
+	"local5": 21, "local6": 22, "local7": 23,
+}
+
+// syslogEventPrinter sends events as RFC 5424 syslog messages over udp, tcp, tcp+tls or
+// a unix socket.
+//
+// The payload of the messages is the event in the json, gotemplate, cef or leef format,
+// and their severity follows the threat severity. The connection is established on the
+// first event and reestablished after failures, so an unreachable server fails the
+// events, not the initialization.
+type syslogEventPrinter struct {
+	name    string
+	outPath string
+	format  string
+
+	network   string
+	address   string
+	tlsConfig *tls.Config
+	framing   string
+	timeout   time.Duration
+	facility  int
+	hostname  string
+	appName   string
+	procID    string
+
+	templateObj *template.Template
+	formatter   *siemFormatter
+
+	mutex    sync.Mutex
+	conn     net.Conn
+	datagram bool
+	stats    *metrics.DestinationStats
+}
+
+// Init initializes the syslogEventPrinter by parsing the URL and its options.
+//
+// --output syslog:udp://host:514[?option=value&...]
+// --output syslog:tcp://host:601[?option=value&...]
+// --output syslog:tcp+tls://host:6514[?option=value&...]
+// --output syslog:unix:///dev/log[?option=value&...]
+func (p *syslogEventPrinter) Init() error {
+	u, err := url.Parse(p.outPath)
+	if err != nil {
+		return errfmt.Errorf("unable to parse URL %q: %v", p.outPath, err)
+	}
+	parameters := u.Query()
+
+	switch u.Scheme {
+	case "udp", "tcp", "tcp+tls":
+		if u.Host == "" {
+			return errfmt.Errorf("missing server address for syslog destination")
+		}
+		p.network = strings.TrimSuffix(u.Scheme, "+tls")
+		p.address = u.Host
+	case "unix":
+		if u.Path == "" {
+			return errfmt.Errorf("missing socket path for syslog destination")
+		}
+		p.network = "unix"
+		p.address = u.Path
+	default:
+		return errfmt.Errorf("unsupported protocol for syslog destination: %s", u.Scheme)
+	}
+
+	if u.Scheme == "tcp+tls" {
+		if p.tlsConfig, err = clientTLSConfig(parameters, "syslog"); err != nil {
+			return err
+		}
+		if p.tlsConfig.ServerName == "" {
+			p.tlsConfig.ServerName = u.Hostname()
+		}
+	}
+
+	p.framing = getParameterValue(parameters, "framing", syslogFramingOctetCounting)
+	switch p.framing {
+	case syslogFramingOctetCounting, syslogFramingNonTransparent:
+	default:
+		return errfmt.Errorf("invalid syslog framing value %q, expected %s or %s",
+			p.framing, syslogFramingOctetCounting, syslogFramingNonTransparent)
+	}
+
+	if p.timeout, err = getDurationParameter(parameters, "timeout", "10s"); err != nil {
+		return err
+	}
+
+	facility := getParameterValue(parameters, "facility", "local0")
+	code, ok := syslogFacilities[facility]
+	if !ok {
+		code, err = strconv.Atoi(facility)
+		if err != nil || code < 0 || code > 23 {
+			return errfmt.Errorf("invalid syslog facility value %q, expected a facility name or a number from 0 to 23", facility)
+		}
+	}
+	p.facility = code
+
+	hostname, err := os.Hostname()
+	if err != nil {
+		return errfmt.Errorf("unable to get the hostname for the syslog messages: %v", err)
+	}
+	p.hostname = syslogHeaderField(getParameterValue(parameters, "hostname", hostname), 255)
+	p.appName = syslogHeaderField(getParameterValue(parameters, "appName", "tracee"), 48)
+	p.procID = strconv.Itoa(os.Getpid())
+
+	switch {
+	case p.format == "" || p.format == "json":
+	case strings.HasPrefix(p.format, "gotemplate="):
+		gotemplate := strings.TrimPrefix(p.format, "gotemplate=")
+		tmpl, err := template.New(filepath.Base(gotemplate)).
+			Funcs(sprig.TxtFuncMap()).
+			ParseFiles(gotemplate)
+		if err != nil {
+			return errfmt.WrapError(err)
+		}
+		p.templateObj = tmpl
+	default:
+		if p.formatter, err = newSIEMFormatter(p.format); err != nil {
+			return err
+		}
+	}
+
+	p.stats = metrics.NewDestinationStats(p.name, p.Kind())
+
+	return nil
+}
+
+// Preamble prints the preamble for the syslog format (no-op).
+func (p *syslogEventPrinter) Preamble() {}
+
+// Print sends a single event as a syslog message.
+func (p *syslogEventPrinter) Print(event *pb.Event) {
+	payload, err := p.payload(event)
+	if err != nil {
+		logger.Errorw("Error formatting event for syslog", "destination", p.name, "error", err)
+		_ = p.stats.Failed.Increment()
+		return
+	}
+	message := p.message(event, payload)
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	// A connection broken since the last event only fails on write, so the message is
+	// sent again once over a new connection
+	for attempt := 0; ; attempt++ {
+		err = p.write(message)
+		if err == nil {
+			_ = p.stats.Delivered.Increment()
+			return
+		}
+		p.disconnect()
+		if attempt > 0 {
+			break
+		}
+	}
+
+	logger.Errorw("Error sending event to syslog", "destination", p.name, "address", p.address, "error", err)
+	_ = p.stats.Failed.Increment()
+}
+
+// payload returns the MSG part of the syslog message of an event
+func (p *syslogEventPrinter) payload(event *pb.Event) ([]byte, error) {
+	switch {
+	case p.formatter != nil:
+		return []byte(p.formatter.Format(event)), nil
+	case p.templateObj != nil:
+		buf := bytes.Buffer{}
+		if err := p.templateObj.Execute(&buf, event); err != nil {
+			return nil, errfmt.WrapError(err)
+		}
+		return bytes.TrimRight(buf.Bytes(), "\r\n"), nil
+	}
+
+	return event.MarshalJSON()
+}
+
+// message returns the RFC 5424 message of an event:
+//
+// <PRI>1 TIMESTAMP HOSTNAME APP-NAME PROCID MSGID STRUCTURED-DATA MSG
+//
+// The message id is the event name, and there is no structured data.
+func (p *syslogEventPrinter) message(event *pb.Event, payload []byte) []byte {
+	timestamp := time.Now()
+	if event.GetTimestamp() != nil {
+		timestamp = event.GetTimestamp().AsTime()
+	}
+
+	var b bytes.Buffer
+	b.WriteByte('<')
+	b.WriteString(strconv.Itoa(p.facility*8 + syslogSeverity(event)))
+	b.WriteString(">1 ")
+	b.WriteString(timestamp.UTC().Format(syslogTimeFormat))
+	for _, field := range []string{p.hostname, p.appName, p.procID, syslogHeaderField(event.GetName(), 32), "-"} {
+		b.WriteByte(' ')
+		b.WriteString(field)
+	}
+	b.WriteByte(' ')
+	b.Write(payload)
+
+	return b.Bytes()
+}
+
+// frame delimits a message on stream connections, by prefixing it with its length or
+// by terminating it with a newline. Datagrams carry a single message each.
+func (p *syslogEventPrinter) frame(message []byte) []byte {
+	if p.datagram {
+		return message
+	}
+	if p.framing == syslogFramingNonTransparent {
+		return append(message, '\n')
+	}
+
+	return append([]byte(strconv.Itoa(len(message))+" "), message...)
+}
+
+// write writes a message to the server, connecting first if needed, with the mutex held
+func (p *syslogEventPrinter) write(message []byte) error {
+	if p.conn == nil {
+		conn, datagram, err := p.connect()
+		if err != nil {
+			return err
+		}
+		p.conn = conn
+		p.datagram = datagram
+	}
+
+	if err := p.conn.SetWriteDeadline(time.Now().Add(p.timeout)); err != nil {
+		return errfmt.WrapError(err)
+	}
+	if _, err := p.conn.Write(p.frame(message)); err != nil {
+		return errfmt.WrapError(err)
+	}
+
+	return nil
+}
+
+// connect connects to the server, returning whether the connection carries datagrams.
+// The unix sockets of the local syslog daemons are usually datagram sockets, stream
+// sockets being the fallback.
+func (p *syslogEventPrinter) connect() (net.Conn, bool, error) {
+	dialer := &net.Dialer{Timeout: p.timeout}
+
+	switch {
+	case p.tlsConfig != nil:
+		conn, err := tls.DialWithDialer(dialer, "tcp", p.address, p.tlsConfig)
+		return conn, false, err
+	case p.network == "unix":
+		if conn, err := dialer.Dial("unixgram", p.address); err == nil {
+			return conn, true, nil
+		}
+		conn, err := dialer.Dial("unix", p.address)
+		return conn, false, err
+	}
+
+	conn, err := dialer.Dial(p.network, p.address)
+	return conn, p.network == "udp", err
+}
+
+// disconnect closes the connection to the server, with the mutex held
+func (p *syslogEventPrinter) disconnect() {
+	if p.conn != nil {
+		_ = p.conn.Close()
+		p.conn = nil
+	}
+}
+
+// Epilogue prints the epilogue for the syslog format (no-op).
+func (p *syslogEventPrinter) Epilogue(stats metrics.Stats) {}
+
+// FromStream receives events from the stream and sends them to syslog.
+func (p *syslogEventPrinter) FromStream(stream *streams.Stream) {
+	consumeFromStream(stream, p)
+}
+
+// Kind returns the kind of the syslogEventPrinter.
+func (p *syslogEventPrinter) Kind() string {
+	return "syslog"
+}
+
+// Close closes the connection to the server.
+func (p *syslogEventPrinter) Close() {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	p.disconnect()
+}
+
+// syslogSeverity maps the threat severity to the syslog severity. Events without a
+// threat are informational.
+func syslogSeverity(event *pb.Event) int {
+	switch event.GetThreat().GetSeverity() {
+	case pb.Severity_LOW:
+		return 5 // notice
+	case pb.Severity_MEDIUM:
+		return 4 // warning
+	case pb.Severity_HIGH:
+		return 3 // error
+	case pb.Severity_CRITICAL:
+		return 2 // critical
+	}
+
+	return 6 // informational
+}
+
+// syslogHeaderField returns a header field of at most max printable ASCII characters,
+// replacing the other characters by underscores, or the nil value if empty
+func syslogHeaderField(value string, maxLen int) string {
+	if value == "" {
+		return "-"
+	}
+
+	field := []byte(value)
+	for i, c := range field {
+		if c < 33 || c > 126 {
+			field[i] = '_'
+		}
+	}
+	if len(field) > maxLen {
+		field = field[:maxLen]
+	}
+
+	return string(field)
+}
@@ -0,0 +1,213 @@
+package printer
+
+import (
+	"bufio"
+	"crypto/tls"
+	"encoding/pem"
+	"io"
+	"net"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/aquasecurity/tracee/api/v1beta1"
+)
+
+// readOctetCounted reads a message framed by its length
+func readOctetCounted(t *testing.T, r *bufio.Reader) string {
+	length, err := r.ReadString(' ')
+	require.NoError(t, err)
+	n, err := strconv.Atoi(strings.TrimSuffix(length, " "))
+	require.NoError(t, err)
+
+	message := make([]byte, n)
+	_, err = io.ReadFull(r, message)
+	require.NoError(t, err)
+
+	return string(message)
+}
+
+// acceptOne accepts a single connection, returning a reader of its data
+func acceptOne(t *testing.T, listener net.Listener) *bufio.Reader {
+	conn, err := listener.Accept()
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+
+	return bufio.NewReader(conn)
+}
+
+func TestSyslogEventPrinter_UDP(t *testing.T) {
+	t.Parallel()
+
+	server, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer server.Close()
+
+	p := &syslogEventPrinter{name: "test", format: "cef",
+		outPath: "udp://" + server.LocalAddr().String() + "?facility=auth&hostname=node%201"}
+	require.NoError(t, p.Init())
+	defer p.Close()
+
+	p.Print(&pb.Event{
+		Name:      "anti_debugging",
+		Timestamp: timestamppb.New(time.Unix(10, 123456789)),
+		Threat:    &pb.Threat{Name: "Anti-Debugging", Severity: pb.Severity_CRITICAL},
+	})
+
+	buf := make([]byte, 64*1024)
+	require.NoError(t, server.SetReadDeadline(time.Now().Add(5*time.Second)))
+	n, _, err := server.ReadFrom(buf)
+	require.NoError(t, err)
+
+	// auth (4) * 8 + critical (2)
+	prefix := "<34>1 1970-01-01T00:00:10.123456Z node_1 tracee " + strconv.Itoa(os.Getpid()) + " anti_debugging - CEF:0|"
+	assert.True(t, strings.HasPrefix(string(buf[:n]), prefix), string(buf[:n]))
+	assert.Contains(t, string(buf[:n]), "|anti_debugging|Anti-Debugging|10|")
+	assert.Equal(t, uint64(1), p.stats.Delivered.Get())
+}
+
+func TestSyslogEventPrinter_TCP(t *testing.T) {
+	t.Parallel()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+
+	p := &syslogEventPrinter{name: "test", outPath: "tcp://" + listener.Addr().String()}
+	require.NoError(t, p.Init())
+	defer p.Close()
+
+	p.Print(&pb.Event{Name: "e0"})
+	p.Print(&pb.Event{Name: "e1"})
+
+	r := acceptOne(t, listener)
+	message := readOctetCounted(t, r)
+	// local0 (16) * 8 + informational (6)
+	assert.True(t, strings.HasPrefix(message, "<134>1 "), message)
+	assert.Contains(t, message, ` e0 - {`)
+	assert.Contains(t, readOctetCounted(t, r), ` e1 - {`)
+	assert.Equal(t, uint64(2), p.stats.Delivered.Get())
+}
+
+func TestSyslogEventPrinter_TLS(t *testing.T) {
+	t.Parallel()
+
+	// The test server certificate is valid for 127.0.0.1
+	server := httptest.NewUnstartedServer(nil)
+	server.StartTLS()
+	defer server.Close()
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	ca := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	require.NoError(t, os.WriteFile(caFile, ca, 0o600))
+
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", &tls.Config{Certificates: server.TLS.Certificates})
+	require.NoError(t, err)
+	defer listener.Close()
+
+	p := &syslogEventPrinter{name: "test", format: "leef", outPath: "tcp+tls://" + listener.Addr().String() +
+		"?tlsCA=" + caFile + "&framing=non-transparent"}
+	require.NoError(t, p.Init())
+	defer p.Close()
+
+	go p.Print(&pb.Event{Name: "e0"})
+
+	line, err := acceptOne(t, listener).ReadString('\n')
+	require.NoError(t, err)
+	assert.Contains(t, line, " e0 - LEEF:1.0|Aqua Security|Tracee|")
+	assert.True(t, strings.HasSuffix(line, "\n"))
+}
+
+func TestSyslogEventPrinter_Unix(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "log.sock")
+	server, err := net.ListenPacket("unixgram", path)
+	require.NoError(t, err)
+	defer server.Close()
+
+	p := &syslogEventPrinter{name: "test", outPath: "unix://" + path}
+	require.NoError(t, p.Init())
+	defer p.Close()
+
+	p.Print(&pb.Event{Name: "e0"})
+
+	buf := make([]byte, 64*1024)
+	require.NoError(t, server.SetReadDeadline(time.Now().Add(5*time.Second)))
+	n, _, err := server.ReadFrom(buf)
+	require.NoError(t, err)
+	// Datagrams aren't framed
+	assert.True(t, strings.HasPrefix(string(buf[:n]), "<134>1 "), string(buf[:n]))
+}
+
+func TestSyslogEventPrinter_Failures(t *testing.T) {
+	t.Parallel()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := listener.Addr().String()
+
+	p := &syslogEventPrinter{name: "test", outPath: "tcp://" + addr + "?timeout=1s"}
+	require.NoError(t, p.Init())
+	defer p.Close()
+
+	// The connection is reestablished after the server closed it. Writes to a closed
+	// connection may still succeed until the peer resets it.
+	p.Print(&pb.Event{Name: "e0"})
+	conn, err := listener.Accept()
+	require.NoError(t, err)
+	require.NoError(t, conn.Close())
+
+	accepted := make(chan *bufio.Reader)
+	go func() { accepted <- acceptOne(t, listener) }()
+	var r *bufio.Reader
+	require.Eventually(t, func() bool {
+		p.Print(&pb.Event{Name: "e1"})
+		select {
+		case r = <-accepted:
+			return true
+		default:
+			return false
+		}
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Contains(t, readOctetCounted(t, r), ` e1 - {`)
+	assert.Equal(t, uint64(0), p.stats.Failed.Get())
+
+	// An unreachable server fails the events
+	require.NoError(t, listener.Close())
+	p.mutex.Lock()
+	p.disconnect()
+	p.mutex.Unlock()
+	p.Print(&pb.Event{Name: "e2"})
+	assert.Equal(t, uint64(1), p.stats.Failed.Get())
+}
+
+func TestSyslogEventPrinter_InvalidOptions(t *testing.T) {
+	t.Parallel()
+
+	for _, outPath := range []string{
+		"http://localhost:514",
+		"udp://",
+		"unix://",
+		"udp://localhost:514?facility=local8",
+		"udp://localhost:514?facility=24",
+		"tcp://localhost:514?framing=lines",
+		"tcp://localhost:514?timeout=soon",
+		"tcp+tls://localhost:6514?tlsCA=/nonexistent/ca.pem",
+	} {
+		p := &syslogEventPrinter{outPath: outPath}
+		assert.Error(t, p.Init(), outPath)
+	}
+
+	p := &syslogEventPrinter{outPath: "udp://localhost:514", format: "xml"}
+	assert.Error(t, p.Init())
+}
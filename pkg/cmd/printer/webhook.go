@@ -0,0 +1,689 @@
+package printer
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"text/template"
+	"time"
+
+	"github.com/Masterminds/sprig/v3"
+
+	pb "github.com/aquasecurity/tracee/api/v1beta1"
+	"github.com/aquasecurity/tracee/common/errfmt"
+	"github.com/aquasecurity/tracee/common/logger"
+	"github.com/aquasecurity/tracee/pkg/metrics"
+	"github.com/aquasecurity/tracee/pkg/streams"
+)
+
+const (
+	webhookBatchNDJSON = "ndjson"
+	webhookBatchArray  = "array"
+
+	// batches waiting in memory for the sender before being spilled to the disk queue
+	webhookPendingBatches = 64
+)
+
+// webhookOptions are the URL query parameters configuring the webhook destination.
+// They are removed from the URL the events are sent to.
+var webhookOptions = []string{
+	"timeout", "contentType", "gotemplate",
+	"batchSize", "batchTimeout", "batchFormat",
+	"retries", "retryBackoff", "retryMaxBackoff",
+	"queueDir", "queueMaxSize",
+	"header", "headersFile", "tokenFile",
+}
+
+// webhookEventPrinter sends events to a webhook endpoint via HTTP POST.
+//
+// Events are sent in batches by a background sender, retrying failed requests with an
+// exponential backoff. When a disk queue is configured, batches the sender can't keep up
+// with or failed to deliver are stored on disk and delivered once the endpoint recovers.
+type webhookEventPrinter struct {
+	name        string
+	outPath     string
+	url         *url.URL
+	timeout     time.Duration
+	format      string
+	templateObj *template.Template
+	contentType string
+
+	batchSize       int
+	batchTimeout    time.Duration
+	batchFormat     string
+	retries         int
+	retryBackoff    time.Duration
+	retryMaxBackoff time.Duration
+	headers         http.Header
+	tokenFile       string
+
+	client *http.Client
+	queue  *diskQueue // nil if events are only queued in memory
+	stats  *metrics.DestinationStats
+	// events in memory: in the current batch, waiting for the sender or being sent
+	pending atomic.Int64
+
+	mutex   sync.Mutex
+	batch   [][]byte
+	closed  bool
+	batches chan [][]byte
+
+	closing     chan struct{}
+	flusherDone chan struct{}
+	senderDone  chan struct{}
+	closeOnce   sync.Once
+}
+
+// Init initializes the webhookEventPrinter by parsing the URL and its options, and starts
+// sending events.
+func (ws *webhookEventPrinter) Init() error {
+	u, err := url.Parse(ws.outPath)
+	if err != nil {
+		return errfmt.Errorf("unable to parse URL %q: %v", ws.outPath, err)
+	}
+
+	parameters, _ := url.ParseQuery(u.RawQuery)
+	query := u.Query()
+	for _, option := range webhookOptions {
+		query.Del(option)
+	}
+	u.RawQuery = query.Encode()
+	ws.url = u
+
+	if ws.timeout, err = getDurationParameter(parameters, "timeout", "10s"); err != nil {
+		return err
+	}
+
+	if gotemplate, ok := strings.CutPrefix(ws.format, "gotemplate="); ok {
+		tmpl, err := template.New(filepath.Base(gotemplate)).
+			Funcs(sprig.TxtFuncMap()).
+			ParseFiles(gotemplate)
+
+		if err != nil {
+			return errfmt.WrapError(err)
+		}
+		ws.templateObj = tmpl
+	}
+
+	if err := ws.parseBatchOptions(parameters); err != nil {
+		return err
+	}
+	if err := ws.parseRetryOptions(parameters); err != nil {
+		return err
+	}
+	if err := ws.parseHeaderOptions(parameters); err != nil {
+		return err
+	}
+
+	ws.stats = metrics.NewDestinationStats(ws.name, ws.Kind())
+
+	if queueDir := getParameterValue(parameters, "queueDir", ""); queueDir != "" {
+		maxSize := getParameterValue(parameters, "queueMaxSize", "100")
+		megabytes, err := strconv.ParseInt(maxSize, 10, 64)
+		if err != nil || megabytes <= 0 {
+			return errfmt.Errorf("invalid webhook queueMaxSize value %q, expected a positive number of megabytes", maxSize)
+		}
+		ws.queue, err = newDiskQueue(queueDir, megabytes<<20)
+		if err != nil {
+			return err
+		}
+		if queued := ws.queue.Events(); queued > 0 {
+			logger.Infow("Delivering events queued by a previous run", "destination", ws.name, "events", queued)
+		}
+		ws.updateQueued()
+	}
+
+	ws.client = &http.Client{Timeout: ws.timeout}
+	ws.batches = make(chan [][]byte, webhookPendingBatches)
+	ws.closing = make(chan struct{})
+	ws.flusherDone = make(chan struct{})
+	ws.senderDone = make(chan struct{})
+
+	go ws.runFlusher()
+	go ws.runSender()
+
+	return nil
+}
+
+// parseBatchOptions parses how events are grouped in requests
+func (ws *webhookEventPrinter) parseBatchOptions(parameters url.Values) error {
+	batchSize := getParameterValue(parameters, "batchSize", "1")
+	size, err := strconv.Atoi(batchSize)
+	if err != nil || size <= 0 {
+		return errfmt.Errorf("invalid webhook batchSize value %q, expected a positive number", batchSize)
+	}
+	ws.batchSize = size
+
+	if ws.batchTimeout, err = getDurationParameter(parameters, "batchTimeout", "1s"); err != nil {
+		return err
+	}
+
+	// A single event is sent as is, unless a batch format is requested
+	ws.batchFormat = getParameterValue(parameters, "batchFormat", "")
+	if ws.batchFormat == "" && ws.batchSize > 1 {
+		ws.batchFormat = webhookBatchNDJSON
+	}
+	switch ws.batchFormat {
+	case "", webhookBatchArray:
+		ws.contentType = getParameterValue(parameters, "contentType", "application/json")
+	case webhookBatchNDJSON:
+		ws.contentType = getParameterValue(parameters, "contentType", "application/x-ndjson")
+	default:
+		return errfmt.Errorf("invalid webhook batchFormat value %q, expected %s or %s", ws.batchFormat, webhookBatchNDJSON, webhookBatchArray)
+	}
+
+	return nil
+}
+
+// parseRetryOptions parses how failed requests are retried
+func (ws *webhookEventPrinter) parseRetryOptions(parameters url.Values) error {
+	retries := getParameterValue(parameters, "retries", "3")
+	n, err := strconv.Atoi(retries)
+	if err != nil || n < 0 {
+		return errfmt.Errorf("invalid webhook retries value %q, expected a non negative number", retries)
+	}
+	ws.retries = n
+
+	if ws.retryBackoff, err = getDurationParameter(parameters, "retryBackoff", "1s"); err != nil {
+		return err
+	}
+	if ws.retryMaxBackoff, err = getDurationParameter(parameters, "retryMaxBackoff", "30s"); err != nil {
+		return err
+	}
+	if ws.retryMaxBackoff < ws.retryBackoff {
+		ws.retryMaxBackoff = ws.retryBackoff
+	}
+
+	return nil
+}
+
+// parseHeaderOptions parses the headers added to the requests, given as Name:Value
+// parameters or read from a file with a Name: Value header per line
+func (ws *webhookEventPrinter) parseHeaderOptions(parameters url.Values) error {
+	ws.headers = http.Header{}
+
+	if headersFile := getParameterValue(parameters, "headersFile", ""); headersFile != "" {
+		f, err := os.Open(headersFile)
+		if err != nil {
+			return errfmt.Errorf("unable to open webhook headers file: %v", err)
+		}
+		defer func() { _ = f.Close() }()
+
+		scanner := bufio.NewScanner(f)
+		for scanner.Scan() {
+			line := strings.TrimSpace(scanner.Text())
+			if line == "" || strings.HasPrefix(line, "#") {
+				continue
+			}
+			if err := addHeader(ws.headers, line); err != nil {
+				return errfmt.Errorf("invalid webhook headers file %s: %v", headersFile, err)
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			return errfmt.Errorf("unable to read webhook headers file: %v", err)
+		}
+	}
+
+	for _, header := range parameters["header"] {
+		if err := addHeader(ws.headers, header); err != nil {
+			return errfmt.Errorf("invalid webhook header: %v", err)
+		}
+	}
+
+	// The token is read on every request, so it can be rotated
+	ws.tokenFile = getParameterValue(parameters, "tokenFile", "")
+	if ws.tokenFile != "" {
+		if _, err := readToken(ws.tokenFile); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Preamble prints the preamble for the webhook format (no-op).
+func (ws *webhookEventPrinter) Preamble() {}
+
+// Print adds a single event to the batch sent to the webhook endpoint.
+func (ws *webhookEventPrinter) Print(event *pb.Event) {
+	var (
+		payload []byte
+		err     error
+	)
+
+	if ws.templateObj != nil {
+		buf := bytes.Buffer{}
+		if err := ws.templateObj.Execute(&buf, event); err != nil {
+			logger.Errorw("error writing to the template", "error", err)
+			return
+		}
+		payload = buf.Bytes()
+	} else {
+		payload, err = event.MarshalJSON()
+		if err != nil {
+			logger.Errorw("Error marshalling event", "error", err)
+			return
+		}
+	}
+
+	ws.mutex.Lock()
+	defer ws.mutex.Unlock()
+
+	if ws.closed {
+		logger.Errorw("Error sending webhook, destination is closed", "destination", ws.name)
+		_ = ws.stats.Failed.Increment()
+		return
+	}
+
+	ws.pending.Add(1)
+	ws.updateQueued()
+
+	ws.batch = append(ws.batch, payload)
+	if len(ws.batch) >= ws.batchSize {
+		ws.enqueue(ws.takeBatch())
+	}
+}
+
+// Epilogue prints the epilogue for the webhook format (no-op).
+func (ws *webhookEventPrinter) Epilogue(stats metrics.Stats) {}
+
+// FromStream receives events from the stream and sends them to the webhook endpoint.
+func (ws *webhookEventPrinter) FromStream(stream *streams.Stream) {
+	consumeFromStream(stream, ws)
+}
+
+// Kind returns the kind of the webhookEventPrinter.
+func (ws *webhookEventPrinter) Kind() string {
+	return "webhook"
+}
+
+// Close sends the pending events and stops the webhookEventPrinter. Events that can't be
+// delivered right away are kept in the disk queue, if any, for the next run.
+func (ws *webhookEventPrinter) Close() {
+	ws.closeOnce.Do(func() {
+		close(ws.closing)
+		<-ws.flusherDone
+
+		ws.mutex.Lock()
+		ws.closed = true
+		if len(ws.batch) > 0 {
+			ws.enqueue(ws.takeBatch())
+		}
+		close(ws.batches)
+		ws.mutex.Unlock()
+
+		<-ws.senderDone
+	})
+}
+
+// takeBatch returns the current batch and starts a new one, with the mutex held
+func (ws *webhookEventPrinter) takeBatch() [][]byte {
+	batch := ws.batch
+	ws.batch = nil
+	return batch
+}
+
+// enqueue hands a batch to the sender, with the mutex held. Without a disk queue, it
+// blocks while the sender is busy, slowing down the stream.
+func (ws *webhookEventPrinter) enqueue(batch [][]byte) {
+	if ws.queue == nil {
+		ws.batches <- batch
+		return
+	}
+
+	// Once batches are queued on disk, the following ones are queued after them,
+	// so events are delivered in order
+	if ws.queue.Len() == 0 {
+		select {
+		case ws.batches <- batch:
+			return
+		default:
+		}
+	}
+
+	ws.spill(batch, false)
+}
+
+// spill moves a batch from memory to the disk queue, failing it if the queue is full
+func (ws *webhookEventPrinter) spill(batch [][]byte, front bool) {
+	var err error
+	if front {
+		err = ws.queue.PushFront(batch)
+	} else {
+		err = ws.queue.PushBack(batch)
+	}
+	if err != nil {
+		logger.Errorw("Error queueing webhook events on disk", "destination", ws.name, "events", len(batch), "error", err)
+		ws.failed(batch, false)
+		return
+	}
+
+	ws.pending.Add(-int64(len(batch)))
+	ws.updateQueued()
+}
+
+// runFlusher hands the current batch to the sender when it waited for batchTimeout
+func (ws *webhookEventPrinter) runFlusher() {
+	defer close(ws.flusherDone)
+
+	ticker := time.NewTicker(ws.batchTimeout)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ws.closing:
+			return
+		case <-ticker.C:
+			ws.mutex.Lock()
+			if len(ws.batch) > 0 {
+				ws.enqueue(ws.takeBatch())
+			}
+			ws.mutex.Unlock()
+		}
+	}
+}
+
+// runSender delivers the batches, the ones in memory first as they are older than the
+// ones queued on disk, until the printer is closed
+func (ws *webhookEventPrinter) runSender() {
+	defer close(ws.senderDone)
+
+	for {
+		select {
+		case batch, ok := <-ws.batches:
+			if !ok {
+				return
+			}
+			ws.deliver(batch)
+			continue
+		default:
+		}
+
+		if ws.queue != nil && ws.queue.Len() > 0 && !ws.isClosing() {
+			if !ws.deliverQueued() {
+				// The endpoint is still unavailable, try again later
+				ws.sleep(ws.retryMaxBackoff)
+			}
+			continue
+		}
+
+		batch, ok := <-ws.batches
+		if !ok {
+			return
+		}
+		ws.deliver(batch)
+	}
+}
+
+// deliver sends a batch from memory. If it can't be delivered, it is queued on disk
+// before the batches waiting in memory, to keep the events in order.
+func (ws *webhookEventPrinter) deliver(batch [][]byte) {
+	retryable, err := ws.send(batch)
+	if err == nil {
+		ws.delivered(batch, false)
+		return
+	}
+
+	if !retryable || ws.queue == nil {
+		logger.Errorw("Error sending webhook", "destination", ws.name, "events", len(batch), "error", err)
+		ws.failed(batch, false)
+		return
+	}
+
+	logger.Warnw("Webhook endpoint unavailable, queueing events on disk", "destination", ws.name, "error", err)
+
+	// Block Print while moving the batches, so no new batch is handed over in the meantime
+	ws.mutex.Lock()
+	defer ws.mutex.Unlock()
+
+	var waiting [][][]byte
+	for done := false; !done; {
+		select {
+		case b, ok := <-ws.batches:
+			if !ok {
+				done = true
+				break
+			}
+			waiting = append(waiting, b)
+		default:
+			done = true
+		}
+	}
+	for i := len(waiting) - 1; i >= 0; i-- {
+		ws.spill(waiting[i], true)
+	}
+	ws.spill(batch, true)
+}
+
+// deliverQueued sends the oldest batch of the disk queue, returning false if the
+// endpoint is unavailable
+func (ws *webhookEventPrinter) deliverQueued() bool {
+	batch, err := ws.queue.Peek()
+	if err != nil {
+		logger.Errorw("Error reading webhook events queued on disk, dropping them", "destination", ws.name, "error", err)
+		ws.updateQueued()
+		return true
+	}
+	if batch == nil {
+		return true
+	}
+
+	retryable, err := ws.send(batch)
+	switch {
+	case err == nil:
+		ws.queue.Pop()
+		ws.delivered(batch, true)
+	case retryable:
+		return false
+	default:
+		logger.Errorw("Error sending webhook", "destination", ws.name, "events", len(batch), "error", err)
+		ws.queue.Pop()
+		ws.failed(batch, true)
+	}
+
+	return true
+}
+
+// send posts a batch, retrying with an exponential backoff, or the delay asked by the
+// endpoint (up to the maximum backoff), while the failure is retryable. Retries stop
+// when the printer is closed, so closing never waits for an unavailable endpoint.
+func (ws *webhookEventPrinter) send(batch [][]byte) (bool, error) {
+	body := ws.encode(batch)
+	backoff := ws.retryBackoff
+
+	for attempt := 0; ; attempt++ {
+		retryAfter, retryable, err := ws.post(body)
+		if err == nil || !retryable || attempt >= ws.retries {
+			return retryable, err
+		}
+
+		wait := min(max(backoff, retryAfter), ws.retryMaxBackoff)
+		backoff = min(backoff*2, ws.retryMaxBackoff)
+
+		logger.Debugw("Retrying webhook", "destination", ws.name, "attempt", attempt+1, "wait", wait, "error", err)
+		if !ws.sleep(wait) {
+			return retryable, err
+		}
+	}
+}
+
+// post sends a request, returning whether a failure is worth retrying, and after how
+// long if the endpoint asked for it
+func (ws *webhookEventPrinter) post(body []byte) (time.Duration, bool, error) {
+	req, err := http.NewRequest(http.MethodPost, ws.url.String(), bytes.NewReader(body))
+	if err != nil {
+		return 0, false, errfmt.WrapError(err)
+	}
+
+	req.Header = ws.headers.Clone()
+	req.Header.Set("Content-Type", ws.contentType)
+	if ws.tokenFile != "" {
+		token, err := readToken(ws.tokenFile)
+		if err != nil {
+			return 0, true, err
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := ws.client.Do(req)
+	if err != nil {
+		return 0, true, errfmt.WrapError(err)
+	}
+	// Drain the body so the connection can be reused
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+	_ = resp.Body.Close()
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return 0, false, nil
+	}
+
+	err = fmt.Errorf("http status: %d", resp.StatusCode)
+	switch {
+	case resp.StatusCode == http.StatusRequestTimeout,
+		resp.StatusCode == http.StatusTooManyRequests,
+		resp.StatusCode >= 500:
+		return parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()), true, err
+	}
+
+	return 0, false, err
+}
+
+// encode returns the request body of a batch
+func (ws *webhookEventPrinter) encode(batch [][]byte) []byte {
+	if ws.batchFormat == "" && len(batch) == 1 {
+		return batch[0]
+	}
+
+	if ws.batchFormat == webhookBatchArray {
+		var buf bytes.Buffer
+		buf.WriteByte('[')
+		for i, payload := range batch {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			buf.Write(payload)
+		}
+		buf.WriteByte(']')
+		return buf.Bytes()
+	}
+
+	var buf bytes.Buffer
+	for _, payload := range batch {
+		buf.Write(bytes.TrimRight(payload, "\n"))
+		buf.WriteByte('\n')
+	}
+	return buf.Bytes()
+}
+
+// delivered accounts for a delivered batch
+func (ws *webhookEventPrinter) delivered(batch [][]byte, fromDisk bool) {
+	_ = ws.stats.Delivered.Increment(uint64(len(batch)))
+	if !fromDisk {
+		ws.pending.Add(-int64(len(batch)))
+	}
+	ws.updateQueued()
+}
+
+// failed accounts for a batch that won't be delivered
+func (ws *webhookEventPrinter) failed(batch [][]byte, fromDisk bool) {
+	_ = ws.stats.Failed.Increment(uint64(len(batch)))
+	if !fromDisk {
+		ws.pending.Add(-int64(len(batch)))
+	}
+	ws.updateQueued()
+}
+
+// updateQueued updates the queued events stat
+func (ws *webhookEventPrinter) updateQueued() {
+	queued := ws.pending.Load()
+	if ws.queue != nil {
+		queued += int64(ws.queue.Events())
+	}
+	ws.stats.Queued.Set(uint64(max(queued, 0)))
+}
+
+func (ws *webhookEventPrinter) isClosing() bool {
+	select {
+	case <-ws.closing:
+		return true
+	default:
+		return false
+	}
+}
+
+// sleep waits for the given duration, returning false if the printer was closed meanwhile
+func (ws *webhookEventPrinter) sleep(d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return true
+	case <-ws.closing:
+		return false
+	}
+}
+
+// getDurationParameter returns the duration value of a parameter
+func getDurationParameter(parameters url.Values, key string, defaultValue string) (time.Duration, error) {
+	value := getParameterValue(parameters, key, defaultValue)
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, errfmt.Errorf("unable to convert %s value %q: %v", key, value, err)
+	}
+
+	return d, nil
+}
+
+// addHeader adds a Name:Value header
+func addHeader(headers http.Header, header string) error {
+	name, value, found := strings.Cut(header, ":")
+	name = strings.TrimSpace(name)
+	if !found || name == "" {
+		return fmt.Errorf("%q is not a Name:Value header", header)
+	}
+	headers.Add(name, strings.TrimSpace(value))
+
+	return nil
+}
+
+// readToken reads a bearer token from a file
+func readToken(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", errfmt.Errorf("unable to read webhook token file: %v", err)
+	}
+
+	token := strings.TrimSpace(string(data))
+	if token == "" {
+		return "", errfmt.Errorf("webhook token file %s is empty", path)
+	}
+
+	return token, nil
+}
+
+// parseRetryAfter parses a Retry-After header, given in seconds or as an HTTP date
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	if value == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(max(seconds, 0)) * time.Second
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		return max(date.Sub(now), 0)
+	}
+
+	return 0
+}
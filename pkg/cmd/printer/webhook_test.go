@@ -0,0 +1,426 @@
+package printer
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/aquasecurity/tracee/api/v1beta1"
+)
+
+// webhookServer records the requests it receives, answering with the given statuses
+// before answering 200
+type webhookServer struct {
+	*httptest.Server
+	mutex    sync.Mutex
+	statuses []int
+	bodies   []string
+	requests []*http.Request
+}
+
+func newWebhookServer(t *testing.T, statuses ...int) *webhookServer {
+	s := &webhookServer{statuses: statuses}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+
+		s.mutex.Lock()
+		defer s.mutex.Unlock()
+
+		s.requests = append(s.requests, r)
+		if len(s.statuses) > 0 {
+			status := s.statuses[0]
+			s.statuses = s.statuses[1:]
+			w.WriteHeader(status)
+			return
+		}
+		s.bodies = append(s.bodies, string(body))
+	}))
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+func (s *webhookServer) delivered() []string {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return append([]string(nil), s.bodies...)
+}
+
+func (s *webhookServer) received() []*http.Request {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return append([]*http.Request(nil), s.requests...)
+}
+
+func newTestWebhookPrinter(t *testing.T, rawURL string) *webhookEventPrinter {
+	ws := &webhookEventPrinter{name: "test", outPath: rawURL, format: "json"}
+	require.NoError(t, ws.Init())
+
+	return ws
+}
+
+func TestWebhookEventPrinter_Batching(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		options     string
+		contentType string
+		decode      func(t *testing.T, body string) []string
+	}{
+		{
+			name:        "single events",
+			options:     "",
+			contentType: "application/json",
+			decode: func(t *testing.T, body string) []string {
+				return []string{eventName(t, body)}
+			},
+		},
+		{
+			name:        "ndjson batches",
+			options:     "batchSize=2",
+			contentType: "application/x-ndjson",
+			decode: func(t *testing.T, body string) []string {
+				var names []string
+				for _, line := range strings.Split(strings.TrimSuffix(body, "\n"), "\n") {
+					names = append(names, eventName(t, line))
+				}
+				return names
+			},
+		},
+		{
+			name:        "array batches",
+			options:     "batchSize=2&batchFormat=array",
+			contentType: "application/json",
+			decode: func(t *testing.T, body string) []string {
+				var events []json.RawMessage
+				require.NoError(t, json.Unmarshal([]byte(body), &events))
+				var names []string
+				for _, event := range events {
+					names = append(names, eventName(t, string(event)))
+				}
+				return names
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := newWebhookServer(t)
+			ws := newTestWebhookPrinter(t, server.URL+"?batchTimeout=1h&"+tc.options)
+
+			for _, name := range []string{"e0", "e1", "e2"} {
+				ws.Print(&pb.Event{Name: name})
+			}
+			ws.Close()
+
+			var batches [][]string
+			for _, body := range server.delivered() {
+				batches = append(batches, tc.decode(t, body))
+			}
+			if ws.batchSize == 1 {
+				assert.Equal(t, [][]string{{"e0"}, {"e1"}, {"e2"}}, batches)
+			} else {
+				assert.Equal(t, [][]string{{"e0", "e1"}, {"e2"}}, batches)
+			}
+			for _, req := range server.received() {
+				assert.Equal(t, tc.contentType, req.Header.Get("Content-Type"))
+			}
+			assert.Equal(t, uint64(3), ws.stats.Delivered.Get())
+			assert.Equal(t, uint64(0), ws.stats.Queued.Get())
+		})
+	}
+}
+
+// eventName returns the name of a JSON event
+func eventName(t *testing.T, body string) string {
+	var event struct {
+		Name string `json:"name"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(body), &event))
+
+	return event.Name
+}
+
+func TestWebhookEventPrinter_BatchTimeout(t *testing.T) {
+	t.Parallel()
+
+	server := newWebhookServer(t)
+	ws := newTestWebhookPrinter(t, server.URL+"?batchSize=100&batchTimeout=10ms")
+	defer ws.Close()
+
+	ws.Print(&pb.Event{Name: "e0"})
+
+	assert.Eventually(t, func() bool {
+		return len(server.delivered()) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestWebhookEventPrinter_Retries(t *testing.T) {
+	t.Parallel()
+
+	t.Run("retryable failures", func(t *testing.T) {
+		t.Parallel()
+
+		server := newWebhookServer(t, http.StatusServiceUnavailable, http.StatusTooManyRequests)
+		ws := newTestWebhookPrinter(t, server.URL+"?retries=2&retryBackoff=1ms")
+
+		ws.Print(&pb.Event{Name: "e0"})
+		assert.Eventually(t, func() bool {
+			return ws.stats.Delivered.Get() == 1
+		}, 5*time.Second, 10*time.Millisecond)
+		ws.Close()
+
+		assert.Len(t, server.delivered(), 1)
+		assert.Equal(t, uint64(1), ws.stats.Delivered.Get())
+		assert.Equal(t, uint64(0), ws.stats.Failed.Get())
+	})
+
+	t.Run("retries exhausted", func(t *testing.T) {
+		t.Parallel()
+
+		server := newWebhookServer(t, http.StatusBadGateway, http.StatusBadGateway)
+		ws := newTestWebhookPrinter(t, server.URL+"?retries=1&retryBackoff=1ms")
+
+		ws.Print(&pb.Event{Name: "e0"})
+		assert.Eventually(t, func() bool {
+			return ws.stats.Failed.Get() == 1
+		}, 5*time.Second, 10*time.Millisecond)
+		ws.Close()
+
+		assert.Empty(t, server.delivered())
+		assert.Equal(t, uint64(0), ws.stats.Delivered.Get())
+		assert.Equal(t, uint64(1), ws.stats.Failed.Get())
+	})
+
+	t.Run("non retryable failure", func(t *testing.T) {
+		t.Parallel()
+
+		server := newWebhookServer(t, http.StatusBadRequest)
+		ws := newTestWebhookPrinter(t, server.URL+"?retries=3&retryBackoff=1ms")
+
+		ws.Print(&pb.Event{Name: "e0"})
+		ws.Close()
+
+		assert.Empty(t, server.delivered())
+		assert.Equal(t, uint64(1), ws.stats.Failed.Get())
+	})
+
+	t.Run("retry after is capped", func(t *testing.T) {
+		t.Parallel()
+
+		server := newRetryAfterServer(t, 1)
+		ws := newTestWebhookPrinter(t, server.URL+"?retries=1&retryBackoff=1ms&retryMaxBackoff=10ms")
+
+		ws.Print(&pb.Event{Name: "e0"})
+		assert.Eventually(t, func() bool {
+			return ws.stats.Delivered.Get() == 1
+		}, 5*time.Second, 10*time.Millisecond)
+		ws.Close()
+	})
+
+	t.Run("close interrupts retries", func(t *testing.T) {
+		t.Parallel()
+
+		server := newRetryAfterServer(t, 10)
+		ws := newTestWebhookPrinter(t, server.URL+"?retries=10&retryBackoff=1h&retryMaxBackoff=1h")
+
+		ws.Print(&pb.Event{Name: "e0"})
+		assert.Eventually(t, func() bool {
+			return server.attempts() == 1
+		}, 5*time.Second, 10*time.Millisecond)
+
+		closed := make(chan struct{})
+		go func() {
+			ws.Close()
+			close(closed)
+		}()
+		select {
+		case <-closed:
+		case <-time.After(5 * time.Second):
+			t.Fatal("Close waited for the retry backoff")
+		}
+		assert.Equal(t, uint64(1), ws.stats.Failed.Get())
+	})
+}
+
+// retryAfterServer answers its first requests with a 503 asking to retry an hour later
+type retryAfterServer struct {
+	*httptest.Server
+	failures int32
+	count    atomic.Int32
+}
+
+func newRetryAfterServer(t *testing.T, failures int32) *retryAfterServer {
+	s := &retryAfterServer{failures: failures}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.Copy(io.Discard, r.Body)
+		if s.count.Add(1) <= s.failures {
+			w.Header().Set("Retry-After", "3600")
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+func (s *retryAfterServer) attempts() int32 {
+	return s.count.Load()
+}
+
+func TestWebhookEventPrinter_DiskQueue(t *testing.T) {
+	t.Parallel()
+
+	queueDir := t.TempDir()
+
+	// The endpoint is down: the events are kept on disk
+	down := newWebhookServer(t, http.StatusServiceUnavailable, http.StatusServiceUnavailable)
+	ws := newTestWebhookPrinter(t, down.URL+"?retries=0&batchSize=2&queueDir="+queueDir)
+
+	for _, name := range []string{"e0", "e1", "e2"} {
+		ws.Print(&pb.Event{Name: name})
+	}
+	ws.Close()
+
+	assert.Empty(t, down.delivered())
+	assert.Equal(t, uint64(0), ws.stats.Failed.Get())
+	assert.Equal(t, uint64(3), ws.stats.Queued.Get())
+
+	// The next run delivers them in order
+	up := newWebhookServer(t)
+	ws = newTestWebhookPrinter(t, up.URL+"?batchSize=2&queueDir="+queueDir)
+	assert.Equal(t, uint64(3), ws.stats.Queued.Get())
+
+	assert.Eventually(t, func() bool {
+		return ws.stats.Delivered.Get() == 3
+	}, 5*time.Second, 10*time.Millisecond)
+	ws.Close()
+
+	bodies := up.delivered()
+	require.Len(t, bodies, 2)
+	assert.Contains(t, bodies[0], `"e0"`)
+	assert.Contains(t, bodies[0], `"e1"`)
+	assert.Contains(t, bodies[1], `"e2"`)
+	assert.Equal(t, uint64(0), ws.stats.Queued.Get())
+}
+
+func TestWebhookEventPrinter_Headers(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	tokenFile := filepath.Join(dir, "token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("secret\n"), 0600))
+	headersFile := filepath.Join(dir, "headers")
+	require.NoError(t, os.WriteFile(headersFile, []byte("# SIEM key\nX-Api-Key: abc\n"), 0600))
+
+	server := newWebhookServer(t)
+	ws := newTestWebhookPrinter(t, server.URL+"/events?source=tracee&timeout=5s&header=X-Tenant:t1&headersFile="+headersFile+"&tokenFile="+tokenFile)
+
+	ws.Print(&pb.Event{Name: "e0"})
+	ws.Close()
+
+	requests := server.received()
+	require.Len(t, requests, 1)
+	req := requests[0]
+	assert.Equal(t, "/events", req.URL.Path)
+	assert.Equal(t, "source=tracee", req.URL.RawQuery)
+	assert.Equal(t, "application/json", req.Header.Get("Content-Type"))
+	assert.Equal(t, "Bearer secret", req.Header.Get("Authorization"))
+	assert.Equal(t, "t1", req.Header.Get("X-Tenant"))
+	assert.Equal(t, "abc", req.Header.Get("X-Api-Key"))
+}
+
+func TestWebhookEventPrinter_InvalidOptions(t *testing.T) {
+	t.Parallel()
+
+	for _, options := range []string{
+		"batchSize=0",
+		"batchFormat=xml",
+		"retries=-1",
+		"retryBackoff=soon",
+		"queueMaxSize=0&queueDir=/tmp",
+		"header=invalid",
+		"tokenFile=/nonexistent/token",
+	} {
+		ws := &webhookEventPrinter{outPath: "http://localhost/webhook?" + options, format: "json"}
+		assert.Error(t, ws.Init(), options)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, time.Duration(0), parseRetryAfter("", now))
+	assert.Equal(t, 5*time.Second, parseRetryAfter("5", now))
+	assert.Equal(t, 30*time.Second, parseRetryAfter("Thu, 01 Jan 2026 00:00:30 GMT", now))
+	assert.Equal(t, time.Duration(0), parseRetryAfter("Wed, 31 Dec 2025 00:00:00 GMT", now))
+	assert.Equal(t, time.Duration(0), parseRetryAfter("later", now))
+}
+
+func TestDiskQueue(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	q, err := newDiskQueue(dir, 1<<20)
+	require.NoError(t, err)
+
+	require.NoError(t, q.PushBack([][]byte{[]byte("b1")}))
+	require.NoError(t, q.PushBack([][]byte{[]byte("b2"), []byte("b2\nmultiline")}))
+	require.NoError(t, q.PushFront([][]byte{[]byte("b0")}))
+	assert.Equal(t, 3, q.Len())
+	assert.Equal(t, 4, q.Events())
+
+	// The queue is reloaded in order
+	q, err = newDiskQueue(dir, 1<<20)
+	require.NoError(t, err)
+	assert.Equal(t, 3, q.Len())
+
+	for _, expected := range [][]string{{"b0"}, {"b1"}, {"b2", "b2\nmultiline"}} {
+		batch, err := q.Peek()
+		require.NoError(t, err)
+		require.Len(t, batch, len(expected))
+		for i := range expected {
+			assert.Equal(t, expected[i], string(batch[i]))
+		}
+		q.Pop()
+	}
+
+	batch, err := q.Peek()
+	require.NoError(t, err)
+	assert.Nil(t, batch)
+	assert.Equal(t, 0, q.Events())
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func TestDiskQueue_Full(t *testing.T) {
+	t.Parallel()
+
+	q, err := newDiskQueue(t.TempDir(), 64)
+	require.NoError(t, err)
+
+	require.NoError(t, q.PushBack([][]byte{make([]byte, 32)}))
+	assert.ErrorIs(t, q.PushBack([][]byte{make([]byte, 32)}), errDiskQueueFull)
+	assert.Equal(t, 1, q.Len())
+}
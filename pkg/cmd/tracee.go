@@ -9,6 +9,8 @@ import (
 	"github.com/aquasecurity/tracee/pkg/config"
 	tracee "github.com/aquasecurity/tracee/pkg/ebpf"
 	"github.com/aquasecurity/tracee/pkg/ebpf/heartbeat"
+	"github.com/aquasecurity/tracee/pkg/k8s"
+	"github.com/aquasecurity/tracee/pkg/otlp"
 	"github.com/aquasecurity/tracee/pkg/server/grpc"
 	"github.com/aquasecurity/tracee/pkg/server/http"
 	"github.com/aquasecurity/tracee/pkg/streams"
@@ -18,6 +20,12 @@ type Runner struct {
 	TraceeConfig config.Config
 	HTTP         *http.Server
 	GRPC         *grpc.Server
+	// exports the metrics to an otlp collector, nil if not configured
+	MetricsExporter *otlp.MetricsExporter
+	// builds the policies created at runtime
+	PolicyBuilder grpc.PolicyBuilder
+	// applies the changes of the kubernetes policies, nil if not using them
+	PolicyWatcher *k8s.PolicyWatcher
 }
 
 func (r Runner) Run(ctx context.Context) error {
@@ -33,6 +41,13 @@ func (r Runner) Run(ctx context.Context) error {
 		func(ctx context.Context) {
 			logger.Debugw("Tracee is ready callback")
 
+			// Register Prometheus metrics if enabled, served over HTTP or exported over OTLP
+			if r.TraceeConfig.MetricsEnabled {
+				if err := t.Stats().RegisterPrometheus(); err != nil {
+					logger.Errorw("Registering prometheus metrics", "error", err)
+				}
+			}
+
 			// Start HTTP server if configured
 			if r.HTTP != nil {
 				// Enable healthz endpoint with combined readiness check
@@ -44,19 +59,23 @@ func (r Runner) Run(ctx context.Context) error {
 						return t.Running()
 					})
 				}
-				// Register Prometheus metrics if enabled
-				if r.HTTP.IsMetricsEnabled() {
-					if err := t.Stats().RegisterPrometheus(); err != nil {
-						logger.Errorw("Registering prometheus metrics", "error", err)
-					}
-				}
 				go r.HTTP.Start(ctx)
 			}
 
+			// Start exporting the metrics over OTLP if configured
+			if r.MetricsExporter != nil {
+				go r.MetricsExporter.Run(ctx)
+			}
+
 			// Start gRPC server if configured
 			if r.GRPC != nil {
 				go r.GRPC.Start(ctx, t, t.Engine())
 			}
+
+			// Apply the kubernetes policies changes live
+			if r.PolicyWatcher != nil {
+				go r.PolicyWatcher.Run(ctx, k8sPolicyApplier{tracee: t, build: r.PolicyBuilder})
+			}
 		},
 	)
 
@@ -2,6 +2,8 @@ package config
 
 import (
 	"io"
+	"strings"
+	"time"
 
 	"github.com/aquasecurity/tracee/api/v1beta1/detection"
 	"github.com/aquasecurity/tracee/common/digest"
@@ -9,6 +11,7 @@ import (
 	"github.com/aquasecurity/tracee/common/errfmt"
 	"github.com/aquasecurity/tracee/pkg/datastores/container/runtime"
 	"github.com/aquasecurity/tracee/pkg/datastores/dns"
+	"github.com/aquasecurity/tracee/pkg/datastores/ipreputation"
 	"github.com/aquasecurity/tracee/pkg/datastores/process"
 	"github.com/aquasecurity/tracee/pkg/signatures/engine"
 )
@@ -57,6 +60,7 @@ type Config struct {
 	CgroupFSForce     bool
 	EngineConfig      engine.Config
 	DNSStore          dns.Config
+	IPReputationStore ipreputation.Config
 	MetricsEnabled    bool
 	HealthzEnabled    bool
 	DetectorConfig    DetectorConfig
@@ -118,6 +122,24 @@ type ArtifactsConfig struct {
 	Mem        bool
 	Bpf        bool
 	Net        PcapsConfig
+	Storage    ArtifactsStorageConfig
+	Retention  map[string]time.Duration // per artifact type (its --artifacts option)
+	Quota      int64                    // bytes kept in the output path (0: unlimited)
+	TypeQuota  map[string]int64         // bytes kept in the output path, per artifact type
+}
+
+// ArtifactsStorageConfig is where captured artifacts are stored, besides the
+// output path. At most one storage is set.
+type ArtifactsStorageConfig struct {
+	Dir string
+	S3  S3StorageConfig
+}
+
+type S3StorageConfig struct {
+	Endpoint string
+	Bucket   string
+	Prefix   string
+	Region   string
 }
 
 type FileArtifactsConfig struct {
@@ -197,6 +219,24 @@ type Destination struct {
 	Url           string
 	File          io.WriteCloser
 	ContainerMode ContainerMode
+	Rotation      FileRotation
+}
+
+// FileRotation configures the rotation of a file destination
+type FileRotation struct {
+	// rotate when the file reaches this size in bytes
+	MaxSize int64
+	// rotate when the file is older than this
+	MaxAge time.Duration
+	// rotated files to keep, 0 keeps them all
+	MaxFiles int
+	// gzip the rotated files
+	Compress bool
+}
+
+// Enabled returns true if the file is rotated by size or age
+func (r FileRotation) Enabled() bool {
+	return r.MaxSize > 0 || r.MaxAge > 0
 }
 
 type StreamBufferMode string
@@ -207,8 +247,12 @@ const (
 )
 
 type StreamFilters struct {
-	Policies []string
-	Events   []string
+	Policies   []string
+	Events     []string
+	Expression string   // CEL condition over the event, see pkg/detectors/yaml
+	Containers []string // container IDs (or ID prefixes) and names
+	Pods       []string // kubernetes pod names
+	Namespaces []string // kubernetes namespaces
 }
 
 type StreamBuffer struct {
@@ -227,6 +271,12 @@ type Stream struct {
 type DetectorConfig struct {
 	Detectors      []detection.EventDetector // All detectors (built-in + extensions)
 	YAMLSearchDirs []string                  // Directories to search for YAML detectors
+	Config         map[string]map[string]any // Per-detector parameters, keyed by lowercase detector ID
+}
+
+// ConfigFor returns the parameters configured for the given detector ID (nil if none)
+func (c DetectorConfig) ConfigFor(detectorID string) map[string]any {
+	return c.Config[strings.ToLower(detectorID)]
 }
 
 //
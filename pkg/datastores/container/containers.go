@@ -30,7 +30,20 @@ type Container struct {
 	Name        string
 	Image       string
 	ImageDigest string
+	Labels      map[string]string
+	Annotations map[string]string
 	Pod         Pod
+	Security    Security
+}
+
+// Security is the runtime configuration of a container relevant to its isolation
+type Security struct {
+	Privileged        bool
+	AddedCapabilities []string
+	HostNetwork       bool
+	HostPID           bool
+	HostIPC           bool
+	Mounts            []runtime.Mount
 }
 
 type Pod struct {
@@ -51,6 +64,7 @@ type Manager struct {
 	enrichmentEnabled bool
 	bpfMapName        string
 	lastAccessNano    atomic.Int64 // last datastore access time (Unix nano)
+	onChange          atomic.Pointer[func()]
 }
 
 // CgroupDir represents a cgroup dir (which may be a container cgroup dir).
@@ -309,16 +323,27 @@ func (c *Manager) EnrichCgroupInfo(cgroupId uint64) (Container, error) {
 			Name:        intern.String(enrichRes.ContName),
 			Image:       intern.String(enrichRes.Image),
 			ImageDigest: intern.String(enrichRes.ImageDigest),
+			Labels:      enrichRes.Labels,
+			Annotations: enrichRes.Annotations,
 			Pod: Pod{
 				Name:      intern.String(enrichRes.PodName),
 				Namespace: intern.String(enrichRes.Namespace),
 				UID:       intern.String(enrichRes.UID),
 				Sandbox:   enrichRes.Sandbox,
 			},
+			Security: Security{
+				Privileged:        enrichRes.Privileged,
+				AddedCapabilities: enrichRes.AddedCapabilities,
+				HostNetwork:       enrichRes.HostNetwork,
+				HostPID:           enrichRes.HostPID,
+				HostIPC:           enrichRes.HostIPC,
+				Mounts:            enrichRes.Mounts,
+			},
 		}
 		c.cgroupsMap[uint32(cgroupId)] = info
 		c.containerMap[containerId] = container
 		cont = container
+		c.notifyChange()
 	}
 
 	return cont, nil
@@ -450,6 +475,9 @@ func (c *Manager) CgroupRemove(cgroupId uint64, hierarchyID uint32) {
 		info.Dead = true
 		c.cgroupsMap[uint32(cgroupId)] = info
 		c.deleted = append(c.deleted, cgroupId)
+		if info.ContainerRoot {
+			c.notifyChange()
+		}
 	}
 }
 
@@ -559,6 +587,33 @@ func (c *Manager) GetLiveContainers() map[uint32]Container {
 	return conts
 }
 
+// GetContainerCgroups returns the containers of all the live container cgroups, including
+// the nested ones, mapped by the 32 LSB of their cgroup id.
+func (c *Manager) GetContainerCgroups() map[uint32]Container {
+	conts := map[uint32]Container{}
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	for id, v := range c.cgroupsMap {
+		if v.ContainerId != "" && !v.Dead {
+			conts[id] = c.containerMap[v.ContainerId]
+		}
+	}
+	return conts
+}
+
+// OnChange sets a function called whenever a container is enriched or removed, so its
+// dependents can be kept in sync. It is called with the manager locked, so it must
+// neither block nor use the manager.
+func (c *Manager) OnChange(fn func()) {
+	c.onChange.Store(&fn)
+}
+
+func (c *Manager) notifyChange() {
+	if fn := c.onChange.Load(); fn != nil && *fn != nil {
+		(*fn)()
+	}
+}
+
 // CgroupExists checks if there is a cgroupInfo data of a given cgroupId.
 func (c *Manager) CgroupExists(cgroupId uint64) bool {
 	c.lock.RLock()
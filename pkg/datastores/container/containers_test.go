@@ -107,3 +107,30 @@ func TestParseContainerIdFromCgroupPath(t *testing.T) {
 		})
 	}
 }
+
+func TestGetContainerCgroups(t *testing.T) {
+	t.Parallel()
+
+	nginx := Container{ContainerId: "abc", Image: "nginx", Pod: Pod{Namespace: "prod"}}
+	c := &Manager{
+		cgroupsMap: map[uint32]CgroupDir{
+			1: {Path: "/system.slice"},
+			2: {ContainerId: "abc", ContainerRoot: true},
+			3: {ContainerId: "abc"},
+			4: {ContainerId: "def", ContainerRoot: true, Dead: true},
+		},
+		containerMap: map[string]Container{
+			"abc": nginx,
+			"def": {ContainerId: "def"},
+		},
+	}
+
+	// Nested cgroups of live containers are included, host and dead cgroups aren't
+	assert.Equal(t, map[uint32]Container{2: nginx, 3: nginx}, c.GetContainerCgroups())
+
+	changes := 0
+	c.notifyChange()
+	c.OnChange(func() { changes++ })
+	c.notifyChange()
+	assert.Equal(t, 1, changes)
+}
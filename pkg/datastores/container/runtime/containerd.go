@@ -2,12 +2,14 @@ package runtime
 
 import (
 	"context"
+	"encoding/json"
 	"strings"
 
 	"github.com/containerd/containerd"
 	"github.com/containerd/containerd/containers"
 	"github.com/containerd/containerd/images"
 	"github.com/containerd/containerd/namespaces"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	cri "k8s.io/cri-api/pkg/apis/runtime/v1"
@@ -91,6 +93,7 @@ func (e *containerdEnricher) Get(ctx context.Context, containerId string) (Enric
 		// if in k8s we can extract pod info from labels
 		if container.Labels != nil {
 			labels := container.Labels
+			res.Labels = labels
 			res.PodName = labels[PodNameLabel]
 			res.Namespace = labels[PodNamespaceLabel]
 			res.UID = labels[PodUIDLabel]
@@ -102,6 +105,16 @@ func (e *containerdEnricher) Get(ctx context.Context, containerId string) (Enric
 		res.Image = imageName
 		res.ImageDigest = imageDigest
 
+		// the spec holds the security settings the container was created with
+		if container.Spec != nil {
+			var spec specs.Spec
+			if err := json.Unmarshal(container.Spec.GetValue(), &spec); err != nil {
+				logger.Debugw("failed to decode containerd container spec", "container", containerId, "err", err)
+			} else {
+				enrichFromSpec(&res, &spec)
+			}
+		}
+
 		return res, nil
 	}
 
@@ -2,15 +2,24 @@ package runtime
 
 import (
 	"context"
+	"encoding/json"
 	"strings"
 
+	specs "github.com/opencontainers/runtime-spec/specs-go"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	cri "k8s.io/cri-api/pkg/apis/runtime/v1"
 
 	"github.com/aquasecurity/tracee/common/errfmt"
+	"github.com/aquasecurity/tracee/common/logger"
 )
 
+// crioInfo is the verbose information cri-o returns with a container status
+type crioInfo struct {
+	Privileged  bool        `json:"privileged"`
+	RuntimeSpec *specs.Spec `json:"runtimeSpec"`
+}
+
 type crioEnricher struct {
 	conn   *grpc.ClientConn
 	client cri.RuntimeServiceClient
@@ -45,6 +54,7 @@ func (e *crioEnricher) Get(ctx context.Context, containerId string) (EnrichResul
 	// if in k8s we can extract pod info from labels
 	labels := resp.Status.Labels
 	if labels != nil {
+		res.Labels = labels
 		res.PodName = labels[PodNameLabel]
 		res.Namespace = labels[PodNamespaceLabel]
 		res.UID = labels[PodUIDLabel]
@@ -57,6 +67,20 @@ func (e *crioEnricher) Get(ctx context.Context, containerId string) (EnrichResul
 	res.Image = resp.Status.Image.Image
 	res.ImageDigest = resp.Status.ImageRef
 
+	if verbose, ok := resp.Info["info"]; ok {
+		var info crioInfo
+		if err := json.Unmarshal([]byte(verbose), &info); err != nil {
+			logger.Debugw("failed to decode cri-o container info", "container", containerId, "err", err)
+		} else {
+			enrichFromSpec(&res, info.RuntimeSpec)
+			res.Privileged = info.Privileged
+		}
+	}
+	// the status annotations are the ones of the container, without the cri-o internal ones
+	if len(annotations) > 0 {
+		res.Annotations = annotations
+	}
+
 	return res, nil
 }
 
@@ -46,6 +46,7 @@ func (e *dockerEnricher) Get(ctx context.Context, containerId string) (EnrichRes
 		// if in k8s extract pod data from the labels
 		if container.Config.Labels != nil {
 			labels := container.Config.Labels
+			res.Labels = labels
 			res.PodName = labels[PodNameLabel]
 			res.Namespace = labels[PodNamespaceLabel]
 			res.UID = labels[PodUIDLabel]
@@ -53,6 +54,26 @@ func (e *dockerEnricher) Get(ctx context.Context, containerId string) (EnrichRes
 		}
 	}
 
+	if container.HostConfig != nil {
+		hostConfig := container.HostConfig
+		if len(hostConfig.Annotations) > 0 {
+			res.Annotations = hostConfig.Annotations
+		}
+		res.Privileged = hostConfig.Privileged
+		res.AddedCapabilities = addedCapabilities(hostConfig.CapAdd)
+		res.HostNetwork = hostConfig.NetworkMode.IsHost()
+		res.HostPID = hostConfig.PidMode.IsHost()
+		res.HostIPC = hostConfig.IpcMode.IsHost()
+	}
+	for _, mount := range container.Mounts {
+		res.Mounts = append(res.Mounts, Mount{
+			Type:        string(mount.Type),
+			Source:      mount.Source,
+			Destination: mount.Destination,
+			ReadOnly:    !mount.RW,
+		})
+	}
+
 	// attempt to get image name from registry (image from config usually has tag as sha/no tag at all)
 	imageId := container.Image
 	image, err := e.client.ImageInspect(ctx, imageId)
@@ -20,6 +20,15 @@ type EnrichResult struct {
 	ContName    string
 	Image       string
 	ImageDigest string
+	Labels      map[string]string
+	Annotations map[string]string
+	/* SECURITY RESULTS */
+	Privileged        bool
+	AddedCapabilities []string // capabilities added to the runtime default set
+	HostNetwork       bool
+	HostPID           bool
+	HostIPC           bool
+	Mounts            []Mount
 	/* POD RESULTS */
 	PodName   string
 	Namespace string
@@ -27,6 +36,14 @@ type EnrichResult struct {
 	Sandbox   bool
 }
 
+// Mount is a host path or volume mounted into a container
+type Mount struct {
+	Type        string
+	Source      string
+	Destination string
+	ReadOnly    bool
+}
+
 type ContainerEnricher interface {
 	Get(ctx context.Context, containerId string) (EnrichResult, error)
 	Close() error
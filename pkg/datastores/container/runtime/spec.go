@@ -0,0 +1,91 @@
+package runtime
+
+import (
+	"slices"
+	"strings"
+
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+)
+
+// defaultCapabilities is the capability set runtimes grant to unprivileged containers
+var defaultCapabilities = []string{
+	"CAP_AUDIT_WRITE",
+	"CAP_CHOWN",
+	"CAP_DAC_OVERRIDE",
+	"CAP_FOWNER",
+	"CAP_FSETID",
+	"CAP_KILL",
+	"CAP_MKNOD",
+	"CAP_NET_BIND_SERVICE",
+	"CAP_NET_RAW",
+	"CAP_SETFCAP",
+	"CAP_SETGID",
+	"CAP_SETPCAP",
+	"CAP_SETUID",
+	"CAP_SYS_CHROOT",
+}
+
+// addedCapabilities returns the capabilities not in the runtime default set, with their
+// CAP_ prefix, in the given order.
+func addedCapabilities(capabilities []string) []string {
+	var added []string
+	for _, capability := range capabilities {
+		capability = strings.ToUpper(capability)
+		if capability != "ALL" && !strings.HasPrefix(capability, "CAP_") {
+			capability = "CAP_" + capability
+		}
+		if slices.Contains(defaultCapabilities, capability) || slices.Contains(added, capability) {
+			continue
+		}
+		added = append(added, capability)
+	}
+
+	return added
+}
+
+// enrichFromSpec fills the security results from the OCI runtime spec of a container.
+// Runtimes don't record the privileged flag in the spec: privileged containers are told
+// apart by their unmasked /proc and /sys paths, which every runtime masks otherwise.
+func enrichFromSpec(res *EnrichResult, spec *specs.Spec) {
+	if spec == nil {
+		return
+	}
+
+	if len(spec.Annotations) > 0 {
+		res.Annotations = spec.Annotations
+	}
+	if spec.Process != nil && spec.Process.Capabilities != nil {
+		res.AddedCapabilities = addedCapabilities(spec.Process.Capabilities.Bounding)
+	}
+
+	if spec.Linux != nil {
+		res.Privileged = len(spec.Linux.MaskedPaths) == 0 && len(spec.Linux.ReadonlyPaths) == 0
+
+		// a container without its own namespace of a type shares the one of the host
+		res.HostNetwork, res.HostPID, res.HostIPC = true, true, true
+		for _, namespace := range spec.Linux.Namespaces {
+			switch namespace.Type {
+			case specs.NetworkNamespace:
+				res.HostNetwork = false
+			case specs.PIDNamespace:
+				res.HostPID = false
+			case specs.IPCNamespace:
+				res.HostIPC = false
+			}
+		}
+	}
+
+	res.Mounts = nil
+	for _, mount := range spec.Mounts {
+		// only bind mounts expose host paths, the others are the runtime pseudo filesystems
+		if mount.Type != "bind" && !slices.Contains(mount.Options, "bind") && !slices.Contains(mount.Options, "rbind") {
+			continue
+		}
+		res.Mounts = append(res.Mounts, Mount{
+			Type:        "bind",
+			Source:      mount.Source,
+			Destination: mount.Destination,
+			ReadOnly:    slices.Contains(mount.Options, "ro"),
+		})
+	}
+}
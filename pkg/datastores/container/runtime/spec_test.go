@@ -0,0 +1,92 @@
+package runtime
+
+import (
+	"testing"
+
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddedCapabilities(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, addedCapabilities(nil))
+	assert.Nil(t, addedCapabilities([]string{"CAP_CHOWN", "CAP_KILL"}))
+	assert.Equal(t, []string{"CAP_NET_ADMIN", "CAP_SYS_ADMIN", "ALL"},
+		addedCapabilities([]string{"NET_ADMIN", "cap_sys_admin", "CAP_NET_ADMIN", "CHOWN", "all"}))
+}
+
+func TestEnrichFromSpec(t *testing.T) {
+	t.Parallel()
+
+	namespaces := []specs.LinuxNamespace{
+		{Type: specs.PIDNamespace},
+		{Type: specs.NetworkNamespace, Path: "/proc/42/ns/net"},
+		{Type: specs.IPCNamespace},
+		{Type: specs.MountNamespace},
+	}
+	mounts := []specs.Mount{
+		{Destination: "/proc", Type: "proc", Source: "proc"},
+		{Destination: "/etc/hosts", Type: "bind", Source: "/var/lib/pod/hosts", Options: []string{"rbind", "rprivate", "rw"}},
+		{Destination: "/host", Source: "/", Options: []string{"rbind", "ro"}},
+	}
+
+	tests := []struct {
+		name     string
+		spec     *specs.Spec
+		expected EnrichResult
+	}{
+		{
+			name:     "no spec",
+			spec:     nil,
+			expected: EnrichResult{},
+		},
+		{
+			name: "unprivileged",
+			spec: &specs.Spec{
+				Annotations: map[string]string{"io.kubernetes.cri.container-type": "container"},
+				Process: &specs.Process{Capabilities: &specs.LinuxCapabilities{
+					Bounding: append([]string{"CAP_SYS_PTRACE"}, defaultCapabilities...),
+				}},
+				Linux: &specs.Linux{
+					Namespaces:    namespaces,
+					MaskedPaths:   []string{"/proc/kcore"},
+					ReadonlyPaths: []string{"/proc/sys"},
+				},
+				Mounts: mounts,
+			},
+			expected: EnrichResult{
+				Annotations:       map[string]string{"io.kubernetes.cri.container-type": "container"},
+				AddedCapabilities: []string{"CAP_SYS_PTRACE"},
+				Mounts: []Mount{
+					{Type: "bind", Source: "/var/lib/pod/hosts", Destination: "/etc/hosts"},
+					{Type: "bind", Source: "/", Destination: "/host", ReadOnly: true},
+				},
+			},
+		},
+		{
+			name: "privileged in the host namespaces",
+			spec: &specs.Spec{
+				Linux: &specs.Linux{
+					Namespaces: []specs.LinuxNamespace{{Type: specs.MountNamespace}},
+				},
+			},
+			expected: EnrichResult{
+				Privileged:  true,
+				HostNetwork: true,
+				HostPID:     true,
+				HostIPC:     true,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			res := EnrichResult{}
+			enrichFromSpec(&res, tt.spec)
+			assert.Equal(t, tt.expected, res)
+		})
+	}
+}
@@ -183,6 +183,18 @@ func matchesFilter(container *Container, filter *datastores.ContainerFilter) boo
 		return false
 	}
 
+	// Check label filters
+	for key, value := range filter.Labels {
+		if label, ok := container.Labels[key]; !ok || label != value {
+			return false
+		}
+	}
+
+	// Check privileged filter
+	if filter.Privileged != nil && container.Security.Privileged != *filter.Privileged {
+		return false
+	}
+
 	return true
 }
 
@@ -198,13 +210,34 @@ func convertContainer(cont *Container) *datastores.ContainerInfo {
 		}
 	}
 
+	var mounts []datastores.MountInfo
+	if len(cont.Security.Mounts) > 0 {
+		mounts = make([]datastores.MountInfo, 0, len(cont.Security.Mounts))
+		for _, mount := range cont.Security.Mounts {
+			mounts = append(mounts, datastores.MountInfo{
+				Type:        mount.Type,
+				Source:      mount.Source,
+				Destination: mount.Destination,
+				ReadOnly:    mount.ReadOnly,
+			})
+		}
+	}
+
 	return &datastores.ContainerInfo{
-		ID:          cont.ContainerId,
-		Name:        cont.Name,
-		Image:       cont.Image,
-		ImageDigest: cont.ImageDigest,
-		Runtime:     cont.Runtime.String(),
-		StartTime:   cont.CreatedAt,
-		Pod:         podInfo,
+		ID:                cont.ContainerId,
+		Name:              cont.Name,
+		Image:             cont.Image,
+		ImageDigest:       cont.ImageDigest,
+		Runtime:           cont.Runtime.String(),
+		StartTime:         cont.CreatedAt,
+		Pod:               podInfo,
+		Labels:            cont.Labels,
+		Annotations:       cont.Annotations,
+		Privileged:        cont.Security.Privileged,
+		AddedCapabilities: cont.Security.AddedCapabilities,
+		HostNetwork:       cont.Security.HostNetwork,
+		HostPID:           cont.Security.HostPID,
+		HostIPC:           cont.Security.HostIPC,
+		Mounts:            mounts,
 	}
 }
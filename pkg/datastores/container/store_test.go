@@ -231,6 +231,59 @@ func TestManager_ListContainers(t *testing.T) {
 		assert.Equal(t, "docker", containers[0].Runtime)
 	})
 
+	t.Run("FilterByLabel", func(t *testing.T) {
+		mgr := &Manager{
+			containerMap: map[string]Container{
+				"container1": {ContainerId: "container1", Name: "web-1", Labels: map[string]string{"app": "web", "tier": "frontend"}},
+				"container2": {ContainerId: "container2", Name: "web-2", Labels: map[string]string{"app": "web", "tier": "backend"}},
+				"container3": {ContainerId: "container3", Name: "unlabeled"},
+			},
+			cgroupsMap: map[uint32]CgroupDir{
+				1: {ContainerId: "container1", ContainerRoot: true, expiresAt: time.Time{}},
+				2: {ContainerId: "container2", ContainerRoot: true, expiresAt: time.Time{}},
+				3: {ContainerId: "container3", ContainerRoot: true, expiresAt: time.Time{}},
+			},
+		}
+
+		containers, err := mgr.ListContainers(datastores.WithLabel("app", "web"))
+		require.NoError(t, err)
+		assert.Len(t, containers, 2)
+
+		// All labels must match
+		containers, err = mgr.ListContainers(datastores.WithLabel("app", "web"), datastores.WithLabel("tier", "backend"))
+		require.NoError(t, err)
+		require.Len(t, containers, 1)
+		assert.Equal(t, "container2", containers[0].ID)
+
+		// An empty value only matches containers having the label
+		containers, err = mgr.ListContainers(datastores.WithLabel("tier", ""))
+		require.NoError(t, err)
+		assert.Empty(t, containers)
+	})
+
+	t.Run("FilterByPrivileged", func(t *testing.T) {
+		mgr := &Manager{
+			containerMap: map[string]Container{
+				"container1": {ContainerId: "container1", Name: "privileged", Security: Security{Privileged: true}},
+				"container2": {ContainerId: "container2", Name: "unprivileged"},
+			},
+			cgroupsMap: map[uint32]CgroupDir{
+				1: {ContainerId: "container1", ContainerRoot: true, expiresAt: time.Time{}},
+				2: {ContainerId: "container2", ContainerRoot: true, expiresAt: time.Time{}},
+			},
+		}
+
+		containers, err := mgr.ListContainers(datastores.WithPrivileged(true))
+		require.NoError(t, err)
+		require.Len(t, containers, 1)
+		assert.Equal(t, "container1", containers[0].ID)
+
+		containers, err = mgr.ListContainers(datastores.WithPrivileged(false))
+		require.NoError(t, err)
+		require.Len(t, containers, 1)
+		assert.Equal(t, "container2", containers[0].ID)
+	})
+
 	t.Run("FilterNoMatches", func(t *testing.T) {
 		mgr := &Manager{
 			containerMap: map[string]Container{
@@ -323,3 +376,40 @@ func TestManager_ListContainers(t *testing.T) {
 		assert.Len(t, containers, 1)
 	})
 }
+
+func TestConvertContainer_RuntimeMetadata(t *testing.T) {
+	cont := &Container{
+		ContainerId: "container1",
+		Name:        "web",
+		Runtime:     runtime.Containerd,
+		Labels:      map[string]string{"app": "web"},
+		Annotations: map[string]string{"io.kubernetes.pod.terminationGracePeriod": "30"},
+		Pod:         Pod{Name: "web-0", UID: "uid", Namespace: "default"},
+		Security: Security{
+			Privileged:        true,
+			AddedCapabilities: []string{"CAP_SYS_ADMIN"},
+			HostNetwork:       true,
+			HostPID:           true,
+			Mounts: []runtime.Mount{
+				{Type: "bind", Source: "/var/run/docker.sock", Destination: "/var/run/docker.sock"},
+				{Type: "bind", Source: "/", Destination: "/host", ReadOnly: true},
+			},
+		},
+	}
+
+	info := convertContainer(cont)
+	assert.Equal(t, map[string]string{"app": "web"}, info.Labels)
+	assert.Equal(t, map[string]string{"io.kubernetes.pod.terminationGracePeriod": "30"}, info.Annotations)
+	assert.True(t, info.Privileged)
+	assert.Equal(t, []string{"CAP_SYS_ADMIN"}, info.AddedCapabilities)
+	assert.True(t, info.HostNetwork)
+	assert.True(t, info.HostPID)
+	assert.False(t, info.HostIPC)
+	assert.Equal(t, []datastores.MountInfo{
+		{Type: "bind", Source: "/var/run/docker.sock", Destination: "/var/run/docker.sock"},
+		{Type: "bind", Source: "/", Destination: "/host", ReadOnly: true},
+	}, info.Mounts)
+
+	// Containers without mounts have none
+	assert.Nil(t, convertContainer(&Container{ContainerId: "container2"}).Mounts)
+}
@@ -0,0 +1,328 @@
+package ipreputation
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/netip"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aquasecurity/tracee/common/fileutil"
+	"github.com/aquasecurity/tracee/common/logger"
+)
+
+const (
+	// DefaultReloadInterval is how often feed files are checked for changes
+	DefaultReloadInterval = time.Minute
+
+	// Defaults of feed entries that do not set a status or a severity
+	defaultFeedStatus   = ReputationDeny
+	defaultFeedSeverity = 5
+
+	feedSourcePrefix = "feed:"
+)
+
+// feedEntry is an entry of a JSON feed
+type feedEntry struct {
+	IP       string            `json:"ip"`
+	Status   string            `json:"status"`
+	Severity int               `json:"severity"`
+	Tags     []string          `json:"tags"`
+	Metadata map[string]string `json:"metadata"`
+}
+
+// feedLoader loads feed files into a store, each file being a source of its own,
+// and reloads them when they change.
+type feedLoader struct {
+	store    IPReputationStore
+	paths    []string
+	interval time.Duration
+	stamps   map[string]fileutil.Stamp
+}
+
+func newFeedLoader(store IPReputationStore, paths []string, interval time.Duration) *feedLoader {
+	if interval <= 0 {
+		interval = DefaultReloadInterval
+	}
+	return &feedLoader{
+		store:    store,
+		paths:    paths,
+		interval: interval,
+		stamps:   make(map[string]fileutil.Stamp),
+	}
+}
+
+// FeedSource returns the store source name of a feed file
+func FeedSource(path string) string {
+	return feedSourcePrefix + path
+}
+
+// loadAll loads all feed files, failing on the first one that can't be loaded
+func (l *feedLoader) loadAll() error {
+	for _, path := range l.paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return fmt.Errorf("ip reputation feed: %w", err)
+		}
+		if err := l.load(path, info); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// run reloads the changed feed files until the context is done
+func (l *feedLoader) run(ctx context.Context) {
+	ticker := time.NewTicker(l.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			l.reload()
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// reload reloads the feed files that changed since they were last loaded.
+// A feed that fails to reload keeps its previous data.
+func (l *feedLoader) reload() {
+	for _, path := range l.paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			logger.Warnw("IP reputation feed unavailable, keeping previous data", "feed", path, "error", err)
+			continue
+		}
+		stamp := fileutil.NewStamp(info)
+		if stamp == l.stamps[path] {
+			continue
+		}
+		if err := l.load(path, info); err != nil {
+			logger.Warnw("Failed to reload IP reputation feed, keeping previous data", "feed", path, "error", err)
+			continue
+		}
+		logger.Debugw("Reloaded IP reputation feed", "feed", path)
+	}
+}
+
+// load loads a feed file, replacing the previous data of its source
+func (l *feedLoader) load(path string, info os.FileInfo) error {
+	// a broken feed is not retried until it changes again
+	l.stamps[path] = fileutil.NewStamp(info)
+
+	entries, err := LoadFeed(path, info.ModTime())
+	if err != nil {
+		return err
+	}
+
+	return l.store.ReplaceSource(FeedSource(path), entries)
+}
+
+// LoadFeed parses a feed file into reputation entries keyed by IP address or CIDR
+// range. The format is given by the file extension:
+//
+//   - .csv: ip,status,severity,tags lines (header and '#' comments are skipped,
+//     tags are separated by ';')
+//   - .json: an array of {"ip", "status", "severity", "tags", "metadata"} objects
+//   - anything else: one IP address or CIDR range per line ('#' comments)
+//
+// Entries without a status are denied, and entries without a severity get a
+// severity of 5.
+func LoadFeed(path string, lastUpdated time.Time) (map[string]*IPReputation, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("ip reputation feed: %w", err)
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	var entries map[string]*IPReputation
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		entries, err = parseCSVFeed(file, lastUpdated)
+	case ".json":
+		entries, err = parseJSONFeed(file, lastUpdated)
+	default:
+		entries, err = parseListFeed(file, lastUpdated)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("ip reputation feed %s: %w", path, err)
+	}
+
+	return entries, nil
+}
+
+// parseListFeed parses a plain list of IP addresses and CIDR ranges
+func parseListFeed(r io.Reader, lastUpdated time.Time) (map[string]*IPReputation, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make(map[string]*IPReputation)
+	for i, line := range strings.Split(string(data), "\n") {
+		if idx := strings.IndexByte(line, '#'); idx >= 0 {
+			line = line[:idx]
+		}
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		key, err := feedKey(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", i+1, err)
+		}
+		entries[key] = &IPReputation{
+			IP:          key,
+			Status:      defaultFeedStatus,
+			Severity:    defaultFeedSeverity,
+			LastUpdated: lastUpdated,
+		}
+	}
+
+	return entries, nil
+}
+
+// parseCSVFeed parses ip,status,severity,tags records
+func parseCSVFeed(r io.Reader, lastUpdated time.Time) (map[string]*IPReputation, error) {
+	reader := csv.NewReader(r)
+	reader.Comment = '#'
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	entries := make(map[string]*IPReputation)
+	for first := true; ; first = false {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		line, _ := reader.FieldPos(0)
+
+		if first && strings.EqualFold(strings.TrimSpace(record[0]), "ip") {
+			continue // header
+		}
+
+		field := func(i int) string {
+			if i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+
+		entry := feedEntry{IP: field(0), Status: field(1)}
+		if severity := field(2); severity != "" {
+			entry.Severity, err = strconv.Atoi(severity)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: invalid severity %q", line, severity)
+			}
+		}
+		for _, tag := range strings.Split(field(3), ";") {
+			if tag = strings.TrimSpace(tag); tag != "" {
+				entry.Tags = append(entry.Tags, tag)
+			}
+		}
+
+		key, rep, err := entry.reputation(lastUpdated)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		entries[key] = rep
+	}
+
+	return entries, nil
+}
+
+// parseJSONFeed parses an array of feed entries
+func parseJSONFeed(r io.Reader, lastUpdated time.Time) (map[string]*IPReputation, error) {
+	var feed []feedEntry
+	if err := json.NewDecoder(r).Decode(&feed); err != nil {
+		return nil, err
+	}
+
+	entries := make(map[string]*IPReputation, len(feed))
+	for i, entry := range feed {
+		key, rep, err := entry.reputation(lastUpdated)
+		if err != nil {
+			return nil, fmt.Errorf("entry %d: %w", i, err)
+		}
+		entries[key] = rep
+	}
+
+	return entries, nil
+}
+
+// reputation converts a feed entry into a store entry, applying the defaults
+func (e feedEntry) reputation(lastUpdated time.Time) (string, *IPReputation, error) {
+	key, err := feedKey(e.IP)
+	if err != nil {
+		return "", nil, err
+	}
+
+	status := defaultFeedStatus
+	if e.Status != "" {
+		status, err = parseStatus(e.Status)
+		if err != nil {
+			return "", nil, err
+		}
+	}
+
+	severity := e.Severity
+	if severity == 0 {
+		severity = defaultFeedSeverity
+	}
+
+	rep := &IPReputation{
+		IP:          key,
+		Status:      status,
+		Severity:    severity,
+		Tags:        e.Tags,
+		LastUpdated: lastUpdated,
+		Metadata:    e.Metadata,
+	}
+	if err := validateReputation(rep); err != nil {
+		return "", nil, fmt.Errorf("%s: %w", e.IP, err)
+	}
+
+	return key, rep, nil
+}
+
+// feedKey validates an IP address or CIDR range of a feed, returning its store key
+func feedKey(value string) (string, error) {
+	if prefix, ok := parseRange(value); ok {
+		return prefix.String(), nil
+	}
+	addr, err := netip.ParseAddr(value)
+	if err != nil {
+		return "", fmt.Errorf("invalid IP address or CIDR range %q", value)
+	}
+	return addr.Unmap().String(), nil
+}
+
+// parseStatus parses a reputation status name
+func parseStatus(status string) (ReputationStatus, error) {
+	switch strings.ToLower(status) {
+	case "allow":
+		return ReputationAllow, nil
+	case "deny":
+		return ReputationDeny, nil
+	case "suspicious":
+		return ReputationSuspicious, nil
+	case "unknown":
+		return ReputationUnknown, nil
+	}
+	return ReputationUnknown, fmt.Errorf("invalid reputation status %q", status)
+}
@@ -0,0 +1,152 @@
+package ipreputation
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeFeed(t *testing.T, dir, name, content string) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+	return path
+}
+
+func TestLoadFeed(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	now := time.Unix(1700000000, 0)
+
+	tests := []struct {
+		name     string
+		file     string
+		content  string
+		expected map[string]*IPReputation
+		wantErr  string
+	}{
+		{
+			name: "plain list",
+			file: "blocklist.txt",
+			content: `# blocklist
+1.2.3.4
+10.0.0.0/8 # private range
+
+2001:db8::/32
+`,
+			expected: map[string]*IPReputation{
+				"1.2.3.4":       {IP: "1.2.3.4", Status: ReputationDeny, Severity: 5, LastUpdated: now},
+				"10.0.0.0/8":    {IP: "10.0.0.0/8", Status: ReputationDeny, Severity: 5, LastUpdated: now},
+				"2001:db8::/32": {IP: "2001:db8::/32", Status: ReputationDeny, Severity: 5, LastUpdated: now},
+			},
+		},
+		{
+			name: "csv",
+			file: "feed.csv",
+			content: `ip,status,severity,tags
+# comment
+1.2.3.4,deny,9,botnet;c2
+192.168.0.0/16,allow,1
+5.6.7.8
+`,
+			expected: map[string]*IPReputation{
+				"1.2.3.4":        {IP: "1.2.3.4", Status: ReputationDeny, Severity: 9, Tags: []string{"botnet", "c2"}, LastUpdated: now},
+				"192.168.0.0/16": {IP: "192.168.0.0/16", Status: ReputationAllow, Severity: 1, LastUpdated: now},
+				"5.6.7.8":        {IP: "5.6.7.8", Status: ReputationDeny, Severity: 5, LastUpdated: now},
+			},
+		},
+		{
+			name: "json",
+			file: "feed.JSON",
+			content: `[
+  {"ip": "1.2.3.4", "status": "suspicious", "severity": 4, "tags": ["tor"], "metadata": {"country": "XX"}},
+  {"ip": "10.1.2.3/8"}
+]`,
+			expected: map[string]*IPReputation{
+				"1.2.3.4":    {IP: "1.2.3.4", Status: ReputationSuspicious, Severity: 4, Tags: []string{"tor"}, Metadata: map[string]string{"country": "XX"}, LastUpdated: now},
+				"10.0.0.0/8": {IP: "10.0.0.0/8", Status: ReputationDeny, Severity: 5, LastUpdated: now},
+			},
+		},
+		{name: "invalid list entry", file: "bad.txt", content: "1.2.3.4\nexample.com\n", wantErr: "line 2: invalid IP address or CIDR range"},
+		{name: "invalid csv status", file: "bad.csv", content: "1.2.3.4,block\n", wantErr: "line 1: invalid reputation status"},
+		{name: "invalid csv severity", file: "bad2.csv", content: "1.2.3.4,deny,high\n", wantErr: "line 1: invalid severity"},
+		{name: "csv severity out of range", file: "bad3.csv", content: "1.2.3.4,deny,11\n", wantErr: "severity must be 1-10"},
+		{name: "invalid json", file: "bad.json", content: `{"ip": "1.2.3.4"}`, wantErr: "cannot unmarshal"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			path := writeFeed(t, dir, tt.file, tt.content)
+			entries, err := LoadFeed(path, now)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, entries)
+		})
+	}
+
+	_, err := LoadFeed(filepath.Join(dir, "missing.txt"), now)
+	assert.Error(t, err)
+}
+
+func TestFeedStore(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	blocklist := writeFeed(t, dir, "blocklist.txt", "1.2.3.4\n10.0.0.0/8\n")
+	allowlist := writeFeed(t, dir, "allowlist.csv", "10.1.2.3,allow,1\n")
+
+	s := New(Config{Enable: true, Feeds: []string{blocklist, allowlist}, ReloadInterval: time.Hour}).(*store)
+	require.NoError(t, s.Initialize(context.Background()))
+	defer func() {
+		require.NoError(t, s.Shutdown(context.Background()))
+	}()
+
+	assert.True(t, s.IsDenied("1.2.3.4"))
+	assert.True(t, s.IsDenied("10.200.0.1"))
+	assert.True(t, s.IsDenied("10.1.2.3"), "highest severity wins across feeds")
+
+	sources, err := s.ListSources()
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{FeedSource(blocklist), FeedSource(allowlist)}, sources)
+
+	// A changed feed is reloaded
+	require.NoError(t, os.WriteFile(blocklist, []byte("5.6.7.8\n"), 0600))
+	require.NoError(t, os.Chtimes(blocklist, time.Now(), time.Now().Add(time.Minute)))
+	s.feeds.reload()
+	assert.False(t, s.IsDenied("1.2.3.4"))
+	assert.True(t, s.IsDenied("5.6.7.8"))
+
+	// A broken or missing feed keeps its previous data
+	require.NoError(t, os.WriteFile(blocklist, []byte("not-an-ip\n"), 0600))
+	require.NoError(t, os.Chtimes(blocklist, time.Now(), time.Now().Add(2*time.Minute)))
+	s.feeds.reload()
+	assert.True(t, s.IsDenied("5.6.7.8"))
+
+	require.NoError(t, os.Remove(blocklist))
+	s.feeds.reload()
+	assert.True(t, s.IsDenied("5.6.7.8"))
+}
+
+func TestFeedStore_InvalidFeed(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	feed := writeFeed(t, dir, "feed.txt", "1.2.3.4/99\n")
+
+	invalid := New(Config{Enable: true, Feeds: []string{feed}}).(*store)
+	assert.ErrorContains(t, invalid.Initialize(context.Background()), "invalid IP address or CIDR range")
+
+	missing := New(Config{Enable: true, Feeds: []string{filepath.Join(dir, "missing.txt")}}).(*store)
+	assert.Error(t, missing.Initialize(context.Background()))
+}
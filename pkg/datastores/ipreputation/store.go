@@ -1,7 +1,11 @@
 package ipreputation
 
 import (
+	"context"
 	"fmt"
+	"net/netip"
+	"sort"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -19,35 +23,176 @@ type IPReputationStore interface {
 	WriteReputation(source string, ip string, rep *IPReputation) error
 	WriteReputationBatch(source string, data map[string]*IPReputation) error
 
+	// ReplaceSource atomically replaces all data of a source
+	ReplaceSource(source string, data map[string]*IPReputation) error
+
 	// Query operations
 	GetReputation(ip string) (*IPReputation, bool)
 	IsDenied(ip string) bool
 	IsAllowed(ip string) bool
 	CheckIPs(ips []string) map[string]*IPReputation
+
+	// Public datastore API (exact IPs and CIDR ranges)
+	GetIPReputation(ip string) (*datastores.IPReputationInfo, error)
 }
 
 // store implements IPReputationStore
 type store struct {
-	// data is organized as [source][ip] = reputation
-	data           map[string]map[string]*IPReputation
+	// data is organized as [source] = reputation of IPs and CIDR ranges
+	data           map[string]*sourceData
 	conflictPolicy ConflictResolutionPolicy
 	sourcePriority map[string]int
 	mu             sync.RWMutex
 	lastAccessNano int64
+
+	// feeds is set when the store is preloaded from feed files
+	feeds  *feedLoader
+	cancel context.CancelFunc
 }
 
 // NewIPReputationStore creates a new IP reputation store
 func NewIPReputationStore(policy ConflictResolutionPolicy, sourcePriority map[string]int) IPReputationStore {
+	return newStore(policy, sourcePriority)
+}
+
+// New creates an IP reputation store preloaded from the feed files of the config.
+// Feeds are loaded when the store is initialized, and reloaded when they change.
+func New(config Config) IPReputationStore {
+	s := newStore(MaxSeverity, nil)
+	if len(config.Feeds) > 0 {
+		s.feeds = newFeedLoader(s, config.Feeds, config.ReloadInterval)
+	}
+	return s
+}
+
+func newStore(policy ConflictResolutionPolicy, sourcePriority map[string]int) *store {
 	if sourcePriority == nil {
 		sourcePriority = make(map[string]int)
 	}
 	return &store{
-		data:           make(map[string]map[string]*IPReputation),
+		data:           make(map[string]*sourceData),
 		conflictPolicy: policy,
 		sourcePriority: sourcePriority,
 	}
 }
 
+// sourceData holds the reputation data written by a single source
+type sourceData struct {
+	ips    map[string]*IPReputation       // exact IP addresses (and other keys)
+	ranges map[netip.Prefix]*IPReputation // CIDR ranges
+	bits   []int                          // distinct prefix lengths of ranges, longest first
+}
+
+func newSourceData() *sourceData {
+	return &sourceData{
+		ips:    make(map[string]*IPReputation),
+		ranges: make(map[netip.Prefix]*IPReputation),
+	}
+}
+
+func (d *sourceData) len() int {
+	return len(d.ips) + len(d.ranges)
+}
+
+// set adds or replaces the reputation of an IP address or CIDR range
+func (d *sourceData) set(key string, rep *IPReputation) {
+	if prefix, ok := parseRange(key); ok {
+		if _, exists := d.ranges[prefix]; !exists {
+			d.addBits(prefix.Bits())
+		}
+		d.ranges[prefix] = rep
+		return
+	}
+	d.ips[normalizeIP(key)] = rep
+}
+
+// remove removes the reputation of an IP address or CIDR range
+func (d *sourceData) remove(key string) {
+	prefix, ok := parseRange(key)
+	if !ok {
+		delete(d.ips, normalizeIP(key))
+		return
+	}
+	if _, exists := d.ranges[prefix]; !exists {
+		return
+	}
+	delete(d.ranges, prefix)
+	for other := range d.ranges {
+		if other.Bits() == prefix.Bits() {
+			return // prefix length still in use
+		}
+	}
+	for i, bits := range d.bits {
+		if bits == prefix.Bits() {
+			d.bits = append(d.bits[:i], d.bits[i+1:]...)
+			break
+		}
+	}
+}
+
+func (d *sourceData) addBits(bits int) {
+	for _, b := range d.bits {
+		if b == bits {
+			return
+		}
+	}
+	d.bits = append(d.bits, bits)
+	sort.Sort(sort.Reverse(sort.IntSlice(d.bits)))
+}
+
+// lookup returns the reputation of an IP address and the matching key: the
+// address itself, or else the most specific CIDR range containing it.
+func (d *sourceData) lookup(ip string) (*IPReputation, string) {
+	key := normalizeIP(ip)
+	if rep, ok := d.ips[key]; ok {
+		return rep, key
+	}
+	if len(d.ranges) == 0 {
+		return nil, ""
+	}
+
+	addr, err := netip.ParseAddr(key)
+	if err != nil {
+		return nil, ""
+	}
+	// one lookup per distinct prefix length
+	for _, bits := range d.bits {
+		prefix, err := addr.Prefix(bits)
+		if err != nil {
+			continue // prefix longer than the address
+		}
+		if rep, ok := d.ranges[prefix]; ok {
+			return rep, prefix.String()
+		}
+	}
+
+	return nil, ""
+}
+
+// parseRange parses a CIDR range key (e.g. "10.0.0.0/8"), returning false for
+// anything else. Host bits are masked, so "10.1.2.3/8" is the "10.0.0.0/8" range.
+func parseRange(key string) (netip.Prefix, bool) {
+	if !strings.Contains(key, "/") {
+		return netip.Prefix{}, false
+	}
+	prefix, err := netip.ParsePrefix(key)
+	if err != nil {
+		return netip.Prefix{}, false
+	}
+	return prefix.Masked(), true
+}
+
+// normalizeIP returns the canonical form of an IP address, so lookups do not
+// depend on its textual form (e.g. "::ffff:1.2.3.4" is "1.2.3.4"). Keys that
+// are not IP addresses are returned as is.
+func normalizeIP(ip string) string {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return ip
+	}
+	return addr.Unmap().String()
+}
+
 // DataStore interface implementation
 
 // Name returns the datastore identifier
@@ -71,7 +216,7 @@ func (s *store) GetMetrics() *datastores.DataStoreMetrics {
 
 	totalCount := int64(0)
 	for _, sourceData := range s.data {
-		totalCount += int64(len(sourceData))
+		totalCount += int64(sourceData.len())
 	}
 
 	return &datastores.DataStoreMetrics{
@@ -189,8 +334,8 @@ func (s *store) Delete(source string, key *anypb.Any) error {
 	defer s.mu.Unlock()
 
 	if sourceData, ok := s.data[source]; ok {
-		delete(sourceData, keyMsg.Ip)
-		if len(sourceData) == 0 {
+		sourceData.remove(keyMsg.Ip)
+		if sourceData.len() == 0 {
 			delete(s.data, source)
 		}
 	}
@@ -235,12 +380,12 @@ func (s *store) WriteReputation(source string, ip string, rep *IPReputation) err
 	defer s.mu.Unlock()
 
 	if s.data[source] == nil {
-		s.data[source] = make(map[string]*IPReputation)
+		s.data[source] = newSourceData()
 	}
 
 	// Update source field to match the key
 	rep.Source = source
-	s.data[source][ip] = rep
+	s.data[source].set(ip, rep)
 	atomic.StoreInt64(&s.lastAccessNano, time.Now().UnixNano())
 
 	return nil
@@ -260,12 +405,40 @@ func (s *store) WriteReputationBatch(source string, data map[string]*IPReputatio
 	defer s.mu.Unlock()
 
 	if s.data[source] == nil {
-		s.data[source] = make(map[string]*IPReputation)
+		s.data[source] = newSourceData()
 	}
 
 	for ip, rep := range data {
 		rep.Source = source
-		s.data[source][ip] = rep
+		s.data[source].set(ip, rep)
+	}
+
+	atomic.StoreInt64(&s.lastAccessNano, time.Now().UnixNano())
+	return nil
+}
+
+// ReplaceSource atomically replaces all data of a source with the given entries
+// All entries are validated before replacing (on error, the source data is kept)
+func (s *store) ReplaceSource(source string, data map[string]*IPReputation) error {
+	for ip, rep := range data {
+		if err := validateReputation(rep); err != nil {
+			return fmt.Errorf("validation failed for IP %s: %w", ip, err)
+		}
+	}
+
+	sourceData := newSourceData()
+	for ip, rep := range data {
+		rep.Source = source
+		sourceData.set(ip, rep)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if sourceData.len() == 0 {
+		delete(s.data, source)
+	} else {
+		s.data[source] = sourceData
 	}
 
 	atomic.StoreInt64(&s.lastAccessNano, time.Now().UnixNano())
@@ -275,15 +448,27 @@ func (s *store) WriteReputationBatch(source string, data map[string]*IPReputatio
 // Query operations
 
 // GetReputation retrieves the aggregated reputation for an IP address
+// Within a source, an exact IP entry takes precedence over the CIDR ranges containing it
 // If multiple sources have data for the same IP, applies the configured conflict resolution policy
 func (s *store) GetReputation(ip string) (*IPReputation, bool) {
+	result, _ := s.lookup(ip)
+	return result, result != nil
+}
+
+// lookup returns the aggregated reputation of an IP address and its matching key
+func (s *store) lookup(ip string) (*IPReputation, string) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
 	var result *IPReputation
+	var match string
 	for source, sourceData := range s.data {
-		if rep, ok := sourceData[ip]; ok {
-			result = s.resolveConflict(result, rep, source)
+		rep, key := sourceData.lookup(ip)
+		if rep == nil {
+			continue
+		}
+		if resolved := s.resolveConflict(result, rep, source); resolved != result {
+			result, match = resolved, key
 		}
 	}
 
@@ -292,7 +477,27 @@ func (s *store) GetReputation(ip string) (*IPReputation, bool) {
 		atomic.StoreInt64(&s.lastAccessNano, time.Now().UnixNano())
 	}
 
-	return result, result != nil
+	return result, match
+}
+
+// GetIPReputation retrieves the reputation of an IP address for the public datastore API
+// Returns ErrNotFound if no source has reputation data for the IP
+func (s *store) GetIPReputation(ip string) (*datastores.IPReputationInfo, error) {
+	rep, match := s.lookup(ip)
+	if rep == nil {
+		return nil, datastores.ErrNotFound
+	}
+
+	return &datastores.IPReputationInfo{
+		IP:          ip,
+		Match:       match,
+		Status:      rep.Status.String(),
+		Severity:    rep.Severity,
+		Source:      rep.Source,
+		Tags:        rep.Tags,
+		LastUpdated: rep.LastUpdated,
+		Metadata:    rep.Metadata,
+	}, nil
 }
 
 // IsDenied checks if an IP address has a deny/block reputation status
@@ -319,6 +524,33 @@ func (s *store) CheckIPs(ips []string) map[string]*IPReputation {
 	return result
 }
 
+// Lifecycle (called by the datastore registry)
+
+// Initialize loads the feed files of the store (if any), and starts reloading
+// them when they change. A feed that fails to load fails the initialization.
+func (s *store) Initialize(ctx context.Context) error {
+	if s.feeds == nil {
+		return nil
+	}
+
+	if err := s.feeds.loadAll(); err != nil {
+		return err
+	}
+
+	ctx, s.cancel = context.WithCancel(ctx)
+	go s.feeds.run(ctx)
+
+	return nil
+}
+
+// Shutdown stops reloading the feed files of the store
+func (s *store) Shutdown(_ context.Context) error {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	return nil
+}
+
 // resolveConflict applies the conflict resolution policy when multiple sources have data for the same IP
 func (s *store) resolveConflict(existing, incoming *IPReputation, incomingSource string) *IPReputation {
 	if existing == nil {
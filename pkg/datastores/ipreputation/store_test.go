@@ -470,3 +470,91 @@ func TestIPReputationStore_ThreadSafety(t *testing.T) {
 	metrics := store.GetMetrics()
 	assert.Greater(t, metrics.ItemCount, int64(0))
 }
+
+func TestIPReputationStore_CIDR(t *testing.T) {
+	store := NewIPReputationStore(LastWriteWins, nil)
+	now := time.Now()
+
+	require.NoError(t, store.WriteReputationBatch("source1", map[string]*IPReputation{
+		"10.0.0.0/8":     {Status: ReputationSuspicious, Severity: 3, LastUpdated: now},
+		"10.1.0.0/16":    {Status: ReputationDeny, Severity: 7, LastUpdated: now},
+		"10.1.2.3":       {Status: ReputationAllow, Severity: 1, LastUpdated: now},
+		"2001:db8::/32":  {Status: ReputationDeny, Severity: 9, LastUpdated: now},
+		"192.168.1.7/24": {Status: ReputationDeny, Severity: 6, LastUpdated: now},
+	}))
+
+	tests := []struct {
+		ip     string
+		status ReputationStatus
+		match  string
+	}{
+		{ip: "10.1.2.3", status: ReputationAllow, match: "10.1.2.3"},
+		{ip: "::ffff:10.1.2.3", status: ReputationAllow, match: "10.1.2.3"},
+		{ip: "10.1.9.9", status: ReputationDeny, match: "10.1.0.0/16"},
+		{ip: "10.200.0.1", status: ReputationSuspicious, match: "10.0.0.0/8"},
+		{ip: "2001:db8::1", status: ReputationDeny, match: "2001:db8::/32"},
+		{ip: "192.168.1.200", status: ReputationDeny, match: "192.168.1.0/24"},
+	}
+	for _, tt := range tests {
+		info, err := store.GetIPReputation(tt.ip)
+		require.NoError(t, err, tt.ip)
+		assert.Equal(t, tt.status.String(), info.Status, tt.ip)
+		assert.Equal(t, tt.match, info.Match, tt.ip)
+		assert.Equal(t, tt.ip, info.IP)
+		assert.Equal(t, "source1", info.Source)
+	}
+
+	_, err := store.GetIPReputation("11.0.0.1")
+	assert.ErrorIs(t, err, datastores.ErrNotFound)
+	_, err = store.GetIPReputation("not-an-ip")
+	assert.ErrorIs(t, err, datastores.ErrNotFound)
+	assert.Equal(t, int64(5), store.GetMetrics().ItemCount)
+
+	// Removing the /16 range falls back to the /8 one
+	key, err := anypb.New(&datastores.IPAddressKey{Ip: "10.1.0.0/16"})
+	require.NoError(t, err)
+	require.NoError(t, store.Delete("source1", key))
+	info, err := store.GetIPReputation("10.1.9.9")
+	require.NoError(t, err)
+	assert.Equal(t, "10.0.0.0/8", info.Match)
+}
+
+func TestIPReputationStore_CIDR_ConflictResolution(t *testing.T) {
+	store := NewIPReputationStore(MaxSeverity, nil)
+	now := time.Now()
+
+	require.NoError(t, store.WriteReputation("feed", "10.0.0.0/8", &IPReputation{Status: ReputationDeny, Severity: 8, LastUpdated: now}))
+	require.NoError(t, store.WriteReputation("detector", "10.1.2.3", &IPReputation{Status: ReputationSuspicious, Severity: 4, LastUpdated: now}))
+
+	rep, found := store.GetReputation("10.1.2.3")
+	require.True(t, found)
+	assert.Equal(t, "feed", rep.Source, "sources are resolved by the conflict policy, not by specificity")
+	assert.True(t, store.IsDenied("10.1.2.3"))
+}
+
+func TestIPReputationStore_ReplaceSource(t *testing.T) {
+	store := NewIPReputationStore(LastWriteWins, nil)
+	now := time.Now()
+
+	require.NoError(t, store.WriteReputation("source1", "1.2.3.4", &IPReputation{Status: ReputationDeny, Severity: 8, LastUpdated: now}))
+	require.NoError(t, store.WriteReputation("source2", "5.6.7.8", &IPReputation{Status: ReputationDeny, Severity: 8, LastUpdated: now}))
+
+	require.NoError(t, store.ReplaceSource("source1", map[string]*IPReputation{
+		"9.9.9.0/24": {Status: ReputationDeny, Severity: 8, LastUpdated: now},
+	}))
+	assert.False(t, store.IsDenied("1.2.3.4"), "previous source data is replaced")
+	assert.True(t, store.IsDenied("9.9.9.9"))
+	assert.True(t, store.IsDenied("5.6.7.8"), "other sources are kept")
+
+	// Invalid data keeps the previous data
+	err := store.ReplaceSource("source1", map[string]*IPReputation{
+		"1.1.1.1": {Status: ReputationDeny, Severity: 11, LastUpdated: now},
+	})
+	require.Error(t, err)
+	assert.True(t, store.IsDenied("9.9.9.9"))
+
+	require.NoError(t, store.ReplaceSource("source1", nil))
+	sources, err := store.ListSources()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"source2"}, sources)
+}
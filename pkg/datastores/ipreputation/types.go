@@ -39,6 +39,13 @@ type IPReputation struct {
 	Metadata    map[string]string
 }
 
+// Config is the config of an IP reputation store preloaded from feed files
+type Config struct {
+	Enable         bool
+	Feeds          []string      // Feed files (CSV, JSON or plain IP/CIDR lists)
+	ReloadInterval time.Duration // How often feed files are checked for changes
+}
+
 // ConflictResolutionPolicy defines how to handle multiple sources writing different data for the same key
 type ConflictResolutionPolicy int
 
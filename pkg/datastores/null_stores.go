@@ -180,3 +180,27 @@ func (n *nullSyscallStore) GetSyscallName(id int32) (string, error) {
 func (n *nullSyscallStore) GetSyscallID(name string) (int32, error) {
 	return 0, datastores.ErrStoreUnhealthy
 }
+
+// nullIPReputationStore implements IPReputationStore with all operations returning ErrStoreUnhealthy
+type nullIPReputationStore struct{}
+
+func (n *nullIPReputationStore) Name() string { return "null_ip_reputation" }
+
+func (n *nullIPReputationStore) GetHealth() *datastores.HealthInfo {
+	return &datastores.HealthInfo{
+		Status:    datastores.HealthUnhealthy,
+		Message:   "IP reputation store not available",
+		LastCheck: time.Now(),
+	}
+}
+
+func (n *nullIPReputationStore) GetMetrics() *datastores.DataStoreMetrics {
+	return &datastores.DataStoreMetrics{
+		ItemCount:  0,
+		LastAccess: time.Now(),
+	}
+}
+
+func (n *nullIPReputationStore) GetIPReputation(ip string) (*datastores.IPReputationInfo, error) {
+	return nil, datastores.ErrStoreUnhealthy
+}
@@ -220,6 +220,35 @@ func TestNullSyscallStore(t *testing.T) {
 	})
 }
 
+func TestNullIPReputationStore(t *testing.T) {
+	store := &nullIPReputationStore{}
+
+	t.Run("Name", func(t *testing.T) {
+		assert.Equal(t, "null_ip_reputation", store.Name())
+	})
+
+	t.Run("GetHealth", func(t *testing.T) {
+		health := store.GetHealth()
+		assert.NotNil(t, health)
+		assert.Equal(t, datastores.HealthUnhealthy, health.Status)
+		assert.Equal(t, "IP reputation store not available", health.Message)
+		assert.False(t, health.LastCheck.IsZero())
+	})
+
+	t.Run("GetMetrics", func(t *testing.T) {
+		metrics := store.GetMetrics()
+		assert.NotNil(t, metrics)
+		assert.Equal(t, int64(0), metrics.ItemCount)
+		assert.False(t, metrics.LastAccess.IsZero())
+	})
+
+	t.Run("GetIPReputation", func(t *testing.T) {
+		info, err := store.GetIPReputation("1.2.3.4")
+		assert.Nil(t, info)
+		assert.ErrorIs(t, err, datastores.ErrStoreUnhealthy)
+	})
+}
+
 // TestNullStores_RegistryIntegration tests that null stores are properly returned by the registry
 func TestNullStores_RegistryIntegration(t *testing.T) {
 	t.Run("Processes returns null store when not registered", func(t *testing.T) {
@@ -305,6 +334,20 @@ func TestNullStores_RegistryIntegration(t *testing.T) {
 		_, err := store.GetSyscallName(1)
 		assert.ErrorIs(t, err, datastores.ErrStoreUnhealthy)
 	})
+
+	t.Run("IPReputation returns null store when not registered", func(t *testing.T) {
+		reg := NewRegistry()
+		store := reg.IPReputation()
+
+		assert.NotNil(t, store)
+		assert.Equal(t, "null_ip_reputation", store.Name())
+
+		health := store.GetHealth()
+		assert.Equal(t, datastores.HealthUnhealthy, health.Status)
+
+		_, err := store.GetIPReputation("1.2.3.4")
+		assert.ErrorIs(t, err, datastores.ErrStoreUnhealthy)
+	})
 }
 
 // TestNullStores_SafeMethodChaining verifies that null stores enable safe method chaining
@@ -0,0 +1,85 @@
+package process
+
+import (
+	"strings"
+)
+
+const (
+	DefaultCmdLineMaxSize = 1024 // 1KB of arguments (and environment) per process
+)
+
+// CmdLine is the command line, and the allowed environment variables, of the last exec
+// of a process. It is never modified once set: a new exec sets a new instance.
+type CmdLine struct {
+	Args []string `json:"args,omitempty"`
+	Env  []string `json:"env,omitempty"` // NAME=VALUE of the allowed variables
+}
+
+// newCmdLine returns the command line of an exec, keeping the environment variables in the
+// tree allowlist. Arguments and variables are kept while their size (including a separator
+// each) fits the tree limit: the one crossing it is truncated and the others dropped. It
+// returns nil if the tree doesn't keep command lines.
+func (pt *ProcessTree) newCmdLine(argv []string, env []string) *CmdLine {
+	if pt.cmdLineMaxSize <= 0 {
+		return nil
+	}
+
+	cmdLine := &CmdLine{}
+	left := pt.cmdLineMaxSize
+
+	cmdLine.Args, left = capStrings(argv, left)
+	if len(pt.envAllowlist) > 0 {
+		allowed := make([]string, 0, len(pt.envAllowlist))
+		for _, variable := range env {
+			if pt.isEnvAllowed(variable) {
+				allowed = append(allowed, variable)
+			}
+		}
+		cmdLine.Env, _ = capStrings(allowed, left)
+	}
+
+	return cmdLine
+}
+
+// isEnvAllowed returns true if the NAME=VALUE variable is in the tree allowlist. Allowlist
+// entries ending with '*' match the names starting with the rest of the entry.
+func (pt *ProcessTree) isEnvAllowed(variable string) bool {
+	name, _, _ := strings.Cut(variable, "=")
+	for _, allowed := range pt.envAllowlist {
+		if prefix, ok := strings.CutSuffix(allowed, "*"); ok {
+			if strings.HasPrefix(name, prefix) {
+				return true
+			}
+			continue
+		}
+		if name == allowed {
+			return true
+		}
+	}
+
+	return false
+}
+
+// capStrings copies the strings fitting in size bytes, counting a separator after each
+// string, and returns them with the bytes left. The copies don't retain the event buffers.
+func capStrings(values []string, size int) ([]string, int) {
+	if len(values) == 0 {
+		return nil, size
+	}
+
+	capped := make([]string, 0, len(values))
+	for _, value := range values {
+		if size <= 0 {
+			break
+		}
+		if len(value)+1 > size {
+			capped = append(capped, strings.Clone(value[:size-1]))
+			size = 0
+			break
+		}
+		capped = append(capped, strings.Clone(value))
+		size -= len(value) + 1
+	}
+
+	return capped, size
+}
@@ -0,0 +1,112 @@
+package process
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCmdLine(t *testing.T) {
+	t.Parallel()
+
+	env := []string{"PATH=/usr/bin", "LD_PRELOAD=/tmp/x.so", "LD_LIBRARY_PATH=/tmp", "KUBERNETES_PORT=443", "HOME=/root"}
+
+	tests := []struct {
+		name         string
+		maxSize      int
+		envAllowlist []string
+		argv         []string
+		expected     *CmdLine
+	}{
+		{
+			name:     "disabled",
+			maxSize:  0,
+			argv:     []string{"python", "app.py"},
+			expected: nil,
+		},
+		{
+			name:     "arguments only",
+			maxSize:  DefaultCmdLineMaxSize,
+			argv:     []string{"python", "app.py"},
+			expected: &CmdLine{Args: []string{"python", "app.py"}},
+		},
+		{
+			name:         "allowed environment",
+			maxSize:      DefaultCmdLineMaxSize,
+			envAllowlist: []string{"LD_*", "HOME"},
+			argv:         []string{"sh"},
+			expected: &CmdLine{
+				Args: []string{"sh"},
+				Env:  []string{"LD_PRELOAD=/tmp/x.so", "LD_LIBRARY_PATH=/tmp", "HOME=/root"},
+			},
+		},
+		{
+			name:     "argument crossing the limit truncated",
+			maxSize:  len("python") + 1 + len("-c") + 1 + 6,
+			argv:     []string{"python", "-c", "import pty", "dropped"},
+			expected: &CmdLine{Args: []string{"python", "-c", "impor"}},
+		},
+		{
+			name:         "environment after the arguments",
+			maxSize:      len("sh") + 1 + len("LD_PRELOAD=/tmp/x.so") + 1,
+			envAllowlist: []string{"LD_*"},
+			argv:         []string{"sh"},
+			expected: &CmdLine{
+				Args: []string{"sh"},
+				Env:  []string{"LD_PRELOAD=/tmp/x.so"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			pt := &ProcessTree{cmdLineMaxSize: tt.maxSize, envAllowlist: tt.envAllowlist}
+			assert.Equal(t, tt.expected, pt.newCmdLine(tt.argv, env))
+		})
+	}
+}
+
+func TestFeedFromExec_CmdLine(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	pt, err := NewProcessTree(ctx, ProcTreeConfig{
+		Source:                   SourceBoth,
+		ProcessCacheSize:         DefaultProcessCacheSize,
+		CmdLineMaxSize:           DefaultCmdLineMaxSize,
+		EnvAllowlist:             []string{"LD_PRELOAD"},
+		SkipProcfsInitForTesting: true,
+	})
+	require.NoError(t, err)
+
+	// The feed references the event buffers, which are reused after the exec
+	argv := []string{strings.Repeat("x", 8), "-c", "id"}
+	require.NoError(t, pt.FeedFromExec(&ExecFeed{
+		TimeStamp: 1000,
+		StartTime: 1000,
+		CmdPath:   "/usr/bin/python3",
+		PathName:  "/usr/bin/python3",
+		TaskHash:  42,
+		Args:      argv,
+		Env:       []string{"LD_PRELOAD=/tmp/x.so", "SECRET=1"},
+	}))
+	argv[2] = "ls"
+
+	process, ok := pt.GetProcessByHash(42)
+	require.True(t, ok)
+	assert.Equal(t, &CmdLine{
+		Args: []string{"xxxxxxxx", "-c", "id"},
+		Env:  []string{"LD_PRELOAD=/tmp/x.so"},
+	}, process.GetCmdLine())
+
+	// Another exec replaces the command line, even without one
+	require.NoError(t, pt.FeedFromExec(&ExecFeed{TimeStamp: 2000, StartTime: 1000, TaskHash: 42}))
+	assert.Equal(t, &CmdLine{}, process.GetCmdLine())
+}
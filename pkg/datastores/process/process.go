@@ -10,10 +10,11 @@ import (
 
 // Process represents a process.
 type Process struct {
-	processHash uint32        // hash of process (immutable, so no need of concurrency control)
-	parentHash  atomic.Uint32 // hash of parent
-	info        *TaskInfo     // task info (immutable pointer)
-	executable  *FileInfo     // executable info (immutable pointer)
+	processHash uint32                  // hash of process (immutable, so no need of concurrency control)
+	parentHash  atomic.Uint32           // hash of parent
+	info        *TaskInfo               // task info (immutable pointer)
+	executable  *FileInfo               // executable info (immutable pointer)
+	cmdLine     atomic.Pointer[CmdLine] // command line of the last exec (nil if unknown)
 }
 
 // NewProcess creates a new thread with an initialized task info.
@@ -48,9 +49,19 @@ func (p *Process) GetExecutable() *FileInfo {
 	return p.executable // immutable pointer
 }
 
+// GetCmdLine returns the command line of the last exec of the process, or nil if unknown.
+func (p *Process) GetCmdLine() *CmdLine {
+	return p.cmdLine.Load()
+}
+
 // Setters
 
 // SetParentHash sets the hash of the parent.
 func (p *Process) SetParentHash(parentHash uint32) {
 	p.parentHash.Store(parentHash)
 }
+
+// SetCmdLine sets the command line of the process. The command line must not be modified after.
+func (p *Process) SetCmdLine(cmdLine *CmdLine) {
+	p.cmdLine.Store(cmdLine)
+}
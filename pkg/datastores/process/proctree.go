@@ -68,6 +68,10 @@ type ProcTreeConfig struct {
 	Source                   SourceType
 	ProcessCacheSize         int
 	ThreadCacheSize          int
+	SnapshotPath             string        // file to persist the tree in across restarts (empty: disabled)
+	SnapshotInterval         time.Duration // interval between snapshots (0: only on shutdown)
+	CmdLineMaxSize           int           // bytes of command line kept per process (0: disabled)
+	EnvAllowlist             []string      // environment variables kept with the command line
 	SkipProcfsInitForTesting bool
 }
 
@@ -81,10 +85,14 @@ type ProcessTree struct {
 	procfsOnce        *sync.Once                     // busy loop debug message throttling
 	ctx               context.Context                // context for the process tree
 	lastAccessNano    atomic.Int64                   // last datastore access time (Unix nano)
+	snapshotPath      string                         // file the tree is persisted in (empty: disabled)
+	cmdLineMaxSize    int                            // bytes of command line kept per process
+	envAllowlist      []string                       // environment variables kept (NAME or PREFIX*)
 
 	// mutexes
 	processesThreadsMtx  sync.RWMutex
 	processesChildrenMtx sync.RWMutex
+	snapshotMtx          sync.Mutex
 
 	// pools
 	forkFeedPool     *sync.Pool // pool of ForkFeed instances
@@ -101,6 +109,9 @@ func NewProcessTree(ctx context.Context, config ProcTreeConfig) (*ProcessTree, e
 		processesChildren: make(map[uint32]map[uint32]struct{}),
 		procfsOnce:        new(sync.Once),
 		ctx:               ctx,
+		snapshotPath:      config.SnapshotPath,
+		cmdLineMaxSize:    config.CmdLineMaxSize,
+		envAllowlist:      config.EnvAllowlist,
 		forkFeedPool: &sync.Pool{
 			New: func() interface{} {
 				return &ForkFeed{}
@@ -202,6 +213,15 @@ func NewProcessTree(ctx context.Context, config ProcTreeConfig) (*ProcessTree, e
 		}
 	}()
 
+	if procTree.snapshotPath != "" {
+		// Restore the tree persisted by a previous run, before procfs is walked, so the tasks
+		// still running keep their ancestry and history.
+		procTree.restoreFromSnapshot()
+		if config.SnapshotInterval > 0 {
+			go procTree.snapshotLoop(config.SnapshotInterval)
+		}
+	}
+
 	if !config.SkipProcfsInitForTesting {
 		// Walk procfs and feed the process tree with data.
 		procTree.FeedFromProcFSAsync(AllPIDs)
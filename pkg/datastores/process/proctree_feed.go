@@ -207,6 +207,8 @@ type ExecFeed struct {
 	PathName          string
 	Interp            string
 	StdinPath         string
+	Args              []string // argv, referenced until the feed is released
+	Env               []string // environment, nil if not captured
 	Pid               int32
 	Tid               int32
 	PPid              int32
@@ -296,6 +298,8 @@ func (pt *ProcessTree) FeedFromExec(feed *ExecFeed) error {
 	// Release the feed back to the pool as soon as it is not needed anymore
 	pt.PutFileInfoFeedInPool(fileInfoFeed)
 
+	process.SetCmdLine(pt.newCmdLine(feed.Args, feed.Env))
+
 	return nil
 }
 
@@ -0,0 +1,416 @@
+package process
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/aquasecurity/tracee/common/errfmt"
+	"github.com/aquasecurity/tracee/common/logger"
+	"github.com/aquasecurity/tracee/common/proc"
+	"github.com/aquasecurity/tracee/common/timeutil"
+)
+
+//
+// The process tree snapshot:
+//
+// 1. The snapshot holds the processes and threads of the LRU caches, from the least to the most
+//    recently used, with their changelogs (task info and executable).
+// 2. It is written periodically and on shutdown, replacing the previous snapshot atomically.
+// 3. At startup, before procfs is walked, the snapshot is reconciled against procfs: a task is
+//    restored if its hash matches its pid and start time, and procfs shows the same task (same
+//    start time) still running. Ancestors of the restored processes are kept, even if they exited,
+//    so the parent chains of long-lived processes survive restarts.
+// 4. Tasks that exited (or had their pid reused) while tracee was down are dropped, as are all the
+//    tasks of a snapshot from a previous boot (their start times no longer match procfs).
+//
+
+const (
+	DefaultSnapshotInterval = 5 * time.Minute // interval between periodic snapshots
+	snapshotVersion         = 1               // bumped on incompatible format changes
+)
+
+// snapshot is the persisted form of the process tree.
+type snapshot struct {
+	Version   int               `json:"version"`
+	Time      int64             `json:"time"` // epoch ns
+	Processes []snapshotProcess `json:"processes"`
+	Threads   []snapshotThread  `json:"threads,omitempty"`
+}
+
+// snapshotEntry is a changelog entry, set at the given time (epoch ns).
+type snapshotEntry[T any] struct {
+	Time  int64 `json:"time"`
+	Value T     `json:"value"`
+}
+
+type snapshotProcess struct {
+	Hash       uint32                        `json:"hash"`
+	ParentHash uint32                        `json:"parent_hash"`
+	Info       []snapshotEntry[TaskInfoFeed] `json:"info"`
+	Executable []snapshotEntry[FileInfoFeed] `json:"executable,omitempty"`
+	CmdLine    *CmdLine                      `json:"cmdline,omitempty"`
+}
+
+type snapshotThread struct {
+	Hash       uint32                        `json:"hash"`
+	ParentHash uint32                        `json:"parent_hash"`
+	LeaderHash uint32                        `json:"leader_hash"`
+	Info       []snapshotEntry[TaskInfoFeed] `json:"info,omitempty"` // empty if shared with its process
+}
+
+// taskHashFromProcFS returns the hash of the task currently running with the given pid and tid,
+// according to procfs (replaced by tests).
+var taskHashFromProcFS = func(pid, tid int32) (uint32, error) {
+	stat, err := proc.NewThreadProcStatFields(
+		pid,
+		tid,
+		[]proc.StatField{
+			proc.StatStartTime,
+		},
+	)
+	if err != nil {
+		return 0, errfmt.WrapError(err)
+	}
+
+	epochTimeNs := timeutil.ProcfsStartTimeToEpochNS(stat.GetStartTime())
+
+	return HashTaskID(uint32(tid), epochTimeNs), nil
+}
+
+//
+// Writing
+//
+
+// snapshotLoop writes the snapshot at the given interval, until the process tree context is done.
+func (pt *ProcessTree) snapshotLoop(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-pt.ctx.Done():
+			return
+		case <-ticker.C:
+			if err := pt.writeSnapshot(); err != nil {
+				logger.Warnw("failed to write proctree snapshot", "path", pt.snapshotPath, "error", err)
+			}
+		}
+	}
+}
+
+// Shutdown writes the final snapshot of the process tree, if snapshots are enabled.
+func (pt *ProcessTree) Shutdown(_ context.Context) error {
+	if pt.snapshotPath == "" {
+		return nil
+	}
+
+	return pt.writeSnapshot()
+}
+
+// writeSnapshot writes the process tree to the snapshot file, replacing it atomically.
+func (pt *ProcessTree) writeSnapshot() error {
+	pt.snapshotMtx.Lock()
+	defer pt.snapshotMtx.Unlock()
+
+	data, err := json.Marshal(pt.takeSnapshot())
+	if err != nil {
+		return errfmt.WrapError(err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(pt.snapshotPath), 0o700); err != nil {
+		return errfmt.WrapError(err)
+	}
+	tmpPath := pt.snapshotPath + ".tmp"
+	err = os.WriteFile(tmpPath, data, 0o600)
+	if err == nil {
+		err = os.Rename(tmpPath, pt.snapshotPath)
+	}
+	if err != nil {
+		_ = os.Remove(tmpPath)
+		return errfmt.WrapError(err)
+	}
+
+	return nil
+}
+
+// takeSnapshot copies the processes and threads of the tree, without changing their recency.
+func (pt *ProcessTree) takeSnapshot() *snapshot {
+	snap := &snapshot{
+		Version:   snapshotVersion,
+		Time:      time.Now().UnixNano(),
+		Processes: make([]snapshotProcess, 0, pt.processesLRU.Len()),
+	}
+
+	// Keys are ordered from the least to the most recently used, so restoring them in order
+	// reproduces the eviction order.
+	for _, hash := range pt.processesLRU.Keys() {
+		process, ok := pt.processesLRU.Peek(hash)
+		if !ok {
+			continue // evicted meanwhile
+		}
+		snap.Processes = append(snap.Processes, snapshotProcess{
+			Hash:       hash,
+			ParentHash: process.GetParentHash(),
+			Info:       process.GetInfo().snapshotEntries(),
+			Executable: process.GetExecutable().snapshotEntries(),
+			CmdLine:    snapshotCmdLine(process.GetCmdLine()),
+		})
+	}
+
+	if pt.threadsLRU == nil {
+		return snap
+	}
+
+	for _, hash := range pt.threadsLRU.Keys() {
+		thread, ok := pt.threadsLRU.Peek(hash)
+		if !ok {
+			continue
+		}
+		snapThread := snapshotThread{
+			Hash:       hash,
+			ParentHash: thread.GetParentHash(),
+			LeaderHash: thread.GetLeaderHash(),
+		}
+		if _, ok := pt.processesLRU.Peek(hash); !ok {
+			snapThread.Info = thread.GetInfo().snapshotEntries()
+		}
+		snap.Threads = append(snap.Threads, snapThread)
+	}
+
+	return snap
+}
+
+// snapshotCmdLine returns the command line of a process to persist. The environment
+// variables are left out, as the allowed ones may hold secrets: a restored process only
+// gets them back from its next exec.
+func snapshotCmdLine(cmdLine *CmdLine) *CmdLine {
+	if cmdLine == nil || len(cmdLine.Env) == 0 {
+		return cmdLine
+	}
+
+	return &CmdLine{Args: cmdLine.Args}
+}
+
+//
+// Restoring
+//
+
+// restoreSnapshot feeds the process tree with the tasks of the snapshot file which are still
+// running, and the ancestors of the running processes. It returns the number of restored
+// processes and threads.
+func (pt *ProcessTree) restoreSnapshot() (int, int, error) {
+	data, err := os.ReadFile(pt.snapshotPath)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	var snap snapshot
+	if err := json.Unmarshal(data, &snap); err != nil {
+		return 0, 0, errfmt.WrapError(err)
+	}
+	if snap.Version != snapshotVersion {
+		return 0, 0, errfmt.Errorf("unsupported process tree snapshot version: %d", snap.Version)
+	}
+
+	// Running processes, and their ancestors
+
+	records := make(map[uint32]*snapshotProcess, len(snap.Processes))
+	running := make(map[uint32]bool, len(snap.Processes))
+	for i := range snap.Processes {
+		record := &snap.Processes[i]
+		feed, ok := validSnapshotEntries(record.Hash, record.Info)
+		if !ok {
+			continue // stale or incomplete record
+		}
+		records[record.Hash] = record
+		running[record.Hash] = isTaskRunning(record.Hash, feed)
+	}
+
+	kept := make(map[uint32]struct{}, len(records))
+	for hash := range records {
+		if !running[hash] {
+			continue
+		}
+		for ancestor := hash; ancestor != 0; ancestor = records[ancestor].ParentHash {
+			if _, ok := kept[ancestor]; ok {
+				break // chain already kept
+			}
+			if _, ok := records[ancestor]; !ok {
+				break // chain ends in the snapshot
+			}
+			kept[ancestor] = struct{}{}
+		}
+	}
+
+	// The ancestors which exited while tracee was down did so before now
+	exitTime := uint64(time.Now().UnixNano())
+
+	restoredProcesses := 0
+	for i := range snap.Processes {
+		record := &snap.Processes[i]
+		if _, ok := kept[record.Hash]; !ok {
+			continue
+		}
+		process := pt.GetOrCreateProcessByHash(record.Hash)
+		process.GetInfo().restoreEntries(record.Info)
+		process.GetExecutable().restoreEntries(record.Executable)
+		if record.CmdLine != nil {
+			// the limit and allowlist may have changed since the snapshot
+			process.SetCmdLine(pt.newCmdLine(record.CmdLine.Args, record.CmdLine.Env))
+		}
+		if !running[record.Hash] && process.GetInfo().GetExitTimeNS() == 0 {
+			process.GetInfo().SetExitTime(exitTime)
+		}
+		process.SetParentHash(record.ParentHash)
+		if _, ok := kept[record.ParentHash]; ok {
+			pt.AddChildToProcess(record.ParentHash, record.Hash)
+		}
+		if thread, ok := pt.GetThreadByHash(record.Hash); ok {
+			thread.SetParentHash(record.ParentHash)
+		}
+		restoredProcesses++
+	}
+
+	if pt.threadsLRU == nil {
+		return restoredProcesses, 0, nil
+	}
+
+	// Running threads of running processes
+
+	restoredThreads := 0
+	for i := range snap.Threads {
+		record := &snap.Threads[i]
+		if !running[record.LeaderHash] {
+			continue
+		}
+		if len(record.Info) == 0 {
+			continue // group leader, restored with its process
+		}
+		feed, ok := validSnapshotEntries(record.Hash, record.Info)
+		if !ok || !isTaskRunning(record.Hash, feed) {
+			continue
+		}
+		thread := pt.GetOrCreateThreadByHash(record.Hash)
+		thread.GetInfo().restoreEntries(record.Info)
+		thread.SetParentHash(record.ParentHash)
+		thread.SetLeaderHash(record.LeaderHash)
+		pt.AddThreadToProcess(record.LeaderHash, record.Hash)
+		restoredThreads++
+	}
+
+	return restoredProcesses, restoredThreads, nil
+}
+
+// restoreFromSnapshot restores the snapshot, if any, logging the outcome.
+func (pt *ProcessTree) restoreFromSnapshot() {
+	processes, threads, err := pt.restoreSnapshot()
+	if err != nil {
+		if os.IsNotExist(err) {
+			logger.Debugw("no proctree snapshot to restore", "path", pt.snapshotPath)
+			return
+		}
+		logger.Warnw("failed to restore proctree snapshot", "path", pt.snapshotPath, "error", err)
+		return
+	}
+
+	logger.Debugw("restored proctree snapshot",
+		"path", pt.snapshotPath,
+		"processes", processes,
+		"threads", threads,
+	)
+}
+
+// validSnapshotEntries returns the most recent feed identifying the task of a snapshot record,
+// and whether the record hash matches the task tid and start time.
+func validSnapshotEntries(hash uint32, entries []snapshotEntry[TaskInfoFeed]) (TaskInfoFeed, bool) {
+	for i := len(entries) - 1; i >= 0; i-- {
+		feed := entries[i].Value
+		if feed.Tid <= 0 || feed.StartTimeNS == 0 {
+			continue
+		}
+		return feed, HashTaskID(uint32(feed.Tid), feed.StartTimeNS) == hash
+	}
+
+	return TaskInfoFeed{}, false
+}
+
+// isTaskRunning returns true if the task of a snapshot record has not exited, and procfs shows
+// the same task (same start time) running with its pid and tid.
+func isTaskRunning(hash uint32, feed TaskInfoFeed) bool {
+	if feed.ExitTimeNS != 0 || feed.Pid <= 0 {
+		return false
+	}
+
+	procfsHash, err := taskHashFromProcFS(feed.Pid, feed.Tid)
+
+	return err == nil && procfsHash == hash
+}
+
+//
+// Changelog helpers
+//
+
+// snapshotEntries returns the changelog entries of the task, from the oldest to the newest.
+func (ti *TaskInfo) snapshotEntries() []snapshotEntry[TaskInfoFeed] {
+	ti.mutex.RLock()
+	defer ti.mutex.RUnlock()
+
+	entries := ti.feed.GetEntries()
+	snapEntries := make([]snapshotEntry[TaskInfoFeed], 0, len(entries))
+	for _, entry := range entries {
+		if entry.Value == nil {
+			continue
+		}
+		snapEntries = append(snapEntries, snapshotEntry[TaskInfoFeed]{
+			Time:  entry.Timestamp.UnixNano(),
+			Value: *entry.Value,
+		})
+	}
+
+	return snapEntries
+}
+
+// restoreEntries sets the given changelog entries of the task, from the oldest to the newest.
+func (ti *TaskInfo) restoreEntries(entries []snapshotEntry[TaskInfoFeed]) {
+	ti.mutex.Lock()
+	defer ti.mutex.Unlock()
+
+	for _, entry := range entries {
+		feed := entry.Value
+		ti.setFeedAt(&feed, time.Unix(0, entry.Time))
+	}
+}
+
+// snapshotEntries returns the changelog entries of the file, from the oldest to the newest.
+func (fi *FileInfo) snapshotEntries() []snapshotEntry[FileInfoFeed] {
+	fi.mutex.RLock()
+	defer fi.mutex.RUnlock()
+
+	entries := fi.feed.GetEntries()
+	snapEntries := make([]snapshotEntry[FileInfoFeed], 0, len(entries))
+	for _, entry := range entries {
+		if entry.Value == nil {
+			continue
+		}
+		snapEntries = append(snapEntries, snapshotEntry[FileInfoFeed]{
+			Time:  entry.Timestamp.UnixNano(),
+			Value: *entry.Value,
+		})
+	}
+
+	return snapEntries
+}
+
+// restoreEntries sets the given changelog entries of the file, from the oldest to the newest.
+func (fi *FileInfo) restoreEntries(entries []snapshotEntry[FileInfoFeed]) {
+	fi.mutex.Lock()
+	defer fi.mutex.Unlock()
+
+	for _, entry := range entries {
+		feed := entry.Value
+		fi.feed.Set(&feed, time.Unix(0, entry.Time))
+	}
+}
@@ -0,0 +1,208 @@
+package process
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// snapshotTestTask is a task of the snapshot tests
+type snapshotTestTask struct {
+	pid, tid  int32
+	startTime uint64
+}
+
+func (task snapshotTestTask) hash() uint32 {
+	return HashTaskID(uint32(task.tid), task.startTime)
+}
+
+// newSnapshotTestTree returns a tree persisted in the given snapshot file
+func newSnapshotTestTree(t *testing.T, path string, threadCacheSize int) *ProcessTree {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	pt, err := NewProcessTree(ctx, ProcTreeConfig{
+		Source:                   SourceBoth,
+		ProcessCacheSize:         DefaultProcessCacheSize,
+		ThreadCacheSize:          threadCacheSize,
+		SnapshotPath:             path,
+		CmdLineMaxSize:           DefaultCmdLineMaxSize,
+		SkipProcfsInitForTesting: true,
+	})
+	require.NoError(t, err)
+
+	return pt
+}
+
+// addSnapshotTestProcess adds a process, started at its start time, to the tree
+func addSnapshotTestProcess(pt *ProcessTree, task snapshotTestTask, name string, parent uint32) *Process {
+	process := pt.GetOrCreateProcessByHash(task.hash())
+	process.GetInfo().SetFeedAt(&TaskInfoFeed{
+		Name:        name,
+		Tid:         task.tid,
+		Pid:         task.pid,
+		PPid:        1,
+		NsTid:       task.tid,
+		NsPid:       task.pid,
+		NsPPid:      1,
+		StartTimeNS: task.startTime,
+	}, time.Unix(0, int64(task.startTime)))
+	process.GetExecutable().SetFeedAt(&FileInfoFeed{Path: "/usr/bin/" + name, Inode: 42}, time.Unix(0, int64(task.startTime)))
+	if parent != 0 {
+		process.SetParentHash(parent)
+		pt.AddChildToProcess(parent, task.hash())
+	}
+
+	return process
+}
+
+// fakeRunningTasks makes procfs show the given tasks running, and only them
+func fakeRunningTasks(t *testing.T, tasks ...snapshotTestTask) {
+	taskHashFromProcFSBackup := taskHashFromProcFS
+	t.Cleanup(func() { taskHashFromProcFS = taskHashFromProcFSBackup })
+
+	taskHashFromProcFS = func(pid, tid int32) (uint32, error) {
+		for _, task := range tasks {
+			if task.pid == pid && task.tid == tid {
+				return task.hash(), nil
+			}
+		}
+		return 0, os.ErrNotExist
+	}
+}
+
+func TestProcessTreeSnapshot(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "proctree", "snapshot.json")
+	start := uint64(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC).UnixNano())
+
+	shell := snapshotTestTask{pid: 100, tid: 100, startTime: start}
+	server := snapshotTestTask{pid: 200, tid: 200, startTime: start + uint64(time.Second)}
+	exited := snapshotTestTask{pid: 300, tid: 300, startTime: start + uint64(2*time.Second)}
+	reused := snapshotTestTask{pid: 400, tid: 400, startTime: start + uint64(3*time.Second)}
+
+	pt := newSnapshotTestTree(t, path, 0)
+	addSnapshotTestProcess(pt, shell, "bash", 0)
+	addSnapshotTestProcess(pt, server, "nginx", shell.hash()).SetCmdLine(&CmdLine{
+		Args: []string{"nginx", "-g", "daemon off;"},
+		Env:  []string{"API_TOKEN=secret"},
+	})
+	addSnapshotTestProcess(pt, exited, "ls", shell.hash())
+	addSnapshotTestProcess(pt, reused, "sleep", 0)
+	require.NoError(t, pt.Shutdown(context.Background()))
+
+	// The environment variables are never written to disk
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "API_TOKEN")
+
+	// The shell exited and the pid of sleep was reused while tracee was down
+	fakeRunningTasks(t, server, snapshotTestTask{pid: 400, tid: 400, startTime: start + uint64(time.Hour)})
+	restored := newSnapshotTestTree(t, path, 0)
+
+	// The running server is restored with its history
+	process, ok := restored.GetProcessByHash(server.hash())
+	require.True(t, ok)
+	assert.Equal(t, shell.hash(), process.GetParentHash())
+	assert.Equal(t, "nginx", process.GetInfo().GetName())
+	assert.Equal(t, server.startTime, process.GetInfo().GetStartTimeNS())
+	assert.True(t, process.GetInfo().IsAlive())
+	assert.Equal(t, "/usr/bin/nginx", process.GetExecutable().GetPath())
+	assert.Equal(t, uint64(42), process.GetExecutable().GetInode())
+	assert.Equal(t, &CmdLine{Args: []string{"nginx", "-g", "daemon off;"}}, process.GetCmdLine())
+
+	// Its parent is kept for its ancestry, as exited
+	parent, ok := restored.GetProcessByHash(shell.hash())
+	require.True(t, ok)
+	assert.Equal(t, "bash", parent.GetInfo().GetName())
+	assert.False(t, parent.GetInfo().IsAlive())
+	assert.True(t, parent.GetInfo().IsAliveAt(time.Unix(0, int64(server.startTime))))
+	assert.Equal(t, []uint32{server.hash()}, restored.GetChildren(shell.hash()))
+
+	// The exited process and the stale pid aren't restored
+	_, ok = restored.GetProcessByHash(exited.hash())
+	assert.False(t, ok)
+	_, ok = restored.GetProcessByHash(reused.hash())
+	assert.False(t, ok)
+}
+
+func TestProcessTreeSnapshot_Threads(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "snapshot.json")
+	start := uint64(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC).UnixNano())
+
+	leader := snapshotTestTask{pid: 100, tid: 100, startTime: start}
+	worker := snapshotTestTask{pid: 100, tid: 101, startTime: start + uint64(time.Second)}
+	done := snapshotTestTask{pid: 100, tid: 102, startTime: start + uint64(2*time.Second)}
+
+	pt := newSnapshotTestTree(t, path, DefaultProcessCacheSize)
+	addSnapshotTestProcess(pt, leader, "java", 0)
+	for _, task := range []snapshotTestTask{worker, done} {
+		thread := pt.GetOrCreateThreadByHash(task.hash())
+		thread.GetInfo().SetFeedAt(&TaskInfoFeed{
+			Name:        "worker",
+			Tid:         task.tid,
+			Pid:         task.pid,
+			StartTimeNS: task.startTime,
+		}, time.Unix(0, int64(task.startTime)))
+		thread.SetLeaderHash(leader.hash())
+		pt.AddThreadToProcess(leader.hash(), task.hash())
+	}
+	require.NoError(t, pt.writeSnapshot())
+
+	fakeRunningTasks(t, leader, worker)
+	restored := newSnapshotTestTree(t, path, DefaultProcessCacheSize)
+
+	// The group leader shares the info of its process
+	process, ok := restored.GetProcessByHash(leader.hash())
+	require.True(t, ok)
+	thread, ok := restored.GetThreadByHash(leader.hash())
+	require.True(t, ok)
+	assert.Same(t, process.GetInfo(), thread.GetInfo())
+
+	// Only the running threads are restored
+	thread, ok = restored.GetThreadByHash(worker.hash())
+	require.True(t, ok)
+	assert.Equal(t, "worker", thread.GetInfo().GetName())
+	assert.Equal(t, leader.hash(), thread.GetLeaderHash())
+	_, ok = restored.GetThreadByHash(done.hash())
+	assert.False(t, ok)
+	assert.ElementsMatch(t, []uint32{leader.hash(), worker.hash()}, restored.GetThreads(leader.hash()))
+}
+
+func TestProcessTreeSnapshot_Invalid(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "snapshot.json")
+	pt := newSnapshotTestTree(t, "", 0)
+
+	// Snapshots are disabled without a path
+	require.NoError(t, pt.Shutdown(context.Background()))
+
+	// A missing snapshot isn't restored
+	pt.snapshotPath = path
+	_, _, err := pt.restoreSnapshot()
+	assert.True(t, os.IsNotExist(err))
+
+	// Nor is a snapshot of another version
+	require.NoError(t, os.WriteFile(path, []byte(`{"version":0,"processes":[]}`), 0o600))
+	_, _, err = pt.restoreSnapshot()
+	assert.Error(t, err)
+
+	// Records which don't match their hash are stale
+	start := uint64(time.Now().UnixNano())
+	task := snapshotTestTask{pid: 100, tid: 100, startTime: start}
+	fakeRunningTasks(t, task)
+	process := addSnapshotTestProcess(pt, task, "bash", 0)
+	process.GetInfo().SetFeedAt(&TaskInfoFeed{Tid: 100, Pid: 100, StartTimeNS: start + uint64(time.Second)},
+		time.Unix(0, int64(start)).Add(time.Second))
+	require.NoError(t, pt.writeSnapshot())
+
+	restored := newSnapshotTestTree(t, "", 0)
+	restored.snapshotPath = path
+	processes, threads, err := restored.restoreSnapshot()
+	require.NoError(t, err)
+	assert.Zero(t, processes)
+	assert.Zero(t, threads)
+}
@@ -106,6 +106,7 @@ func (pt *ProcessTree) GetProcess(entityId uint32) (*datastores.ProcessInfo, err
 
 	info := proc.GetInfo()
 	executable := proc.GetExecutable()
+	cmdLine := getCmdLine(proc)
 
 	return &datastores.ProcessInfo{
 		UniqueId:       entityId,
@@ -122,6 +123,9 @@ func (pt *ProcessTree) GetProcess(entityId uint32) (*datastores.ProcessInfo, err
 		ExitTime:  info.GetExitTime(),
 		UID:       info.GetUid(),
 		GID:       info.GetGid(),
+
+		CmdLine: cmdLine.Args,
+		Env:     cmdLine.Env,
 	}, nil
 }
 
@@ -172,6 +176,7 @@ func (pt *ProcessTree) GetAncestry(entityId uint32, maxDepth int) ([]*datastores
 
 		info := proc.GetInfo()
 		executable := proc.GetExecutable()
+		cmdLine := getCmdLine(proc)
 		parentHash := proc.GetParentHash()
 
 		ancestry = append(ancestry, &datastores.ProcessInfo{
@@ -189,6 +194,9 @@ func (pt *ProcessTree) GetAncestry(entityId uint32, maxDepth int) ([]*datastores
 			ExitTime:  info.GetExitTime(),
 			UID:       info.GetUid(),
 			GID:       info.GetGid(),
+
+			CmdLine: cmdLine.Args,
+			Env:     cmdLine.Env,
 		})
 
 		// Move to parent
@@ -200,3 +208,11 @@ func (pt *ProcessTree) GetAncestry(entityId uint32, maxDepth int) ([]*datastores
 
 	return ancestry, nil
 }
+
+// getCmdLine returns the command line of a process, empty if unknown
+func getCmdLine(proc *Process) CmdLine {
+	if cmdLine := proc.GetCmdLine(); cmdLine != nil {
+		return *cmdLine
+	}
+	return CmdLine{}
+}
@@ -42,6 +42,7 @@ type Registry struct {
 	dnsStore          datastores.DNSStore
 	systemStore       datastores.SystemStore
 	syscallStore      datastores.SyscallStore
+	ipReputationStore datastores.IPReputationStore
 
 	// Lifecycle tracking
 	initialized map[string]bool
@@ -59,6 +60,7 @@ func NewRegistry() *Registry {
 		dnsStore:          &nullDNSStore{},
 		systemStore:       &nullSystemStore{},
 		syscallStore:      &nullSyscallStore{},
+		ipReputationStore: &nullIPReputationStore{},
 	}
 }
 
@@ -125,6 +127,10 @@ func (r *Registry) RegisterStore(name string, store datastores.DataStore, requir
 		if sc, ok := store.(datastores.SyscallStore); ok {
 			r.syscallStore = sc
 		}
+	case datastores.Reputation:
+		if rs, ok := store.(datastores.IPReputationStore); ok {
+			r.ipReputationStore = rs
+		}
 	}
 
 	r.stores[name] = store
@@ -162,6 +168,14 @@ func (r *Registry) Syscalls() datastores.SyscallStore {
 	return r.syscallStore
 }
 
+// IPReputation returns the IP reputation datastore
+func (r *Registry) IPReputation() datastores.IPReputationStore {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.ipReputationStore
+}
+
 // GetCustom returns a custom datastore by name with type safety
 func (r *Registry) GetCustom(name string) (datastores.DataStore, error) {
 	r.mu.RLock()
@@ -196,6 +210,14 @@ func (r *Registry) RegisterWritableStore(name string, store datastores.WritableS
 		return fmt.Errorf("datastore '%s' already registered", name)
 	}
 
+	// A writable IP reputation store (e.g. fed by a detector) is also exposed
+	// through the typed accessor
+	if name == datastores.Reputation {
+		if rs, ok := store.(datastores.IPReputationStore); ok {
+			r.ipReputationStore = rs
+		}
+	}
+
 	// Register the writable store (it's also a DataStore)
 	r.stores[name] = store
 
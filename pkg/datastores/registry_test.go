@@ -11,6 +11,7 @@ import (
 	"google.golang.org/protobuf/types/known/anypb"
 
 	"github.com/aquasecurity/tracee/api/v1beta1/datastores"
+	"github.com/aquasecurity/tracee/pkg/datastores/ipreputation"
 )
 
 // mockDataStore is a simple mock for testing
@@ -510,6 +511,41 @@ func (m *mockWritableStore) ListSources() ([]string, error) {
 	return []string{}, nil
 }
 
+func TestRegistry_IPReputation(t *testing.T) {
+	t.Run("RegisterStore", func(t *testing.T) {
+		registry := NewRegistry()
+		store := ipreputation.NewIPReputationStore(ipreputation.LastWriteWins, nil)
+
+		require.NoError(t, registry.RegisterStore(datastores.Reputation, store, false))
+		assert.Equal(t, store, registry.IPReputation())
+	})
+
+	t.Run("RegisterWritableStore", func(t *testing.T) {
+		registry := NewRegistry()
+		store := ipreputation.NewIPReputationStore(ipreputation.LastWriteWins, nil)
+
+		require.NoError(t, registry.RegisterWritableStore(datastores.Reputation, store))
+		assert.Equal(t, store, registry.IPReputation())
+
+		require.NoError(t, store.WriteReputation("detector", "10.0.0.0/8", &ipreputation.IPReputation{
+			Status:   ipreputation.ReputationDeny,
+			Severity: 8,
+		}))
+		info, err := registry.IPReputation().GetIPReputation("10.1.2.3")
+		require.NoError(t, err)
+		assert.Equal(t, "deny", info.Status)
+		assert.Equal(t, "10.0.0.0/8", info.Match)
+	})
+
+	t.Run("Other writable stores are not exposed", func(t *testing.T) {
+		registry := NewRegistry()
+		store := &mockWritableStore{mockDataStore: mockDataStore{name: "test_writable"}}
+
+		require.NoError(t, registry.RegisterWritableStore("test_writable", store))
+		assert.Equal(t, "null_ip_reputation", registry.IPReputation().Name())
+	})
+}
+
 func TestRegistry_RegisterWritableStore(t *testing.T) {
 	t.Run("Register_Success", func(t *testing.T) {
 		registry := NewRegistry()
@@ -310,6 +310,8 @@ func (d *dispatcher) autoPopulateFieldsFromOutput(event *v1beta1.Event, output *
 			UniqueId: &wrapperspb.UInt32Value{Value: ancestor.UniqueId},
 			HostPid:  &wrapperspb.UInt32Value{Value: ancestor.HostPid},
 			Pid:      &wrapperspb.UInt32Value{Value: ancestor.Pid},
+			Cmdline:  ancestor.CmdLine,
+			Env:      ancestor.Env,
 		}
 
 		// Populate thread with process name (comm)
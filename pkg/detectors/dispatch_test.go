@@ -663,6 +663,7 @@ func TestAutoPopulateFields_ProcessAncestry(t *testing.T) {
 	}
 	bashProc.GetInfo().SetFeed(bashFeed)
 	bashProc.SetParentHash(100)
+	bashProc.SetCmdLine(&process.CmdLine{Args: []string{"bash", "-i"}})
 
 	pythonProc := pt.GetOrCreateProcessByHash(300)
 	pythonFeed := &process.TaskInfoFeed{
@@ -742,12 +743,14 @@ func TestAutoPopulateFields_ProcessAncestry(t *testing.T) {
 	assert.Equal(t, uint32(200), bash.UniqueId.GetValue())
 	assert.Equal(t, uint32(1000), bash.HostPid.GetValue())
 	assert.Equal(t, uint32(1), bash.Pid.GetValue()) // VERIFY: namespace PID
+	assert.Equal(t, []string{"bash", "-i"}, bash.Cmdline)
 
 	// Check init (second ancestor)
 	init := output.Workload.Process.Ancestors[1]
 	assert.Equal(t, uint32(100), init.UniqueId.GetValue())
 	assert.Equal(t, uint32(1), init.HostPid.GetValue())
 	assert.Equal(t, uint32(1), init.Pid.GetValue()) // Same for init
+	assert.Empty(t, init.Cmdline)                   // Unknown command line
 }
 
 // TestAutoPopulateFields_DetectedFrom_ChainPreservation tests that detection chains are preserved
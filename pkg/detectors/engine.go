@@ -2,6 +2,7 @@ package detectors
 
 import (
 	"context"
+	"sort"
 
 	"github.com/aquasecurity/tracee/api/v1beta1"
 	"github.com/aquasecurity/tracee/api/v1beta1/detection"
@@ -16,6 +17,15 @@ type Engine struct {
 	enrichmentOptions *EnrichmentOptions
 }
 
+// DetectorInfo describes a registered detector and its runtime state
+type DetectorInfo struct {
+	Definition *detection.DetectorDefinition
+	EventID    v1beta1.EventId
+	Enabled    bool // Initialized, processing events while selected
+	Selected   bool // Output event selected by policy
+	Stats      DetectorStats
+}
+
 // NewEngine creates a new detector engine
 func NewEngine(policyManager *policy.Manager, enrichmentOptions *EnrichmentOptions) *Engine {
 	registry := newRegistry(policyManager, enrichmentOptions)
@@ -82,6 +92,39 @@ func (e *Engine) DisableDetector(detectorID string) error {
 	return e.registry.DisableDetector(detectorID)
 }
 
+// GetDetectorInfo returns the definition, state and activity of a registered detector
+func (e *Engine) GetDetectorInfo(detectorID string) (DetectorInfo, error) {
+	info, err := e.registry.getDetectorInfo(detectorID)
+	if err != nil {
+		return DetectorInfo{}, err
+	}
+	info.Stats = e.metrics.DetectorStats(detectorID)
+
+	return info, nil
+}
+
+// ListDetectorInfos returns the definition, state and activity of all registered
+// detectors, ordered by detector ID
+func (e *Engine) ListDetectorInfos() []DetectorInfo {
+	infos := e.registry.listDetectorInfos()
+	for i := range infos {
+		infos[i].Stats = e.metrics.DetectorStats(infos[i].Definition.ID)
+	}
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].Definition.ID < infos[j].Definition.ID
+	})
+
+	return infos
+}
+
+// RefreshSelection updates the detectors after the policies changed at runtime:
+// detectors whose output event became selected are initialized, and events are only
+// dispatched to the detectors selected now
+func (e *Engine) RefreshSelection() {
+	e.registry.refreshSelection()
+	e.dispatcher.rebuild()
+}
+
 // DispatchToDetectors dispatches an event to all registered detectors that are interested in it
 // Returns the output events produced by detectors
 func (e *Engine) DispatchToDetectors(ctx context.Context, inputEvent *v1beta1.Event) ([]*v1beta1.Event, error) {
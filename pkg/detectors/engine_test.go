@@ -269,6 +269,119 @@ func TestEngine_DispatchToDetectors(t *testing.T) {
 	}
 }
 
+func TestEngine_DetectorInfo(t *testing.T) {
+	detector := &producingDetector{
+		id:        "test_engine_info",
+		eventName: "test_engine_info_event",
+		requirements: detection.DetectorRequirements{
+			Events: []detection.EventRequirement{
+				{Name: "execve", Dependency: detection.DependencyRequired},
+			},
+		},
+	}
+	_, err := CreateEventsFromDetectors(events.StartDetectorID+10009, []detection.EventDetector{detector})
+	require.NoError(t, err)
+
+	detEventID, _ := events.Core.GetDefinitionIDByName(detector.eventName)
+	engine := NewEngine(newTestPolicyManager(detEventID), nil)
+	params := detection.DetectorParams{
+		Config: detection.NewEmptyDetectorConfig(),
+	}
+
+	err = engine.RegisterDetector(detector, params)
+	require.NoError(t, err)
+
+	_, err = engine.DispatchToDetectors(context.Background(), &v1beta1.Event{
+		Id:   v1beta1.EventId(events.Execve),
+		Name: "execve",
+	})
+	require.NoError(t, err)
+
+	info, err := engine.GetDetectorInfo(detector.id)
+	require.NoError(t, err)
+	assert.Equal(t, detector.id, info.Definition.ID)
+	assert.Equal(t, v1beta1.EventId(detEventID), info.EventID)
+	assert.True(t, info.Enabled)
+	assert.True(t, info.Selected)
+	assert.Equal(t, uint64(1), info.Stats.EventsProcessed)
+	assert.Equal(t, uint64(1), info.Stats.EventsProduced)
+	assert.Equal(t, uint64(0), info.Stats.Errors)
+
+	infos := engine.ListDetectorInfos()
+	require.Len(t, infos, 1)
+	assert.Equal(t, detector.id, infos[0].Definition.ID)
+
+	_, err = engine.GetDetectorInfo("non_existent")
+	assert.ErrorIs(t, err, ErrDetectorNotRegistered)
+}
+
+func TestEngine_RefreshSelection(t *testing.T) {
+	detector := &producingDetector{
+		id:        "test_engine_refresh",
+		eventName: "test_engine_refresh_event",
+		requirements: detection.DetectorRequirements{
+			Events: []detection.EventRequirement{
+				{Name: "execve", Dependency: detection.DependencyRequired},
+			},
+		},
+	}
+	_, err := CreateEventsFromDetectors(events.StartDetectorID+10010, []detection.EventDetector{detector})
+	require.NoError(t, err)
+
+	detEventID, _ := events.Core.GetDefinitionIDByName(detector.eventName)
+	policyMgr := newTestPolicyManager()
+	engine := NewEngine(policyMgr, nil)
+	params := detection.DetectorParams{
+		Config: detection.NewEmptyDetectorConfig(),
+	}
+
+	err = engine.RegisterDetector(detector, params)
+	require.NoError(t, err)
+
+	inputEvent := &v1beta1.Event{
+		Id:   v1beta1.EventId(events.Execve),
+		Name: "execve",
+	}
+	ctx := context.Background()
+
+	// Not selected by policy: not initialized nor dispatched to
+	info, err := engine.GetDetectorInfo(detector.id)
+	require.NoError(t, err)
+	assert.False(t, info.Enabled)
+	assert.False(t, info.Selected)
+
+	outputs, err := engine.DispatchToDetectors(ctx, inputEvent)
+	require.NoError(t, err)
+	assert.Empty(t, outputs)
+
+	// Selected by a policy added at runtime
+	policyMgr.EnableEvent(detEventID)
+	engine.RefreshSelection()
+
+	info, err = engine.GetDetectorInfo(detector.id)
+	require.NoError(t, err)
+	assert.True(t, info.Enabled)
+	assert.True(t, info.Selected)
+
+	outputs, err = engine.DispatchToDetectors(ctx, inputEvent)
+	require.NoError(t, err)
+	assert.Len(t, outputs, 1)
+
+	// Disabled detectors stay disabled while selected
+	err = engine.DisableDetector(detector.id)
+	require.NoError(t, err)
+	engine.RefreshSelection()
+
+	info, err = engine.GetDetectorInfo(detector.id)
+	require.NoError(t, err)
+	assert.False(t, info.Enabled)
+	assert.True(t, info.Selected)
+
+	outputs, err = engine.DispatchToDetectors(ctx, inputEvent)
+	require.NoError(t, err)
+	assert.Empty(t, outputs)
+}
+
 func TestEngine_GetMetrics(t *testing.T) {
 	engine := NewEngine(nil, nil)
 	metrics := engine.GetMetrics()
@@ -66,6 +66,20 @@ func loadYAMLContent(yamlSearchDirs []string) yamldetectors.LoadResult {
 	return result
 }
 
+// LoadYAMLDetector loads a YAML detector given as a document, e.g. to register it at
+// runtime. Its CEL expressions can use the shared lists of the YAML search directories.
+func LoadYAMLDetector(data []byte, yamlSearchDirs []string) (detection.EventDetector, error) {
+	lists := make(map[string][]string)
+	for _, list := range CollectAllLists(yamlSearchDirs) {
+		// Lists are scoped to their directory, the first directory wins on conflicts
+		if _, exists := lists[list.Name]; !exists {
+			lists[list.Name] = list.Values
+		}
+	}
+
+	return yamldetectors.LoadFromBytes(data, "runtime", lists)
+}
+
 // GetDefaultSearchPaths returns the default directories to search for YAML detectors and lists
 func GetDefaultSearchPaths() []string {
 	return yamldetectors.GetDefaultSearchPaths()
@@ -155,6 +169,25 @@ func CreateEventsFromDetectors(startID events.ID, detectors []detection.EventDet
 	return eventNameToID, nil
 }
 
+// CreateEventFromDetector registers the event of a detector loaded at runtime in
+// events.Core, allocating the first dynamic event ID still free.
+// Returns the allocated event ID.
+func CreateEventFromDetector(detector detection.EventDetector) (events.ID, error) {
+	for id := events.StartDetectorID; id <= events.MaxDetectorID; id++ {
+		if events.Core.IsDefined(id) {
+			continue
+		}
+
+		eventNameToID, err := CreateEventsFromDetectors(id, []detection.EventDetector{detector})
+		if err != nil {
+			return events.Undefined, err
+		}
+		return eventNameToID[detector.GetDefinition().ProducedEvent.Name], nil
+	}
+
+	return events.Undefined, fmt.Errorf("no free detector event ID left (max %d)", events.MaxDetectorID)
+}
+
 // convertRequirementsToDependencies converts detector EventRequirements to event dependencies
 // Only DependencyRequired events are added - optional dependencies are handled separately
 func convertRequirementsToDependencies(reqs []detection.EventRequirement, eventNameToID map[string]events.ID) events.Dependencies {
@@ -172,3 +172,33 @@ func TestDetectorTagsWithMultipleTags(t *testing.T) {
 		assert.Contains(t, foundEvents, "multi_tag_event", "Tag %s should find the event", tag)
 	}
 }
+
+func TestCreateEventFromDetector(t *testing.T) {
+	// Save the current state of events.Core and restore after test
+	originalCore := events.Core
+	defer func() { events.Core = originalCore }()
+
+	events.Core = events.NewDefinitionGroup()
+	err := events.Core.AddBatch(events.CoreEvents)
+	require.NoError(t, err)
+
+	// Detector registered at startup
+	_, err = CreateEventsFromDetectors(events.StartDetectorID, []detection.EventDetector{
+		createMockDetectorWithTags("TEST-STARTUP", "startup_event", nil),
+	})
+	require.NoError(t, err)
+
+	// Detectors loaded at runtime get the next free IDs
+	id, err := CreateEventFromDetector(createMockDetectorWithTags("TEST-RUNTIME-1", "runtime_event_1", nil))
+	require.NoError(t, err)
+	assert.Equal(t, events.StartDetectorID+1, id)
+	assert.Equal(t, "runtime_event_1", events.Core.GetDefinitionByID(id).GetName())
+
+	id, err = CreateEventFromDetector(createMockDetectorWithTags("TEST-RUNTIME-2", "runtime_event_2", nil))
+	require.NoError(t, err)
+	assert.Equal(t, events.StartDetectorID+2, id)
+
+	// Event names stay unique
+	_, err = CreateEventFromDetector(createMockDetectorWithTags("TEST-RUNTIME-3", "startup_event", nil))
+	assert.Error(t, err)
+}
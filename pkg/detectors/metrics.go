@@ -1,7 +1,10 @@
 package detectors
 
 import (
+	"time"
+
 	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
 )
 
 // Metrics tracks detector performance and activity
@@ -91,3 +94,38 @@ func (m *Metrics) RegisterPrometheus() error {
 	// Chain depth safety counter
 	return prometheus.Register(m.ChainDepthExceeded)
 }
+
+// DetectorStats holds the activity recorded for a detector
+type DetectorStats struct {
+	EventsProcessed uint64
+	EventsProduced  uint64
+	Errors          uint64
+	ExecutionTime   time.Duration // Total time spent in OnEvent
+}
+
+// DetectorStats returns the activity recorded for a detector
+func (m *Metrics) DetectorStats(detectorID string) DetectorStats {
+	stats := DetectorStats{
+		EventsProcessed: counterValue(m.EventsProcessed, detectorID),
+		EventsProduced:  counterValue(m.EventsProduced, detectorID),
+		Errors:          counterValue(m.Errors, detectorID),
+	}
+
+	var metric dto.Metric
+	if histogram, ok := m.ExecutionDuration.WithLabelValues(detectorID).(prometheus.Metric); ok {
+		if err := histogram.Write(&metric); err == nil {
+			stats.ExecutionTime = time.Duration(metric.GetHistogram().GetSampleSum() * float64(time.Second))
+		}
+	}
+
+	return stats
+}
+
+// counterValue returns the value of a per-detector counter
+func counterValue(vec *prometheus.CounterVec, detectorID string) uint64 {
+	var metric dto.Metric
+	if err := vec.WithLabelValues(detectorID).Write(&metric); err != nil {
+		return 0
+	}
+	return uint64(metric.GetCounter().GetValue())
+}
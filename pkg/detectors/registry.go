@@ -18,6 +18,13 @@ import (
 	"github.com/aquasecurity/tracee/pkg/version"
 )
 
+var (
+	// ErrDetectorNotRegistered is returned for operations on an unknown detector
+	ErrDetectorNotRegistered = errors.New("not registered")
+	// ErrDetectorRegistered is returned when registering a detector ID twice
+	ErrDetectorRegistered = errors.New("already registered")
+)
+
 // parseHashMode converts a string hash mode to digest.CalcHashesOption
 func parseHashMode(mode string) digest.CalcHashesOption {
 	switch mode {
@@ -47,6 +54,7 @@ type entry struct {
 	eventID      v1beta1.EventId
 	eventName    string
 	enabled      bool                                     // Runtime state for enable/disable
+	selected     bool                                     // Output event selected by policy at last refresh
 	params       detection.DetectorParams                 // Stored for re-initialization on enable
 	scopeFilters map[v1beta1.EventId]*filters.ScopeFilter // Scope filters per subscribed event
 	dataFilters  map[v1beta1.EventId]*filters.DataFilter  // Data filters per subscribed event
@@ -241,7 +249,7 @@ func (r *registry) RegisterDetector(
 
 	// Check for detector ID conflicts (one detector per detector ID)
 	if _, exists := r.detectors[detectorID]; exists {
-		return fmt.Errorf("detector ID %s already registered", detectorID)
+		return fmt.Errorf("detector ID %s %w", detectorID, ErrDetectorRegistered)
 	}
 
 	// Validate event requirements (version constraints, filter syntax, etc.)
@@ -485,6 +493,7 @@ func (r *registry) RegisterDetector(
 		eventID:      v1beta1.EventId(eventID),
 		eventName:    eventName,
 		enabled:      enabled, // enabled = initialized
+		selected:     enabled, // Initialized only when selected
 		params:       params,  // Store for potential re-initialization
 		scopeFilters: scopeFilters,
 		dataFilters:  dataFilters,
@@ -516,7 +525,7 @@ func (r *registry) UnregisterDetector(detectorID string) error {
 
 	detector, exists := r.detectors[detectorID]
 	if !exists {
-		return fmt.Errorf("detector %s not registered", detectorID)
+		return fmt.Errorf("detector %s %w", detectorID, ErrDetectorNotRegistered)
 	}
 
 	// Clean up detector resources if enabled (initialized) and implements Close()
@@ -555,7 +564,7 @@ func (r *registry) GetDetector(detectorID string) (detection.EventDetector, erro
 
 	detector, exists := r.detectors[detectorID]
 	if !exists {
-		return nil, fmt.Errorf("detector %s not registered", detectorID)
+		return nil, fmt.Errorf("detector %s %w", detectorID, ErrDetectorNotRegistered)
 	}
 	return detector.detector, nil
 }
@@ -568,7 +577,7 @@ func (r *registry) EnableDetector(detectorID string) error {
 
 	detector, exists := r.detectors[detectorID]
 	if !exists {
-		return fmt.Errorf("detector %s not registered", detectorID)
+		return fmt.Errorf("detector %s %w", detectorID, ErrDetectorNotRegistered)
 	}
 
 	// Already enabled
@@ -597,7 +606,7 @@ func (r *registry) DisableDetector(detectorID string) error {
 
 	detector, exists := r.detectors[detectorID]
 	if !exists {
-		return fmt.Errorf("detector %s not registered", detectorID)
+		return fmt.Errorf("detector %s %w", detectorID, ErrDetectorNotRegistered)
 	}
 
 	// Already disabled
@@ -620,6 +629,67 @@ func (r *registry) DisableDetector(detectorID string) error {
 	return nil
 }
 
+// refreshSelection records which detectors are selected by policy, initializing
+// the ones selected since the last refresh. Detectors disabled while remaining
+// selected stay disabled.
+func (r *registry) refreshSelection() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for detectorID, detector := range r.detectors {
+		selected := r.policyManager != nil && r.policyManager.IsEventSelected(events.ID(detector.eventID))
+
+		if selected && !detector.selected && !detector.enabled {
+			if err := detector.detector.Init(detector.params); err != nil {
+				logger.Errorw("Failed to initialize detector selected by policy",
+					"detector", detectorID,
+					"error", err)
+			} else {
+				detector.enabled = true
+				logger.Debugw("Detector enabled",
+					"detector", detectorID,
+					"event", detector.eventName)
+			}
+		}
+
+		detector.selected = selected
+	}
+}
+
+// getDetectorInfo returns the registration state of a detector
+func (r *registry) getDetectorInfo(detectorID string) (DetectorInfo, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	detector, exists := r.detectors[detectorID]
+	if !exists {
+		return DetectorInfo{}, fmt.Errorf("detector %s %w", detectorID, ErrDetectorNotRegistered)
+	}
+	return detector.info(), nil
+}
+
+// listDetectorInfos returns the registration state of all detectors
+func (r *registry) listDetectorInfos() []DetectorInfo {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	infos := make([]DetectorInfo, 0, len(r.detectors))
+	for _, detector := range r.detectors {
+		infos = append(infos, detector.info())
+	}
+	return infos
+}
+
+// info returns the registration state of the entry
+func (e *entry) info() DetectorInfo {
+	return DetectorInfo{
+		Definition: e.definition,
+		EventID:    e.eventID,
+		Enabled:    e.enabled,
+		Selected:   e.selected,
+	}
+}
+
 // validateEventRequirements validates event requirements
 // Checks dependency types, version constraints, and filter syntax
 func validateEventRequirements(requirements []detection.EventRequirement) error {
@@ -678,13 +748,11 @@ func parseScopeFilters(filterStrings []string, contextMsg string) (*filters.Scop
 }
 
 // parseFilterString splits a filter string into field and operatorAndValues
+// at its first operator, so values may contain operator characters (e.g. regexes).
 // Examples: "container" -> ("container", ""), "pathname=/tmp/*" -> ("pathname", "=/tmp/*")
 func parseFilterString(filterStr string) (field string, operatorAndValues string) {
-	operators := []string{"!=", "<=", ">=", "=", "<", ">"}
-	for _, op := range operators {
-		if idx := strings.Index(filterStr, op); idx != -1 {
-			return filterStr[:idx], filterStr[idx:]
-		}
+	if idx := strings.IndexAny(filterStr, "=!<>"); idx != -1 {
+		return filterStr[:idx], filterStr[idx:]
 	}
 	// No operator found, return whole string as field (valid for scope filters)
 	return filterStr, ""
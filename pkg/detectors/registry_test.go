@@ -510,6 +510,24 @@ func TestRegisterDetector_DataFilters(t *testing.T) {
 			},
 			expectErr: false,
 		},
+		{
+			name: "valid data filter - regex with operator characters",
+			requirement: detection.EventRequirement{
+				Name:        "execve",
+				Dependency:  detection.DependencyRequired,
+				DataFilters: []string{"pathname=~/tmp/[^!<>=]+"},
+			},
+			expectErr: false,
+		},
+		{
+			name: "invalid data filter - regex of a non-string field",
+			requirement: detection.EventRequirement{
+				Name:        "execve",
+				Dependency:  detection.DependencyRequired,
+				DataFilters: []string{"argv=~.*"},
+			},
+			expectErr: true,
+		},
 		{
 			name: "invalid data filter - bad syntax",
 			requirement: detection.EventRequirement{
@@ -53,6 +53,20 @@ func registerDatastoreFunctions(registry datastores.Registry) []cel.EnvOption {
 				cel.UnaryBinding(createContainerGetByNameBinding(registry)),
 			),
 		),
+		cel.Function("container.listByLabel",
+			cel.Overload("container_listByLabel_string_string",
+				[]*cel.Type{cel.StringType, cel.StringType},
+				cel.ListType(cel.DynType), // Returns list of ContainerInfo
+				cel.BinaryBinding(createContainerListByLabelBinding(registry)),
+			),
+		),
+		cel.Function("container.isPrivileged",
+			cel.Overload("container_isPrivileged_string",
+				[]*cel.Type{cel.StringType},
+				cel.BoolType, // Returns false if the container is not found
+				cel.UnaryBinding(createContainerIsPrivilegedBinding(registry)),
+			),
+		),
 
 		// SystemStore function (no args, returns SystemInfo)
 		cel.Function("system.info",
@@ -90,6 +104,22 @@ func registerDatastoreFunctions(registry datastores.Registry) []cel.EnvOption {
 			),
 		),
 
+		// IPReputationStore functions
+		cel.Function("ip.reputation",
+			cel.Overload("ip_reputation_string",
+				[]*cel.Type{cel.StringType},
+				cel.DynType, // Returns IPReputationInfo or null
+				cel.UnaryBinding(createIPReputationBinding(registry)),
+			),
+		),
+		cel.Function("ip.isDenied",
+			cel.Overload("ip_isDenied_string",
+				[]*cel.Type{cel.StringType},
+				cel.BoolType, // Returns false if the IP has no reputation
+				cel.UnaryBinding(createIPIsDeniedBinding(registry)),
+			),
+		),
+
 		// SyscallStore functions
 		cel.Function("syscall.getName",
 			cel.Overload("syscall_getName_int",
@@ -256,6 +286,65 @@ func createContainerGetByNameBinding(registry datastores.Registry) func(ref.Val)
 	}
 }
 
+func createContainerListByLabelBinding(registry datastores.Registry) func(ref.Val, ref.Val) ref.Val {
+	return func(lhs, rhs ref.Val) ref.Val {
+		// Handle nil registry (validation mode)
+		if registry == nil {
+			return types.DefaultTypeAdapter.NativeToValue([]any{})
+		}
+
+		key, ok := lhs.Value().(string)
+		if !ok {
+			return types.NewErr("container.listByLabel: first argument must be string")
+		}
+		value, ok := rhs.Value().(string)
+		if !ok {
+			return types.NewErr("container.listByLabel: second argument must be string")
+		}
+
+		containerStore := registry.Containers()
+		if containerStore == nil {
+			return types.DefaultTypeAdapter.NativeToValue([]any{})
+		}
+
+		containers, err := containerStore.ListContainers(datastores.WithLabel(key, value))
+		if err != nil {
+			return types.NewErr("container.listByLabel: %v", err)
+		}
+
+		return convertContainerListToCEL(containers)
+	}
+}
+
+func createContainerIsPrivilegedBinding(registry datastores.Registry) func(ref.Val) ref.Val {
+	return func(arg ref.Val) ref.Val {
+		// Handle nil registry (validation mode)
+		if registry == nil {
+			return types.False
+		}
+
+		containerId, ok := arg.Value().(string)
+		if !ok {
+			return types.NewErr("container.isPrivileged: argument must be string")
+		}
+
+		containerStore := registry.Containers()
+		if containerStore == nil {
+			return types.False
+		}
+
+		containerInfo, err := containerStore.GetContainer(containerId)
+		if err != nil {
+			if errors.Is(err, datastores.ErrNotFound) {
+				return types.False
+			}
+			return types.NewErr("container.isPrivileged: %v", err)
+		}
+
+		return types.Bool(containerInfo.Privileged)
+	}
+}
+
 // SystemStore binding
 
 func createSystemInfoBinding(registry datastores.Registry) func() ref.Val {
@@ -366,6 +455,66 @@ func createDNSGetResponseBinding(registry datastores.Registry) func(ref.Val) ref
 	}
 }
 
+// IPReputationStore bindings
+
+func createIPReputationBinding(registry datastores.Registry) func(ref.Val) ref.Val {
+	return func(arg ref.Val) ref.Val {
+		// Handle nil registry (validation mode)
+		if registry == nil {
+			return types.NullValue
+		}
+
+		ip, ok := arg.Value().(string)
+		if !ok {
+			return types.NewErr("ip.reputation: argument must be string")
+		}
+
+		reputationStore := registry.IPReputation()
+		if reputationStore == nil {
+			return types.NullValue
+		}
+
+		info, err := reputationStore.GetIPReputation(ip)
+		if err != nil {
+			if errors.Is(err, datastores.ErrNotFound) {
+				return types.NullValue
+			}
+			return types.NewErr("ip.reputation: %v", err)
+		}
+
+		return convertIPReputationInfoToCEL(info)
+	}
+}
+
+func createIPIsDeniedBinding(registry datastores.Registry) func(ref.Val) ref.Val {
+	return func(arg ref.Val) ref.Val {
+		// Handle nil registry (validation mode)
+		if registry == nil {
+			return types.False
+		}
+
+		ip, ok := arg.Value().(string)
+		if !ok {
+			return types.NewErr("ip.isDenied: argument must be string")
+		}
+
+		reputationStore := registry.IPReputation()
+		if reputationStore == nil {
+			return types.False
+		}
+
+		info, err := reputationStore.GetIPReputation(ip)
+		if err != nil {
+			if errors.Is(err, datastores.ErrNotFound) {
+				return types.False
+			}
+			return types.NewErr("ip.isDenied: %v", err)
+		}
+
+		return types.Bool(info.Status == "deny")
+	}
+}
+
 // SyscallStore bindings
 
 func createSyscallGetNameBinding(registry datastores.Registry) func(ref.Val) ref.Val {
@@ -429,7 +578,28 @@ func createSyscallGetIdBinding(registry datastores.Registry) func(ref.Val) ref.V
 // Conversion helpers (convert datastore types to CEL values)
 
 func convertProcessInfoToCEL(p *datastores.ProcessInfo) ref.Val {
-	return types.DefaultTypeAdapter.NativeToValue(map[string]any{
+	return types.DefaultTypeAdapter.NativeToValue(processInfoToMap(p))
+}
+
+func convertProcessListToCEL(procs []*datastores.ProcessInfo) ref.Val {
+	procList := make([]any, len(procs))
+	for i, p := range procs {
+		procList[i] = processInfoToMap(p)
+	}
+	return types.DefaultTypeAdapter.NativeToValue(procList)
+}
+
+func processInfoToMap(p *datastores.ProcessInfo) map[string]any {
+	cmdline := p.CmdLine
+	if cmdline == nil {
+		cmdline = []string{}
+	}
+	env := p.Env
+	if env == nil {
+		env = []string{}
+	}
+
+	return map[string]any{
 		"entity_id":  p.UniqueId,
 		"pid":        p.Pid,
 		"ppid":       p.Ppid,
@@ -438,34 +608,61 @@ func convertProcessInfoToCEL(p *datastores.ProcessInfo) ref.Val {
 		"start_time": p.StartTime.Unix(),
 		"uid":        p.UID,
 		"gid":        p.GID,
-	})
+		"cmdline":    cmdline,
+		"env":        env,
+	}
 }
 
-func convertProcessListToCEL(procs []*datastores.ProcessInfo) ref.Val {
-	procList := make([]any, len(procs))
-	for i, p := range procs {
-		procList[i] = map[string]any{
-			"entity_id":  p.UniqueId,
-			"pid":        p.Pid,
-			"ppid":       p.Ppid,
-			"name":       p.Name,
-			"exe":        p.Exe,
-			"start_time": p.StartTime.Unix(),
-			"uid":        p.UID,
-			"gid":        p.GID,
-		}
+func convertContainerInfoToCEL(c *datastores.ContainerInfo) ref.Val {
+	return types.DefaultTypeAdapter.NativeToValue(containerInfoToMap(c))
+}
+
+func convertContainerListToCEL(containers []*datastores.ContainerInfo) ref.Val {
+	containerList := make([]any, len(containers))
+	for i, c := range containers {
+		containerList[i] = containerInfoToMap(c)
 	}
-	return types.DefaultTypeAdapter.NativeToValue(procList)
+	return types.DefaultTypeAdapter.NativeToValue(containerList)
 }
 
-func convertContainerInfoToCEL(c *datastores.ContainerInfo) ref.Val {
+func containerInfoToMap(c *datastores.ContainerInfo) map[string]any {
+	labels := c.Labels
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	annotations := c.Annotations
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	capabilities := c.AddedCapabilities
+	if capabilities == nil {
+		capabilities = []string{}
+	}
+	mounts := make([]any, 0, len(c.Mounts))
+	for _, m := range c.Mounts {
+		mounts = append(mounts, map[string]any{
+			"type":        m.Type,
+			"source":      m.Source,
+			"destination": m.Destination,
+			"read_only":   m.ReadOnly,
+		})
+	}
+
 	data := map[string]any{
-		"id":           c.ID,
-		"name":         c.Name,
-		"image":        c.Image,
-		"image_digest": c.ImageDigest,
-		"runtime":      c.Runtime,
-		"start_time":   c.StartTime.Unix(),
+		"id":                 c.ID,
+		"name":               c.Name,
+		"image":              c.Image,
+		"image_digest":       c.ImageDigest,
+		"runtime":            c.Runtime,
+		"start_time":         c.StartTime.Unix(),
+		"labels":             labels,
+		"annotations":        annotations,
+		"privileged":         c.Privileged,
+		"added_capabilities": capabilities,
+		"host_network":       c.HostNetwork,
+		"host_pid":           c.HostPID,
+		"host_ipc":           c.HostIPC,
+		"mounts":             mounts,
 	}
 	if c.Pod != nil {
 		data["pod"] = map[string]any{
@@ -477,7 +674,7 @@ func convertContainerInfoToCEL(c *datastores.ContainerInfo) ref.Val {
 	} else {
 		data["pod"] = nil
 	}
-	return types.DefaultTypeAdapter.NativeToValue(data)
+	return data
 }
 
 func convertSystemInfoToCEL(s *datastores.SystemInfo) ref.Val {
@@ -513,3 +710,16 @@ func convertDNSResponseToCEL(r *datastores.DNSResponse) ref.Val {
 		"domains": r.Domains,
 	})
 }
+
+func convertIPReputationInfoToCEL(r *datastores.IPReputationInfo) ref.Val {
+	return types.DefaultTypeAdapter.NativeToValue(map[string]any{
+		"ip":           r.IP,
+		"match":        r.Match,
+		"status":       r.Status,
+		"severity":     r.Severity,
+		"source":       r.Source,
+		"tags":         r.Tags,
+		"last_updated": r.LastUpdated.Unix(),
+		"metadata":     r.Metadata,
+	})
+}
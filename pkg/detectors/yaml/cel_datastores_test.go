@@ -20,6 +20,7 @@ type mockRegistry struct {
 	kernelSymbolStore datastores.KernelSymbolStore
 	dnsStore          datastores.DNSStore
 	syscallStore      datastores.SyscallStore
+	ipReputationStore datastores.IPReputationStore
 }
 
 func (m *mockRegistry) Processes() datastores.ProcessStore {
@@ -46,6 +47,10 @@ func (m *mockRegistry) Syscalls() datastores.SyscallStore {
 	return m.syscallStore
 }
 
+func (m *mockRegistry) IPReputation() datastores.IPReputationStore {
+	return m.ipReputationStore
+}
+
 func (m *mockRegistry) GetCustom(name string) (datastores.DataStore, error) {
 	return nil, datastores.ErrNotFound
 }
@@ -179,6 +184,18 @@ func (m *mockContainerStore) ListContainers(opts ...datastores.ContainerFilterOp
 		if filter.Runtime != nil && container.Runtime != *filter.Runtime {
 			continue
 		}
+		if filter.Privileged != nil && container.Privileged != *filter.Privileged {
+			continue
+		}
+		matches := true
+		for key, value := range filter.Labels {
+			if label, ok := container.Labels[key]; !ok || label != value {
+				matches = false
+			}
+		}
+		if !matches {
+			continue
+		}
 		result = append(result, container)
 	}
 	return result, nil
@@ -277,6 +294,32 @@ func (m *mockDNSStore) GetDNSResponse(query string) (*datastores.DNSResponse, er
 	return resp, nil
 }
 
+// Mock IPReputationStore
+
+type mockIPReputationStore struct {
+	reputations map[string]*datastores.IPReputationInfo
+}
+
+func (m *mockIPReputationStore) Name() string {
+	return "mock_ip_reputation_store"
+}
+
+func (m *mockIPReputationStore) GetHealth() *datastores.HealthInfo {
+	return &datastores.HealthInfo{Status: datastores.HealthHealthy}
+}
+
+func (m *mockIPReputationStore) GetMetrics() *datastores.DataStoreMetrics {
+	return &datastores.DataStoreMetrics{}
+}
+
+func (m *mockIPReputationStore) GetIPReputation(ip string) (*datastores.IPReputationInfo, error) {
+	info, ok := m.reputations[ip]
+	if !ok {
+		return nil, datastores.ErrNotFound
+	}
+	return info, nil
+}
+
 // Mock SyscallStore
 
 type mockSyscallStore struct {
@@ -327,6 +370,8 @@ func TestProcessGetFunction(t *testing.T) {
 					StartTime: time.Unix(1234567890, 0),
 					UID:       1000,
 					GID:       1000,
+					CmdLine:   []string{"test_proc", "--verbose"},
+					Env:       []string{"LD_PRELOAD=/tmp/x.so"},
 				},
 			},
 		},
@@ -342,6 +387,14 @@ func TestProcessGetFunction(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, "test_proc", result)
 
+	// Test command line and environment
+	prog, err = CompileExpression(env, `"--verbose" in process.get(12345u).cmdline && `+
+		`process.get(12345u).env.exists(e, e.startsWith("LD_PRELOAD="))`)
+	require.NoError(t, err)
+	result, err = EvaluateExpression(prog, &v1beta1.Event{}, nil, 5*time.Millisecond)
+	require.NoError(t, err)
+	assert.Equal(t, true, result)
+
 	// Test not found returns null
 	prog, err = CompileExpression(env, `process.get(99999u) == null`)
 	require.NoError(t, err)
@@ -355,7 +408,7 @@ func TestProcessGetAncestryFunction(t *testing.T) {
 		processStore: &mockProcessStore{
 			processes: map[uint32]*datastores.ProcessInfo{
 				1:   {UniqueId: 1, Pid: 1, Ppid: 0, Name: "init"},
-				100: {UniqueId: 100, Pid: 100, Ppid: 1, Name: "parent"},
+				100: {UniqueId: 100, Pid: 100, Ppid: 1, Name: "parent", CmdLine: []string{"python", "-c", "import pty"}},
 				200: {UniqueId: 200, Pid: 200, Ppid: 100, Name: "child"},
 			},
 		},
@@ -377,6 +430,13 @@ func TestProcessGetAncestryFunction(t *testing.T) {
 	result, err = EvaluateExpression(prog, &v1beta1.Event{}, nil, 5*time.Millisecond)
 	require.NoError(t, err)
 	assert.Equal(t, true, result)
+
+	// Test matching the command line of an ancestor (empty if unknown)
+	prog, err = CompileExpression(env, `process.getAncestry(200u, 5).exists(p, "-c" in p.cmdline)`)
+	require.NoError(t, err)
+	result, err = EvaluateExpression(prog, &v1beta1.Event{}, nil, 5*time.Millisecond)
+	require.NoError(t, err)
+	assert.Equal(t, true, result)
 }
 
 func TestProcessGetChildrenFunction(t *testing.T) {
@@ -455,6 +515,65 @@ func TestContainerGetByNameFunction(t *testing.T) {
 	assert.Equal(t, "abc123", result)
 }
 
+func TestContainerRuntimeMetadata(t *testing.T) {
+	registry := &mockRegistry{
+		containerStore: &mockContainerStore{
+			containers: map[string]*datastores.ContainerInfo{
+				"abc123": {
+					ID:                "abc123",
+					Name:              "web",
+					Labels:            map[string]string{"app": "web"},
+					Annotations:       map[string]string{"seccomp.security.alpha.kubernetes.io/pod": "unconfined"},
+					Privileged:        true,
+					AddedCapabilities: []string{"CAP_SYS_ADMIN"},
+					HostPID:           true,
+					Mounts: []datastores.MountInfo{
+						{Type: "bind", Source: "/var/run/docker.sock", Destination: "/var/run/docker.sock"},
+					},
+				},
+				"def456": {
+					ID:     "def456",
+					Name:   "db",
+					Labels: map[string]string{"app": "db"},
+				},
+				"ghi789": {
+					ID:   "ghi789",
+					Name: "unlabeled",
+				},
+			},
+		},
+	}
+
+	env, err := createCELEnvironment(nil, registry)
+	require.NoError(t, err)
+
+	tests := []struct {
+		expression string
+		expected   any
+	}{
+		{`container.get("abc123").labels["app"] == "web"`, true},
+		{`container.get("abc123").annotations["seccomp.security.alpha.kubernetes.io/pod"]`, "unconfined"},
+		{`container.get("abc123").privileged && container.get("abc123").host_pid`, true},
+		{`"CAP_SYS_ADMIN" in container.get("abc123").added_capabilities`, true},
+		{`container.get("abc123").mounts.exists(m, m.source == "/var/run/docker.sock" && !m.read_only)`, true},
+		{`"app" in container.get("ghi789").labels`, false},
+		{`container.get("ghi789").added_capabilities.size() + container.get("ghi789").mounts.size()`, int64(0)},
+		{`container.isPrivileged("abc123")`, true},
+		{`container.isPrivileged("def456")`, false},
+		{`container.isPrivileged("nonexistent")`, false},
+		{`container.listByLabel("app", "db").size() == 1 && container.listByLabel("app", "db")[0].id == "def456"`, true},
+		{`container.listByLabel("app", "cache").size()`, int64(0)},
+	}
+
+	for _, tt := range tests {
+		prog, err := CompileExpression(env, tt.expression)
+		require.NoError(t, err, tt.expression)
+		result, err := EvaluateExpression(prog, &v1beta1.Event{}, nil, 5*time.Millisecond)
+		require.NoError(t, err, tt.expression)
+		assert.Equal(t, tt.expected, result, tt.expression)
+	}
+}
+
 func TestSystemInfoFunction(t *testing.T) {
 	registry := &mockRegistry{
 		systemStore: &mockSystemStore{
@@ -559,6 +678,49 @@ func TestDNSGetResponseFunction(t *testing.T) {
 	assert.Equal(t, true, result)
 }
 
+func TestIPReputationFunctions(t *testing.T) {
+	registry := &mockRegistry{
+		ipReputationStore: &mockIPReputationStore{
+			reputations: map[string]*datastores.IPReputationInfo{
+				"10.1.2.3": {
+					IP:       "10.1.2.3",
+					Match:    "10.0.0.0/8",
+					Status:   "deny",
+					Severity: 8,
+					Source:   "feed:/etc/tracee/blocklist.txt",
+					Tags:     []string{"botnet"},
+				},
+				"192.168.1.1": {IP: "192.168.1.1", Match: "192.168.1.1", Status: "suspicious", Severity: 3},
+			},
+		},
+	}
+
+	env, err := createCELEnvironment(nil, registry)
+	require.NoError(t, err)
+
+	tests := []struct {
+		expression string
+		expected   any
+	}{
+		{`ip.reputation("10.1.2.3").status`, "deny"},
+		{`ip.reputation("10.1.2.3").match`, "10.0.0.0/8"},
+		{`ip.reputation("10.1.2.3").severity >= 5`, true},
+		{`"botnet" in ip.reputation("10.1.2.3").tags`, true},
+		{`ip.reputation("1.1.1.1") == null`, true},
+		{`ip.isDenied("10.1.2.3")`, true},
+		{`ip.isDenied("192.168.1.1")`, false},
+		{`ip.isDenied("1.1.1.1")`, false},
+	}
+
+	for _, tt := range tests {
+		prog, err := CompileExpression(env, tt.expression)
+		require.NoError(t, err, tt.expression)
+		result, err := EvaluateExpression(prog, &v1beta1.Event{}, nil, 5*time.Millisecond)
+		require.NoError(t, err, tt.expression)
+		assert.Equal(t, tt.expected, result, tt.expression)
+	}
+}
+
 func TestSyscallGetNameFunction(t *testing.T) {
 	registry := &mockRegistry{
 		syscallStore: &mockSyscallStore{
@@ -72,6 +72,9 @@ func createCELEnvironment(lists map[string][]string, registry datastores.Registr
 		),
 	}
 
+	// Detector parameters (params.<name>), values are bound at evaluation time
+	envOptions = append(envOptions, cel.Variable("params", cel.MapType(cel.StringType, cel.DynType)))
+
 	// Add shared list variables
 	for name := range lists {
 		envOptions = append(envOptions, cel.Variable(name, cel.ListType(cel.StringType)))
@@ -186,6 +189,18 @@ func CompileCondition(env *cel.Env, expression string) (cel.Program, error) {
 	)
 }
 
+// CompileEventFilter compiles a standalone event filter condition, with the event
+// variables and helper functions of detector conditions, but without shared lists
+// nor datastore access
+func CompileEventFilter(expression string) (cel.Program, error) {
+	env, err := createCELEnvironment(nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create CEL environment: %w", err)
+	}
+
+	return CompileCondition(env, expression)
+}
+
 // CompileExpression compiles a CEL expression for field extraction
 // Expressions can return any type
 func CompileExpression(env *cel.Env, expression string) (cel.Program, error) {
@@ -204,6 +219,11 @@ func CompileExpression(env *cel.Env, expression string) (cel.Program, error) {
 
 // EvaluateCondition evaluates a compiled CEL condition with timeout enforcement
 func EvaluateCondition(prog cel.Program, event *v1beta1.Event, lists map[string][]string, timeout time.Duration) (bool, error) {
+	return evaluateCondition(prog, event, lists, nil, timeout)
+}
+
+// evaluateCondition evaluates a compiled CEL condition with detector parameters bound
+func evaluateCondition(prog cel.Program, event *v1beta1.Event, lists map[string][]string, params map[string]any, timeout time.Duration) (bool, error) {
 	// Validate event is not nil
 	if event == nil {
 		return false, errors.New("event cannot be nil")
@@ -233,6 +253,11 @@ func EvaluateCondition(prog cel.Program, event *v1beta1.Event, lists map[string]
 		vars[name] = values
 	}
 
+	// Add detector parameters
+	if params != nil {
+		vars["params"] = params
+	}
+
 	// Evaluate with context - will be interrupted if timeout is exceeded
 	result, details, err := prog.ContextEval(ctx, vars)
 
@@ -261,6 +286,11 @@ func EvaluateCondition(prog cel.Program, event *v1beta1.Event, lists map[string]
 
 // EvaluateExpression evaluates a compiled CEL expression with timeout enforcement
 func EvaluateExpression(prog cel.Program, event *v1beta1.Event, lists map[string][]string, timeout time.Duration) (interface{}, error) {
+	return evaluateExpression(prog, event, lists, nil, timeout)
+}
+
+// evaluateExpression evaluates a compiled CEL expression with detector parameters bound
+func evaluateExpression(prog cel.Program, event *v1beta1.Event, lists map[string][]string, params map[string]any, timeout time.Duration) (interface{}, error) {
 	// Validate event is not nil
 	if event == nil {
 		return nil, errors.New("event cannot be nil")
@@ -290,6 +320,11 @@ func EvaluateExpression(prog cel.Program, event *v1beta1.Event, lists map[string
 		vars[name] = values
 	}
 
+	// Add detector parameters
+	if params != nil {
+		vars["params"] = params
+	}
+
 	// Evaluate with context - will be interrupted if timeout is exceeded
 	result, details, err := prog.ContextEval(ctx, vars)
 
@@ -9,6 +9,7 @@ import (
 
 	"github.com/google/cel-go/cel"
 	"github.com/google/cel-go/common/types"
+	"google.golang.org/protobuf/types/known/structpb"
 
 	"github.com/aquasecurity/tracee/api/v1beta1"
 	"github.com/aquasecurity/tracee/api/v1beta1/datastores"
@@ -39,6 +40,19 @@ type YAMLDetector struct {
 	fieldSpecs      []FieldSpec         // Original field specs (for recompilation with datastores)
 	lists           map[string][]string // Shared list variables for CEL
 
+	// Parameter fields
+	paramSpecs    []ParamSpec    // Declared parameters
+	paramDefaults map[string]any // Declared defaults, converted to the parameter types
+	params        map[string]any // Resolved parameter values exposed to CEL as params.<name>
+
+	// Stateful correlation fields (nil for stateless detectors)
+	sequenceSpec  *SequenceSpec     // Original sequence spec (for recompilation with datastores)
+	thresholdSpec *ThresholdSpec    // Original threshold spec (for recompilation with datastores)
+	keyProgram    cel.Program       // Compiled correlation key expression
+	stepPrograms  [][]cel.Program   // Compiled conditions per sequence step
+	sequence      *sequenceTracker  // Per-key sequence progress
+	threshold     *thresholdTracker // Per-key event counts
+
 	// Detector runtime fields
 	logger     detection.Logger
 	datastores datastores.Registry // Access to system state
@@ -78,9 +92,24 @@ func NewDetector(def *detection.DetectorDefinition, spec *YAMLDetectorSpec, list
 	if spec.Output != nil {
 		detector.fieldSpecs = spec.Output.Fields
 	}
+	detector.sequenceSpec = spec.Sequence
+	detector.thresholdSpec = spec.Threshold
+
+	// Parameters start with their defaults, configured values are resolved in Init
+	var err error
+	detector.paramSpecs = spec.Params
+	detector.paramDefaults, err = defaultParamValues(spec.Params)
+	if err != nil {
+		return nil, err
+	}
+	detector.params = detector.paramDefaults
+
+	if err = detector.createStateTrackers(); err != nil {
+		return nil, err
+	}
 
 	// Create CEL environment and compile expressions (no datastores yet - will be added in Init)
-	if err := detector.compileCELPrograms(nil); err != nil {
+	if err = detector.compileCELPrograms(nil); err != nil {
 		return nil, err
 	}
 
@@ -109,6 +138,7 @@ func (d *YAMLDetector) GetDefinition() detection.DetectorDefinition {
 func (d *YAMLDetector) Init(params detection.DetectorParams) error {
 	d.logger = params.Logger
 	d.datastores = params.DataStores // Store for CEL datastore functions
+	d.params = resolveParams(d.paramSpecs, d.paramDefaults, params.Config)
 
 	// Rebuild CEL environment with datastores now available and recompile all expressions
 	if d.datastores != nil {
@@ -162,9 +192,116 @@ func (d *YAMLDetector) compileCELPrograms(registry datastores.Registry) error {
 		})
 	}
 
+	// Compile correlation key and sequence steps
+	d.keyProgram = nil
+	d.stepPrograms = d.stepPrograms[:0]
+	switch {
+	case d.sequenceSpec != nil:
+		d.keyProgram, err = CompileExpression(d.celEnv, d.sequenceSpec.Key)
+		if err != nil {
+			return fmt.Errorf("failed to compile sequence key (%s): %w", d.sequenceSpec.Key, err)
+		}
+		for i, step := range d.sequenceSpec.Steps {
+			var progs []cel.Program
+			for _, condExpr := range step.Conditions {
+				prog, err := CompileCondition(d.celEnv, condExpr)
+				if err != nil {
+					return fmt.Errorf("failed to compile sequence step %d condition (%s): %w", i, condExpr, err)
+				}
+				progs = append(progs, prog)
+			}
+			d.stepPrograms = append(d.stepPrograms, progs)
+		}
+	case d.thresholdSpec != nil:
+		d.keyProgram, err = CompileExpression(d.celEnv, d.thresholdSpec.Key)
+		if err != nil {
+			return fmt.Errorf("failed to compile threshold key (%s): %w", d.thresholdSpec.Key, err)
+		}
+	}
+
 	return nil
 }
 
+// createStateTrackers creates the bounded state used by sequence and threshold detectors
+func (d *YAMLDetector) createStateTrackers() error {
+	var err error
+
+	if d.sequenceSpec != nil {
+		within, parseErr := time.ParseDuration(d.sequenceSpec.Within)
+		if parseErr != nil {
+			return fmt.Errorf("invalid sequence window '%s': %w", d.sequenceSpec.Within, parseErr)
+		}
+		d.sequence, err = newSequenceTracker(len(d.sequenceSpec.Steps), within, d.sequenceSpec.MaxKeys)
+		if err != nil {
+			return err
+		}
+	}
+
+	if d.thresholdSpec != nil {
+		window, parseErr := time.ParseDuration(d.thresholdSpec.Window)
+		if parseErr != nil {
+			return fmt.Errorf("invalid threshold window '%s': %w", d.thresholdSpec.Window, parseErr)
+		}
+		d.threshold, err = newThresholdTracker(d.thresholdSpec.Count, window, d.thresholdSpec.MaxKeys)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// correlate feeds an event that passed the conditions to the sequence or threshold state
+// Returns true if the event completes a sequence or reaches the threshold for its key
+func (d *YAMLDetector) correlate(event *v1beta1.Event) bool {
+	key, err := evaluateExpression(d.keyProgram, event, d.lists, d.params, d.timeout)
+	if err != nil || key == nil {
+		return false // Events without a key can't be correlated
+	}
+	if _, isNull := key.(structpb.NullValue); isNull {
+		return false
+	}
+	keyStr := fmt.Sprint(key)
+
+	now := time.Now()
+	if event.Timestamp != nil {
+		now = event.Timestamp.AsTime()
+	}
+
+	if d.threshold != nil {
+		return d.threshold.observe(keyStr, now)
+	}
+
+	return d.sequence.observe(keyStr, now, func(step int) bool {
+		return d.matchStep(step, event)
+	})
+}
+
+// matchStep checks if an event matches the given sequence step
+func (d *YAMLDetector) matchStep(step int, event *v1beta1.Event) bool {
+	if event.Name != d.sequenceSpec.Steps[step].Event {
+		return false
+	}
+	for i, condProg := range d.stepPrograms[step] {
+		result, evalErr := evaluateCondition(condProg, event, d.lists, d.params, d.timeout)
+		if evalErr != nil {
+			if d.logger != nil {
+				d.logger.Warnw("CEL sequence step evaluation error, treating as false",
+					"detector_id", d.id,
+					"step", step,
+					"condition_index", i,
+					"error", evalErr.Error(),
+				)
+			}
+			return false
+		}
+		if !result {
+			return false
+		}
+	}
+	return true
+}
+
 // OnEvent processes an event and returns zero or more detection outputs
 func (d *YAMLDetector) OnEvent(_ context.Context, event *v1beta1.Event) (outputs []detection.DetectorOutput, err error) {
 	// Recover from panics to prevent a single malformed detector from crashing Tracee
@@ -185,7 +322,7 @@ func (d *YAMLDetector) OnEvent(_ context.Context, event *v1beta1.Event) (outputs
 
 	// Evaluate CEL conditions (all must be true)
 	for i, condProg := range d.conditions {
-		result, evalErr := EvaluateCondition(condProg, event, d.lists, d.timeout)
+		result, evalErr := evaluateCondition(condProg, event, d.lists, d.params, d.timeout)
 		if evalErr != nil {
 			if d.logger != nil {
 				d.logger.Warnw("CEL condition evaluation error, treating as false",
@@ -203,12 +340,17 @@ func (d *YAMLDetector) OnEvent(_ context.Context, event *v1beta1.Event) (outputs
 		}
 	}
 
+	// Stateful detectors only fire once the sequence or threshold is satisfied
+	if d.keyProgram != nil && !d.correlate(event) {
+		return nil, nil
+	}
+
 	// Extract fields using CEL expressions
 	var dataValues []*v1beta1.EventValue
 
 	for _, extractor := range d.fieldExtractors {
 		// Evaluate CEL expression
-		value, evalErr := EvaluateExpression(extractor.program, event, d.lists, d.timeout)
+		value, evalErr := evaluateExpression(extractor.program, event, d.lists, d.params, d.timeout)
 		if evalErr != nil {
 			if !extractor.optional {
 				if d.logger != nil {
@@ -328,3 +470,28 @@ func loadFromFile(filePath string, lists map[string][]string) (*YAMLDetector, er
 
 	return detector, nil
 }
+
+// LoadFromBytes loads a YAML detector from a document with optional shared lists.
+// source names the document in errors and logs.
+func LoadFromBytes(data []byte, source string, lists map[string][]string) (*YAMLDetector, error) {
+	if lists == nil {
+		lists = make(map[string][]string)
+	}
+
+	spec, err := Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load YAML detector from %s: %w", source, err)
+	}
+
+	def, spec, err := validateAndConvert(spec, lists, source)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load YAML detector from %s: %w", source, err)
+	}
+
+	detector, err := NewDetector(def, spec, lists, source)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create detector from %s: %w", source, err)
+	}
+
+	return detector, nil
+}
@@ -1,6 +1,7 @@
 package yaml
 
 import (
+	"os"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -29,6 +30,35 @@ func TestLoadFromFile(t *testing.T) {
 	})
 }
 
+func TestLoadFromBytes(t *testing.T) {
+	t.Run("valid threat detector", func(t *testing.T) {
+		data, err := os.ReadFile("testdata/valid_threat.yaml")
+		require.NoError(t, err)
+
+		detector, err := LoadFromBytes(data, "runtime", nil)
+		require.NoError(t, err)
+		require.NotNil(t, detector)
+
+		def := detector.GetDefinition()
+		assert.Equal(t, "TRC-TEST-001", def.ID)
+		assert.Equal(t, "test_threat_detection", def.ProducedEvent.Name)
+	})
+
+	t.Run("invalid document returns error naming the source", func(t *testing.T) {
+		data, err := os.ReadFile("testdata/invalid_syntax.yaml")
+		require.NoError(t, err)
+
+		_, err = LoadFromBytes(data, "runtime", nil)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "runtime")
+	})
+
+	t.Run("too large document returns error", func(t *testing.T) {
+		_, err := LoadFromBytes(make([]byte, MaxYAMLFileSize+1), "runtime", nil)
+		assert.ErrorContains(t, err, "too large")
+	})
+}
+
 func TestLoadFromDirectory(t *testing.T) {
 	t.Run("load testdata directory", func(t *testing.T) {
 		result := LoadFromDirectory("testdata")
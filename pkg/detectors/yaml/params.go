@@ -0,0 +1,111 @@
+package yaml
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/aquasecurity/tracee/api/v1beta1/detection"
+)
+
+// Parameter types supported in detector params
+const (
+	ParamTypeInt        = "int"
+	ParamTypeDouble     = "double"
+	ParamTypeString     = "string"
+	ParamTypeBool       = "bool"
+	ParamTypeStringList = "string_list"
+)
+
+var (
+	// validParamNameRegex ensures param names are lowercase snake_case. Config file
+	// keys are case-insensitive, so mixed case names could never be configured.
+	validParamNameRegex = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+)
+
+// defaultParamValues converts the declared defaults to their parameter types
+func defaultParamValues(specs []ParamSpec) (map[string]any, error) {
+	values := make(map[string]any, len(specs))
+	for _, spec := range specs {
+		value, err := paramDefault(spec)
+		if err != nil {
+			return nil, err
+		}
+		values[spec.Name] = value
+	}
+	return values, nil
+}
+
+// paramDefault converts the default of a parameter to its declared type
+func paramDefault(spec ParamSpec) (any, error) {
+	if spec.Default == nil {
+		return nil, fmt.Errorf("param '%s' requires a default value", spec.Name)
+	}
+
+	mismatch := fmt.Errorf("param '%s' default %v is not of type %s", spec.Name, spec.Default, spec.Type)
+
+	switch spec.Type {
+	case ParamTypeInt:
+		if v, ok := spec.Default.(int); ok {
+			return v, nil
+		}
+	case ParamTypeDouble:
+		switch v := spec.Default.(type) {
+		case float64:
+			return v, nil
+		case int:
+			return float64(v), nil
+		}
+	case ParamTypeString:
+		if v, ok := spec.Default.(string); ok {
+			return v, nil
+		}
+	case ParamTypeBool:
+		if v, ok := spec.Default.(bool); ok {
+			return v, nil
+		}
+	case ParamTypeStringList:
+		list, ok := spec.Default.([]any)
+		if !ok {
+			return nil, mismatch
+		}
+		values := make([]string, 0, len(list))
+		for _, item := range list {
+			str, ok := item.(string)
+			if !ok {
+				return nil, mismatch
+			}
+			values = append(values, str)
+		}
+		return values, nil
+	default:
+		return nil, fmt.Errorf("param '%s' has invalid type '%s'", spec.Name, spec.Type)
+	}
+
+	return nil, mismatch
+}
+
+// resolveParams returns the parameter values from config, falling back to defaults
+func resolveParams(specs []ParamSpec, defaults map[string]any, config detection.DetectorConfig) map[string]any {
+	values := make(map[string]any, len(specs))
+	for _, spec := range specs {
+		def := defaults[spec.Name]
+		if config == nil {
+			values[spec.Name] = def
+			continue
+		}
+
+		switch spec.Type {
+		case ParamTypeInt:
+			values[spec.Name] = config.GetInt(spec.Name, def.(int))
+		case ParamTypeDouble:
+			values[spec.Name] = config.GetFloat64(spec.Name, def.(float64))
+		case ParamTypeString:
+			values[spec.Name] = config.GetString(spec.Name, def.(string))
+		case ParamTypeBool:
+			values[spec.Name] = config.GetBool(spec.Name, def.(bool))
+		case ParamTypeStringList:
+			values[spec.Name] = config.GetStringSlice(spec.Name, def.([]string))
+		}
+	}
+	return values
+}
@@ -0,0 +1,143 @@
+package yaml
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aquasecurity/tracee/api/v1beta1"
+	"github.com/aquasecurity/tracee/api/v1beta1/detection"
+)
+
+func newParamsTestEvent(pathname string) *v1beta1.Event {
+	return &v1beta1.Event{
+		Name: "security_file_open",
+		Data: []*v1beta1.EventValue{v1beta1.NewStringValue("pathname", pathname)},
+	}
+}
+
+func TestYAMLDetector_Params(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		config   map[string]any
+		pathname string
+		fires    bool
+	}{
+		{name: "defaults match", pathname: "/etc/passwd", fires: true},
+		{name: "defaults allowlisted", pathname: "/etc/hosts", fires: false},
+		{name: "defaults outside watched dir", pathname: "/tmp/x", fires: false},
+		{
+			name:     "configured dir",
+			config:   map[string]any{"watched_dir": "/tmp/"},
+			pathname: "/tmp/x",
+			fires:    true,
+		},
+		{
+			name:     "configured allowlist from command line",
+			config:   map[string]any{"allowlist": "/etc/passwd, /etc/group"},
+			pathname: "/etc/passwd",
+			fires:    false,
+		},
+		{
+			name:     "configured allowlist replaces default",
+			config:   map[string]any{"allowlist": []any{"/etc/group"}},
+			pathname: "/etc/hosts",
+			fires:    true,
+		},
+		{
+			name:     "disabled",
+			config:   map[string]any{"enabled": "false"},
+			pathname: "/etc/passwd",
+			fires:    false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			detector, err := LoadFromFile("testdata/valid_params.yaml", nil)
+			require.NoError(t, err)
+
+			require.NoError(t, detector.Init(detection.DetectorParams{
+				Config: detection.NewDetectorConfig(tt.config),
+			}))
+
+			outputs, err := detector.OnEvent(ctx, newParamsTestEvent(tt.pathname))
+			require.NoError(t, err)
+			if tt.fires {
+				assert.Len(t, outputs, 1)
+			} else {
+				assert.Empty(t, outputs)
+			}
+		})
+	}
+}
+
+func TestValidateParams(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  []ParamSpec
+		wantErr string
+	}{
+		{
+			name: "valid params",
+			params: []ParamSpec{
+				{Name: "min_count", Type: ParamTypeInt, Default: 5},
+				{Name: "ratio", Type: ParamTypeDouble, Default: 1},
+				{Name: "paths", Type: ParamTypeStringList, Default: []any{"/etc"}},
+			},
+		},
+		{
+			name:    "missing name",
+			params:  []ParamSpec{{Type: ParamTypeInt, Default: 5}},
+			wantErr: "param 0 name is required",
+		},
+		{
+			name:    "uppercase name",
+			params:  []ParamSpec{{Name: "MinCount", Type: ParamTypeInt, Default: 5}},
+			wantErr: "must be lowercase snake_case",
+		},
+		{
+			name: "duplicate name",
+			params: []ParamSpec{
+				{Name: "min_count", Type: ParamTypeInt, Default: 5},
+				{Name: "min_count", Type: ParamTypeInt, Default: 6},
+			},
+			wantErr: "duplicate param name",
+		},
+		{
+			name:    "invalid type",
+			params:  []ParamSpec{{Name: "min_count", Type: "uint64", Default: 5}},
+			wantErr: "invalid type",
+		},
+		{
+			name:    "missing default",
+			params:  []ParamSpec{{Name: "min_count", Type: ParamTypeInt}},
+			wantErr: "requires a default value",
+		},
+		{
+			name:    "default type mismatch",
+			params:  []ParamSpec{{Name: "min_count", Type: ParamTypeInt, Default: "five"}},
+			wantErr: "is not of type int",
+		},
+		{
+			name:    "list default with non string items",
+			params:  []ParamSpec{{Name: "paths", Type: ParamTypeStringList, Default: []any{1, 2}}},
+			wantErr: "is not of type string_list",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateParams(tt.params, "test.yaml")
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -36,6 +36,15 @@ func ParseFile(filePath string) (*YAMLDetectorSpec, error) {
 		return nil, errfmt.WrapError(err)
 	}
 
+	return Parse(data)
+}
+
+// Parse parses a YAML detector document
+func Parse(data []byte) (*YAMLDetectorSpec, error) {
+	if len(data) > MaxYAMLFileSize {
+		return nil, fmt.Errorf("YAML document too large: %d bytes (max: %d bytes)", len(data), MaxYAMLFileSize)
+	}
+
 	var spec YAMLDetectorSpec
 	if err := yaml.Unmarshal(data, &spec); err != nil {
 		return nil, fmt.Errorf("failed to parse YAML: %w", err)
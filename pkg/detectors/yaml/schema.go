@@ -29,15 +29,86 @@ type YAMLDetectorSpec struct {
 	// AutoPopulate specifies which fields the engine should auto-populate
 	AutoPopulate AutoPopulateSpec `yaml:"auto_populate"`
 
+	// Params declare tunable parameters, exposed to CEL as params.<name> (optional)
+	// Values come from the detectors config, falling back to the declared defaults
+	Params []ParamSpec `yaml:"params,omitempty"`
+
 	// Conditions are CEL expressions that must all evaluate to true for detection
 	// Each condition is evaluated with access to the 'event' variable
 	// Example: ["event.workload.container.id != \"\"", "hasData(event, \"pathname\")"]
 	Conditions []string `yaml:"conditions,omitempty"`
 
+	// Sequence correlates an ordered series of events sharing the same key (optional)
+	// Mutually exclusive with Threshold
+	Sequence *SequenceSpec `yaml:"sequence,omitempty"`
+
+	// Threshold fires when enough matching events share the same key within a window (optional)
+	// Mutually exclusive with Sequence
+	Threshold *ThresholdSpec `yaml:"threshold,omitempty"`
+
 	// Output specifies how to extract fields from input events
 	Output *OutputSpec `yaml:"output,omitempty"`
 }
 
+// ParamSpec declares a tunable detector parameter
+type ParamSpec struct {
+	// Name is the parameter name, lowercase snake_case (e.g., "min_count")
+	Name string `yaml:"name"`
+
+	// Type is the parameter type (int, double, string, bool, string_list)
+	Type string `yaml:"type"`
+
+	// Default is the value used when no configuration is given (required)
+	Default any `yaml:"default"`
+
+	// Description is a human-readable description
+	Description string `yaml:"description,omitempty"`
+}
+
+// SequenceSpec defines an ordered series of steps that must be observed for the same
+// correlation key within a time window. Conditions are evaluated before any step.
+type SequenceSpec struct {
+	// Key is a CEL expression computing the correlation key (required)
+	// Examples: "workload.process.unique_id", "workload.container.id"
+	Key string `yaml:"key"`
+
+	// Within is the maximum duration between the first and the last step (e.g., "5s")
+	Within string `yaml:"within"`
+
+	// MaxKeys bounds the number of keys tracked at once (default: DefaultMaxStateKeys)
+	// The least recently used key is evicted when the limit is reached
+	MaxKeys int `yaml:"max_keys,omitempty"`
+
+	// Steps are matched in order, at least two are required
+	Steps []SequenceStepSpec `yaml:"steps"`
+}
+
+// SequenceStepSpec defines a single step of a sequence
+type SequenceStepSpec struct {
+	// Event is the event name this step matches (required, must be in requirements.events)
+	Event string `yaml:"event"`
+
+	// Conditions are CEL expressions that must all be true for the step to match
+	Conditions []string `yaml:"conditions,omitempty"`
+}
+
+// ThresholdSpec defines a count threshold over a sliding time window per correlation key
+type ThresholdSpec struct {
+	// Key is a CEL expression computing the correlation key (required)
+	// Examples: "workload.container.id", "workload.process.unique_id"
+	Key string `yaml:"key"`
+
+	// Count is the number of matching events that fires the detection (required, > 1)
+	Count int `yaml:"count"`
+
+	// Window is the sliding time window the events must fall in (e.g., "10s")
+	Window string `yaml:"window"`
+
+	// MaxKeys bounds the number of keys tracked at once (default: DefaultMaxStateKeys)
+	// The least recently used key is evicted when the limit is reached
+	MaxKeys int `yaml:"max_keys,omitempty"`
+}
+
 // ProducedEventSpec defines the event that this detector produces
 type ProducedEventSpec struct {
 	// Name is the event name (e.g., "suspicious_shadow_write")
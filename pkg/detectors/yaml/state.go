@@ -0,0 +1,188 @@
+package yaml
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+)
+
+const (
+	// DefaultMaxStateKeys is the default number of correlation keys a stateful
+	// detector tracks at once. The least recently used key is evicted beyond it.
+	DefaultMaxStateKeys = 10000
+
+	// MaxThresholdCount bounds threshold counts, since one timestamp is kept per
+	// counted event and key
+	MaxThresholdCount = 10000
+
+	// statePurgeInterval is the number of observed events between sweeps of
+	// expired keys
+	statePurgeInterval = 1024
+)
+
+// sequenceState is the progress of a single key through a sequence
+type sequenceState struct {
+	next    int       // index of the next step to match
+	started time.Time // time the first step matched
+}
+
+// sequenceTracker tracks ordered steps per correlation key within a time window.
+// Time is taken from the event timestamps, so replayed events behave as live ones.
+type sequenceTracker struct {
+	mu       sync.Mutex
+	within   time.Duration
+	steps    int
+	states   *lru.Cache[string, *sequenceState]
+	observed uint64
+}
+
+// newSequenceTracker creates a sequence tracker bounded to maxKeys keys
+func newSequenceTracker(steps int, within time.Duration, maxKeys int) (*sequenceTracker, error) {
+	if maxKeys <= 0 {
+		maxKeys = DefaultMaxStateKeys
+	}
+	states, err := lru.New[string, *sequenceState](maxKeys)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create sequence state: %w", err)
+	}
+
+	return &sequenceTracker{
+		within: within,
+		steps:  steps,
+		states: states,
+	}, nil
+}
+
+// observe advances the sequence for key. matchStep reports whether the event matches
+// the given step index. Returns true when the last step of the sequence matched.
+func (t *sequenceTracker) observe(key string, now time.Time, matchStep func(step int) bool) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.maybePurge(now)
+
+	state, ok := t.states.Get(key)
+	if ok && now.Sub(state.started) > t.within {
+		// Sequence expired, the current event may start a new one
+		t.states.Remove(key)
+		ok = false
+	}
+
+	if !ok {
+		if matchStep(0) {
+			t.states.Add(key, &sequenceState{next: 1, started: now})
+		}
+		return false
+	}
+
+	if !matchStep(state.next) {
+		return false
+	}
+
+	state.next++
+	if state.next < t.steps {
+		return false
+	}
+
+	// Sequence complete, start over for this key
+	t.states.Remove(key)
+	return true
+}
+
+// maybePurge periodically removes keys whose window already elapsed
+func (t *sequenceTracker) maybePurge(now time.Time) {
+	t.observed++
+	if t.observed%statePurgeInterval != 0 {
+		return
+	}
+	for _, key := range t.states.Keys() {
+		if state, ok := t.states.Peek(key); ok && now.Sub(state.started) > t.within {
+			t.states.Remove(key)
+		}
+	}
+}
+
+// len returns the number of keys currently tracked
+func (t *sequenceTracker) len() int {
+	return t.states.Len()
+}
+
+// thresholdTracker counts events per correlation key over a sliding time window
+type thresholdTracker struct {
+	mu       sync.Mutex
+	window   time.Duration
+	count    int
+	hits     *lru.Cache[string, []time.Time]
+	observed uint64
+}
+
+// newThresholdTracker creates a threshold tracker bounded to maxKeys keys
+func newThresholdTracker(count int, window time.Duration, maxKeys int) (*thresholdTracker, error) {
+	if maxKeys <= 0 {
+		maxKeys = DefaultMaxStateKeys
+	}
+	hits, err := lru.New[string, []time.Time](maxKeys)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create threshold state: %w", err)
+	}
+
+	return &thresholdTracker{
+		window: window,
+		count:  count,
+		hits:   hits,
+	}, nil
+}
+
+// observe records an event for key. Returns true when the number of events within the
+// window reaches the threshold; the key is then reset so the next detection needs a
+// full new count.
+func (t *thresholdTracker) observe(key string, now time.Time) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.maybePurge(now)
+
+	hits, _ := t.hits.Get(key)
+	hits = append(dropExpired(hits, now, t.window), now)
+
+	if len(hits) >= t.count {
+		t.hits.Remove(key)
+		return true
+	}
+
+	t.hits.Add(key, hits)
+	return false
+}
+
+// maybePurge periodically removes keys without hits inside the window
+func (t *thresholdTracker) maybePurge(now time.Time) {
+	t.observed++
+	if t.observed%statePurgeInterval != 0 {
+		return
+	}
+	for _, key := range t.hits.Keys() {
+		hits, ok := t.hits.Peek(key)
+		if !ok {
+			continue
+		}
+		if hits = dropExpired(hits, now, t.window); len(hits) == 0 {
+			t.hits.Remove(key)
+		}
+	}
+}
+
+// len returns the number of keys currently tracked
+func (t *thresholdTracker) len() int {
+	return t.hits.Len()
+}
+
+// dropExpired removes hits older than window from the (time ordered) hits slice
+func dropExpired(hits []time.Time, now time.Time, window time.Duration) []time.Time {
+	i := 0
+	for i < len(hits) && now.Sub(hits[i]) > window {
+		i++
+	}
+	return hits[i:]
+}
@@ -0,0 +1,291 @@
+package yaml
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/aquasecurity/tracee/api/v1beta1"
+)
+
+func TestSequenceTracker(t *testing.T) {
+	base := time.Unix(1700000000, 0)
+
+	t.Run("fires when all steps match in order within window", func(t *testing.T) {
+		tracker, err := newSequenceTracker(2, 5*time.Second, 0)
+		require.NoError(t, err)
+
+		assert.False(t, tracker.observe("p1", base, func(step int) bool { return step == 0 }))
+		assert.False(t, tracker.observe("p1", base.Add(time.Second), func(int) bool { return false }))
+		assert.True(t, tracker.observe("p1", base.Add(2*time.Second), func(step int) bool { return step == 1 }))
+		assert.Equal(t, 0, tracker.len(), "completed sequence should be reset")
+	})
+
+	t.Run("keys are tracked independently", func(t *testing.T) {
+		tracker, err := newSequenceTracker(2, 5*time.Second, 0)
+		require.NoError(t, err)
+
+		assert.False(t, tracker.observe("p1", base, func(step int) bool { return step == 0 }))
+		assert.False(t, tracker.observe("p2", base, func(step int) bool { return step == 1 }))
+		assert.True(t, tracker.observe("p1", base, func(step int) bool { return step == 1 }))
+	})
+
+	t.Run("expired sequence restarts", func(t *testing.T) {
+		tracker, err := newSequenceTracker(2, 5*time.Second, 0)
+		require.NoError(t, err)
+
+		assert.False(t, tracker.observe("p1", base, func(step int) bool { return step == 0 }))
+		// Too late for step 1, and it doesn't match step 0 either
+		assert.False(t, tracker.observe("p1", base.Add(6*time.Second), func(step int) bool { return step == 1 }))
+		assert.Equal(t, 0, tracker.len())
+	})
+
+	t.Run("state is bounded", func(t *testing.T) {
+		tracker, err := newSequenceTracker(2, time.Minute, 2)
+		require.NoError(t, err)
+
+		for _, key := range []string{"a", "b", "c"} {
+			tracker.observe(key, base, func(step int) bool { return step == 0 })
+		}
+		assert.Equal(t, 2, tracker.len())
+		// "a" was evicted, so step 1 alone can't complete it
+		assert.False(t, tracker.observe("a", base, func(step int) bool { return step == 1 }))
+	})
+
+	t.Run("expired keys are purged", func(t *testing.T) {
+		tracker, err := newSequenceTracker(2, time.Second, 0)
+		require.NoError(t, err)
+
+		tracker.observe("old", base, func(step int) bool { return step == 0 })
+		for i := 0; i < statePurgeInterval; i++ {
+			tracker.observe("new", base.Add(time.Minute), func(int) bool { return false })
+		}
+		assert.Equal(t, 0, tracker.len())
+	})
+}
+
+func TestThresholdTracker(t *testing.T) {
+	base := time.Unix(1700000000, 0)
+
+	t.Run("fires when count is reached within window", func(t *testing.T) {
+		tracker, err := newThresholdTracker(3, 10*time.Second, 0)
+		require.NoError(t, err)
+
+		assert.False(t, tracker.observe("c1", base))
+		assert.False(t, tracker.observe("c1", base.Add(time.Second)))
+		assert.True(t, tracker.observe("c1", base.Add(2*time.Second)))
+		// Reset after firing
+		assert.False(t, tracker.observe("c1", base.Add(3*time.Second)))
+	})
+
+	t.Run("old events slide out of the window", func(t *testing.T) {
+		tracker, err := newThresholdTracker(3, 10*time.Second, 0)
+		require.NoError(t, err)
+
+		assert.False(t, tracker.observe("c1", base))
+		assert.False(t, tracker.observe("c1", base.Add(time.Second)))
+		assert.False(t, tracker.observe("c1", base.Add(15*time.Second)))
+		assert.False(t, tracker.observe("c1", base.Add(16*time.Second)))
+		assert.True(t, tracker.observe("c1", base.Add(17*time.Second)))
+	})
+
+	t.Run("state is bounded", func(t *testing.T) {
+		tracker, err := newThresholdTracker(2, time.Minute, 2)
+		require.NoError(t, err)
+
+		for _, key := range []string{"a", "b", "c"} {
+			assert.False(t, tracker.observe(key, base))
+		}
+		assert.Equal(t, 2, tracker.len())
+		assert.False(t, tracker.observe("a", base), "evicted key starts counting again")
+	})
+}
+
+func newStatefulTestEvent(name string, ts time.Time, entityID uint32, containerID string, pathname string) *v1beta1.Event {
+	return &v1beta1.Event{
+		Name:      name,
+		Timestamp: timestamppb.New(ts),
+		Workload: &v1beta1.Workload{
+			Process:   &v1beta1.Process{UniqueId: wrapperspb.UInt32(entityID)},
+			Container: &v1beta1.Container{Id: containerID},
+		},
+		Data: []*v1beta1.EventValue{v1beta1.NewStringValue("pathname", pathname)},
+	}
+}
+
+func TestYAMLDetector_Sequence(t *testing.T) {
+	detector, err := LoadFromFile("testdata/valid_sequence.yaml", nil)
+	require.NoError(t, err)
+
+	base := time.Unix(1700000000, 0)
+	ctx := context.Background()
+
+	steps := []struct {
+		event *v1beta1.Event
+		fires bool
+	}{
+		{newStatefulTestEvent("sched_process_exec", base, 10, "", "/bin/sh"), false},                        // exec before connect
+		{newStatefulTestEvent("security_socket_connect", base, 10, "", ""), false},                          // step 0
+		{newStatefulTestEvent("sched_process_exec", base.Add(time.Second), 20, "", "/bin/sh"), false},       // other process
+		{newStatefulTestEvent("sched_process_exec", base.Add(2*time.Second), 10, "", "/usr/bin/ls"), false}, // step 1 condition false
+		{newStatefulTestEvent("sched_process_exec", base.Add(3*time.Second), 10, "", "/bin/bash"), true},    // step 1
+		{newStatefulTestEvent("security_socket_connect", base.Add(4*time.Second), 10, "", ""), false},       // step 0 again
+		{newStatefulTestEvent("sched_process_exec", base.Add(10*time.Second), 10, "", "/bin/bash"), false},  // window exceeded
+	}
+
+	for i, step := range steps {
+		outputs, err := detector.OnEvent(ctx, step.event)
+		require.NoError(t, err)
+		if step.fires {
+			require.Len(t, outputs, 1, "event %d", i)
+			assert.Equal(t, "/bin/bash", outputs[0].Data[0].GetStr())
+		} else {
+			assert.Empty(t, outputs, "event %d", i)
+		}
+	}
+}
+
+func TestYAMLDetector_Threshold(t *testing.T) {
+	detector, err := LoadFromFile("testdata/valid_threshold.yaml", nil)
+	require.NoError(t, err)
+
+	base := time.Unix(1700000000, 0)
+	ctx := context.Background()
+
+	fired := 0
+	for i := 0; i < 6; i++ {
+		// Files outside /etc don't count towards the threshold
+		outputs, err := detector.OnEvent(ctx, newStatefulTestEvent("security_file_open", base, 1, "abc", "/tmp/x"))
+		require.NoError(t, err)
+		assert.Empty(t, outputs)
+
+		outputs, err = detector.OnEvent(ctx, newStatefulTestEvent("security_file_open", base.Add(time.Duration(i)*time.Second), 1, "abc", "/etc/passwd"))
+		require.NoError(t, err)
+		fired += len(outputs)
+	}
+	assert.Equal(t, 2, fired)
+}
+
+func TestValidateSpec_Stateful(t *testing.T) {
+	newSpec := func() *YAMLDetectorSpec {
+		return &YAMLDetectorSpec{
+			Type:          TypeDetector,
+			ID:            "TRC-TEST-STATE",
+			ProducedEvent: ProducedEventSpec{Name: "test_state", Version: "1.0.0"},
+			Requirements: RequirementsSpec{Events: []EventRequirementSpec{
+				{Name: "security_socket_connect"},
+				{Name: "sched_process_exec"},
+			}},
+		}
+	}
+	validSequence := func() *SequenceSpec {
+		return &SequenceSpec{
+			Key:    "workload.process.unique_id",
+			Within: "5s",
+			Steps: []SequenceStepSpec{
+				{Event: "security_socket_connect"},
+				{Event: "sched_process_exec"},
+			},
+		}
+	}
+	validThreshold := func() *ThresholdSpec {
+		return &ThresholdSpec{Key: "workload.container.id", Count: 20, Window: "10s"}
+	}
+
+	tests := []struct {
+		name    string
+		modify  func(*YAMLDetectorSpec)
+		wantErr string
+	}{
+		{
+			name:   "valid sequence",
+			modify: func(s *YAMLDetectorSpec) { s.Sequence = validSequence() },
+		},
+		{
+			name:   "valid threshold",
+			modify: func(s *YAMLDetectorSpec) { s.Threshold = validThreshold() },
+		},
+		{
+			name: "both sequence and threshold",
+			modify: func(s *YAMLDetectorSpec) {
+				s.Sequence = validSequence()
+				s.Threshold = validThreshold()
+			},
+			wantErr: "mutually exclusive",
+		},
+		{
+			name: "sequence missing key",
+			modify: func(s *YAMLDetectorSpec) {
+				s.Sequence = validSequence()
+				s.Sequence.Key = ""
+			},
+			wantErr: "sequence.key is required",
+		},
+		{
+			name: "sequence invalid window",
+			modify: func(s *YAMLDetectorSpec) {
+				s.Sequence = validSequence()
+				s.Sequence.Within = "five seconds"
+			},
+			wantErr: "invalid duration",
+		},
+		{
+			name: "sequence single step",
+			modify: func(s *YAMLDetectorSpec) {
+				s.Sequence = validSequence()
+				s.Sequence.Steps = s.Sequence.Steps[:1]
+			},
+			wantErr: "at least 2 steps",
+		},
+		{
+			name: "sequence step event not required",
+			modify: func(s *YAMLDetectorSpec) {
+				s.Sequence = validSequence()
+				s.Sequence.Steps[1].Event = "openat"
+			},
+			wantErr: "not listed in requirements.events",
+		},
+		{
+			name: "sequence step invalid condition",
+			modify: func(s *YAMLDetectorSpec) {
+				s.Sequence = validSequence()
+				s.Sequence.Steps[1].Conditions = []string{"getEventData("}
+			},
+			wantErr: "sequence step 1 condition 0",
+		},
+		{
+			name: "threshold count too low",
+			modify: func(s *YAMLDetectorSpec) {
+				s.Threshold = validThreshold()
+				s.Threshold.Count = 1
+			},
+			wantErr: "threshold.count must be between",
+		},
+		{
+			name: "threshold negative window",
+			modify: func(s *YAMLDetectorSpec) {
+				s.Threshold = validThreshold()
+				s.Threshold.Window = "-1s"
+			},
+			wantErr: "must be positive",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := newSpec()
+			tt.modify(spec)
+			err := ValidateSpec(spec, nil, "test.yaml")
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"runtime"
 	"strings"
+	"time"
 
 	"github.com/aquasecurity/tracee/api/v1beta1"
 	"github.com/aquasecurity/tracee/api/v1beta1/detection"
@@ -46,6 +47,11 @@ func ValidateSpec(spec *YAMLDetectorSpec, lists map[string][]string, filePath st
 		}
 	}
 
+	// Validate parameters if present
+	if err := validateParams(spec.Params, filePath); err != nil {
+		return err
+	}
+
 	// Validate CEL conditions if present
 	if len(spec.Conditions) > 0 {
 		if err := validateConditions(spec.Conditions, lists, filePath); err != nil {
@@ -53,6 +59,21 @@ func ValidateSpec(spec *YAMLDetectorSpec, lists map[string][]string, filePath st
 		}
 	}
 
+	// Validate stateful correlation if present
+	if spec.Sequence != nil && spec.Threshold != nil {
+		return errfmt.Errorf("%s: sequence and threshold are mutually exclusive", filePath)
+	}
+	if spec.Sequence != nil {
+		if err := validateSequence(spec.Sequence, spec.Requirements.Events, lists, filePath); err != nil {
+			return err
+		}
+	}
+	if spec.Threshold != nil {
+		if err := validateThreshold(spec.Threshold, lists, filePath); err != nil {
+			return err
+		}
+	}
+
 	// Validate output extraction if present
 	if spec.Output != nil {
 		if err := validateOutput(spec.Output, spec.ProducedEvent.Fields, lists, filePath); err != nil {
@@ -412,6 +433,11 @@ func ParseAndValidate(filePath string, lists map[string][]string) (*detection.De
 		return nil, nil, fmt.Errorf("failed to parse file: %w", err)
 	}
 
+	return validateAndConvert(spec, lists, filePath)
+}
+
+// validateAndConvert validates a parsed spec and converts it to a detector definition
+func validateAndConvert(spec *YAMLDetectorSpec, lists map[string][]string, filePath string) (*detection.DetectorDefinition, *YAMLDetectorSpec, error) {
 	if err := ValidateSpec(spec, lists, filePath); err != nil {
 		return nil, spec, fmt.Errorf("validation failed: %w", err)
 	}
@@ -454,3 +480,130 @@ func validateConditions(conditions []string, lists map[string][]string, filePath
 
 	return nil
 }
+
+// validateParams validates parameter declarations and their defaults
+func validateParams(params []ParamSpec, filePath string) error {
+	seen := make(map[string]bool, len(params))
+	for i, param := range params {
+		if param.Name == "" {
+			return errfmt.Errorf("%s: param %d name is required", filePath, i)
+		}
+		if !validParamNameRegex.MatchString(param.Name) {
+			return errfmt.Errorf("%s: param name '%s' must be lowercase snake_case (e.g., min_count)", filePath, param.Name)
+		}
+		if seen[param.Name] {
+			return errfmt.Errorf("%s: duplicate param name '%s'", filePath, param.Name)
+		}
+		seen[param.Name] = true
+
+		if _, err := paramDefault(param); err != nil {
+			return errfmt.Errorf("%s: %v", filePath, err)
+		}
+	}
+
+	return nil
+}
+
+// validateSequence validates a sequence specification
+func validateSequence(spec *SequenceSpec, requiredEvents []EventRequirementSpec, lists map[string][]string, filePath string) error {
+	if err := validateCorrelationKey(spec.Key, lists, "sequence", filePath); err != nil {
+		return err
+	}
+
+	if err := validateWindow(spec.Within, "sequence.within", filePath); err != nil {
+		return err
+	}
+
+	if spec.MaxKeys < 0 {
+		return errfmt.Errorf("%s: sequence.max_keys cannot be negative", filePath)
+	}
+
+	if len(spec.Steps) < 2 {
+		return errfmt.Errorf("%s: sequence requires at least 2 steps", filePath)
+	}
+
+	required := make(map[string]bool, len(requiredEvents))
+	for _, event := range requiredEvents {
+		required[event.Name] = true
+	}
+
+	env, err := createCELEnvironment(lists, nil)
+	if err != nil {
+		return fmt.Errorf("%s: failed to create CEL environment: %w", filePath, err)
+	}
+
+	for i, step := range spec.Steps {
+		if step.Event == "" {
+			return errfmt.Errorf("%s: sequence step %d event is required", filePath, i)
+		}
+		if !required[step.Event] {
+			return errfmt.Errorf("%s: sequence step %d event '%s' is not listed in requirements.events", filePath, i, step.Event)
+		}
+		for j, condition := range step.Conditions {
+			if condition == "" {
+				return errfmt.Errorf("%s: sequence step %d condition %d is empty", filePath, i, j)
+			}
+			if _, err := CompileCondition(env, condition); err != nil {
+				return fmt.Errorf("%s: sequence step %d condition %d (%s) is invalid: %w", filePath, i, j, condition, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateThreshold validates a threshold specification
+func validateThreshold(spec *ThresholdSpec, lists map[string][]string, filePath string) error {
+	if err := validateCorrelationKey(spec.Key, lists, "threshold", filePath); err != nil {
+		return err
+	}
+
+	if err := validateWindow(spec.Window, "threshold.window", filePath); err != nil {
+		return err
+	}
+
+	if spec.Count < 2 || spec.Count > MaxThresholdCount {
+		return errfmt.Errorf("%s: threshold.count must be between 2 and %d, got %d", filePath, MaxThresholdCount, spec.Count)
+	}
+
+	if spec.MaxKeys < 0 {
+		return errfmt.Errorf("%s: threshold.max_keys cannot be negative", filePath)
+	}
+
+	return nil
+}
+
+// validateCorrelationKey validates the CEL key expression of a sequence or threshold
+func validateCorrelationKey(key string, lists map[string][]string, section string, filePath string) error {
+	if key == "" {
+		return errfmt.Errorf("%s: %s.key is required", filePath, section)
+	}
+
+	env, err := createCELEnvironment(lists, nil)
+	if err != nil {
+		return fmt.Errorf("%s: failed to create CEL environment: %w", filePath, err)
+	}
+
+	if _, err := CompileExpression(env, key); err != nil {
+		return fmt.Errorf("%s: %s.key has invalid CEL expression '%s': %w", filePath, section, key, err)
+	}
+
+	return nil
+}
+
+// validateWindow validates a positive duration such as "10s" or "5m"
+func validateWindow(window string, field string, filePath string) error {
+	if window == "" {
+		return errfmt.Errorf("%s: %s is required", filePath, field)
+	}
+
+	d, err := time.ParseDuration(window)
+	if err != nil {
+		return errfmt.Errorf("%s: %s: invalid duration '%s'", filePath, field, window)
+	}
+	if d <= 0 {
+		return errfmt.Errorf("%s: %s must be positive", filePath, field)
+	}
+
+	return nil
+}
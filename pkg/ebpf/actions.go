@@ -0,0 +1,116 @@
+package ebpf
+
+import (
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/aquasecurity/tracee/api/v1beta1"
+	"github.com/aquasecurity/tracee/common/bitwise"
+	"github.com/aquasecurity/tracee/common/errfmt"
+	"github.com/aquasecurity/tracee/pkg/actions"
+	"github.com/aquasecurity/tracee/pkg/events"
+	"github.com/aquasecurity/tracee/pkg/pcaps"
+	"github.com/aquasecurity/tracee/pkg/policy"
+)
+
+// initActionsExecutor creates the executor of the policy rules response
+// actions, if any policy rule has one.
+func (t *Tracee) initActionsExecutor() error {
+	policyActions := t.policyManager.Actions()
+	if len(policyActions) == 0 {
+		return nil
+	}
+
+	if err := t.validateActions(policyActions); err != nil {
+		return err
+	}
+
+	t.actionExecutor = actions.NewExecutor(actions.Config{
+		OutDir:    t.OutDir,
+		QueueSize: t.config.Buffers.Pipeline,
+		Forward:   t.forwardEvent,
+		Audit:     t.publishActionEvent,
+	})
+
+	return nil
+}
+
+// validatePolicyActions checks the response actions of a policy given at
+// runtime can be run. The executor only runs if tracee started with actions.
+func (t *Tracee) validatePolicyActions(p *policy.Policy) error {
+	var policyActions []actions.Action
+	for _, rule := range p.Rules {
+		policyActions = append(policyActions, rule.Actions...)
+	}
+	if len(policyActions) == 0 {
+		return nil
+	}
+
+	if t.actionExecutor == nil {
+		return errfmt.Errorf("policy %s has response actions, which require a policy with response actions at startup", p.Name)
+	}
+
+	return t.validateActions(policyActions)
+}
+
+// validateActions checks the requirements of the given response actions are met
+func (t *Tracee) validateActions(policyActions []actions.Action) error {
+	destinations := make(map[string]struct{})
+	for _, stream := range t.config.Output.Streams {
+		for _, destination := range stream.Destinations {
+			destinations[destination.Name] = struct{}{}
+		}
+	}
+
+	for _, action := range policyActions {
+		switch action.Type {
+		case actions.Pcap:
+			// pcap actions are fed by the network capture pipeline
+			if !pcaps.PcapsEnabled(t.config.Artifacts.Net) {
+				return errfmt.Errorf("action %s requires network capture to be enabled (--artifacts network)", action)
+			}
+		case actions.Forward:
+			if _, ok := destinations[action.Destination]; !ok {
+				return errfmt.Errorf("action %s: destination %s is not configured", action, action.Destination)
+			}
+		}
+	}
+
+	return nil
+}
+
+// submitActions submits the response actions of the matched policies rules of an event
+func (t *Tracee) submitActions(eventID events.ID, matchedPolicies bitwise.Bitmap, pbEvent *pb.Event) {
+	matchedActions := t.policyManager.MatchedActions(eventID, matchedPolicies)
+	if len(matchedActions) == 0 {
+		return
+	}
+
+	// Actions run asynchronously: they get their own copy of the event, with
+	// the external event ID (the one seen by the streams).
+	actionEvent := proto.Clone(pbEvent).(*pb.Event)
+	actionEvent.Id = pb.EventId(events.TranslateEventID(int(actionEvent.Id)))
+
+	for _, policyActions := range matchedActions {
+		for _, action := range policyActions.Actions {
+			t.actionExecutor.Submit(actions.Request{
+				PolicyID:   policyActions.PolicyID,
+				PolicyName: policyActions.PolicyName,
+				Action:     action,
+				Event:      actionEvent,
+			})
+		}
+	}
+}
+
+// forwardEvent sends an event to the streams delivering to a destination
+func (t *Tracee) forwardEvent(destination string, event *pb.Event) error {
+	if t.streamsManager.PublishTo(destination, event) == 0 {
+		return errfmt.Errorf("no stream delivers to destination %s", destination)
+	}
+	return nil
+}
+
+// publishActionEvent publishes the policy_action audit event of an executed action
+func (t *Tracee) publishActionEvent(event *pb.Event, policyID int) {
+	t.streamsManager.Publish(event, bitwise.NewBitmap(uint(policyID)))
+}
@@ -0,0 +1,176 @@
+package ebpf
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	pb "github.com/aquasecurity/tracee/api/v1beta1"
+	"github.com/aquasecurity/tracee/common/errfmt"
+	"github.com/aquasecurity/tracee/pkg/artifacts"
+	"github.com/aquasecurity/tracee/pkg/config"
+	"github.com/aquasecurity/tracee/pkg/events"
+	"github.com/aquasecurity/tracee/pkg/pcaps"
+)
+
+// artifactsUploadTimeout is how long shutdown waits for pending artifact uploads
+const artifactsUploadTimeout = 30 * time.Second
+
+// captureEvents maps each artifact type to the capture event announcing its
+// artifacts, to the event selected (in all policies) to capture them, and to
+// the event they are captured on.
+var captureEvents = map[artifacts.Type]struct {
+	id          events.ID
+	selectedBy  events.ID
+	triggeredBy events.ID
+}{
+	artifacts.FileWrite:    {events.CaptureFileWrite, events.CaptureFileWrite, events.CaptureFileWrite},
+	artifacts.FileRead:     {events.CaptureFileRead, events.CaptureFileRead, events.CaptureFileRead},
+	artifacts.Executable:   {events.CaptureExec, events.CaptureExec, events.SchedProcessExec},
+	artifacts.KernelModule: {events.CaptureModule, events.CaptureModule, events.CaptureModule},
+	artifacts.BpfProgram:   {events.CaptureBpf, events.CaptureBpf, events.CaptureBpf},
+	artifacts.MemoryRegion: {events.CaptureMem, events.CaptureMem, events.CaptureMem},
+	artifacts.Network:      {events.CapturePcap, events.CaptureNetPacket, events.CaptureNetPacket},
+}
+
+// initArtifactsUploader creates the uploader storing the captured artifacts,
+// if any artifact is captured.
+func (t *Tracee) initArtifactsUploader() error {
+	cfg := t.config.Artifacts
+	if !cfg.FileWrite.Capture && !cfg.FileRead.Capture && !cfg.Exec && !cfg.Module &&
+		!cfg.Mem && !cfg.Bpf && !pcaps.PcapsEnabled(cfg.Net) {
+		return nil
+	}
+
+	sink, err := newArtifactsSink(cfg.Storage)
+	if err != nil {
+		return err
+	}
+
+	retention := make(map[artifacts.Type]time.Duration, len(cfg.Retention))
+	for name, period := range cfg.Retention {
+		artifactType, err := artifacts.ParseType(name)
+		if err != nil {
+			return errfmt.WrapError(err)
+		}
+		retention[artifactType] = period
+	}
+
+	quotas := artifacts.Quotas{
+		Total:   cfg.Quota,
+		PerType: make(map[artifacts.Type]int64, len(cfg.TypeQuota)),
+	}
+	for name, size := range cfg.TypeQuota {
+		artifactType, err := artifacts.ParseType(name)
+		if err != nil {
+			return errfmt.WrapError(err)
+		}
+		quotas.PerType[artifactType] = size
+	}
+
+	t.artifactsUploader, err = artifacts.NewUploader(artifacts.Config{
+		OutDir:    t.OutDir,
+		Sink:      sink,
+		Retention: retention,
+		Quotas:    quotas,
+		Stored:    t.publishCaptureEvent,
+	})
+	if err != nil {
+		return errfmt.WrapError(err)
+	}
+	t.stats.SetArtifactsStats(t.artifactsUploader.Stats)
+
+	return nil
+}
+
+// newArtifactsSink returns the configured artifacts storage, or nil to keep
+// the artifacts in the output directory only.
+func newArtifactsSink(cfg config.ArtifactsStorageConfig) (artifacts.Sink, error) {
+	switch {
+	case cfg.Dir != "":
+		sink, err := artifacts.NewDirSink(cfg.Dir)
+		if err != nil {
+			return nil, errfmt.WrapError(err)
+		}
+		return sink, nil
+	case cfg.S3.Bucket != "":
+		sink, err := artifacts.NewS3Sink(artifacts.S3Config{
+			Endpoint: cfg.S3.Endpoint,
+			Bucket:   cfg.S3.Bucket,
+			Prefix:   cfg.S3.Prefix,
+			Region:   cfg.S3.Region,
+		})
+		if err != nil {
+			return nil, errfmt.WrapError(err)
+		}
+		return sink, nil
+	}
+
+	return nil, nil
+}
+
+// submitArtifact queues a captured artifact, whose content is final, to be stored
+func (t *Tracee) submitArtifact(artifact artifacts.Artifact) {
+	if t.artifactsUploader != nil {
+		t.artifactsUploader.Submit(withTriggeringEvent(artifact))
+	}
+}
+
+// submitArtifactWhenSettled queues a captured artifact, still being written, to
+// be stored once no more writes happen to it.
+func (t *Tracee) submitArtifactWhenSettled(artifact artifacts.Artifact) {
+	if t.artifactsUploader != nil {
+		t.artifactsUploader.SubmitWhenSettled(withTriggeringEvent(artifact))
+	}
+}
+
+// withTriggeringEvent sets the event an artifact was captured on, recorded in
+// the artifacts manifest
+func withTriggeringEvent(artifact artifacts.Artifact) artifacts.Artifact {
+	if captureEvent, ok := captureEvents[artifact.Type]; ok {
+		artifact.Context.EventID = int32(captureEvent.triggeredBy)
+		artifact.Context.EventName = events.Core.GetDefinitionByID(captureEvent.triggeredBy).GetName()
+	}
+
+	return artifact
+}
+
+// publishCaptureEvent publishes the capture event of a stored artifact
+func (t *Tracee) publishCaptureEvent(artifact artifacts.Artifact) {
+	captureEvent, ok := captureEvents[artifact.Type]
+	if !ok {
+		return
+	}
+
+	// artifacts are captured on behalf of all policies (see the policy manager)
+	policies := t.policyManager.MatchEventInAnyPolicy(captureEvent.selectedBy)
+	if policies.IsZero() {
+		return
+	}
+
+	var workload *pb.Workload
+	if artifact.Context.ContainerID != "" || artifact.Context.HostPid != 0 {
+		workload = &pb.Workload{}
+		if artifact.Context.ContainerID != "" {
+			workload.Container = &pb.Container{Id: artifact.Context.ContainerID}
+		}
+		if artifact.Context.HostPid != 0 {
+			workload.Process = &pb.Process{HostPid: wrapperspb.UInt32(artifact.Context.HostPid)}
+		}
+	}
+
+	t.streamsManager.Publish(&pb.Event{
+		Timestamp: timestamppb.New(artifact.Time),
+		Id:        events.TranslateEventID(int(captureEvent.id)),
+		Name:      events.Core.GetDefinitionByID(captureEvent.id).GetName(),
+		Policies:  &pb.Policies{Matched: t.policyManager.MatchedNames(policies)},
+		Workload:  workload,
+		Data: []*pb.EventValue{
+			pb.NewStringValue("path", artifact.Path),
+			pb.NewStringValue("uri", artifact.URI),
+			pb.NewStringValue("sha256", artifact.Hash),
+			pb.NewUInt64Value("size", uint64(artifact.Size)),
+		},
+	}, policies)
+}
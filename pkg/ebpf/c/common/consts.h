@@ -29,6 +29,8 @@
 #define MAX_PATH_COMPONENTS   20
 #define MAX_BIN_CHUNKS        110
 #define MAX_ARGS              32 // Update if any eBPF program requires more arguments
+#define MAX_POLICIES          256 // must match PolicyMax in pkg/policy
+#define POLICIES_WORDS        (MAX_POLICIES / 64)
 #define INVALID_ARG_OFFSET    0xFFFF
 
 #define CAPTURE_IFACE (1 << 0)
@@ -7,6 +7,7 @@
 #include <common/task.h>
 #include <common/cgroups.h>
 #include <common/common.h>
+#include <common/policies.h>
 
 // PROTOTYPES
 
@@ -193,10 +194,10 @@ statfunc int init_program_data(program_data_t *p, void *ctx, u32 event_id)
     }
 
     // default to match all policies until an event is selected
-    p->event->config.submit_for_policies = ~0ULL;
+    policies_set_all(&p->event->config.submit_for_policies);
 
     if (event_id != NO_EVENT_SUBMIT) {
-        p->event->config.submit_for_policies = 0;
+        policies_clear(&p->event->config.submit_for_policies);
         event_config_t *event_config = get_event_config(event_id, p->event->context.policies_version);
         if (event_config != NULL) {
             p->event->config.field_types = event_config->field_types;
@@ -254,7 +255,7 @@ statfunc bool reset_event(event_data_t *event, u32 event_id)
 {
     event->context.eventid = event_id;
     reset_event_args_buf(event);
-    event->config.submit_for_policies = ~0ULL;
+    policies_set_all(&event->config.submit_for_policies);
 
     event_config_t *event_config = get_event_config(event_id, event->context.policies_version);
     if (event_config == NULL)
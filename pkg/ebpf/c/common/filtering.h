@@ -7,21 +7,27 @@
 #include <common/logging.h>
 #include <common/task.h>
 #include <common/common.h>
+#include <common/policies.h>
 
 // PROTOTYPES
 
 statfunc void *get_filter_map(void *, u16);
 statfunc void *get_event_filter_map(void *, u16, u32);
-statfunc u64 uint_filter_range_matches(u64, void *, u64, u64, u64);
-statfunc u64 binary_filter_matches(u64, void *, proc_info_t *);
-statfunc u64 equality_filter_matches(u64, void *, void *);
-statfunc u64 bool_filter_matches(u64, bool);
-statfunc u64 match_scope_filters(program_data_t *);
-statfunc u64 match_data_filters(program_data_t *, u8);
+statfunc void
+uint_filter_range_matches(policies_bitmap_t *, void *, u64, u64, u64, policies_bitmap_t *);
+statfunc void
+binary_filter_matches(policies_bitmap_t *, void *, proc_info_t *, policies_bitmap_t *);
+statfunc void equality_filter_matches(policies_bitmap_t *, void *, void *, policies_bitmap_t *);
+statfunc void equality_matches(policies_bitmap_t *, eq_t *, policies_bitmap_t *);
+statfunc void bool_filter_matches(policies_bitmap_t *, bool, policies_bitmap_t *);
+statfunc void apply_filter(policies_bitmap_t *, policies_bitmap_t *, policies_bitmap_t *);
+statfunc void match_scope_filters(program_data_t *, policies_bitmap_t *);
+statfunc void match_data_filters(program_data_t *, u8, policies_bitmap_t *);
 statfunc bool evaluate_scope_filters(program_data_t *);
 statfunc bool evaluate_data_filters(program_data_t *, u8);
 statfunc bool event_is_selected(u32, u16);
 statfunc bool policies_matched(event_data_t *);
+statfunc void get_scopes_to_follow(program_data_t *, policies_bitmap_t *);
 
 // CONSTANTS
 
@@ -47,56 +53,66 @@ statfunc void *get_event_filter_map(void *outer_map, u16 version, u32 event_id)
     return bpf_map_lookup_elem(outer_map, &policy_key);
 }
 
-statfunc u64
-uint_filter_range_matches(u64 match_if_key_missing, void *filter_map, u64 value, u64 max, u64 min)
+
+statfunc void uint_filter_range_matches(policies_bitmap_t *match_if_key_missing,
+                                        void *filter_map,
+                                        u64 value,
+                                        u64 max,
+                                        u64 min,
+                                        policies_bitmap_t *res)
 {
     // check equality_filter_matches() for more info
 
-    u64 equals_in_policies = 0;
-    u64 key_used_in_policies = 0;
+    eq_t *equality = NULL;
 
-    if (filter_map) {
-        eq_t *equality = bpf_map_lookup_elem(filter_map, &value);
-        if (equality != NULL) {
-            equals_in_policies = equality->equals_in_policies;
-            key_used_in_policies = equality->key_used_in_policies;
-        }
-    }
+    if (filter_map)
+        equality = bpf_map_lookup_elem(filter_map, &value);
 
-    if ((max != FILTER_MAX_NOT_SET) && (value >= max))
-        return equals_in_policies;
+    bool out_of_range = ((max != FILTER_MAX_NOT_SET) && (value >= max)) ||
+                        ((min != FILTER_MIN_NOT_SET) && (value <= min));
 
-    if ((min != FILTER_MIN_NOT_SET) && (value <= min))
-        return equals_in_policies;
+#pragma unroll
+    for (int i = 0; i < POLICIES_WORDS; i++) {
+        u64 equals_in_policies = 0;
+        u64 key_used_in_policies = 0;
 
-    return equals_in_policies | (match_if_key_missing & ~key_used_in_policies);
+        if (equality != NULL) {
+            equals_in_policies = equality->equals_in_policies.words[i];
+            key_used_in_policies = equality->key_used_in_policies.words[i];
+        }
+
+        if (out_of_range)
+            res->words[i] = equals_in_policies;
+        else
+            res->words[i] =
+                equals_in_policies | (match_if_key_missing->words[i] & ~key_used_in_policies);
+    }
 }
 
-statfunc u64 binary_filter_matches(u64 match_if_key_missing,
-                                   void *filter_map,
-                                   proc_info_t *proc_info)
+statfunc void binary_filter_matches(policies_bitmap_t *match_if_key_missing,
+                                    void *filter_map,
+                                    proc_info_t *proc_info,
+                                    policies_bitmap_t *res)
 {
     // check equality_filter_matches() for more info
 
-    u64 equals_in_policies = 0;
-    u64 key_used_in_policies = 0;
+    eq_t *equality = NULL;
 
     if (filter_map) {
-        eq_t *equality = bpf_map_lookup_elem(filter_map, proc_info->binary.path);
+        equality = bpf_map_lookup_elem(filter_map, proc_info->binary.path);
         if (equality == NULL) {
             // lookup by binary path and mount namespace
             equality = bpf_map_lookup_elem(filter_map, &proc_info->binary);
         }
-        if (equality != NULL) {
-            equals_in_policies = equality->equals_in_policies;
-            key_used_in_policies = equality->key_used_in_policies;
-        }
     }
 
-    return equals_in_policies | (match_if_key_missing & ~key_used_in_policies);
+    equality_matches(match_if_key_missing, equality, res);
 }
 
-statfunc u64 equality_filter_matches(u64 match_if_key_missing, void *filter_map, void *key)
+statfunc void equality_filter_matches(policies_bitmap_t *match_if_key_missing,
+                                      void *filter_map,
+                                      void *key,
+                                      policies_bitmap_t *res)
 {
     // check match_scope_filters() for initial info
     //
@@ -137,25 +153,40 @@ statfunc u64 equality_filter_matches(u64 match_if_key_missing, void *filter_map,
     //                     0000 1000
     //                     ---------
     //                     0000 1100 = (policy 3 and 4 matched)
+    //
+    // The bitmaps hold POLICIES_WORDS words, and the above is done for each of them.
 
-    u64 equals_in_policies = 0;
-    u64 key_used_in_policies = 0;
+    eq_t *equality = NULL;
+
+    if (filter_map)
+        equality = bpf_map_lookup_elem(filter_map, key);
+
+    equality_matches(match_if_key_missing, equality, res);
+}
+
+statfunc void
+equality_matches(policies_bitmap_t *match_if_key_missing, eq_t *equality, policies_bitmap_t *res)
+{
+#pragma unroll
+    for (int i = 0; i < POLICIES_WORDS; i++) {
+        u64 equals_in_policies = 0;
+        u64 key_used_in_policies = 0;
 
-    if (filter_map) {
-        eq_t *equality = bpf_map_lookup_elem(filter_map, key);
         if (equality != NULL) {
-            equals_in_policies = equality->equals_in_policies;
-            key_used_in_policies = equality->key_used_in_policies;
+            equals_in_policies = equality->equals_in_policies.words[i];
+            key_used_in_policies = equality->key_used_in_policies.words[i];
         }
-    }
 
-    // match if:
-    // 1. key is used and equality matches (equals_in_policies)
-    // 2. key is NOT used and the default action is to match
-    return equals_in_policies | (match_if_key_missing & ~key_used_in_policies);
+        // match if:
+        // 1. key is used and equality matches (equals_in_policies)
+        // 2. key is NOT used and the default action is to match
+        res->words[i] =
+            equals_in_policies | (match_if_key_missing->words[i] & ~key_used_in_policies);
+    }
 }
 
-statfunc u64 bool_filter_matches(u64 match_bitmap, bool bool_value)
+statfunc void
+bool_filter_matches(policies_bitmap_t *match_bitmap, bool bool_value, policies_bitmap_t *res)
 {
     // check match_scope_filters() for initial info
     //
@@ -179,62 +210,79 @@ statfunc u64 bool_filter_matches(u64 match_bitmap, bool bool_value)
     //                          ---------
     //                          0000 0000
 
-    return match_bitmap ^ (bool_value ? ~0ULL : 0);
+    u64 value = bool_value ? ~0ULL : 0;
+
+#pragma unroll
+    for (int i = 0; i < POLICIES_WORDS; i++)
+        res->words[i] = match_bitmap->words[i] ^ value;
 }
 
-statfunc u64 match_scope_filters(program_data_t *p)
+// apply_filter removes from res the policies that have the filter enabled and didn't match it.
+// For policies that have the filter disabled, the bits of res are kept.
+statfunc void
+apply_filter(policies_bitmap_t *res, policies_bitmap_t *matched, policies_bitmap_t *enabled)
+{
+#pragma unroll
+    for (int i = 0; i < POLICIES_WORDS; i++)
+        res->words[i] &= matched->words[i] | ~enabled->words[i];
+}
+
+statfunc void match_scope_filters(program_data_t *p, policies_bitmap_t *res)
 {
     task_context_t *context = &p->event->context.task;
 
     // Don't monitor self
-    if (p->config->tracee_pid == context->host_pid)
-        return 0;
+    if (p->config->tracee_pid == context->host_pid) {
+        policies_clear(res);
+        return;
+    }
 
     proc_info_t *proc_info = p->proc_info;
     policies_config_t *policies_cfg = &p->event->policies_config;
-    u64 res = ~0ULL;
+    policies_bitmap_t matched;
+
+    policies_set_all(res);
 
     //
     // boolean filters (not using versioned filter maps)
     //
 
-    if (policies_cfg->cont_filter_enabled) {
+    if (policies_any(&policies_cfg->cont_filter_enabled)) {
         bool is_container = false;
         u8 state = p->task_info->container_state;
         if (state == CONTAINER_STARTED || state == CONTAINER_EXISTED)
             is_container = true;
-        u64 match_bitmap = policies_cfg->cont_filter_match_if_key_missing;
-        u64 mask = ~policies_cfg->cont_filter_enabled;
 
-        // For policies that have this filter disabled we want to set the matching bits using 'mask'
-        res &= bool_filter_matches(match_bitmap, is_container) | mask;
+        bool_filter_matches(
+            &policies_cfg->cont_filter_match_if_key_missing, is_container, &matched);
+        // For policies that have this filter disabled the matching bits are kept
+        apply_filter(res, &matched, &policies_cfg->cont_filter_enabled);
     }
 
-    if (policies_cfg->new_cont_filter_enabled) {
+    if (policies_any(&policies_cfg->new_cont_filter_enabled)) {
         bool is_new_container = false;
         if (p->task_info->container_state == CONTAINER_STARTED)
             is_new_container = true;
-        u64 match_bitmap = policies_cfg->new_cont_filter_match_if_key_missing;
-        u64 mask = ~policies_cfg->new_cont_filter_enabled;
 
-        res &= bool_filter_matches(match_bitmap, is_new_container) | mask;
+        bool_filter_matches(
+            &policies_cfg->new_cont_filter_match_if_key_missing, is_new_container, &matched);
+        apply_filter(res, &matched, &policies_cfg->new_cont_filter_enabled);
     }
 
-    if (policies_cfg->cont_started_filter_enabled) {
+    if (policies_any(&policies_cfg->cont_started_filter_enabled)) {
         bool is_started = false;
         if (p->event->context.task.flags & CONTAINER_STARTED_FLAG)
             is_started = true;
-        u64 match_bitmap = policies_cfg->cont_started_filter_match_if_key_missing;
-        u64 mask = ~policies_cfg->cont_started_filter_enabled;
 
-        res &= bool_filter_matches(match_bitmap, is_started) | mask;
+        bool_filter_matches(
+            &policies_cfg->cont_started_filter_match_if_key_missing, is_started, &matched);
+        apply_filter(res, &matched, &policies_cfg->cont_started_filter_enabled);
     }
 
-    if (policies_cfg->new_pid_filter_enabled) {
-        u64 match_bitmap = policies_cfg->new_pid_filter_match_if_key_missing;
-        u64 mask = ~policies_cfg->new_pid_filter_enabled;
-
-        res &= bool_filter_matches(match_bitmap, proc_info->new_proc) | mask;
+    if (policies_any(&policies_cfg->new_pid_filter_enabled)) {
+        bool_filter_matches(
+            &policies_cfg->new_pid_filter_match_if_key_missing, proc_info->new_proc, &matched);
+        apply_filter(res, &matched, &policies_cfg->new_pid_filter_enabled);
     }
 
     //
@@ -244,233 +292,277 @@ statfunc u64 match_scope_filters(program_data_t *p)
     u16 version = p->event->context.policies_version;
     void *filter_map = NULL;
 
-    if (policies_cfg->pid_filter_enabled) {
-        u64 match_if_key_missing = policies_cfg->pid_filter_match_if_key_missing;
-        u64 mask = ~policies_cfg->pid_filter_enabled;
+    if (policies_any(&policies_cfg->pid_filter_enabled)) {
+        policies_bitmap_t *match_if_key_missing = &policies_cfg->pid_filter_match_if_key_missing;
         u64 max = policies_cfg->pid_max;
         u64 min = policies_cfg->pid_min;
+        policies_bitmap_t matched_tid;
 
         filter_map = get_filter_map(&pid_filter_version, version);
+        uint_filter_range_matches(
+            match_if_key_missing, filter_map, context->host_pid, max, min, &matched);
         // the user might have given us a tid - check for it too
-        res &= uint_filter_range_matches(
-                   match_if_key_missing, filter_map, context->host_pid, max, min) |
-               uint_filter_range_matches(
-                   match_if_key_missing, filter_map, context->host_tid, max, min) |
-               mask;
+        uint_filter_range_matches(
+            match_if_key_missing, filter_map, context->host_tid, max, min, &matched_tid);
+        policies_or(&matched, &matched_tid);
+        apply_filter(res, &matched, &policies_cfg->pid_filter_enabled);
     }
 
-    if (policies_cfg->uid_filter_enabled) {
+    if (policies_any(&policies_cfg->uid_filter_enabled)) {
         context->uid = bpf_get_current_uid_gid();
-        u64 match_if_key_missing = policies_cfg->uid_filter_match_if_key_missing;
-        u64 mask = ~policies_cfg->uid_filter_enabled;
         u64 max = policies_cfg->uid_max;
         u64 min = policies_cfg->uid_min;
 
         filter_map = get_filter_map(&uid_filter_version, version);
-        res &= uint_filter_range_matches(match_if_key_missing, filter_map, context->uid, max, min) |
-               mask;
+        uint_filter_range_matches(&policies_cfg->uid_filter_match_if_key_missing,
+                                  filter_map,
+                                  context->uid,
+                                  max,
+                                  min,
+                                  &matched);
+        apply_filter(res, &matched, &policies_cfg->uid_filter_enabled);
     }
 
-    if (policies_cfg->mnt_ns_filter_enabled) {
+    if (policies_any(&policies_cfg->mnt_ns_filter_enabled)) {
         context->mnt_id = get_task_mnt_ns_id(p->event->task);
-        u64 match_if_key_missing = policies_cfg->mnt_ns_filter_match_if_key_missing;
-        u64 mask = ~policies_cfg->mnt_ns_filter_enabled;
 
         filter_map = get_filter_map(&mnt_ns_filter_version, version);
-        res &= equality_filter_matches(match_if_key_missing, filter_map, &context->mnt_id) | mask;
+        equality_filter_matches(&policies_cfg->mnt_ns_filter_match_if_key_missing,
+                                filter_map,
+                                &context->mnt_id,
+                                &matched);
+        apply_filter(res, &matched, &policies_cfg->mnt_ns_filter_enabled);
     }
 
-    if (policies_cfg->pid_ns_filter_enabled) {
+    if (policies_any(&policies_cfg->pid_ns_filter_enabled)) {
         context->pid_id = get_task_pid_ns_id(p->event->task);
-        u64 match_if_key_missing = policies_cfg->pid_ns_filter_match_if_key_missing;
-        u64 mask = ~policies_cfg->pid_ns_filter_enabled;
 
         filter_map = get_filter_map(&pid_ns_filter_version, version);
-        res &= equality_filter_matches(match_if_key_missing, filter_map, &context->pid_id) | mask;
+        equality_filter_matches(&policies_cfg->pid_ns_filter_match_if_key_missing,
+                                filter_map,
+                                &context->pid_id,
+                                &matched);
+        apply_filter(res, &matched, &policies_cfg->pid_ns_filter_enabled);
     }
 
-    if (policies_cfg->uts_ns_filter_enabled) {
+    if (policies_any(&policies_cfg->uts_ns_filter_enabled)) {
         char *uts_name = get_task_uts_name(p->event->task);
         if (uts_name)
             bpf_probe_read_kernel_str(&context->uts_name, TASK_COMM_LEN, uts_name);
-        u64 match_if_key_missing = policies_cfg->uts_ns_filter_match_if_key_missing;
-        u64 mask = ~policies_cfg->uts_ns_filter_enabled;
 
         filter_map = get_filter_map(&uts_ns_filter_version, version);
-        res &= equality_filter_matches(match_if_key_missing, filter_map, &context->uts_name) | mask;
+        equality_filter_matches(&policies_cfg->uts_ns_filter_match_if_key_missing,
+                                filter_map,
+                                &context->uts_name,
+                                &matched);
+        apply_filter(res, &matched, &policies_cfg->uts_ns_filter_enabled);
     }
 
-    if (policies_cfg->comm_filter_enabled) {
+    if (policies_any(&policies_cfg->comm_filter_enabled)) {
         bpf_get_current_comm(&context->comm, sizeof(context->comm));
-        u64 match_if_key_missing = policies_cfg->comm_filter_match_if_key_missing;
-        u64 mask = ~policies_cfg->comm_filter_enabled;
 
         filter_map = get_filter_map(&comm_filter_version, version);
-        res &= equality_filter_matches(match_if_key_missing, filter_map, &context->comm) | mask;
+        equality_filter_matches(
+            &policies_cfg->comm_filter_match_if_key_missing, filter_map, &context->comm, &matched);
+        apply_filter(res, &matched, &policies_cfg->comm_filter_enabled);
     }
 
-    if (policies_cfg->cgroup_id_filter_enabled) {
+    if (policies_any(&policies_cfg->cgroup_id_filter_enabled)) {
         u32 cgroup_id_lsb = context->cgroup_id;
-        u64 match_if_key_missing = policies_cfg->cgroup_id_filter_match_if_key_missing;
-        u64 mask = ~policies_cfg->cgroup_id_filter_enabled;
 
         filter_map = get_filter_map(&cgroup_id_filter_version, version);
-        res &= equality_filter_matches(match_if_key_missing, filter_map, &cgroup_id_lsb) | mask;
+        equality_filter_matches(&policies_cfg->cgroup_id_filter_match_if_key_missing,
+                                filter_map,
+                                &cgroup_id_lsb,
+                                &matched);
+        apply_filter(res, &matched, &policies_cfg->cgroup_id_filter_enabled);
     }
 
-    if (policies_cfg->proc_tree_filter_enabled) {
-        u64 match_if_key_missing = policies_cfg->proc_tree_filter_match_if_key_missing;
-        u64 mask = ~policies_cfg->proc_tree_filter_enabled;
-
+    if (policies_any(&policies_cfg->proc_tree_filter_enabled)) {
         filter_map = get_filter_map(&process_tree_map_version, version);
-        res &= equality_filter_matches(match_if_key_missing, filter_map, &context->host_pid) | mask;
+        equality_filter_matches(&policies_cfg->proc_tree_filter_match_if_key_missing,
+                                filter_map,
+                                &context->host_pid,
+                                &matched);
+        apply_filter(res, &matched, &policies_cfg->proc_tree_filter_enabled);
     }
 
-    if (policies_cfg->bin_path_filter_enabled) {
-        u64 match_if_key_missing = policies_cfg->bin_path_filter_match_if_key_missing;
-        u64 mask = ~policies_cfg->bin_path_filter_enabled;
-
+    if (policies_any(&policies_cfg->bin_path_filter_enabled)) {
         filter_map = get_filter_map(&binary_filter_version, version);
-        res &= binary_filter_matches(match_if_key_missing, filter_map, proc_info) | mask;
+        binary_filter_matches(
+            &policies_cfg->bin_path_filter_match_if_key_missing, filter_map, proc_info, &matched);
+        apply_filter(res, &matched, &policies_cfg->bin_path_filter_enabled);
     }
 
     //
     // follow filter
     //
 
-    if (policies_cfg->follow_filter_enabled) {
+    if (policies_any(&policies_cfg->follow_filter_enabled)) {
         // trace this proc anyway if follow was set by a scope
-        res |= proc_info->follow_in_scopes & policies_cfg->follow_filter_enabled;
+#pragma unroll
+        for (int i = 0; i < POLICIES_WORDS; i++)
+            res->words[i] |=
+                proc_info->follow_in_scopes.words[i] & policies_cfg->follow_filter_enabled.words[i];
     }
 
     // Make sure only enabled policies are set in the bitmap (other bits are invalid)
-    return res & policies_cfg->enabled_policies;
+    policies_and(res, &policies_cfg->enabled_policies);
 }
 
 // Function to evaluate data filters based on the program data and index.
-// Returns policies bitmap.
+// Sets the matched policies in the given bitmap.
 //
 // Parameters:
 // - program_data_t *p: Pointer to the program data structure.
 // - u8 index: Index of the string data to be used as filter.
-statfunc u64 match_data_filters(program_data_t *p, u8 index)
+// - policies_bitmap_t *res: Pointer to the resulting policies bitmap.
+statfunc void match_data_filters(program_data_t *p, u8 index, policies_bitmap_t *res)
 {
     policies_config_t *policies_cfg = &p->event->policies_config;
     // Retrieve the string filter for the current event
     // TODO: Dynamically determine the filter and type based on policy configuration
     string_filter_config_t *str_filter = &p->event->config.data_filter.string;
 
-    if (!(str_filter->exact_enabled || str_filter->prefix_enabled || str_filter->suffix_enabled))
-        return policies_cfg->enabled_policies;
+    bool exact_enabled = policies_any(&str_filter->exact_enabled);
+    bool prefix_enabled = policies_any(&str_filter->prefix_enabled);
+    bool suffix_enabled = policies_any(&str_filter->suffix_enabled);
 
-    u64 res = 0;
-    u64 explicit_disable_policies = 0;
-    u64 explicit_enable_policies = 0;
-    u64 default_enable_policies = 0;
-    // Determine policies that do not use any type of string filter (exact, prefix, suffix)
-    u64 mask_no_str_filter_policies = ~str_filter->exact_enabled & ~str_filter->prefix_enabled &
-                                      ~str_filter->suffix_enabled;
+    *res = policies_cfg->enabled_policies;
+    if (!(exact_enabled || prefix_enabled || suffix_enabled))
+        return;
+
+    // Each filter type matches the policies in its own bitmap
+    policies_bitmap_t exact_matched, prefix_matched, suffix_matched;
     void *filter_map = NULL;
 
     // event ID
     u32 eventid = p->event->context.eventid;
     u16 version = p->event->context.policies_version;
 
+    policies_clear(&exact_matched);
+    policies_clear(&prefix_matched);
+    policies_clear(&suffix_matched);
+
     // Exact match
-    if (str_filter->exact_enabled) {
+    if (exact_enabled) {
         data_filter_key_t *key = get_string_data_filter_buf(DATA_FILTER_BUF1_IDX);
         if (key == NULL)
-            return 0;
+            goto no_match;
 
         __builtin_memset(key->str, 0, sizeof(key->str));
 
         u32 len = load_str_from_buf(&p->event->args_buf, key->str, index, FILTER_TYPE_EXACT);
         if (!len)
-            return 0;
+            goto no_match;
 
-        u64 match_if_key_missing = str_filter->exact_match_if_key_missing;
         filter_map = get_event_filter_map(&data_filter_exact_version, version, eventid);
-        res = equality_filter_matches(match_if_key_missing, filter_map, key);
-        explicit_enable_policies |= (res & ~match_if_key_missing);
-        explicit_disable_policies |= (~res & match_if_key_missing);
-        default_enable_policies |= (res & match_if_key_missing);
+        equality_filter_matches(
+            &str_filter->exact_match_if_key_missing, filter_map, key, &exact_matched);
     }
 
     // Prefix match
-    if (str_filter->prefix_enabled) {
+    if (prefix_enabled) {
         data_filter_lpm_key_t *key = get_string_data_filter_lpm_buf(DATA_FILTER_BUF1_IDX);
         if (key == NULL)
-            return 0;
+            goto no_match;
 
         u32 len = load_str_from_buf(&p->event->args_buf, key->str, index, FILTER_TYPE_PREFIX);
         if (!len)
-            return 0;
+            goto no_match;
 
         // LPM tries may be created with a maximum prefix length that is a multiple of 8,
         // in the range from 8 to 2048. For more details, see:
         // https://docs.kernel.org/bpf/map_lpm_trie.html
         key->prefix_len = len * 8;
 
-        u64 match_if_key_missing = str_filter->prefix_match_if_key_missing;
         filter_map = get_event_filter_map(&data_filter_prefix_version, version, eventid);
-        res = equality_filter_matches(match_if_key_missing, filter_map, key);
-        explicit_enable_policies |= (res & ~match_if_key_missing);
-        explicit_disable_policies |= (~res & match_if_key_missing);
-        default_enable_policies |= (res & match_if_key_missing);
+        equality_filter_matches(
+            &str_filter->prefix_match_if_key_missing, filter_map, key, &prefix_matched);
     }
 
     // Suffix match
-    if (str_filter->suffix_enabled) {
+    if (suffix_enabled) {
         data_filter_lpm_key_t *key = get_string_data_filter_lpm_buf(DATA_FILTER_BUF1_IDX);
 
         if (key == NULL)
-            return 0;
+            goto no_match;
 
         u32 len = load_str_from_buf(&p->event->args_buf, key->str, index, FILTER_TYPE_SUFFIX);
         if (!len)
-            return 0;
+            goto no_match;
 
         key->prefix_len = len * 8;
 
-        u64 match_if_key_missing = str_filter->suffix_match_if_key_missing;
         filter_map = get_event_filter_map(&data_filter_suffix_version, version, eventid);
-        res = equality_filter_matches(match_if_key_missing, filter_map, key);
-        explicit_enable_policies |= (res & ~match_if_key_missing);
-        explicit_disable_policies |= (~res & match_if_key_missing);
-        default_enable_policies |= (res & match_if_key_missing);
+        equality_filter_matches(
+            &str_filter->suffix_match_if_key_missing, filter_map, key, &suffix_matched);
     }
 
-    // Match policies based on the following conditions:
-    //
-    // 1. Explicitly Enabled Policies: A policy is enabled if at least one of the three
-    // filter types explicitly enables it (explicit_enable_policies).
-    // 2. Default Enabled Policies: Policies that are enabled by default (default_enable_policies)
-    // remain enabled only if they are not explicitly disabled (explicit_disable_policies).
-    res = explicit_enable_policies | (default_enable_policies & ~explicit_disable_policies);
-    // Combine policies that use string filters with those that do not
-    res |= mask_no_str_filter_policies;
+#pragma unroll
+    for (int i = 0; i < POLICIES_WORDS; i++) {
+        u64 exact_missing = str_filter->exact_match_if_key_missing.words[i];
+        u64 prefix_missing = str_filter->prefix_match_if_key_missing.words[i];
+        u64 suffix_missing = str_filter->suffix_match_if_key_missing.words[i];
+        u64 exact = exact_matched.words[i];
+        u64 prefix = prefix_matched.words[i];
+        u64 suffix = suffix_matched.words[i];
+
+        // Disabled filter types matched nothing, so they don't enable nor disable policies
+        u64 explicit_enable_policies =
+            (exact & ~exact_missing) | (prefix & ~prefix_missing) | (suffix & ~suffix_missing);
+        u64 explicit_disable_policies = (~exact & exact_missing) | (~prefix & prefix_missing) |
+                                        (~suffix & suffix_missing);
+        u64 default_enable_policies =
+            (exact & exact_missing) | (prefix & prefix_missing) | (suffix & suffix_missing);
+        // Determine policies that do not use any type of string filter (exact, prefix, suffix)
+        u64 mask_no_str_filter_policies = ~str_filter->exact_enabled.words[i] &
+                                          ~str_filter->prefix_enabled.words[i] &
+                                          ~str_filter->suffix_enabled.words[i];
+
+        // Match policies based on the following conditions:
+        //
+        // 1. Explicitly Enabled Policies: A policy is enabled if at least one of the three
+        // filter types explicitly enables it (explicit_enable_policies).
+        // 2. Default Enabled Policies: Policies that are enabled by default
+        // (default_enable_policies) remain enabled only if they are not explicitly disabled
+        // (explicit_disable_policies).
+        u64 matched =
+            explicit_enable_policies | (default_enable_policies & ~explicit_disable_policies);
+        // Combine policies that use string filters with those that do not
+        matched |= mask_no_str_filter_policies;
+
+        // Make sure only enabled policies are set in the bitmap (other bits are invalid)
+        res->words[i] &= matched;
+    }
 
-    // Make sure only enabled policies are set in the bitmap (other bits are invalid)
-    return res & policies_cfg->enabled_policies;
+    return;
+
+no_match:
+    policies_clear(res);
 }
 
 statfunc bool evaluate_scope_filters(program_data_t *p)
 {
-    u64 matched_policies = match_scope_filters(p);
-    p->event->context.matched_policies &= matched_policies;
-    return p->event->context.matched_policies != 0;
+    policies_bitmap_t matched_policies;
+
+    match_scope_filters(p, &matched_policies);
+    policies_and(&p->event->context.matched_policies, &matched_policies);
+    return policies_any(&p->event->context.matched_policies);
 }
 
 statfunc bool evaluate_data_filters(program_data_t *p, u8 index)
 {
-    u64 matched_data_filters = match_data_filters(p, index);
-    p->event->context.matched_policies &= matched_data_filters;
-    return p->event->context.matched_policies != 0;
+    policies_bitmap_t matched_data_filters;
+
+    match_data_filters(p, index, &matched_data_filters);
+    policies_and(&p->event->context.matched_policies, &matched_data_filters);
+    return policies_any(&p->event->context.matched_policies);
 }
 
 statfunc bool policies_matched(event_data_t *event)
 {
-    return event->context.matched_policies != 0;
+    return policies_any(&event->context.matched_policies);
 }
 
 statfunc bool event_is_selected(u32 event_id, u16 policies_version)
@@ -483,12 +575,16 @@ statfunc bool event_is_selected(u32 event_id, u16 policies_version)
     if (event_config == NULL)
         return 0;
 
-    return event_config->submit_for_policies != 0;
+    return policies_any(&event_config->submit_for_policies);
 }
 
-statfunc u64 get_scopes_to_follow(program_data_t *p)
+statfunc void get_scopes_to_follow(program_data_t *p, policies_bitmap_t *res)
 {
-    return match_scope_filters(p);
+    // res may point to the follow_in_scopes of the task, which is read while matching
+    policies_bitmap_t scopes;
+
+    match_scope_filters(p, &scopes);
+    *res = scopes;
 }
 
 #endif
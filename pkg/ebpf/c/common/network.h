@@ -109,6 +109,7 @@ typedef enum net_packet {
     // Layer 7
     SUB_NET_PACKET_DNS = 1 << 7,
     SUB_NET_PACKET_HTTP = 1 << 8,
+    SUB_NET_PACKET_TLS = 1 << 9,
 } net_packet_t;
 
 typedef struct net_event_contextmd {
@@ -159,7 +160,7 @@ typedef struct net_task_context {
     s32 syscall;
     u16 padding;
     u16 policies_version;
-    u64 matched_policies;
+    policies_bitmap_t matched_policies;
 } net_task_context_t;
 
 struct {
@@ -228,6 +229,9 @@ struct {
 #define flow_udp_begin          (1 << 8)  // first flow packet
 #define flow_udp_end            (1 << 9)  // last flow packet
 #define flow_src_initiator      (1 << 10) // src is the flow initiator
+// TLS Handshake (client/server hello) Flag
+#define proto_tls_client_hello  (1 << 11)
+#define proto_tls_server_hello  (1 << 12)
 
 // payload size: full packets, only headers
 #define FULL    65536       // 1 << 16
@@ -239,6 +243,7 @@ struct {
 
 // layer 7 parsing related constants
 #define http_min_len 7 // longest http command is "DELETE "
+#define tls_min_len  6 // record header + handshake type
 
 // PROTOTYPES
 
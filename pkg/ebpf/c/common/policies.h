@@ -0,0 +1,76 @@
+#ifndef __COMMON_POLICIES_H__
+#define __COMMON_POLICIES_H__
+
+#include <vmlinux.h>
+
+#include <types.h>
+#include <common/common.h>
+
+// PROTOTYPES
+
+statfunc void policies_set_all(policies_bitmap_t *);
+statfunc void policies_clear(policies_bitmap_t *);
+statfunc void policies_and(policies_bitmap_t *, policies_bitmap_t *);
+statfunc void policies_or(policies_bitmap_t *, policies_bitmap_t *);
+statfunc bool policies_any(policies_bitmap_t *);
+statfunc bool policies_intersect(policies_bitmap_t *, policies_bitmap_t *);
+
+// FUNCTIONS
+
+// policies_set_all sets the bits of all the policies
+statfunc void policies_set_all(policies_bitmap_t *bitmap)
+{
+#pragma unroll
+    for (int i = 0; i < POLICIES_WORDS; i++)
+        bitmap->words[i] = ~0ULL;
+}
+
+// policies_clear clears the bits of all the policies
+statfunc void policies_clear(policies_bitmap_t *bitmap)
+{
+#pragma unroll
+    for (int i = 0; i < POLICIES_WORDS; i++)
+        bitmap->words[i] = 0;
+}
+
+// policies_and keeps in dst only the policies also set in src
+statfunc void policies_and(policies_bitmap_t *dst, policies_bitmap_t *src)
+{
+#pragma unroll
+    for (int i = 0; i < POLICIES_WORDS; i++)
+        dst->words[i] &= src->words[i];
+}
+
+// policies_or adds to dst the policies set in src
+statfunc void policies_or(policies_bitmap_t *dst, policies_bitmap_t *src)
+{
+#pragma unroll
+    for (int i = 0; i < POLICIES_WORDS; i++)
+        dst->words[i] |= src->words[i];
+}
+
+// policies_any returns true if any policy is set
+statfunc bool policies_any(policies_bitmap_t *bitmap)
+{
+    u64 any = 0;
+
+#pragma unroll
+    for (int i = 0; i < POLICIES_WORDS; i++)
+        any |= bitmap->words[i];
+
+    return any != 0;
+}
+
+// policies_intersect returns true if any policy is set in both bitmaps
+statfunc bool policies_intersect(policies_bitmap_t *a, policies_bitmap_t *b)
+{
+    u64 any = 0;
+
+#pragma unroll
+    for (int i = 0; i < POLICIES_WORDS; i++)
+        any |= a->words[i] & b->words[i];
+
+    return any != 0;
+}
+
+#endif
@@ -35,6 +35,7 @@
 #include <common/logging.h>
 #include <common/memory.h>
 #include <common/network.h>
+#include <common/policies.h>
 #include <common/probes.h>
 #include <common/signal.h>
 
@@ -679,7 +680,7 @@ int tracepoint__sched__sched_process_fork(struct bpf_raw_tracepoint_args *ctx)
             return 0;
         }
 
-        c_proc_info->follow_in_scopes = get_scopes_to_follow(&p); // follow task for matched scopes
+        get_scopes_to_follow(&p, &c_proc_info->follow_in_scopes); // follow task for matched scopes
         c_proc_info->new_proc = true; // started after tracee (new_pid filter)
     }
 
@@ -687,7 +688,7 @@ int tracepoint__sched__sched_process_fork(struct bpf_raw_tracepoint_args *ctx)
 
     policies_config_t *policies_cfg = &p.event->policies_config;
 
-    if (policies_cfg->proc_tree_filter_enabled) {
+    if (policies_any(&policies_cfg->proc_tree_filter_enabled)) {
         u16 version = p.event->context.policies_version;
         // Give the compiler a hint about the map type, otherwise libbpf will complain
         // about missing type information. i.e.: "can't determine value size for type".
@@ -1433,7 +1434,7 @@ int tracepoint__sched__sched_process_exec(struct bpf_raw_tracepoint_args *ctx)
     void *file_path = get_path_str(__builtin_preserve_access_index(&file->f_path));
 
     proc_info_t *proc_info = p.proc_info;
-    proc_info->follow_in_scopes = get_scopes_to_follow(&p); // follow task for matched scopes
+    get_scopes_to_follow(&p, &proc_info->follow_in_scopes); // follow task for matched scopes
     proc_info->new_proc = true; // task has started after tracee started running
 
     // Extract the binary name to be used in evaluate_scope_filters
@@ -5959,6 +5960,8 @@ statfunc enum event_id_e net_packet_to_net_event(net_packet_t packet_type)
             return NET_PACKET_DNS;
         case SUB_NET_PACKET_HTTP:
             return NET_PACKET_HTTP;
+        case SUB_NET_PACKET_TLS:
+            return NET_PACKET_TLS;
     };
     return MAX_EVENT_ID;
 }
@@ -5973,8 +5976,8 @@ statfunc enum event_id_e net_packet_to_net_event(net_packet_t packet_type)
 // matched, submit the network event. This means that if any of the policies
 // need a network event, kernel can submit the network base event and let
 // userland deal with it (derived events will match the appropriate policies).
-statfunc u64 should_submit_net_event(net_event_context_t *neteventctx,
-                                     net_packet_t packet_type)
+statfunc bool should_submit_net_event(net_event_context_t *neteventctx,
+                                      net_packet_t packet_type)
 {
     enum event_id_e evt_id = net_packet_to_net_event(packet_type);
 
@@ -5987,11 +5990,10 @@ statfunc u64 should_submit_net_event(net_event_context_t *neteventctx,
     if (evt_config == NULL)
         return 0;
 
-    return evt_config->submit_for_policies & neteventctx->eventctx.matched_policies;
+    return policies_intersect(&evt_config->submit_for_policies,
+                              &neteventctx->eventctx.matched_policies);
 }
 
-#pragma clang diagnostic pop // -Waddress-of-packed-member
-
 // Return if a network flow event should be submitted.
 statfunc bool should_submit_flow_event(net_event_context_t *neteventctx)
 {
@@ -6012,7 +6014,8 @@ statfunc bool should_submit_flow_event(net_event_context_t *neteventctx)
     if (evt_config == NULL)
         return 0;
 
-    u64 should = evt_config->submit_for_policies & neteventctx->eventctx.matched_policies;
+    bool should = policies_intersect(&evt_config->submit_for_policies,
+                                     &neteventctx->eventctx.matched_policies);
 
     // Cache the result so next time we don't need to check again.
     if (should)
@@ -6020,14 +6023,36 @@ statfunc bool should_submit_flow_event(net_event_context_t *neteventctx)
     else
         neteventctx->md.should_flow = 2; // cache result: don't submit flow events
 
-    return should ? true : false;
+    return should;
 }
 
+// Return if a connectionless (UDP, ICMP) flow base event should be submitted. There is no
+// handshake to track in kernel, so every packet is submitted (only headers) and userland
+// keeps the flows state, expiring idle flows.
+statfunc bool should_submit_connless_flow_event(net_event_context_t *neteventctx)
+{
+    u32 evt_id = NET_FLOW_CONNLESS_BASE;
+
+    u16 version = neteventctx->eventctx.policies_version;
+    void *inner_events_map = bpf_map_lookup_elem(&events_map_version, &version);
+    if (inner_events_map == NULL)
+        return false;
+
+    event_config_t *evt_config = bpf_map_lookup_elem(inner_events_map, &evt_id);
+    if (evt_config == NULL)
+        return false;
+
+    return policies_intersect(&evt_config->submit_for_policies,
+                              &neteventctx->eventctx.matched_policies);
+}
+
+#pragma clang diagnostic pop // -Waddress-of-packed-member
+
 // Return if a network capture event should be submitted.
-statfunc u64 should_capture_net_event(net_event_context_t *neteventctx, net_packet_t packet_type)
+statfunc bool should_capture_net_event(net_event_context_t *neteventctx, net_packet_t packet_type)
 {
     if (neteventctx->md.captured) // already captured
-        return 0;
+        return false;
 
     return should_submit_net_event(neteventctx, packet_type);
 }
@@ -6048,6 +6073,7 @@ CGROUP_SKB_HANDLE_FUNCTION(proto);
 CGROUP_SKB_HANDLE_FUNCTION(proto_tcp);
 CGROUP_SKB_HANDLE_FUNCTION(proto_tcp_dns);
 CGROUP_SKB_HANDLE_FUNCTION(proto_tcp_http);
+CGROUP_SKB_HANDLE_FUNCTION(proto_tcp_tls);
 CGROUP_SKB_HANDLE_FUNCTION(proto_udp);
 CGROUP_SKB_HANDLE_FUNCTION(proto_udp_dns);
 CGROUP_SKB_HANDLE_FUNCTION(proto_icmp);
@@ -7012,6 +7038,32 @@ statfunc int net_l7_is_http(struct __sk_buff *skb, u32 l7_off)
     return 0;
 }
 
+statfunc int net_l7_is_tls(struct __sk_buff *skb, u32 l7_off)
+{
+    u8 tls_min_str[tls_min_len];
+    __builtin_memset((void *) &tls_min_str, 0, sizeof(u8) * tls_min_len);
+
+    // load record header and handshake type from layer 7 in packet.
+    if (bpf_skb_load_bytes(skb, l7_off, tls_min_str, tls_min_len) < 0) {
+        return 0; // failed loading data into tls_min_str - return.
+    }
+
+    // check if handshake record (SSL 3.0 up to TLS 1.3 record versions)
+    if (tls_min_str[0] != 22 || tls_min_str[1] != 3 || tls_min_str[2] > 4) {
+        return 0;
+    }
+
+    // check if client or server hello
+    switch (tls_min_str[5]) {
+        case 1:
+            return proto_tls_client_hello;
+        case 2:
+            return proto_tls_server_hello;
+    }
+
+    return 0;
+}
+
 //
 // SUPPORTED L4 NETWORK PROTOCOL (tcp, udp, icmp) HANDLERS
 //
@@ -7058,7 +7110,8 @@ CGROUP_SKB_HANDLE_FUNCTION(proto_tcp)
     // Fastpath: return if no other L7 network events.
 
     if (!should_submit_net_event(neteventctx, SUB_NET_PACKET_DNS) &&
-        !should_submit_net_event(neteventctx, SUB_NET_PACKET_HTTP))
+        !should_submit_net_event(neteventctx, SUB_NET_PACKET_HTTP) &&
+        !should_submit_net_event(neteventctx, SUB_NET_PACKET_TLS))
         goto capture;
 
     // Guess layer 7 protocols by src/dst ports ...
@@ -7076,6 +7129,12 @@ CGROUP_SKB_HANDLE_FUNCTION(proto_tcp)
         return CGROUP_SKB_HANDLE(proto_tcp_http);
     }
 
+    int tls_proto = net_l7_is_tls(ctx, neteventctx->md.header_size);
+    if (tls_proto) {
+        neteventctx->flags |= tls_proto;
+        return CGROUP_SKB_HANDLE(proto_tcp_tls);
+    }
+
     // ... continue with net_l7_is_protocol_xxx
 
 capture:
@@ -7090,6 +7149,11 @@ capture:
 
 CGROUP_SKB_HANDLE_FUNCTION(proto_udp)
 {
+    // Submit UDP flow base event if needed (only headers).
+
+    if (should_submit_connless_flow_event(neteventctx))
+        cgroup_skb_submit_event(ctx, neteventctx, NET_FLOW_CONNLESS_BASE, HEADERS);
+
     // Submit UDP base event if needed (only headers).
 
     if (should_submit_net_event(neteventctx, SUB_NET_PACKET_UDP))
@@ -7130,6 +7194,10 @@ capture:
 
 CGROUP_SKB_HANDLE_FUNCTION(proto_icmp)
 {
+    // submit ICMP flow base event if needed (only headers)
+    if (should_submit_connless_flow_event(neteventctx))
+        cgroup_skb_submit_event(ctx, neteventctx, NET_FLOW_CONNLESS_BASE, HEADERS);
+
     // submit ICMP base event if needed (full packet)
     if (should_submit_net_event(neteventctx, SUB_NET_PACKET_ICMP))
         cgroup_skb_submit_event(ctx, neteventctx, NET_PACKET_ICMP, FULL);
@@ -7146,6 +7214,10 @@ CGROUP_SKB_HANDLE_FUNCTION(proto_icmp)
 
 CGROUP_SKB_HANDLE_FUNCTION(proto_icmpv6)
 {
+    // submit ICMPv6 flow base event if needed (only headers)
+    if (should_submit_connless_flow_event(neteventctx))
+        cgroup_skb_submit_event(ctx, neteventctx, NET_FLOW_CONNLESS_BASE, HEADERS);
+
     // submit ICMPv6 base event if needed (full packet)
     if (should_submit_net_event(neteventctx, SUB_NET_PACKET_ICMPV6))
         cgroup_skb_submit_event(ctx, neteventctx, NET_PACKET_ICMPV6, FULL);
@@ -7214,6 +7286,22 @@ CGROUP_SKB_HANDLE_FUNCTION(proto_tcp_http)
     return 1; // NOTE: might block HTTP here if needed (return 0)
 }
 
+CGROUP_SKB_HANDLE_FUNCTION(proto_tcp_tls)
+{
+    // submit TLS base event if needed (full packet)
+    if (should_submit_net_event(neteventctx, SUB_NET_PACKET_TLS))
+        cgroup_skb_submit_event(ctx, neteventctx, NET_PACKET_TLS, FULL);
+
+    // capture TLS-TCP, TCP or IP packets (filtered)
+    if (should_capture_net_event(neteventctx, SUB_NET_PACKET_IP) ||
+        should_capture_net_event(neteventctx, SUB_NET_PACKET_TCP) ||
+        should_capture_net_event(neteventctx, SUB_NET_PACKET_TLS)) {
+        cgroup_skb_capture(); // tls handshake is dyn, do not change header_size
+    }
+
+    return 1; // NOTE: might block TLS here if needed (return 0)
+}
+
 // clang-format on
 
 //
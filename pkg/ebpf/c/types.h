@@ -7,6 +7,11 @@
 #include <linux/limits.h>
 #include <common/consts.h>
 
+// bitmap of policies, one bit per policy ID, least significant word first
+typedef struct policies_bitmap {
+    u64 words[POLICIES_WORDS];
+} policies_bitmap_t;
+
 typedef struct task_context {
     u64 start_time;               // task's start time
     u64 cgroup_id;                // control group ID
@@ -34,7 +39,7 @@ typedef struct event_context {
     u32 stack_id;
     u16 processor_id; // ID of the processor that processed the event
     u16 policies_version;
-    u64 matched_policies;
+    policies_bitmap_t matched_policies;
 } event_context_t;
 
 #define EVENT_ID_LIST_NET                                                                          \
@@ -47,8 +52,10 @@ typedef struct event_context {
     X(NET_PACKET_ICMPV6, )                                                                         \
     X(NET_PACKET_DNS, )                                                                            \
     X(NET_PACKET_HTTP, )                                                                           \
+    X(NET_PACKET_TLS, )                                                                            \
     X(NET_CAPTURE_BASE, )                                                                          \
     X(NET_FLOW_BASE, )                                                                             \
+    X(NET_FLOW_CONNLESS_BASE, )                                                                    \
     X(MAX_NET_EVENT_ID, )                                                                          \
     // ...
 
@@ -328,8 +335,9 @@ typedef struct io_data {
 } io_data_t;
 
 typedef struct proc_info {
-    bool new_proc;        // set if this process was started after tracee. Used with new_pid filter
-    u64 follow_in_scopes; // set if this process was traced before. Used with the follow filter
+    bool new_proc; // set if this process was started after tracee. Used with new_pid filter
+    // set if this process was traced before. Used with the follow filter
+    policies_bitmap_t follow_in_scopes;
     struct binary binary;
     u32 binary_no_mnt; // used in binary lookup when we don't care about mount ns. always 0.
     file_info_t interpreter;
@@ -383,43 +391,43 @@ typedef struct policy_key {
 
 typedef struct equality {
     // bitmap indicating which policies have a filter that uses the '=' operator (0 means '!=')
-    u64 equals_in_policies;
+    policies_bitmap_t equals_in_policies;
     // bitmap indicating which policies have a filter that utilize the provided key
-    u64 key_used_in_policies;
+    policies_bitmap_t key_used_in_policies;
 } eq_t;
 
 typedef struct policies_config {
     // bitmap indicating which policies have the filter enabled
-    u64 uid_filter_enabled;
-    u64 pid_filter_enabled;
-    u64 mnt_ns_filter_enabled;
-    u64 pid_ns_filter_enabled;
-    u64 uts_ns_filter_enabled;
-    u64 comm_filter_enabled;
-    u64 cgroup_id_filter_enabled;
-    u64 cont_filter_enabled;
-    u64 new_cont_filter_enabled;
-    u64 cont_started_filter_enabled;
-    u64 new_pid_filter_enabled;
-    u64 proc_tree_filter_enabled;
-    u64 bin_path_filter_enabled;
-    u64 follow_filter_enabled;
+    policies_bitmap_t uid_filter_enabled;
+    policies_bitmap_t pid_filter_enabled;
+    policies_bitmap_t mnt_ns_filter_enabled;
+    policies_bitmap_t pid_ns_filter_enabled;
+    policies_bitmap_t uts_ns_filter_enabled;
+    policies_bitmap_t comm_filter_enabled;
+    policies_bitmap_t cgroup_id_filter_enabled;
+    policies_bitmap_t cont_filter_enabled;
+    policies_bitmap_t new_cont_filter_enabled;
+    policies_bitmap_t cont_started_filter_enabled;
+    policies_bitmap_t new_pid_filter_enabled;
+    policies_bitmap_t proc_tree_filter_enabled;
+    policies_bitmap_t bin_path_filter_enabled;
+    policies_bitmap_t follow_filter_enabled;
     // bitmap indicating whether to match a rule if the key is missing from its filter map
-    u64 uid_filter_match_if_key_missing;
-    u64 pid_filter_match_if_key_missing;
-    u64 mnt_ns_filter_match_if_key_missing;
-    u64 pid_ns_filter_match_if_key_missing;
-    u64 uts_ns_filter_match_if_key_missing;
-    u64 comm_filter_match_if_key_missing;
-    u64 cgroup_id_filter_match_if_key_missing;
-    u64 cont_filter_match_if_key_missing;
-    u64 new_cont_filter_match_if_key_missing;
-    u64 cont_started_filter_match_if_key_missing;
-    u64 new_pid_filter_match_if_key_missing;
-    u64 proc_tree_filter_match_if_key_missing;
-    u64 bin_path_filter_match_if_key_missing;
+    policies_bitmap_t uid_filter_match_if_key_missing;
+    policies_bitmap_t pid_filter_match_if_key_missing;
+    policies_bitmap_t mnt_ns_filter_match_if_key_missing;
+    policies_bitmap_t pid_ns_filter_match_if_key_missing;
+    policies_bitmap_t uts_ns_filter_match_if_key_missing;
+    policies_bitmap_t comm_filter_match_if_key_missing;
+    policies_bitmap_t cgroup_id_filter_match_if_key_missing;
+    policies_bitmap_t cont_filter_match_if_key_missing;
+    policies_bitmap_t new_cont_filter_match_if_key_missing;
+    policies_bitmap_t cont_started_filter_match_if_key_missing;
+    policies_bitmap_t new_pid_filter_match_if_key_missing;
+    policies_bitmap_t proc_tree_filter_match_if_key_missing;
+    policies_bitmap_t bin_path_filter_match_if_key_missing;
     // bitmap with policies that have at least one filter enabled
-    u64 enabled_policies;
+    policies_bitmap_t enabled_policies;
 
     // global min max
     u64 uid_max;
@@ -438,12 +446,12 @@ typedef struct config_entry {
 } config_entry_t;
 
 typedef struct string_filter_config {
-    u64 prefix_enabled;
-    u64 suffix_enabled;
-    u64 exact_enabled;
-    u64 prefix_match_if_key_missing;
-    u64 suffix_match_if_key_missing;
-    u64 exact_match_if_key_missing;
+    policies_bitmap_t prefix_enabled;
+    policies_bitmap_t suffix_enabled;
+    policies_bitmap_t exact_enabled;
+    policies_bitmap_t prefix_match_if_key_missing;
+    policies_bitmap_t suffix_match_if_key_missing;
+    policies_bitmap_t exact_match_if_key_missing;
 } string_filter_config_t;
 
 typedef struct data_filter_config {
@@ -452,7 +460,7 @@ typedef struct data_filter_config {
 } data_filter_config_t;
 
 typedef struct event_config {
-    u64 submit_for_policies;
+    policies_bitmap_t submit_for_policies;
     u64 field_types;
     data_filter_config_t data_filter;
 } event_config_t;
@@ -13,6 +13,7 @@ import (
 	"github.com/aquasecurity/tracee/common/logger"
 	"github.com/aquasecurity/tracee/common/stringutil"
 	"github.com/aquasecurity/tracee/common/timeutil"
+	"github.com/aquasecurity/tracee/pkg/artifacts"
 	"github.com/aquasecurity/tracee/pkg/bufferdecoder"
 )
 
@@ -69,6 +70,9 @@ func (t *Tracee) handleFileCaptures(ctx context.Context) {
 				continue
 			}
 			filename := ""
+			artifact := artifacts.Artifact{
+				Context: artifacts.Context{ContainerID: cgroup.ContainerId},
+			}
 			metaBuffDecoder := bufferdecoder.New(meta.Metadata[:], t.dataTypeDecoder)
 			var kernelModuleMeta bufferdecoder.KernelModuleMeta
 			var bpfObjectMeta bufferdecoder.BpfObjectMeta
@@ -85,8 +89,10 @@ func (t *Tracee) handleFileCaptures(ctx context.Context) {
 				var operation string
 				if meta.BinType == bufferdecoder.SendVfsRead {
 					operation = "read"
+					artifact.Type = artifacts.FileRead
 				} else {
 					operation = "write"
+					artifact.Type = artifacts.FileWrite
 				}
 				if vfsMeta.Pid == 0 {
 					filename = fmt.Sprintf(
@@ -114,6 +120,8 @@ func (t *Tracee) handleFileCaptures(ctx context.Context) {
 				// note: size of buffer will determine maximum extracted file size! (as writes from kernel are immediate)
 				mprotectMeta.Ts = timeutil.BootToEpochNS(uint64(mprotectMeta.Ts))
 				filename = fmt.Sprintf("bin.pid-%d.ts-%d", mprotectMeta.Pid, mprotectMeta.Ts)
+				artifact.Type = artifacts.MemoryRegion
+				artifact.Context.HostPid = mprotectMeta.Pid
 			} else if meta.BinType == bufferdecoder.SendKernelModule {
 				err = metaBuffDecoder.DecodeKernelModuleMeta(&kernelModuleMeta)
 				if err != nil {
@@ -130,6 +138,8 @@ func (t *Tracee) handleFileCaptures(ctx context.Context) {
 				if kernelModuleMeta.Pid != 0 {
 					filename = fmt.Sprintf("%s.pid-%d", filename, kernelModuleMeta.Pid)
 				}
+				artifact.Type = artifacts.KernelModule
+				artifact.Context.HostPid = kernelModuleMeta.Pid
 			} else if meta.BinType == bufferdecoder.SendBpfObject {
 				err = metaBuffDecoder.DecodeBpfObjectMeta(&bpfObjectMeta)
 				if err != nil {
@@ -142,6 +152,8 @@ func (t *Tracee) handleFileCaptures(ctx context.Context) {
 					filename = fmt.Sprintf("%s.pid-%d", filename, bpfObjectMeta.Pid)
 				}
 				filename = fmt.Sprintf("%s.%d", filename, bpfObjectMeta.Rand)
+				artifact.Type = artifacts.BpfProgram
+				artifact.Context.HostPid = bpfObjectMeta.Pid
 			} else {
 				t.handleError(errfmt.Errorf("unknown binary type: %d", meta.BinType))
 				continue
@@ -199,6 +211,8 @@ func (t *Tracee) handleFileCaptures(ctx context.Context) {
 					t.handleError(err)
 					continue
 				}
+				artifact.Path = fullname + "." + fileHash
+				t.submitArtifact(artifact)
 			} else if meta.BinType == bufferdecoder.SendBpfObject && (uint32(meta.Size)+uint32(meta.Off)) == bpfObjectMeta.Size {
 				fileHash, _ := t.computeOutFileHash(fullname)
 				// Delete the random int used to differentiate files
@@ -208,6 +222,12 @@ func (t *Tracee) handleFileCaptures(ctx context.Context) {
 					t.handleError(err)
 					continue
 				}
+				artifact.Path = fullname[:dotIndex] + "." + fileHash
+				t.submitArtifact(artifact)
+			} else if artifact.Type != artifacts.KernelModule && artifact.Type != artifacts.BpfProgram {
+				// Written and read files, and memory regions, might get more chunks
+				artifact.Path = fullname
+				t.submitArtifactWhenSettled(artifact)
 			}
 
 		case lost := <-t.lostCapturesChannel:
@@ -206,6 +206,13 @@ func (ctrl *Controller) procTreeExecProcessor(args []trace.Argument) error {
 		return err
 	}
 
+	// Command line (the environment isn't available from this signal)
+	execFeed.Args, err = parse.ArgVal[[]string](args, "argv")
+	if err != nil {
+		return err
+	}
+	execFeed.Env = nil
+
 	// Hashes
 	execFeed.TaskHash = process.HashTaskID(uint32(execFeed.HostTid), execFeed.StartTime)
 	execFeed.ParentHash = process.HashTaskID(uint32(execFeed.HostPPid), parentStartTime)
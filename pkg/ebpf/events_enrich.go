@@ -390,10 +390,16 @@ func (t *Tracee) enrichContainerEvents(in <-chan *events.PipelineEvent,
 
 func enrichEvent(evt *trace.Event, cont container.Container) {
 	evt.Container = trace.Container{
-		ID:          intern.String(cont.ContainerId),
-		ImageName:   intern.String(cont.Image),
-		ImageDigest: intern.String(cont.ImageDigest),
-		Name:        intern.String(cont.Name),
+		ID:                intern.String(cont.ContainerId),
+		ImageName:         intern.String(cont.Image),
+		ImageDigest:       intern.String(cont.ImageDigest),
+		Name:              intern.String(cont.Name),
+		Labels:            cont.Labels,
+		Privileged:        cont.Security.Privileged,
+		AddedCapabilities: cont.Security.AddedCapabilities,
+		HostNetwork:       cont.Security.HostNetwork,
+		HostPID:           cont.Security.HostPID,
+		HostIPC:           cont.Security.HostIPC,
 	}
 	evt.Kubernetes = trace.Kubernetes{
 		PodName:      intern.String(cont.Pod.Name),
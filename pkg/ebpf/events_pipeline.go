@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strconv"
 	"sync"
+	"time"
 	"unsafe"
 
 	pb "github.com/aquasecurity/tracee/api/v1beta1"
@@ -29,6 +30,9 @@ const maxStackDepth int = 20
 // Matches 'NO_SYSCALL' in eBPF code
 const noSyscall int32 = -1
 
+// How often the idle connectionless flows are ended
+const flowsExpirationInterval = time.Second
+
 // handleEvents is the main pipeline of tracee. It receives events from the perf buffer
 // and passes them through a series of stages, each stage is a goroutine that performs a
 // specific task on the event. The pipeline is started in a separate goroutine.
@@ -251,10 +255,16 @@ func (t *Tracee) decodeEvents(sourceChan chan []byte) (<-chan *events.PipelineEv
 			containerId := intern.String(containerInfo.ContainerId)
 			evt.ContainerID = containerId
 			evt.Container = trace.Container{
-				ID:          containerId,
-				ImageName:   intern.String(containerInfo.Image),
-				ImageDigest: intern.String(containerInfo.ImageDigest),
-				Name:        intern.String(containerInfo.Name),
+				ID:                containerId,
+				ImageName:         intern.String(containerInfo.Image),
+				ImageDigest:       intern.String(containerInfo.ImageDigest),
+				Name:              intern.String(containerInfo.Name),
+				Labels:            containerInfo.Labels,
+				Privileged:        containerInfo.Security.Privileged,
+				AddedCapabilities: containerInfo.Security.AddedCapabilities,
+				HostNetwork:       containerInfo.Security.HostNetwork,
+				HostPID:           containerInfo.Security.HostPID,
+				HostIPC:           containerInfo.Security.HostIPC,
 			}
 			evt.Kubernetes = trace.Kubernetes{
 				PodName:      intern.String(containerInfo.Pod.Name),
@@ -264,7 +274,7 @@ func (t *Tracee) decodeEvents(sourceChan chan []byte) (<-chan *events.PipelineEv
 			evt.EventName = evtName
 			evt.PoliciesVersion = eCtx.PoliciesVersion
 			evt.MatchedPoliciesKernel = eCtx.MatchedPolicies
-			evt.MatchedPoliciesUser = 0
+			evt.MatchedPoliciesUser = bitwise.Bitmap{}
 			evt.MatchedPolicies = []string{}
 			evt.ArgsNum = int(argnum)
 
@@ -294,7 +304,7 @@ func (t *Tracee) decodeEvents(sourceChan chan []byte) (<-chan *events.PipelineEv
 			// this event, as long as there aren't any derivatives or signatures that depend on it.
 			// Some base events (derivative and signatures) might not have set related policy bit,
 			// thus the need to continue with those within the pipeline.
-			if t.matchPolicies(evt) == 0 {
+			if t.matchPolicies(evt).IsZero() {
 				_, hasDerivation := t.eventDerivations[eventId]
 				reqBySig := t.policyManager.IsRequiredBySignature(eventId)
 
@@ -320,9 +330,9 @@ func (t *Tracee) decodeEvents(sourceChan chan []byte) (<-chan *events.PipelineEv
 // not match the event after userland filters are applied. In those cases, the policy bit is cleared
 // (so the event is "filtered" for that policy). This may be called in different stages of the
 // pipeline (decode, derive, engine).
-func (t *Tracee) matchPolicies(event *events.PipelineEvent) uint64 {
+func (t *Tracee) matchPolicies(event *events.PipelineEvent) bitwise.Bitmap {
 	if event == nil || event.Event == nil {
-		return 0
+		return bitwise.Bitmap{}
 	}
 
 	eventID := event.EventID
@@ -345,7 +355,7 @@ func (t *Tracee) matchPolicies(event *events.PipelineEvent) uint64 {
 		// Policy ID is the bit offset in the bitmap.
 		bitOffset := uint(p.ID)
 
-		if !bitwise.HasBit(bitmap, bitOffset) { // event does not match this policy
+		if !bitmap.Has(bitOffset) { // event does not match this policy
 			continue
 		}
 
@@ -365,27 +375,27 @@ func (t *Tracee) matchPolicies(event *events.PipelineEvent) uint64 {
 		// 1. UID/PID range checks (very fast)
 		if p.UIDFilter.Enabled() {
 			if !p.UIDFilter.InMinMaxRange(eventUID) {
-				bitwise.ClearBit(&bitmap, bitOffset)
+				bitmap.Clear(bitOffset)
 				continue
 			}
 		}
 
 		if p.PIDFilter.Enabled() {
 			if !p.PIDFilter.InMinMaxRange(eventPID) {
-				bitwise.ClearBit(&bitmap, bitOffset)
+				bitmap.Clear(bitOffset)
 				continue
 			}
 		}
 
 		// 2. event return value filters (fast)
 		if !rule.RetFilter.Filter(eventRetVal) {
-			bitwise.ClearBit(&bitmap, bitOffset)
+			bitmap.Clear(bitOffset)
 			continue
 		}
 
 		// 3. event scope filters (medium cost)
 		if !rule.ScopeFilter.Filter(*event.Event) {
-			bitwise.ClearBit(&bitmap, bitOffset)
+			bitmap.Clear(bitOffset)
 			continue
 		}
 
@@ -396,12 +406,12 @@ func (t *Tracee) matchPolicies(event *events.PipelineEvent) uint64 {
 		// because it uses usermode applied filters as parameters for the event,
 		// which occurs after filtering
 		if eventID != events.PrintMemDump && !rule.DataFilter.Filter(event.Args) {
-			bitwise.ClearBit(&bitmap, bitOffset)
+			bitmap.Clear(bitOffset)
 			continue
 		}
 
 		// Early exit optimization: if bitmap becomes 0, no need to continue
-		if bitmap == 0 {
+		if bitmap.IsZero() {
 			break
 		}
 	}
@@ -417,9 +427,9 @@ func (t *Tracee) matchPolicies(event *events.PipelineEvent) uint64 {
 // Note: This function applies only basic filters (UID/PID) and skips RetFilter, ScopeFilter,
 // and DataFilter since they require trace.Event. This is acceptable since detector outputs
 // typically don't need complex filtering.
-func (t *Tracee) matchPoliciesProto(pipelineEvent *events.PipelineEvent) uint64 {
+func (t *Tracee) matchPoliciesProto(pipelineEvent *events.PipelineEvent) bitwise.Bitmap {
 	if pipelineEvent == nil || pipelineEvent.ProtoEvent == nil {
-		return 0
+		return bitwise.Bitmap{}
 	}
 
 	pbEvent := pipelineEvent.ProtoEvent
@@ -442,7 +452,7 @@ func (t *Tracee) matchPoliciesProto(pipelineEvent *events.PipelineEvent) uint64
 		// Policy ID is the bit offset in the bitmap.
 		bitOffset := uint(p.ID)
 
-		if !bitwise.HasBit(bitmap, bitOffset) { // event does not match this policy
+		if !bitmap.Has(bitOffset) { // event does not match this policy
 			continue
 		}
 
@@ -458,14 +468,14 @@ func (t *Tracee) matchPoliciesProto(pipelineEvent *events.PipelineEvent) uint64
 		// Apply fast filters (UID/PID only for proto-native events)
 		if p.UIDFilter.Enabled() {
 			if !p.UIDFilter.InMinMaxRange(eventUID) {
-				bitwise.ClearBit(&bitmap, bitOffset)
+				bitmap.Clear(bitOffset)
 				continue
 			}
 		}
 
 		if p.PIDFilter.Enabled() {
 			if !p.PIDFilter.InMinMaxRange(eventPID) {
-				bitwise.ClearBit(&bitmap, bitOffset)
+				bitmap.Clear(bitOffset)
 				continue
 			}
 		}
@@ -479,7 +489,7 @@ func (t *Tracee) matchPoliciesProto(pipelineEvent *events.PipelineEvent) uint64
 		// full filtering capabilities for all events.
 
 		// Early exit optimization: if bitmap becomes 0, no need to continue
-		if bitmap == 0 {
+		if bitmap.IsZero() {
 			break
 		}
 	}
@@ -555,7 +565,7 @@ func (t *Tracee) processEvents(ctx context.Context, in <-chan *events.PipelineEv
 			// enabled, so, in those cases, ignore the event IF the event is not a
 			// cgroup_mkdir or cgroup_rmdir.
 
-			if policiesWithContainerFilter > 0 && event.Container.ID == "" {
+			if !policiesWithContainerFilter.IsZero() && event.Container.ID == "" {
 				eventId := event.EventID
 
 				// never skip cgroup_{mkdir,rmdir}: container_{create,remove} events need it
@@ -567,11 +577,11 @@ func (t *Tracee) processEvents(ctx context.Context, in <-chan *events.PipelineEv
 					"eventId", eventId)
 
 				// remove event from the policies with container filters
-				bitwise.ClearBits(&event.MatchedPoliciesKernel, policiesWithContainerFilter)
-				bitwise.ClearBits(&event.MatchedPoliciesUser, policiesWithContainerFilter)
-				bitwise.ClearBits(&event.MatchedPoliciesBitmap, policiesWithContainerFilter)
+				event.MatchedPoliciesKernel = bitwise.Bitmap(event.MatchedPoliciesKernel).AndNot(policiesWithContainerFilter)
+				event.MatchedPoliciesUser = bitwise.Bitmap(event.MatchedPoliciesUser).AndNot(policiesWithContainerFilter)
+				event.MatchedPoliciesBitmap.ClearBits(policiesWithContainerFilter)
 
-				if event.MatchedPoliciesKernel == 0 {
+				if bitwise.Bitmap(event.MatchedPoliciesKernel).IsZero() {
 					t.eventsPool.Put(event)
 					continue
 				}
@@ -588,7 +598,8 @@ func (t *Tracee) processEvents(ctx context.Context, in <-chan *events.PipelineEv
 
 // deriveEvents is the event derivation pipeline stage. For each received event, it runs
 // the event derivation logic, described in the derivation table, and sends the derived
-// events down the pipeline.
+// events down the pipeline. It also periodically ends the idle connectionless flows,
+// sending their end events.
 func (t *Tracee) deriveEvents(in <-chan *events.PipelineEvent) (
 	<-chan *events.PipelineEvent, <-chan error,
 ) {
@@ -599,25 +610,7 @@ func (t *Tracee) deriveEvents(in <-chan *events.PipelineEvent) (
 		defer close(out)
 		defer close(errc)
 
-		// NOTE: Use for-range to naturally exit when input channel is closed.
-		// This ensures all events are processed during graceful shutdown.
-		for event := range in {
-			if event == nil {
-				continue // might happen during initialization (ctrl+c seg faults)
-			}
-
-			// Derive events using original event pointer directly (no copying needed)
-			// We derive before sending the event downstream to avoid race conditions
-			// Extract trace.Event for derivation
-			derivatives, errors := t.eventDerivations.DeriveEvent(event.Event)
-
-			// Send original event down the pipeline
-			out <- event
-
-			for _, err := range errors {
-				t.handleError(err)
-			}
-
+		sendDerivatives := func(derivatives []trace.Event) {
 			for i := range derivatives {
 				// Passing "derivative" variable here will make the ptr address always
 				// be the same as the last item. This makes the printer to print 2 or
@@ -637,7 +630,7 @@ func (t *Tracee) deriveEvents(in <-chan *events.PipelineEvent) (
 				case events.SymbolsLoaded, events.SharedObjectLoaded, events.PrintMemDump:
 				default:
 					// Derived events might need filtering as well
-					if t.matchPolicies(derivativePipelineEvent) == 0 {
+					if t.matchPolicies(derivativePipelineEvent).IsZero() {
 						_ = t.stats.EventsFiltered.Increment()
 						continue
 					}
@@ -648,6 +641,50 @@ func (t *Tracee) deriveEvents(in <-chan *events.PipelineEvent) (
 				out <- derivativePipelineEvent
 			}
 		}
+
+		// Idle flows are expired only if tracked
+		var flowsTick <-chan time.Time
+		if len(t.flowTrackers) > 0 {
+			ticker := time.NewTicker(flowsExpirationInterval)
+			defer ticker.Stop()
+			flowsTick = ticker.C
+		}
+
+		// NOTE: Exit only when input channel is closed, ending the flows still tracked.
+		// This ensures all events are processed during graceful shutdown.
+		for {
+			select {
+			case event, ok := <-in:
+				if !ok {
+					for _, tracker := range t.flowTrackers {
+						sendDerivatives(tracker.Flush(time.Now()))
+					}
+					return
+				}
+				if event == nil {
+					continue // might happen during initialization (ctrl+c seg faults)
+				}
+
+				// Derive events using original event pointer directly (no copying needed)
+				// We derive before sending the event downstream to avoid race conditions
+				// Extract trace.Event for derivation
+				derivatives, errors := t.eventDerivations.DeriveEvent(event.Event)
+
+				// Send original event down the pipeline
+				out <- event
+
+				for _, err := range errors {
+					t.handleError(err)
+				}
+
+				sendDerivatives(derivatives)
+
+			case now := <-flowsTick:
+				for _, tracker := range t.flowTrackers {
+					sendDerivatives(tracker.Expire(now))
+				}
+			}
+		}
 	}()
 
 	return out, errc
@@ -728,7 +765,7 @@ func (t *Tracee) detectEvents(ctx context.Context, in <-chan *events.PipelineEve
 					}
 
 					// Apply policy filtering to detector outputs
-					if t.matchPoliciesProto(pipelineEvent) == 0 {
+					if t.matchPoliciesProto(pipelineEvent).IsZero() {
 						continue // Skip events not matching policy
 					}
 
@@ -795,7 +832,7 @@ func (t *Tracee) sinkEvents(in <-chan *events.PipelineEvent) <-chan error {
 
 			// Only emit events requested by the user and matched by at least one policy.
 			event.MatchedPoliciesBitmap = t.policyManager.MatchEvent(event.EventID, event.MatchedPoliciesBitmap)
-			if event.MatchedPoliciesBitmap == 0 {
+			if event.MatchedPoliciesBitmap.IsZero() {
 				t.eventsPool.Put(event)
 				continue
 			}
@@ -822,6 +859,11 @@ func (t *Tracee) sinkEvents(in <-chan *events.PipelineEvent) <-chan error {
 				}
 			}
 
+			// Run the response actions of the matched policies rules.
+			if t.actionExecutor != nil {
+				t.submitActions(event.EventID, event.MatchedPoliciesBitmap, pbEvent)
+			}
+
 			// Send the event to the streams.
 			if t.streamsManager.HasSubscribers() {
 				// Detach the slab from pool management — the stream takes ownership.
@@ -97,7 +97,7 @@ func BenchmarkGetEventFromPool(b *testing.B) {
 				evt.EventName = eventDefinition.GetName()
 				evt.PoliciesVersion = ctx.PoliciesVersion
 				evt.MatchedPoliciesKernel = ctx.MatchedPolicies
-				evt.MatchedPoliciesUser = 0
+				evt.MatchedPoliciesUser = [4]uint64{}
 				evt.MatchedPolicies = []string{}
 				evt.ArgsNum = int(argnum)
 				evt.ReturnValue = 0 // Extracted from Args if present
@@ -29,9 +29,10 @@ const (
 	familyIpv6
 )
 
-func (t *Tracee) handleNetCaptureEvents(ctx context.Context) {
+func (t *Tracee) handleNetCaptureEvents(ctx context.Context, done chan<- struct{}) {
 	logger.Debugw("Starting handleNetCaptureEvents goroutine")
 	defer logger.Debugw("Stopped handleNetCaptureEvents goroutine")
+	defer close(done)
 
 	var errChanList []<-chan error
 
@@ -73,6 +74,10 @@ func (t *Tracee) processNetCapEvents(ctx context.Context, in <-chan *events.Pipe
 				logger.Warnw(fmt.Sprintf("Lost %d network capture events", lost))
 
 			case <-ctx.Done():
+				// close all pcap files (storing them as artifacts)
+				if err := t.netCapturePcap.Destroy(); err != nil {
+					logger.Errorw("Closing pcap files", "error", err)
+				}
 				return
 			}
 		}
@@ -325,6 +330,12 @@ func (t *Tracee) processNetCapEvent(event *trace.Event) {
 			logger.Errorw("Could not write pcap data", "err", err)
 		}
 
+		// and to the running pcap actions of policy rules
+
+		if t.actionExecutor != nil {
+			t.actionExecutor.WritePacket(event, payloadLayer2)
+		}
+
 	default:
 		logger.Debugw("Network capture: wrong net capture event type")
 	}
@@ -17,6 +17,7 @@ import (
 	"github.com/aquasecurity/tracee/common/intern"
 	"github.com/aquasecurity/tracee/common/logger"
 	"github.com/aquasecurity/tracee/common/stringutil"
+	"github.com/aquasecurity/tracee/pkg/artifacts"
 	"github.com/aquasecurity/tracee/pkg/datastores/container"
 	"github.com/aquasecurity/tracee/pkg/datastores/symbol"
 	"github.com/aquasecurity/tracee/pkg/events"
@@ -185,6 +186,14 @@ func (t *Tracee) processSchedProcessExec(event *trace.Event) error {
 					}
 					// mark this file as captured
 					t.artifactsFiles[capturedFileID] = castedSourceFileCtime
+					t.submitArtifact(artifacts.Artifact{
+						Type: artifacts.Executable,
+						Path: destinationFilePath,
+						Context: artifacts.Context{
+							ContainerID: event.Container.ID,
+							HostPid:     uint32(event.HostProcessID),
+						},
+					})
 				}
 			}
 			// check exec'ed hash ?
@@ -177,6 +177,19 @@ func (t *Tracee) procTreeExecProcessor(event *trace.Event) error {
 		return err
 	}
 
+	// Command line
+	execFeed.Args, err = parse.ArgVal[[]string](event.Args, "argv")
+	if err != nil {
+		return err
+	}
+	execFeed.Env = nil
+	if len(t.config.ProcessStore.EnvAllowlist) > 0 {
+		execFeed.Env, _ = parse.ArgVal[[]string](event.Args, "env") // only if captured
+		if !t.config.Output.Environment {
+			removeExecEnv(event) // captured for the process tree only
+		}
+	}
+
 	execFeed.TimeStamp = uint64(event.Timestamp)       // already normalized at decode stage
 	execFeed.StartTime = uint64(event.ThreadStartTime) // already normalized at decode stage
 
@@ -193,6 +206,17 @@ func (t *Tracee) procTreeExecProcessor(event *trace.Event) error {
 	return processTree.FeedFromExec(execFeed)
 }
 
+// removeExecEnv resets the environment of an exec event, captured for the process tree
+// but not requested in the output.
+func removeExecEnv(event *trace.Event) {
+	for i := range event.Args {
+		if event.Args[i].Name == "env" {
+			event.Args[i].Value = parse.ArgZeroValueFromType(event.Args[i].Type)
+			return
+		}
+	}
+}
+
 // procTreeExitProcessor handles process exit events.
 func (t *Tracee) procTreeExitProcessor(event *trace.Event) error {
 	processTree := t.dataStoreRegistry.GetProcessTree()
@@ -0,0 +1,97 @@
+package ebpf
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aquasecurity/tracee/pkg/config"
+	"github.com/aquasecurity/tracee/pkg/datastores"
+	"github.com/aquasecurity/tracee/pkg/datastores/process"
+	"github.com/aquasecurity/tracee/pkg/events/parse"
+	"github.com/aquasecurity/tracee/types/trace"
+)
+
+func Test_procTreeExecProcessor_Env(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		environment bool
+		expectedEnv []string
+	}{
+		{
+			name:        "environment not in the output",
+			environment: false,
+			expectedEnv: []string{},
+		},
+		{
+			name:        "environment in the output",
+			environment: true,
+			expectedEnv: []string{"LD_PRELOAD=/tmp/x.so", "SECRET=1"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			procTreeConfig := process.ProcTreeConfig{
+				Source:                   process.SourceBoth,
+				ProcessCacheSize:         process.DefaultProcessCacheSize,
+				CmdLineMaxSize:           process.DefaultCmdLineMaxSize,
+				EnvAllowlist:             []string{"LD_PRELOAD"},
+				SkipProcfsInitForTesting: true,
+			}
+			processTree, err := process.NewProcessTree(ctx, procTreeConfig)
+			require.NoError(t, err)
+			registry := datastores.NewRegistry()
+			require.NoError(t, registry.RegisterStore("process", processTree, true))
+
+			tracee := &Tracee{
+				config: config.Config{
+					Output:       &config.OutputConfig{Environment: tc.environment},
+					ProcessStore: procTreeConfig,
+				},
+				dataStoreRegistry: registry,
+			}
+
+			event := &trace.Event{
+				Timestamp:       1000,
+				ThreadStartTime: 1000,
+				ProcessEntityId: 42,
+				ThreadEntityId:  42,
+				Args: []trace.Argument{
+					{ArgMeta: trace.ArgMeta{Name: "cmdpath"}, Value: "/bin/bash"},
+					{ArgMeta: trace.ArgMeta{Name: "pathname"}, Value: "/bin/bash"},
+					{ArgMeta: trace.ArgMeta{Name: "dev"}, Value: uint32(1)},
+					{ArgMeta: trace.ArgMeta{Name: "inode"}, Value: uint64(1)},
+					{ArgMeta: trace.ArgMeta{Name: "ctime"}, Value: uint64(1)},
+					{ArgMeta: trace.ArgMeta{Name: "inode_mode"}, Value: uint16(1)},
+					{ArgMeta: trace.ArgMeta{Name: "interp"}, Value: "/lib64/ld-linux-x86-64.so.2"},
+					{ArgMeta: trace.ArgMeta{Name: "stdin_type"}, Value: uint16(1)},
+					{ArgMeta: trace.ArgMeta{Name: "stdin_path"}, Value: "/dev/null"},
+					{ArgMeta: trace.ArgMeta{Name: "invoked_from_kernel"}, Value: false},
+					{ArgMeta: trace.ArgMeta{Name: "argv", Type: "[]string"}, Value: []string{"bash"}},
+					{ArgMeta: trace.ArgMeta{Name: "env", Type: "[]string"}, Value: []string{"LD_PRELOAD=/tmp/x.so", "SECRET=1"}},
+				},
+			}
+			require.NoError(t, tracee.procTreeExecProcessor(event))
+
+			// The allowed variables are always kept by the tree
+			p, ok := processTree.GetProcessByHash(42)
+			require.True(t, ok)
+			assert.Equal(t, []string{"LD_PRELOAD=/tmp/x.so"}, p.GetCmdLine().Env)
+
+			// The environment is only in the output if requested
+			env, err := parse.ArgVal[[]string](event.Args, "env")
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedEnv, env)
+		})
+	}
+}
@@ -215,7 +215,7 @@ func (t *Tracee) engineEvents(in <-chan *events.PipelineEvent) (<-chan *events.P
 
 			// Wrap finding event in PipelineEvent
 			event := events.NewPipelineEvent(traceEvent)
-			if t.matchPolicies(event) == 0 {
+			if t.matchPolicies(event).IsZero() {
 				_ = t.stats.EventsFiltered.Increment()
 				continue
 			}
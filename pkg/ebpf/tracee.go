@@ -30,11 +30,14 @@ import (
 	"github.com/aquasecurity/tracee/common/logger"
 	"github.com/aquasecurity/tracee/common/proc"
 	"github.com/aquasecurity/tracee/common/timeutil"
+	"github.com/aquasecurity/tracee/pkg/actions"
+	"github.com/aquasecurity/tracee/pkg/artifacts"
 	"github.com/aquasecurity/tracee/pkg/bufferdecoder"
 	"github.com/aquasecurity/tracee/pkg/config"
 	"github.com/aquasecurity/tracee/pkg/datastores"
 	"github.com/aquasecurity/tracee/pkg/datastores/container"
 	"github.com/aquasecurity/tracee/pkg/datastores/dns"
+	"github.com/aquasecurity/tracee/pkg/datastores/ipreputation"
 	"github.com/aquasecurity/tracee/pkg/datastores/process"
 	"github.com/aquasecurity/tracee/pkg/datastores/symbol"
 	"github.com/aquasecurity/tracee/pkg/datastores/syscall"
@@ -84,11 +87,14 @@ type Tracee struct {
 	eventDecodeTypes map[events.ID][]data.DecodeAs
 	eventProcessor   map[events.ID][]func(evt *trace.Event) error
 	eventDerivations derive.Table
+	flowTrackers     []*derive.FlowTracker // connectionless flows, expired by deriveEvents
 	// Artifacts
-	fileHashes     *digest.Cache
-	artifactsFiles map[string]int64
-	writtenFiles   map[string]string
-	netCapturePcap *pcaps.Pcaps
+	fileHashes        *digest.Cache
+	artifactsFiles    map[string]int64
+	writtenFiles      map[string]string
+	netCapturePcap    *pcaps.Pcaps
+	actionExecutor    *actions.Executor   // policy rules response actions (nil if none)
+	artifactsUploader *artifacts.Uploader // stores the captured artifacts (nil if none)
 	// Internal Data
 	readFiles   map[string]string
 	pidsInMntns bucketcache.BucketCache // first n PIDs in each mountns
@@ -120,6 +126,7 @@ type Tracee struct {
 	cgroups           *cgroup.Cgroups
 	contPathResolver  *container.ContainerPathResolver
 	contSymbolsLoader *container.ContainersSymbolsLoader
+	containersChanged chan struct{} // signals containers enriched or removed (coalesced)
 	// Control Plane
 	controlPlane *controlplane.Controller
 	// DataStore Registry Manager (provides both internal and public access).
@@ -138,6 +145,7 @@ type Tracee struct {
 	streamsManager *streams.StreamsManager
 	// policyManager manages policy state
 	policyManager *policy.Manager
+	policiesMutex sync.Mutex // serializes runtime policies changes
 	// The dependencies of events used by Tracee
 	eventsDependencies *dependencies.Manager
 	// A reference to a symbol.KernelSymbolTable that might change at runtime.
@@ -314,6 +322,7 @@ func New(cfg config.Config) (*Tracee, error) {
 		extraProbes:        make(map[string]*probes.ProbeGroup),
 		dataTypeDecoder:    bufferdecoder.NewTypeDecoder(),
 		extensions:         NewExtensions(),
+		containersChanged:  make(chan struct{}, 1),
 	}
 
 	// Allow e2e build-tagged files to set e2e registration functions
@@ -417,6 +426,12 @@ func (t *Tracee) Init(ctx gocontext.Context) error {
 	if err != nil {
 		return errfmt.Errorf("error initializing containers: %v", err)
 	}
+	containerMgr.OnChange(func() {
+		select {
+		case t.containersChanged <- struct{}{}:
+		default: // a sync is already pending
+		}
+	})
 
 	// Initialize DNS Cache
 
@@ -615,6 +630,14 @@ func (t *Tracee) Init(ctx gocontext.Context) error {
 		return errfmt.WrapError(err)
 	}
 
+	// IP reputation store is optional, its feed files are loaded on initialization
+	if t.config.IPReputationStore.Enable {
+		reputationStore := ipreputation.New(t.config.IPReputationStore)
+		if err := t.dataStoreRegistry.RegisterStore(dsapi.Reputation, reputationStore, false); err != nil {
+			return errfmt.WrapError(err)
+		}
+	}
+
 	if t.registerE2eDatastoresFn != nil {
 		if err := t.registerE2eDatastoresFn(t.dataStoreRegistry.Registry()); err != nil {
 			return errfmt.WrapError(err)
@@ -676,14 +699,28 @@ func (t *Tracee) Init(ctx gocontext.Context) error {
 		return errfmt.Errorf("error opening out directory: %v", err)
 	}
 
+	// Initialize artifacts storage
+
+	if err := t.initArtifactsUploader(); err != nil {
+		t.Close()
+		return errfmt.Errorf("error initializing artifacts storage: %v", err)
+	}
+
 	// Initialize network capture (all needed pcap files)
 
-	t.netCapturePcap, err = pcaps.New(t.config.Artifacts.Net, t.OutDir)
+	t.netCapturePcap, err = pcaps.New(t.config.Artifacts.Net, t.OutDir, t.submitArtifact)
 	if err != nil {
 		t.Close()
 		return errfmt.Errorf("error initializing network capture: %v", err)
 	}
 
+	// Initialize policy rules response actions
+
+	if err := t.initActionsExecutor(); err != nil {
+		t.Close()
+		return errfmt.Errorf("error initializing policy actions: %v", err)
+	}
+
 	// Get reference to stack trace addresses map
 
 	stackAddressesMap, err := t.bpfModule.GetMap("stack_addresses")
@@ -819,6 +856,34 @@ func (t *Tracee) initDerivationTable() error {
 		return nil
 	}
 
+	udpFlowTracker, err := derive.InitUDPFlowTracker(
+		t.dataStoreRegistry.GetDNSCache(),
+		shouldSubmit(events.NetFlowUDPBegin),
+		shouldSubmit(events.NetFlowUDPEnd),
+		t.stats.NetFlowsActive,
+		t.stats.NetFlowsEvicted,
+	)
+	if err != nil {
+		return errfmt.WrapError(err)
+	}
+	icmpFlowTracker, err := derive.InitICMPFlowTracker(
+		t.dataStoreRegistry.GetDNSCache(),
+		shouldSubmit(events.NetFlowICMPBegin),
+		shouldSubmit(events.NetFlowICMPEnd),
+		t.stats.NetFlowsActive,
+		t.stats.NetFlowsEvicted,
+	)
+	if err != nil {
+		return errfmt.WrapError(err)
+	}
+	t.flowTrackers = []*derive.FlowTracker{udpFlowTracker, icmpFlowTracker}
+
+	// A flow tracker derives both the begin and the end events of its flows, so each
+	// packet must reach it once: its begin registration is only enabled without the end.
+	onlyBegin := func(begin, end events.ID) func() bool {
+		return func() bool { return shouldSubmit(begin)() && !shouldSubmit(end)() }
+	}
+
 	coreDerivations := derive.Table{
 		events.CgroupMkdir: {
 			events.ContainerCreate: {
@@ -954,6 +1019,16 @@ func (t *Tracee) initDerivationTable() error {
 				DeriveFunction: derive.NetPacketHTTPResponse(),
 			},
 		},
+		events.NetPacketTLSBase: {
+			events.NetPacketTLSClientHello: {
+				Enabled:        shouldSubmit(events.NetPacketTLSClientHello),
+				DeriveFunction: derive.NetPacketTLSClientHello(),
+			},
+			events.NetPacketTLSServerHello: {
+				Enabled:        shouldSubmit(events.NetPacketTLSServerHello),
+				DeriveFunction: derive.NetPacketTLSServerHello(),
+			},
+		},
 		//
 		// Network Flow Derivations
 		//
@@ -971,6 +1046,24 @@ func (t *Tracee) initDerivationTable() error {
 				),
 			},
 		},
+		events.NetPacketFlowConnlessBase: {
+			events.NetFlowUDPBegin: {
+				Enabled:        onlyBegin(events.NetFlowUDPBegin, events.NetFlowUDPEnd),
+				DeriveFunction: udpFlowTracker.NetFlowConnless(),
+			},
+			events.NetFlowUDPEnd: {
+				Enabled:        shouldSubmit(events.NetFlowUDPEnd),
+				DeriveFunction: udpFlowTracker.NetFlowConnless(),
+			},
+			events.NetFlowICMPBegin: {
+				Enabled:        onlyBegin(events.NetFlowICMPBegin, events.NetFlowICMPEnd),
+				DeriveFunction: icmpFlowTracker.NetFlowConnless(),
+			},
+			events.NetFlowICMPEnd: {
+				Enabled:        shouldSubmit(events.NetFlowICMPEnd),
+				DeriveFunction: icmpFlowTracker.NetFlowConnless(),
+			},
+		},
 	}
 
 	// Register core derivations using the registration function
@@ -1027,6 +1120,9 @@ func (t *Tracee) getOptionsConfig() uint32 {
 	switch t.config.ProcessStore.Source {
 	case process.SourceBoth, process.SourceEvents:
 		cOptVal = cOptVal | optForkProcTree // tell sched_process_fork to be prolix
+		if len(t.config.ProcessStore.EnvAllowlist) > 0 {
+			cOptVal = cOptVal | optExecEnv // the process tree keeps allowed variables
+		}
 	}
 
 	return cOptVal
@@ -1845,6 +1941,12 @@ func (t *Tracee) Run(ctx gocontext.Context) error {
 	go t.processLostEvents() // termination signaled by closing t.done
 	go t.handleEvents(ctx, pipelineReady, pipelineDone)
 
+	// Artifacts storage
+
+	if t.artifactsUploader != nil {
+		t.artifactsUploader.Start()
+	}
+
 	// Parallel perf buffer with file writes events
 
 	if t.config.Buffers.Kernel.Artifacts > 0 {
@@ -1854,9 +1956,18 @@ func (t *Tracee) Run(ctx gocontext.Context) error {
 
 	// Network capture perf buffer (similar to regular pipeline)
 
+	netCaptureDone := make(chan struct{})
 	if pcaps.PcapsEnabled(t.config.Artifacts.Net) {
 		t.netCapPerfMap.Poll(pollTimeout)
-		go t.handleNetCaptureEvents(ctx)
+		go t.handleNetCaptureEvents(ctx, netCaptureDone)
+	} else {
+		close(netCaptureDone)
+	}
+
+	// Policy rules response actions
+
+	if t.actionExecutor != nil {
+		go t.actionExecutor.Run(ctx)
 	}
 
 	// Logging perf buffer
@@ -1874,6 +1985,7 @@ func (t *Tracee) Run(ctx gocontext.Context) error {
 	// events (syscall table check, seq ops, mem dump, lkm seeker).
 
 	go t.hookedSyscallTableRoutine(ctx)
+	go t.containerSelectorsRoutine(ctx)
 	t.triggerSeqOpsIntegrityCheck(trace.Event{})
 	errs := t.triggerMemDump(trace.Event{})
 	for _, err := range errs {
@@ -1917,6 +2029,21 @@ func (t *Tracee) Run(ctx gocontext.Context) error {
 			"duration", time.Since(shutdownStart))
 	}
 
+	// Wait for the network capture to close its pcap files, then store the
+	// artifacts not stored yet
+
+	select {
+	case <-netCaptureDone:
+	case <-time.After(pipelineDrainTimeout):
+		logger.Warnw("Network capture drain timeout exceeded", "timeout", pipelineDrainTimeout)
+	}
+
+	if t.artifactsUploader != nil {
+		uploadCtx, cancel := gocontext.WithTimeout(gocontext.Background(), artifactsUploadTimeout)
+		t.artifactsUploader.Close(uploadCtx)
+		cancel()
+	}
+
 	// TODO: move logic below somewhere else (related to file writes)
 
 	// record index of written files
@@ -2156,23 +2283,23 @@ func (t *Tracee) getSelfLoadedPrograms(kprobesOnly bool) map[string]int {
 // events for the signatures engine/logic. The wg tracks background goroutines that send to out;
 // the caller must wait on wg before closing out.
 func (t *Tracee) invokeInitEvents(ctx gocontext.Context, out chan *events.PipelineEvent, wg *sync.WaitGroup) {
-	var matchedPolicies uint64
+	var matchedPolicies bitwise.Bitmap
 
-	setMatchedPolicies := func(event *trace.Event, matchedPolicies uint64) {
+	setMatchedPolicies := func(event *trace.Event, matchedPolicies bitwise.Bitmap) {
 		event.PoliciesVersion = 1 // version will be removed soon
 		event.MatchedPoliciesKernel = matchedPolicies
 		event.MatchedPoliciesUser = matchedPolicies
 		event.MatchedPolicies = t.policyManager.MatchedNames(matchedPolicies)
 	}
 
-	policiesMatch := func(id events.ID) uint64 {
+	policiesMatch := func(id events.ID) bitwise.Bitmap {
 		return t.policyManager.MatchEventInAnyPolicy(id)
 	}
 
 	// Initial namespace events
 
 	matchedPolicies = policiesMatch(events.TraceeInfo)
-	if matchedPolicies > 0 {
+	if !matchedPolicies.IsZero() {
 		traceeDataEvent := events.TraceeInfoEvent(t.bootTime, t.startTime)
 		setMatchedPolicies(&traceeDataEvent, matchedPolicies)
 		out <- events.NewPipelineEvent(&traceeDataEvent)
@@ -2180,7 +2307,7 @@ func (t *Tracee) invokeInitEvents(ctx gocontext.Context, out chan *events.Pipeli
 	}
 
 	matchedPolicies = policiesMatch(events.InitNamespaces)
-	if matchedPolicies > 0 {
+	if !matchedPolicies.IsZero() {
 		systemInfoEvent := events.InitNamespacesEvent()
 		setMatchedPolicies(&systemInfoEvent, matchedPolicies)
 		out <- events.NewPipelineEvent(&systemInfoEvent)
@@ -2190,7 +2317,7 @@ func (t *Tracee) invokeInitEvents(ctx gocontext.Context, out chan *events.Pipeli
 	// Initial existing containers events (1 event per container)
 
 	matchedPolicies = policiesMatch(events.ExistingContainer)
-	if matchedPolicies > 0 {
+	if !matchedPolicies.IsZero() {
 		existingContainerEvents := events.ExistingContainersEvents(t.dataStoreRegistry.GetContainerManager(), t.config.EnrichmentEnabled)
 		for i := range existingContainerEvents {
 			event := &(existingContainerEvents[i])
@@ -2203,7 +2330,7 @@ func (t *Tracee) invokeInitEvents(ctx gocontext.Context, out chan *events.Pipeli
 	// Ftrace hook event
 
 	matchedPolicies = policiesMatch(events.FtraceHook)
-	if matchedPolicies > 0 {
+	if !matchedPolicies.IsZero() {
 		ftraceBaseEvent := events.GetFtraceBaseEvent()
 		setMatchedPolicies(ftraceBaseEvent, matchedPolicies)
 
@@ -2252,14 +2379,14 @@ func (t *Tracee) Subscribe(stream config.Stream) (*streams.Stream, error) {
 	eventMap := map[int32]struct{}{}
 
 	if len(stream.Filters.Policies) > 0 {
-		policyMask = 0
+		policyMask = policy.PolicyNone
 
 		for _, policyName := range stream.Filters.Policies {
 			p, err := t.policyManager.LookupByName(policyName)
 			if err != nil {
 				return nil, err
 			}
-			bitwise.SetBit(&policyMask, uint(p.ID))
+			policyMask.Set(uint(p.ID))
 		}
 	}
 
@@ -2267,23 +2394,36 @@ func (t *Tracee) Subscribe(stream config.Stream) (*streams.Stream, error) {
 		for _, eventName := range stream.Filters.Events {
 			id, found := v1beta1.EventId_value[eventName]
 			if !found {
-				return nil, errfmt.Errorf("error event not found: %s", eventName)
+				return nil, errfmt.WrapError(&streams.FilterError{Reason: "event not found: " + eventName})
 			}
 
 			eventMap[id] = struct{}{}
 		}
 	}
 
-	return t.subscribe(policyMask, eventMap, stream.Buffer), nil
+	filter, err := streams.NewFilter(stream.Filters)
+	if err != nil {
+		return nil, errfmt.WrapError(err)
+	}
+
+	s := t.subscribe(policyMask, eventMap, filter, stream.Buffer)
+
+	destinations := make([]string, 0, len(stream.Destinations))
+	for _, destination := range stream.Destinations {
+		destinations = append(destinations, destination.Name)
+	}
+	t.streamsManager.SetDestinations(s, destinations)
+
+	return s, nil
 }
 
-func (t *Tracee) subscribe(policyMask uint64, eventMap map[int32]struct{}, bufferConfig config.StreamBuffer) *streams.Stream {
+func (t *Tracee) subscribe(policyMask bitwise.Bitmap, eventMap map[int32]struct{}, filter *streams.Filter, bufferConfig config.StreamBuffer) *streams.Stream {
 	// To keep old behavior in case of streams created from GRPC server
 	if bufferConfig.Size <= 0 {
 		bufferConfig.Size = t.config.Buffers.Pipeline
 	}
 
-	return t.streamsManager.Subscribe(policyMask, eventMap, bufferConfig)
+	return t.streamsManager.SubscribeWithFilter(policyMask, eventMap, filter, bufferConfig)
 }
 
 // Unsubscribe unsubscribes stream
@@ -2373,18 +2513,13 @@ func (t *Tracee) DisableEvent(eventName string) error {
 func (t *Tracee) registerAllDetectors(detectorList []detection.EventDetector) error {
 	logger.Debugw("Registering detectors", "count", len(detectorList))
 
-	// Build detector parameters
-	params := detection.DetectorParams{
-		Logger:     logger.Current(),
-		DataStores: t.dataStoreRegistry.Registry(),
-		Config:     detection.NewEmptyDetectorConfig(), // Empty config for now
-	}
-
 	// Register all detectors
+	knownIDs := make(map[string]struct{}, len(detectorList))
 	for _, detector := range detectorList {
 		definition := detector.GetDefinition()
+		knownIDs[strings.ToLower(definition.ID)] = struct{}{}
 
-		if err := t.detectorEngine.RegisterDetector(detector, params); err != nil {
+		if err := t.detectorEngine.RegisterDetector(detector, t.detectorParams(definition.ID)); err != nil {
 			logger.Errorw("Failed to register detector",
 				"detector", definition.ID,
 				"error", err)
@@ -2393,6 +2528,13 @@ func (t *Tracee) registerAllDetectors(detectorList []detection.EventDetector) er
 		}
 	}
 
+	// Configuration for unknown detectors is most likely a typo in the detector ID
+	for id := range t.config.DetectorConfig.Config {
+		if _, ok := knownIDs[id]; !ok {
+			logger.Warnw("Configuration given for unknown detector", "detector", id)
+		}
+	}
+
 	logger.Debugw("Detector registration complete",
 		"total", len(detectorList),
 		"registered", t.detectorEngine.GetDetectorCount())
@@ -2400,6 +2542,15 @@ func (t *Tracee) registerAllDetectors(detectorList []detection.EventDetector) er
 	return nil
 }
 
+// detectorParams builds the parameters of a detector, with the configuration given for it
+func (t *Tracee) detectorParams(detectorID string) detection.DetectorParams {
+	return detection.DetectorParams{
+		Logger:     logger.Current(),
+		DataStores: t.dataStoreRegistry.Registry(),
+		Config:     detection.NewDetectorConfig(t.config.DetectorConfig.ConfigFor(detectorID)),
+	}
+}
+
 // EnableRule enables a rule in the specified policies
 func (t *Tracee) EnableRule(policyNames []string, ruleId string) error {
 	eventID, found := events.Core.GetDefinitionIDByName(ruleId)
@@ -2444,6 +2595,178 @@ func (t *Tracee) DisableRule(policyNames []string, ruleId string) error {
 	return nil
 }
 
+// CreatePolicy adds a policy to the running ones. The probes of its events are
+// attached and the eBPF maps updated, without reloading the eBPF programs.
+func (t *Tracee) CreatePolicy(p *policy.Policy) error {
+	if err := t.validatePolicyActions(p); err != nil {
+		return err
+	}
+
+	t.policiesMutex.Lock()
+	defer t.policiesMutex.Unlock()
+
+	if err := t.policyManager.AddPolicy(p); err != nil {
+		return err
+	}
+
+	return t.policiesChanged()
+}
+
+// UpdatePolicy replaces the running policy with the same name
+func (t *Tracee) UpdatePolicy(p *policy.Policy) error {
+	if err := t.validatePolicyActions(p); err != nil {
+		return err
+	}
+
+	t.policiesMutex.Lock()
+	defer t.policiesMutex.Unlock()
+
+	if err := t.policyManager.ReplacePolicy(p); err != nil {
+		return err
+	}
+
+	return t.policiesChanged()
+}
+
+// DeletePolicy removes a running policy, detaching the probes of the events
+// no other policy selects
+func (t *Tracee) DeletePolicy(name string) error {
+	t.policiesMutex.Lock()
+	defer t.policiesMutex.Unlock()
+
+	if err := t.policyManager.RemovePolicy(name); err != nil {
+		return err
+	}
+
+	return t.policiesChanged()
+}
+
+// policiesChanged updates the eBPF maps and the detectors to the running policies
+func (t *Tracee) policiesChanged() error {
+	if err := t.populateFilterMaps(true); err != nil {
+		return err
+	}
+
+	if t.detectorEngine != nil {
+		t.detectorEngine.RefreshSelection()
+	}
+
+	return nil
+}
+
+// containerSelectorsRoutine keeps the eBPF filters of the policies selecting containers
+// by their metadata (k8s namespace, k8s labels and image) in sync with the containers
+// coming and going, so events of unselected containers keep being dropped in-kernel.
+func (t *Tracee) containerSelectorsRoutine(ctx gocontext.Context) {
+	logger.Debugw("Starting containerSelectors goroutine")
+	defer logger.Debugw("Stopped containerSelectors goroutine")
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.containersChanged:
+		}
+
+		if !t.policyManager.ContainerSelectorsEnabled() {
+			continue
+		}
+
+		t.policiesMutex.Lock()
+		err := t.populateFilterMaps(false)
+		t.policiesMutex.Unlock()
+		if err != nil {
+			logger.Errorw("Error syncing container selectors", "error", err)
+		}
+	}
+}
+
+// Policies returns the running policies, ordered by ID
+func (t *Tracee) Policies() []*policy.Policy {
+	return t.policyManager.Policies()
+}
+
+// Detectors returns the registered detectors, ordered by ID
+func (t *Tracee) Detectors() []detectors.DetectorInfo {
+	if t.detectorEngine == nil {
+		return nil
+	}
+
+	return t.detectorEngine.ListDetectorInfos()
+}
+
+// Detector returns a registered detector
+func (t *Tracee) Detector(detectorID string) (detectors.DetectorInfo, error) {
+	if t.detectorEngine == nil {
+		return detectors.DetectorInfo{}, errfmt.Errorf("detectors are not initialized")
+	}
+
+	return t.detectorEngine.GetDetectorInfo(detectorID)
+}
+
+// EnableDetector enables a registered detector, which processes events again
+// while a policy selects its event
+func (t *Tracee) EnableDetector(detectorID string) error {
+	if t.detectorEngine == nil {
+		return errfmt.Errorf("detectors are not initialized")
+	}
+
+	return t.detectorEngine.EnableDetector(detectorID)
+}
+
+// DisableDetector disables a registered detector until it is enabled again
+func (t *Tracee) DisableDetector(detectorID string) error {
+	if t.detectorEngine == nil {
+		return errfmt.Errorf("detectors are not initialized")
+	}
+
+	return t.detectorEngine.DisableDetector(detectorID)
+}
+
+// LoadDetector registers a detector at runtime, allocating an ID to its event.
+// It starts detecting once a policy selects its event.
+func (t *Tracee) LoadDetector(detector detection.EventDetector) error {
+	// The detection pipeline stage only runs if detectors were registered at startup
+	if t.detectorEngine == nil || t.detectorEngine.GetDetectorCount() == 0 {
+		return errfmt.Errorf("no detectors were registered at startup, detection is not running")
+	}
+
+	definition := detector.GetDefinition()
+
+	// Policy changes refresh the detectors selection, don't race with them
+	t.policiesMutex.Lock()
+	defer t.policiesMutex.Unlock()
+
+	if _, err := t.detectorEngine.GetDetector(definition.ID); err == nil {
+		return fmt.Errorf("detector ID %s %w", definition.ID, detectors.ErrDetectorRegistered)
+	}
+
+	eventID, err := detectors.CreateEventFromDetector(detector)
+	if err != nil {
+		return errfmt.WrapError(err)
+	}
+
+	err = t.detectorEngine.RegisterDetector(detector, t.detectorParams(definition.ID))
+	if err == nil {
+		// Detectors not supporting this architecture or tracee version are skipped
+		if _, getErr := t.detectorEngine.GetDetector(definition.ID); getErr != nil {
+			err = errfmt.Errorf("detector %s does not support this architecture or tracee version", definition.ID)
+		}
+	}
+	if err != nil {
+		// No policy can select the event yet, free its ID and name
+		events.Core.Remove(eventID)
+		return err
+	}
+
+	logger.Infow("Loaded detector",
+		"detector", definition.ID,
+		"event", definition.ProducedEvent.Name,
+		"event_id", eventID)
+
+	return nil
+}
+
 // RegisterEventDerivations allows additional event derivations to be registered
 func (t *Tracee) RegisterEventDerivations(eventDerivations derive.Table) {
 	if t.eventDerivations == nil {
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net"
 	"net/http"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -112,10 +113,17 @@ func fillProtoSlab(e *trace.Event, s *eventSlab) *pb.Event {
 
 	// Container info
 	if e.Container.ID != "" {
+		// labels and capabilities are shared with the container store: sanitize copies
 		workload.Container = &pb.Container{
-			Id:      sanitizeStringForProtobuf(e.Container.ID),
-			Name:    sanitizeStringForProtobuf(e.Container.Name),
-			Started: e.ContextFlags.ContainerStarted,
+			Id:                sanitizeStringForProtobuf(e.Container.ID),
+			Name:              sanitizeStringForProtobuf(e.Container.Name),
+			Started:           e.ContextFlags.ContainerStarted,
+			Labels:            sanitizeStringMapForProtobuf(e.Container.Labels),
+			Privileged:        e.Container.Privileged,
+			AddedCapabilities: sanitizeStringArrayForProtobuf(slices.Clone(e.Container.AddedCapabilities)),
+			HostNetwork:       e.Container.HostNetwork,
+			HostPid:           e.Container.HostPID,
+			HostIpc:           e.Container.HostIPC,
 		}
 		if e.Container.ImageName != "" {
 			workload.Container.Image = &pb.ContainerImage{
@@ -248,8 +256,14 @@ func ConvertFromProto(e *pb.Event) *trace.Event {
 		c := e.Workload.Container
 		event.ContainerID = c.Id
 		event.Container = trace.Container{
-			ID:   c.Id,
-			Name: c.Name,
+			ID:                c.Id,
+			Name:              c.Name,
+			Labels:            c.Labels,
+			Privileged:        c.Privileged,
+			AddedCapabilities: c.AddedCapabilities,
+			HostNetwork:       c.HostNetwork,
+			HostPID:           c.HostPid,
+			HostIPC:           c.HostIpc,
 		}
 		event.ContextFlags.ContainerStarted = c.Started
 		if c.Image != nil {
@@ -598,6 +612,16 @@ func parseArgument(arg trace.Argument) (*pb.EventValue, error) {
 	case *trace.ProtoHTTPResponse:
 		return convertProtoHTTPResponse(v)
 
+	case trace.ProtoTLSClientHello:
+		return convertProtoTLSClientHello(&v)
+	case *trace.ProtoTLSClientHello:
+		return convertProtoTLSClientHello(v)
+
+	case trace.ProtoTLSServerHello:
+		return convertProtoTLSServerHello(&v)
+	case *trace.ProtoTLSServerHello:
+		return convertProtoTLSServerHello(v)
+
 	case []trace.DnsQueryData:
 		questions := make([]*pb.DnsQueryData, len(v))
 		for i, q := range v {
@@ -807,6 +831,21 @@ func sanitizeStringArrayForProtobuf(arr []string) []string {
 	return arr
 }
 
+// sanitizeStringMapForProtobuf returns a copy of a string map with only valid UTF-8
+// keys and values, or nil if the map is empty
+func sanitizeStringMapForProtobuf(m map[string]string) map[string]string {
+	if len(m) == 0 {
+		return nil
+	}
+
+	sanitizedMap := make(map[string]string, len(m))
+	for k, v := range m {
+		sanitizedMap[sanitizeStringForProtobuf(k)] = sanitizeStringForProtobuf(v)
+	}
+
+	return sanitizedMap
+}
+
 // sanitizeMapForProtobuf recursively sanitizes string values in a map
 // to ensure they contain only valid UTF-8 characters
 func sanitizeMapForProtobuf(m map[string]interface{}) map[string]interface{} {
@@ -1203,6 +1242,63 @@ func convertProtoHttp(v *trace.ProtoHTTP) (*pb.EventValue, error) {
 	}, nil
 }
 
+// TLS protocol converters
+
+func convertUint16ArrayToUint32(arr []uint16) []uint32 {
+	if arr == nil {
+		return nil
+	}
+	res := make([]uint32, len(arr))
+	for i, v := range arr {
+		res[i] = uint32(v)
+	}
+	return res
+}
+
+func convertProtoTLSClientHello(v *trace.ProtoTLSClientHello) (*pb.EventValue, error) {
+	return &pb.EventValue{
+		Value: &pb.EventValue_TlsClientHello{
+			TlsClientHello: &pb.TLSClientHello{
+				Version:           v.Version,
+				SupportedVersions: v.SupportedVersions,
+				CipherSuites:      v.CipherSuites,
+				Extensions:        convertUint16ArrayToUint32(v.Extensions),
+				ServerName:        sanitizeStringForProtobuf(v.ServerName),
+				Alpn:              sanitizeStringArrayForProtobuf(v.ALPN),
+				Ja3:               v.JA3,
+				Ja3Hash:           v.JA3Hash,
+				Ja4:               v.JA4,
+			},
+		},
+	}, nil
+}
+
+func convertProtoTLSServerHello(v *trace.ProtoTLSServerHello) (*pb.EventValue, error) {
+	certificates := make([]*pb.TLSCertificate, len(v.Certificates))
+	for i, c := range v.Certificates {
+		certificates[i] = &pb.TLSCertificate{
+			Subject:  sanitizeStringForProtobuf(c.Subject),
+			Issuer:   sanitizeStringForProtobuf(c.Issuer),
+			DnsNames: sanitizeStringArrayForProtobuf(c.DNSNames),
+		}
+	}
+
+	return &pb.EventValue{
+		Value: &pb.EventValue_TlsServerHello{
+			TlsServerHello: &pb.TLSServerHello{
+				Version:      v.Version,
+				CipherSuite:  v.CipherSuite,
+				Extensions:   convertUint16ArrayToUint32(v.Extensions),
+				Alpn:         sanitizeStringForProtobuf(v.ALPN),
+				Ja3S:         v.JA3S,
+				Ja3SHash:     v.JA3SHash,
+				Ja4S:         v.JA4S,
+				Certificates: certificates,
+			},
+		},
+	}, nil
+}
+
 func convertToStruct(arg trace.Argument) (*pb.EventValue, error) {
 	i, ok := arg.Value.(detect.FindingDataStruct)
 	if !ok {
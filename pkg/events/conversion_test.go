@@ -297,6 +297,48 @@ func TestConvertToProto_EventData_HTTP(t *testing.T) {
 	assert.NotEmpty(t, httpReq.Headers)
 }
 
+func TestConvertToProto_EventData_TLS(t *testing.T) {
+	t.Parallel()
+
+	// Use a non-syscall event ID
+	e := trace.Event{
+		EventID:   2029,
+		EventName: "net_packet_tls_server_hello",
+		Args: []trace.Argument{
+			{
+				ArgMeta: trace.ArgMeta{Name: "tls_server_hello"},
+				Value: trace.ProtoTLSServerHello{
+					Version:     "TLS 1.2",
+					CipherSuite: "TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256",
+					Extensions:  []uint16{65281, 16},
+					ALPN:        "h2",
+					JA3S:        "771,49199,65281-16",
+					JA4S:        "t1202h2_c02f_3d3ad0e0a4e1",
+					Certificates: []trace.ProtoTLSCertificate{
+						{Subject: "CN=example.com", Issuer: "CN=Example CA", DNSNames: []string{"example.com"}},
+					},
+				},
+			},
+		},
+	}
+
+	protoEvent := ConvertToProto(&e)
+
+	require.NotNil(t, protoEvent.Data)
+	assert.Len(t, protoEvent.Data, 1)
+
+	assert.Equal(t, "tls_server_hello", protoEvent.Data[0].Name)
+	hello := protoEvent.Data[0].GetTlsServerHello()
+	require.NotNil(t, hello)
+	assert.Equal(t, "TLS 1.2", hello.Version)
+	assert.Equal(t, []uint32{65281, 16}, hello.Extensions)
+	assert.Equal(t, "h2", hello.Alpn)
+	assert.Equal(t, "771,49199,65281-16", hello.Ja3S)
+	require.Len(t, hello.Certificates, 1)
+	assert.Equal(t, "CN=example.com", hello.Certificates[0].Subject)
+	assert.Equal(t, []string{"example.com"}, hello.Certificates[0].DnsNames)
+}
+
 func TestConvertToProto_EventData_HookedSyscalls(t *testing.T) {
 	t.Parallel()
 
@@ -399,6 +441,51 @@ func TestConvertFromProto_Container(t *testing.T) {
 	assert.Equal(t, "sha256:abcdef123456", traceEvent.Container.ImageDigest)
 }
 
+func TestConvertToProto_ContainerRuntimeMetadata(t *testing.T) {
+	t.Parallel()
+
+	labels := map[string]string{"app": "web", "invalid": "a\xffb"}
+	capabilities := []string{"CAP_SYS_ADMIN"}
+	e := trace.Event{
+		EventID:     1,
+		EventName:   "test_event",
+		ContainerID: "abc123",
+		Container: trace.Container{
+			ID:                "abc123",
+			Labels:            labels,
+			Privileged:        true,
+			AddedCapabilities: capabilities,
+			HostNetwork:       true,
+			HostIPC:           true,
+		},
+	}
+
+	protoEvent := ConvertToProto(&e)
+
+	require.NotNil(t, protoEvent.Workload)
+	c := protoEvent.Workload.Container
+	require.NotNil(t, c)
+	assert.Equal(t, map[string]string{"app": "web", "invalid": "ab"}, c.Labels)
+	assert.True(t, c.Privileged)
+	assert.Equal(t, []string{"CAP_SYS_ADMIN"}, c.AddedCapabilities)
+	assert.True(t, c.HostNetwork)
+	assert.False(t, c.HostPid)
+	assert.True(t, c.HostIpc)
+
+	// The container store values are shared by the events, they are left untouched
+	assert.Equal(t, "a\xffb", labels["invalid"])
+	c.AddedCapabilities[0] = "CAP_NET_ADMIN"
+	assert.Equal(t, []string{"CAP_SYS_ADMIN"}, capabilities)
+
+	// And back
+	traceEvent := ConvertFromProto(protoEvent)
+	assert.Equal(t, map[string]string{"app": "web", "invalid": "ab"}, traceEvent.Container.Labels)
+	assert.True(t, traceEvent.Container.Privileged)
+	assert.Equal(t, []string{"CAP_NET_ADMIN"}, traceEvent.Container.AddedCapabilities)
+	assert.True(t, traceEvent.Container.HostNetwork)
+	assert.True(t, traceEvent.Container.HostIPC)
+}
+
 func TestConvertFromProto_EventData(t *testing.T) {
 	t.Parallel()
 
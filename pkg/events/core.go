@@ -91,8 +91,10 @@ const (
 	NetPacketICMPv6Base
 	NetPacketDNSBase
 	NetPacketHTTPBase
+	NetPacketTLSBase
 	NetPacketCapture
 	NetPacketFlowBase
+	NetPacketFlowConnlessBase
 	MaxNetID // network base events go ABOVE this item
 
 	SysEnter
@@ -194,8 +196,14 @@ const (
 	NetPacketHTTP
 	NetPacketHTTPRequest
 	NetPacketHTTPResponse
+	NetPacketTLSClientHello
+	NetPacketTLSServerHello
 	NetFlowTCPBegin
 	NetFlowTCPEnd
+	NetFlowUDPBegin
+	NetFlowUDPEnd
+	NetFlowICMPBegin
+	NetFlowICMPEnd
 	MaxUserNetID
 
 	NetTCPConnect
@@ -210,6 +218,7 @@ const (
 	HiddenKernelModule
 	FtraceHook
 	TraceeInfo
+	PolicyAction
 	// MaxUserSpaceID (2999)
 )
 
@@ -13459,6 +13468,23 @@ var CoreEvents = map[ID]Definition{
 			{DecodeAs: data.STR_T, ArgMeta: trace.ArgMeta{Type: "string", Name: "version"}},
 		},
 	},
+	PolicyAction: {
+		id:      PolicyAction,
+		id32Bit: Sys32Undefined,
+		name:    "policy_action",
+		version: NewVersion(1, 0, 0),
+		sets:    []string{},
+		dependencies: DependencyStrategy{
+			primary: Dependencies{},
+		},
+		fields: []DataField{
+			{DecodeAs: data.STR_T, ArgMeta: trace.ArgMeta{Type: "string", Name: "action"}},
+			{DecodeAs: data.STR_T, ArgMeta: trace.ArgMeta{Type: "string", Name: "trigger_event"}},
+			{DecodeAs: data.STR_T, ArgMeta: trace.ArgMeta{Type: "string", Name: "result"}},
+			{DecodeAs: data.STR_T, ArgMeta: trace.ArgMeta{Type: "string", Name: "error"}},
+			{DecodeAs: data.STR_T, ArgMeta: trace.ArgMeta{Type: "string", Name: "artifact"}},
+		},
+	},
 	SocketDup: {
 		id:      SocketDup,
 		id32Bit: Sys32Undefined,
@@ -13945,6 +13971,12 @@ var CoreEvents = map[ID]Definition{
 				},
 			},
 		},
+		fields: []DataField{
+			{ArgMeta: trace.ArgMeta{Type: "string", Name: "path"}},
+			{ArgMeta: trace.ArgMeta{Type: "string", Name: "uri"}},
+			{ArgMeta: trace.ArgMeta{Type: "string", Name: "sha256"}},
+			{ArgMeta: trace.ArgMeta{Type: "uint64", Name: "size"}},
+		},
 	},
 	CaptureFileRead: {
 		id:       CaptureFileRead,
@@ -13971,6 +14003,12 @@ var CoreEvents = map[ID]Definition{
 				},
 			},
 		},
+		fields: []DataField{
+			{ArgMeta: trace.ArgMeta{Type: "string", Name: "path"}},
+			{ArgMeta: trace.ArgMeta{Type: "string", Name: "uri"}},
+			{ArgMeta: trace.ArgMeta{Type: "string", Name: "sha256"}},
+			{ArgMeta: trace.ArgMeta{Type: "uint64", Name: "size"}},
+		},
 	},
 	CaptureExec: {
 		id:       CaptureExec,
@@ -13990,6 +14028,12 @@ var CoreEvents = map[ID]Definition{
 				},
 			},
 		},
+		fields: []DataField{
+			{ArgMeta: trace.ArgMeta{Type: "string", Name: "path"}},
+			{ArgMeta: trace.ArgMeta{Type: "string", Name: "uri"}},
+			{ArgMeta: trace.ArgMeta{Type: "string", Name: "sha256"}},
+			{ArgMeta: trace.ArgMeta{Type: "uint64", Name: "size"}},
+		},
 	},
 	CaptureModule: {
 		id:       CaptureModule,
@@ -14014,6 +14058,12 @@ var CoreEvents = map[ID]Definition{
 				},
 			},
 		},
+		fields: []DataField{
+			{ArgMeta: trace.ArgMeta{Type: "string", Name: "path"}},
+			{ArgMeta: trace.ArgMeta{Type: "string", Name: "uri"}},
+			{ArgMeta: trace.ArgMeta{Type: "string", Name: "sha256"}},
+			{ArgMeta: trace.ArgMeta{Type: "uint64", Name: "size"}},
+		},
 	},
 	CaptureMem: {
 		id:       CaptureMem,
@@ -14031,6 +14081,12 @@ var CoreEvents = map[ID]Definition{
 				},
 			},
 		},
+		fields: []DataField{
+			{ArgMeta: trace.ArgMeta{Type: "string", Name: "path"}},
+			{ArgMeta: trace.ArgMeta{Type: "string", Name: "uri"}},
+			{ArgMeta: trace.ArgMeta{Type: "string", Name: "sha256"}},
+			{ArgMeta: trace.ArgMeta{Type: "uint64", Name: "size"}},
+		},
 	},
 	CaptureBpf: {
 		id:       CaptureBpf,
@@ -14048,6 +14104,25 @@ var CoreEvents = map[ID]Definition{
 				},
 			},
 		},
+		fields: []DataField{
+			{ArgMeta: trace.ArgMeta{Type: "string", Name: "path"}},
+			{ArgMeta: trace.ArgMeta{Type: "string", Name: "uri"}},
+			{ArgMeta: trace.ArgMeta{Type: "string", Name: "sha256"}},
+			{ArgMeta: trace.ArgMeta{Type: "uint64", Name: "size"}},
+		},
+	},
+	CapturePcap: {
+		id:       CapturePcap, // Pseudo Event: pcap files are written by capture_net_packet
+		id32Bit:  Sys32Undefined,
+		name:     "capture_pcap",
+		version:  NewVersion(1, 0, 0),
+		internal: true,
+		fields: []DataField{
+			{ArgMeta: trace.ArgMeta{Type: "string", Name: "path"}},
+			{ArgMeta: trace.ArgMeta{Type: "string", Name: "uri"}},
+			{ArgMeta: trace.ArgMeta{Type: "string", Name: "sha256"}},
+			{ArgMeta: trace.ArgMeta{Type: "uint64", Name: "size"}},
+		},
 	},
 	DoInitModule: {
 		id:      DoInitModule,
@@ -15462,6 +15537,69 @@ var CoreEvents = map[ID]Definition{
 			{ArgMeta: trace.ArgMeta{Type: "trace.ProtoHTTPResponse", Name: "http_response"}},
 		},
 	},
+	NetPacketTLSBase: {
+		id:       NetPacketTLSBase,
+		id32Bit:  Sys32Undefined,
+		name:     "net_packet_tls_base",
+		version:  NewVersion(1, 0, 0),
+		internal: true,
+		dependencies: DependencyStrategy{
+			primary: Dependencies{
+				ids: []ID{
+					NetPacketBase,
+				},
+			},
+		},
+		sets: []string{"network_events"},
+		fields: []DataField{
+			{DecodeAs: data.LONG_T, ArgMeta: trace.ArgMeta{Type: "int64", Name: "flags"}},
+			{DecodeAs: data.BYTES_T, ArgMeta: trace.ArgMeta{Type: "[]byte", Name: "payload"}},
+		},
+	},
+	NetPacketTLSClientHello: {
+		id:      NetPacketTLSClientHello,
+		id32Bit: Sys32Undefined,
+		name:    "net_packet_tls_client_hello",
+		version: NewVersion(1, 0, 0),
+		dependencies: DependencyStrategy{
+			primary: Dependencies{
+				ids: []ID{
+					NetPacketTLSBase,
+				},
+			},
+		},
+		sets: []string{"network_events"},
+		fields: []DataField{
+			{ArgMeta: trace.ArgMeta{Name: "src"}},      // TODO: pack and remove into trace.PacketMetadata after it supports filtering
+			{ArgMeta: trace.ArgMeta{Name: "dst"}},      // TODO: pack and remove into trace.PacketMetadata after it supports filtering
+			{ArgMeta: trace.ArgMeta{Name: "src_port"}}, // TODO: pack and remove into trace.PacketMetadata after it supports filtering
+			{ArgMeta: trace.ArgMeta{Name: "dst_port"}}, // TODO: pack and remove into trace.PacketMetadata after it supports filtering
+			{ArgMeta: trace.ArgMeta{Type: "trace.PacketMetadata", Name: "metadata"}},
+			{ArgMeta: trace.ArgMeta{Type: "trace.ProtoTLSClientHello", Name: "tls_client_hello"}},
+		},
+	},
+	NetPacketTLSServerHello: {
+		id:      NetPacketTLSServerHello,
+		id32Bit: Sys32Undefined,
+		name:    "net_packet_tls_server_hello",
+		version: NewVersion(1, 0, 0),
+		dependencies: DependencyStrategy{
+			primary: Dependencies{
+				ids: []ID{
+					NetPacketTLSBase,
+				},
+			},
+		},
+		sets: []string{"network_events"},
+		fields: []DataField{
+			{ArgMeta: trace.ArgMeta{Name: "src"}},      // TODO: pack and remove into trace.PacketMetadata after it supports filtering
+			{ArgMeta: trace.ArgMeta{Name: "dst"}},      // TODO: pack and remove into trace.PacketMetadata after it supports filtering
+			{ArgMeta: trace.ArgMeta{Name: "src_port"}}, // TODO: pack and remove into trace.PacketMetadata after it supports filtering
+			{ArgMeta: trace.ArgMeta{Name: "dst_port"}}, // TODO: pack and remove into trace.PacketMetadata after it supports filtering
+			{ArgMeta: trace.ArgMeta{Type: "trace.PacketMetadata", Name: "metadata"}},
+			{ArgMeta: trace.ArgMeta{Type: "trace.ProtoTLSServerHello", Name: "tls_server_hello"}},
+		},
+	},
 	NetPacketCapture: {
 		id:       NetPacketCapture, // Packets with full payload (sent in a dedicated perfbuffer)
 		id32Bit:  Sys32Undefined,
@@ -15513,6 +15651,25 @@ var CoreEvents = map[ID]Definition{
 			{DecodeAs: data.BYTES_T, ArgMeta: trace.ArgMeta{Type: "[]byte", Name: "payload"}},
 		},
 	},
+	NetPacketFlowConnlessBase: {
+		id:       NetPacketFlowConnlessBase,
+		id32Bit:  Sys32Undefined,
+		name:     "net_packet_flow_connless_base",
+		version:  NewVersion(1, 0, 0),
+		internal: true,
+		dependencies: DependencyStrategy{
+			primary: Dependencies{
+				ids: []ID{
+					NetPacketBase,
+				},
+			},
+		},
+		sets: []string{"network_events"},
+		fields: []DataField{
+			{DecodeAs: data.LONG_T, ArgMeta: trace.ArgMeta{Type: "int64", Name: "flags"}},
+			{DecodeAs: data.BYTES_T, ArgMeta: trace.ArgMeta{Type: "[]byte", Name: "payload"}},
+		},
+	},
 	NetFlowTCPBegin: {
 		id:      NetFlowTCPBegin,
 		id32Bit: Sys32Undefined,
@@ -15559,6 +15716,102 @@ var CoreEvents = map[ID]Definition{
 			{ArgMeta: trace.ArgMeta{Type: "[]string", Name: "dst_dns"}},
 		},
 	},
+	NetFlowUDPBegin: {
+		id:      NetFlowUDPBegin,
+		id32Bit: Sys32Undefined,
+		name:    "net_flow_udp_begin",
+		version: NewVersion(1, 0, 0),
+		dependencies: DependencyStrategy{
+			primary: Dependencies{
+				ids: []ID{
+					NetPacketFlowConnlessBase,
+				},
+			},
+		},
+		sets: []string{"network_events", "flows"},
+		fields: []DataField{
+			{ArgMeta: trace.ArgMeta{Type: "string", Name: "conn_direction"}},
+			{ArgMeta: trace.ArgMeta{Type: "string", Name: "src"}},
+			{ArgMeta: trace.ArgMeta{Type: "string", Name: "dst"}},
+			{ArgMeta: trace.ArgMeta{Type: "uint16", Name: "src_port"}},
+			{ArgMeta: trace.ArgMeta{Type: "uint16", Name: "dst_port"}},
+			{ArgMeta: trace.ArgMeta{Type: "[]string", Name: "src_dns"}},
+			{ArgMeta: trace.ArgMeta{Type: "[]string", Name: "dst_dns"}},
+		},
+	},
+	NetFlowUDPEnd: {
+		id:      NetFlowUDPEnd,
+		id32Bit: Sys32Undefined,
+		name:    "net_flow_udp_end",
+		version: NewVersion(1, 0, 0),
+		dependencies: DependencyStrategy{
+			primary: Dependencies{
+				ids: []ID{
+					NetPacketFlowConnlessBase,
+				},
+			},
+		},
+		sets: []string{"network_events", "flows"},
+		fields: []DataField{
+			{ArgMeta: trace.ArgMeta{Type: "string", Name: "conn_direction"}},
+			{ArgMeta: trace.ArgMeta{Type: "string", Name: "src"}},
+			{ArgMeta: trace.ArgMeta{Type: "string", Name: "dst"}},
+			{ArgMeta: trace.ArgMeta{Type: "uint16", Name: "src_port"}},
+			{ArgMeta: trace.ArgMeta{Type: "uint16", Name: "dst_port"}},
+			{ArgMeta: trace.ArgMeta{Type: "[]string", Name: "src_dns"}},
+			{ArgMeta: trace.ArgMeta{Type: "[]string", Name: "dst_dns"}},
+			{ArgMeta: trace.ArgMeta{Type: "uint64", Name: "src_packets"}},
+			{ArgMeta: trace.ArgMeta{Type: "uint64", Name: "src_bytes"}},
+			{ArgMeta: trace.ArgMeta{Type: "uint64", Name: "dst_packets"}},
+			{ArgMeta: trace.ArgMeta{Type: "uint64", Name: "dst_bytes"}},
+		},
+	},
+	NetFlowICMPBegin: {
+		id:      NetFlowICMPBegin,
+		id32Bit: Sys32Undefined,
+		name:    "net_flow_icmp_begin",
+		version: NewVersion(1, 0, 0),
+		dependencies: DependencyStrategy{
+			primary: Dependencies{
+				ids: []ID{
+					NetPacketFlowConnlessBase,
+				},
+			},
+		},
+		sets: []string{"network_events", "flows"},
+		fields: []DataField{
+			{ArgMeta: trace.ArgMeta{Type: "string", Name: "conn_direction"}},
+			{ArgMeta: trace.ArgMeta{Type: "string", Name: "src"}},
+			{ArgMeta: trace.ArgMeta{Type: "string", Name: "dst"}},
+			{ArgMeta: trace.ArgMeta{Type: "[]string", Name: "src_dns"}},
+			{ArgMeta: trace.ArgMeta{Type: "[]string", Name: "dst_dns"}},
+		},
+	},
+	NetFlowICMPEnd: {
+		id:      NetFlowICMPEnd,
+		id32Bit: Sys32Undefined,
+		name:    "net_flow_icmp_end",
+		version: NewVersion(1, 0, 0),
+		dependencies: DependencyStrategy{
+			primary: Dependencies{
+				ids: []ID{
+					NetPacketFlowConnlessBase,
+				},
+			},
+		},
+		sets: []string{"network_events", "flows"},
+		fields: []DataField{
+			{ArgMeta: trace.ArgMeta{Type: "string", Name: "conn_direction"}},
+			{ArgMeta: trace.ArgMeta{Type: "string", Name: "src"}},
+			{ArgMeta: trace.ArgMeta{Type: "string", Name: "dst"}},
+			{ArgMeta: trace.ArgMeta{Type: "[]string", Name: "src_dns"}},
+			{ArgMeta: trace.ArgMeta{Type: "[]string", Name: "dst_dns"}},
+			{ArgMeta: trace.ArgMeta{Type: "uint64", Name: "src_packets"}},
+			{ArgMeta: trace.ArgMeta{Type: "uint64", Name: "src_bytes"}},
+			{ArgMeta: trace.ArgMeta{Type: "uint64", Name: "dst_packets"}},
+			{ArgMeta: trace.ArgMeta{Type: "uint64", Name: "dst_bytes"}},
+		},
+	},
 
 	// Test Events
 	ExecTest: {
@@ -5,6 +5,7 @@ import (
 	"sort"
 	"sync"
 
+	"github.com/aquasecurity/tracee/common/bitwise"
 	"github.com/aquasecurity/tracee/common/errfmt"
 	"github.com/aquasecurity/tracee/common/logger"
 	"github.com/aquasecurity/tracee/pkg/events/parse"
@@ -13,8 +14,8 @@ import (
 // TODO: add states to the EventGroup struct (to keep states of events from that group)
 
 type EventState struct {
-	Submit uint64 // should be submitted to userspace (by policies bitmap)
-	Emit   uint64 // should be emitted to the user (by policies bitmap)
+	Submit bitwise.Bitmap // should be submitted to userspace (by policies bitmap)
+	Emit   bitwise.Bitmap // should be emitted to the user (by policies bitmap)
 }
 
 // ATTENTION: the definition group is instantiable (all the rest is immutable)
@@ -168,6 +169,15 @@ func (d *DefinitionGroup) AddBatch(givenDefs map[ID]Definition) error {
 	return nil
 }
 
+// Remove removes a definition from the definition group. Only definitions not
+// used by any policy can be removed, e.g. after failing to load a detector.
+func (d *DefinitionGroup) Remove(givenId ID) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	delete(d.definitions, givenId)
+}
+
 // add adds a definition to the definition group (no locking).
 func (d *DefinitionGroup) add(givenId ID, givenDef Definition) error {
 	if _, ok := d.definitions[givenId]; ok {
@@ -61,6 +61,26 @@ func TestDefinitionGroup_AddBatch(t *testing.T) {
 	require.True(t, ok, true)
 }
 
+// TestDefinitionGroup_Remove tests that Remove removes a definition, freeing its ID and name.
+func TestDefinitionGroup_Remove(t *testing.T) {
+	t.Parallel()
+
+	defGroup := NewDefinitionGroup()
+
+	id := ID(1)
+
+	def := NewDefinition(id, id+1000, "def", version, "", false, false, []string{}, DependencyStrategy{}, nil, nil)
+
+	err := defGroup.Add(id, def)
+	require.NoError(t, err)
+
+	defGroup.Remove(id)
+	require.False(t, defGroup.IsDefined(id))
+
+	err = defGroup.Add(id, def)
+	require.NoError(t, err)
+}
+
 // TestDefinitionGroup_GetDefinitionIDByName tests that GetDefinitionIDByName returns a definition ID by its name.
 func TestDefinitionGroup_GetDefinitionIDByName(t *testing.T) {
 	t.Parallel()
@@ -147,19 +147,3 @@ func NetFlowTCPEnd(cache *dns.DNSCache) DeriveFunction {
 		},
 	)
 }
-
-// func NetFlowUDPBegin(cache *dns.DNSCache) DeriveFunction {
-// 	return deriveSingleEvent(events.NetFlowUDPBegin,
-// 		func(event trace.Event) ([]interface{}, error) {
-// 			return nil, nil
-// 		},
-// 	)
-// }
-
-// func NetFlowUDPEnd(cache *dns.DNSCache) DeriveFunction {
-// 	return deriveSingleEvent(events.NetFlowUDPBegin,
-// 		func(event trace.Event) ([]interface{}, error) {
-// 			return nil, nil
-// 		},
-// 	)
-// }
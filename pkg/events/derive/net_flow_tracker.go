@@ -0,0 +1,351 @@
+package derive
+
+import (
+	"net"
+	"sync"
+	"time"
+
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	lru "github.com/hashicorp/golang-lru/v2"
+
+	"github.com/aquasecurity/tracee/common/counter"
+	"github.com/aquasecurity/tracee/common/errfmt"
+	"github.com/aquasecurity/tracee/common/logger"
+	"github.com/aquasecurity/tracee/pkg/datastores/dns"
+	"github.com/aquasecurity/tracee/pkg/events"
+	"github.com/aquasecurity/tracee/types/trace"
+)
+
+const (
+	// UDPFlowTimeout is the idle time after which a UDP flow ends
+	UDPFlowTimeout = 60 * time.Second
+	// ICMPFlowTimeout is the idle time after which an ICMP flow ends
+	ICMPFlowTimeout = 30 * time.Second
+	// maxTrackedFlows bounds the flows kept by each tracker, the least recently seen
+	// flow is ended when a new flow exceeds it
+	maxTrackedFlows = 65536
+)
+
+// flowKey identifies a connectionless flow, with its endpoints ordered so both
+// directions of the flow share the key. ICMP flows use the echo identifier as the
+// port of both endpoints.
+type flowKey struct {
+	lowIP    [16]byte
+	highIP   [16]byte
+	lowPort  uint16
+	highPort uint16
+}
+
+// flowState is the state of a flow, oriented from its initiator (src): the endpoint
+// which sent the first packet seen.
+type flowState struct {
+	context    trace.Event // the first packet event, without its arguments
+	direction  string
+	src        net.IP
+	dst        net.IP
+	srcPort    uint16
+	dstPort    uint16
+	srcPackets uint64
+	srcBytes   uint64
+	dstPackets uint64
+	dstBytes   uint64
+	lastSeen   int // timestamp of the last packet, in epoch ns
+}
+
+// FlowTracker derives the begin and end events of connectionless (UDP or ICMP)
+// flows. A flow begins with its first packet and ends once idle for the tracker
+// timeout, reporting the packets and bytes sent by each side.
+type FlowTracker struct {
+	mutex        sync.Mutex
+	icmp         bool
+	timeout      time.Duration
+	maxFlows     int
+	flows        *lru.Cache[flowKey, *flowState]
+	cache        *dns.DNSCache
+	begin        deriveBase
+	end          deriveBase
+	beginEnabled func() bool
+	endEnabled   func() bool
+	active       *counter.Counter
+	evicted      *counter.Counter
+}
+
+// InitUDPFlowTracker initializes a tracker of UDP flows, deriving the net_flow_udp_begin
+// and net_flow_udp_end events if enabled. The active and evicted counters, if given,
+// count the flows being tracked and the flows ended for exceeding the tracker bound.
+func InitUDPFlowTracker(
+	cache *dns.DNSCache, beginEnabled, endEnabled func() bool, active, evicted *counter.Counter,
+) (*FlowTracker, error) {
+	return initFlowTracker(false, UDPFlowTimeout, maxTrackedFlows, cache,
+		events.NetFlowUDPBegin, events.NetFlowUDPEnd, beginEnabled, endEnabled, active, evicted)
+}
+
+// InitICMPFlowTracker initializes a tracker of ICMP and ICMPv6 flows, deriving the
+// net_flow_icmp_begin and net_flow_icmp_end events if enabled.
+func InitICMPFlowTracker(
+	cache *dns.DNSCache, beginEnabled, endEnabled func() bool, active, evicted *counter.Counter,
+) (*FlowTracker, error) {
+	return initFlowTracker(true, ICMPFlowTimeout, maxTrackedFlows, cache,
+		events.NetFlowICMPBegin, events.NetFlowICMPEnd, beginEnabled, endEnabled, active, evicted)
+}
+
+func initFlowTracker(
+	icmp bool, timeout time.Duration, maxFlows int, cache *dns.DNSCache, begin, end events.ID,
+	beginEnabled, endEnabled func() bool, active, evicted *counter.Counter,
+) (*FlowTracker, error) {
+	// The bound is enforced before adding a flow, so the cache never evicts on its own
+	flows, err := lru.New[flowKey, *flowState](maxFlows + 1)
+	if err != nil {
+		return nil, errfmt.WrapError(err)
+	}
+	if active == nil {
+		active = counter.NewCounter(0)
+	}
+	if evicted == nil {
+		evicted = counter.NewCounter(0)
+	}
+
+	return &FlowTracker{
+		icmp:         icmp,
+		timeout:      timeout,
+		maxFlows:     maxFlows,
+		flows:        flows,
+		cache:        cache,
+		begin:        makeDeriveBase(begin),
+		end:          makeDeriveBase(end),
+		beginEnabled: beginEnabled,
+		endEnabled:   endEnabled,
+		active:       active,
+		evicted:      evicted,
+	}, nil
+}
+
+// NetFlowConnless returns the DeriveFunction of the tracker. It accounts every
+// net_packet_flow_connless_base packet of the tracker protocol, returning the begin
+// event of a new flow and the end event of the flow evicted to make room for it.
+func (t *FlowTracker) NetFlowConnless() DeriveFunction {
+	return func(event *trace.Event) ([]trace.Event, []error) {
+		derivedEvents, err := t.track(event)
+		if err != nil {
+			return derivedEvents, []error{err}
+		}
+		return derivedEvents, nil
+	}
+}
+
+// Expire ends the flows idle for longer than the tracker timeout at the given time,
+// returning their end events.
+func (t *FlowTracker) Expire(now time.Time) []trace.Event {
+	deadline := int(now.Add(-t.timeout).UnixNano())
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	var derivedEvents []trace.Event
+	for {
+		// Flows are refreshed by every packet, so the oldest flow is the least recently seen
+		key, state, ok := t.flows.GetOldest()
+		if !ok || state.lastSeen > deadline {
+			break
+		}
+		derivedEvents = t.appendEnd(derivedEvents, key, state, int(now.UnixNano()))
+	}
+
+	return derivedEvents
+}
+
+// Flush ends all the tracked flows, returning their end events.
+func (t *FlowTracker) Flush(now time.Time) []trace.Event {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	var derivedEvents []trace.Event
+	for {
+		key, state, ok := t.flows.GetOldest()
+		if !ok {
+			break
+		}
+		derivedEvents = t.appendEnd(derivedEvents, key, state, int(now.UnixNano()))
+	}
+
+	return derivedEvents
+}
+
+// track accounts a packet in its flow, starting the flow if needed.
+func (t *FlowTracker) track(event *trace.Event) ([]trace.Event, error) {
+	packetDirection := getPacketDirection(event)
+
+	// Sanity check
+	if packetDirection == trace.InvalidPacketDirection {
+		logger.Debugw("wrong flow direction", "id", event.EventID)
+		return nil, nil
+	}
+
+	// Get the packet from the event
+	packet, err := createPacketFromEvent(event)
+	if err != nil {
+		return nil, err
+	}
+	srcPort, dstPort, ok := t.getFlowPorts(packet)
+	if !ok {
+		return nil, nil // not a packet of the tracker protocol
+	}
+	srcIP, dstIP, err := getLayer3SrcDstFromPacket(packet)
+	if err != nil {
+		return nil, err
+	}
+	length, err := getLengthFromPacket(packet)
+	if err != nil {
+		return nil, err
+	}
+	if packet.NetworkLayer().LayerType() == layers.LayerTypeIPv6 {
+		length += 40 // the IPv6 length doesn't include the fixed header
+	}
+
+	key := makeFlowKey(srcIP, dstIP, srcPort, dstPort)
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	state, found := t.flows.Get(key)
+	if found {
+		// The packet src is the flow initiator if it is the same endpoint
+		if state.src.Equal(srcIP) && state.srcPort == srcPort {
+			state.srcPackets++
+			state.srcBytes += uint64(length)
+		} else {
+			state.dstPackets++
+			state.dstBytes += uint64(length)
+		}
+		if event.Timestamp > state.lastSeen {
+			state.lastSeen = event.Timestamp
+		}
+		return nil, nil
+	}
+
+	var derivedEvents []trace.Event
+
+	// Make room for the new flow ending the least recently seen one
+	if t.flows.Len() >= t.maxFlows {
+		if oldestKey, oldest, ok := t.flows.GetOldest(); ok {
+			derivedEvents = t.appendEnd(derivedEvents, oldestKey, oldest, event.Timestamp)
+			_ = t.evicted.Increment()
+		}
+	}
+
+	state = &flowState{
+		context:    *event, // shallow copy, the packet event is reused by the pipeline
+		direction:  directionIncoming,
+		src:        append(net.IP(nil), srcIP...),
+		dst:        append(net.IP(nil), dstIP...),
+		srcPort:    srcPort,
+		dstPort:    dstPort,
+		srcPackets: 1,
+		srcBytes:   uint64(length),
+		lastSeen:   event.Timestamp,
+	}
+	state.context.Args = nil
+	state.context.StackAddresses = nil
+	state.context.MatchedPolicies = nil
+	if packetDirection == trace.PacketEgress {
+		state.direction = directionOutgoing // this host sent the first packet
+	}
+	t.flows.Add(key, state)
+	_ = t.active.Increment()
+
+	if t.beginEnabled() {
+		args := []interface{}{state.direction, state.src, state.dst}
+		if !t.icmp {
+			args = append(args, state.srcPort, state.dstPort)
+		}
+		args = append(args,
+			getDomainsFromCache(state.src, t.cache),
+			getDomainsFromCache(state.dst, t.cache),
+		)
+		derivedEvent, err := buildDerivedEvent(event, t.begin, args)
+		if err != nil {
+			return derivedEvents, err
+		}
+		derivedEvents = append(derivedEvents, derivedEvent)
+	}
+
+	return derivedEvents, nil
+}
+
+// appendEnd removes a flow, appending its end event if enabled. Must be called with
+// the tracker mutex held.
+func (t *FlowTracker) appendEnd(derivedEvents []trace.Event, key flowKey, state *flowState, timestamp int) []trace.Event {
+	t.flows.Remove(key)
+	_ = t.active.Decrement()
+
+	if !t.endEnabled() {
+		return derivedEvents
+	}
+
+	args := []interface{}{state.direction, state.src, state.dst}
+	if !t.icmp {
+		args = append(args, state.srcPort, state.dstPort)
+	}
+	args = append(args,
+		getDomainsFromCache(state.src, t.cache),
+		getDomainsFromCache(state.dst, t.cache),
+		state.srcPackets,
+		state.srcBytes,
+		state.dstPackets,
+		state.dstBytes,
+	)
+	derivedEvent, err := buildDerivedEvent(&state.context, t.end, args)
+	if err != nil {
+		logger.Debugw("failed to derive flow end", "event", t.end.Name, "error", err)
+		return derivedEvents
+	}
+	derivedEvent.Timestamp = timestamp
+
+	return append(derivedEvents, derivedEvent)
+}
+
+// revive:disable:confusing-results
+
+// getFlowPorts returns the ports identifying the flow of a packet of the tracker
+// protocol: the UDP ports, or the ICMP echo identifier (0 for the other messages).
+func (t *FlowTracker) getFlowPorts(packet gopacket.Packet) (uint16, uint16, bool) {
+	if !t.icmp {
+		udp, err := getLayer4UDPFromPacket(packet)
+		if err != nil {
+			return 0, 0, false
+		}
+		return uint16(udp.SrcPort), uint16(udp.DstPort), true
+	}
+
+	if icmp, err := getLayerICMPFromPacket(packet); err == nil {
+		switch icmp.TypeCode.Type() {
+		case layers.ICMPv4TypeEchoRequest, layers.ICMPv4TypeEchoReply:
+			return icmp.Id, icmp.Id, true
+		}
+		return 0, 0, true
+	}
+	if _, err := getLayerICMPv6FromPacket(packet); err == nil {
+		if echo, ok := packet.Layer(layers.LayerTypeICMPv6Echo).(*layers.ICMPv6Echo); ok {
+			return echo.Identifier, echo.Identifier, true
+		}
+		return 0, 0, true
+	}
+
+	return 0, 0, false
+}
+
+// revive:enable:confusing-results
+
+// makeFlowKey returns the key of the flow between two endpoints.
+func makeFlowKey(srcIP, dstIP net.IP, srcPort, dstPort uint16) flowKey {
+	var src, dst [16]byte
+	copy(src[:], srcIP.To16())
+	copy(dst[:], dstIP.To16())
+
+	if string(src[:]) < string(dst[:]) || (src == dst && srcPort <= dstPort) {
+		return flowKey{lowIP: src, highIP: dst, lowPort: srcPort, highPort: dstPort}
+	}
+
+	return flowKey{lowIP: dst, highIP: src, lowPort: dstPort, highPort: srcPort}
+}
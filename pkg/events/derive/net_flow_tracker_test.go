@@ -0,0 +1,228 @@
+package derive
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aquasecurity/tracee/common/counter"
+	"github.com/aquasecurity/tracee/pkg/events"
+	"github.com/aquasecurity/tracee/types/trace"
+)
+
+var (
+	testFlowLocalIP  = net.IPv4(10, 0, 0, 1).To4()
+	testFlowRemoteIP = net.IPv4(8, 8, 8, 8).To4()
+)
+
+// testFlowEvent returns a net_packet_flow_connless_base event of an IPv4 packet
+func testFlowEvent(t *testing.T, timestamp int, egress bool, src, dst net.IP, l4 gopacket.SerializableLayer, payload []byte) *trace.Event {
+	protocol := layers.IPProtocolUDP
+	if _, ok := l4.(*layers.ICMPv4); ok {
+		protocol = layers.IPProtocolICMPv4
+	}
+	ip := &layers.IPv4{Version: 4, TTL: 64, Protocol: protocol, SrcIP: src, DstIP: dst}
+	if udp, ok := l4.(*layers.UDP); ok {
+		require.NoError(t, udp.SetNetworkLayerForChecksum(ip))
+	}
+
+	buf := gopacket.NewSerializeBuffer()
+	opts := gopacket.SerializeOptions{FixLengths: true, ComputeChecksums: true}
+	require.NoError(t, gopacket.SerializeLayers(buf, opts, ip, l4, gopacket.Payload(payload)))
+
+	flags := familyIPv4 | packetIngress
+	if egress {
+		flags = familyIPv4 | packetEgress
+	}
+
+	return &trace.Event{
+		Timestamp:   timestamp,
+		EventID:     int(events.NetPacketFlowConnlessBase),
+		EventName:   "net_packet_flow_connless_base",
+		ProcessName: "dig",
+		ReturnValue: flags,
+		Args: []trace.Argument{
+			{ArgMeta: trace.ArgMeta{Name: "flags", Type: "int64"}, Value: int64(flags)},
+			{ArgMeta: trace.ArgMeta{Name: "payload", Type: "[]byte"}, Value: buf.Bytes()},
+		},
+	}
+}
+
+// testFlowTracker returns a tracker with both its events enabled
+func testFlowTracker(t *testing.T, icmp bool, maxFlows int, active, evicted *counter.Counter) *FlowTracker {
+	begin, end, timeout := events.NetFlowUDPBegin, events.NetFlowUDPEnd, UDPFlowTimeout
+	if icmp {
+		begin, end, timeout = events.NetFlowICMPBegin, events.NetFlowICMPEnd, ICMPFlowTimeout
+	}
+	enabled := func() bool { return true }
+
+	tracker, err := initFlowTracker(icmp, timeout, maxFlows, nil, begin, end, enabled, enabled, active, evicted)
+	require.NoError(t, err)
+
+	return tracker
+}
+
+func Test_FlowTracker_UDP(t *testing.T) {
+	t.Parallel()
+
+	active := counter.NewCounter(0)
+	tracker := testFlowTracker(t, false, maxTrackedFlows, active, nil)
+	derive := tracker.NetFlowConnless()
+	start := time.Unix(1000, 0)
+
+	// A query of 8 bytes (36 with the headers) and its answer of 32 bytes (60)
+	query := &layers.UDP{SrcPort: 40000, DstPort: 53}
+	answer := &layers.UDP{SrcPort: 53, DstPort: 40000}
+
+	derived, errs := derive(testFlowEvent(t, int(start.UnixNano()), true, testFlowLocalIP, testFlowRemoteIP, query, make([]byte, 8)))
+	require.Empty(t, errs)
+	require.Len(t, derived, 1)
+	assert.Equal(t, int(events.NetFlowUDPBegin), derived[0].EventID)
+	assert.Equal(t, "dig", derived[0].ProcessName)
+	assert.Equal(t, []interface{}{directionOutgoing, testFlowLocalIP, testFlowRemoteIP, uint16(40000), uint16(53)},
+		argValues(derived[0])[:5])
+	assert.Equal(t, uint64(1), active.Get())
+
+	// The following packets of the flow, in both directions, derive nothing
+	for i, packet := range []*trace.Event{
+		testFlowEvent(t, int(start.Add(time.Second).UnixNano()), false, testFlowRemoteIP, testFlowLocalIP, answer, make([]byte, 32)),
+		testFlowEvent(t, int(start.Add(2*time.Second).UnixNano()), true, testFlowLocalIP, testFlowRemoteIP, query, make([]byte, 8)),
+	} {
+		derived, errs = derive(packet)
+		require.Empty(t, errs, i)
+		assert.Empty(t, derived, i)
+	}
+
+	// The flow ends once idle for the timeout since its last packet
+	assert.Empty(t, tracker.Expire(start.Add(UDPFlowTimeout)))
+	ended := tracker.Expire(start.Add(2*time.Second + UDPFlowTimeout))
+	require.Len(t, ended, 1)
+	assert.Equal(t, int(events.NetFlowUDPEnd), ended[0].EventID)
+	assert.Equal(t, int(start.Add(2*time.Second+UDPFlowTimeout).UnixNano()), ended[0].Timestamp)
+	assert.Equal(t, []interface{}{
+		directionOutgoing, testFlowLocalIP, testFlowRemoteIP, uint16(40000), uint16(53),
+		[]string{}, []string{}, uint64(2), uint64(72), uint64(1), uint64(60),
+	}, argValues(ended[0]))
+	assert.Equal(t, uint64(0), active.Get())
+
+	// An incoming packet starts a new flow
+	derived, errs = derive(testFlowEvent(t, int(start.Add(time.Hour).UnixNano()), false, testFlowRemoteIP, testFlowLocalIP, answer, nil))
+	require.Empty(t, errs)
+	require.Len(t, derived, 1)
+	assert.Equal(t, []interface{}{directionIncoming, testFlowRemoteIP, testFlowLocalIP, uint16(53), uint16(40000)},
+		argValues(derived[0])[:5])
+
+	// Other protocols are ignored
+	derived, errs = derive(testFlowEvent(t, int(start.UnixNano()), true, testFlowLocalIP, testFlowRemoteIP,
+		&layers.ICMPv4{TypeCode: layers.CreateICMPv4TypeCode(layers.ICMPv4TypeEchoRequest, 0), Id: 1}, nil))
+	assert.Empty(t, errs)
+	assert.Empty(t, derived)
+}
+
+func Test_FlowTracker_ICMP(t *testing.T) {
+	t.Parallel()
+
+	tracker := testFlowTracker(t, true, maxTrackedFlows, nil, nil)
+	derive := tracker.NetFlowConnless()
+	start := time.Unix(1000, 0)
+
+	echo := func(id uint16, reply bool) *layers.ICMPv4 {
+		icmpType := uint8(layers.ICMPv4TypeEchoRequest)
+		if reply {
+			icmpType = layers.ICMPv4TypeEchoReply
+		}
+		return &layers.ICMPv4{TypeCode: layers.CreateICMPv4TypeCode(icmpType, 0), Id: id, Seq: 1}
+	}
+
+	// Echoes with distinct identifiers are distinct flows, each with their replies
+	for _, id := range []uint16{1, 2} {
+		derived, errs := derive(testFlowEvent(t, int(start.UnixNano()), true, testFlowLocalIP, testFlowRemoteIP, echo(id, false), nil))
+		require.Empty(t, errs)
+		require.Len(t, derived, 1)
+		assert.Equal(t, int(events.NetFlowICMPBegin), derived[0].EventID)
+		assert.Equal(t, []interface{}{directionOutgoing, testFlowLocalIP, testFlowRemoteIP, []string{}, []string{}},
+			argValues(derived[0]))
+
+		derived, errs = derive(testFlowEvent(t, int(start.UnixNano()), false, testFlowRemoteIP, testFlowLocalIP, echo(id, true), nil))
+		require.Empty(t, errs)
+		assert.Empty(t, derived)
+	}
+
+	// UDP packets are ignored
+	derived, errs := derive(testFlowEvent(t, int(start.UnixNano()), true, testFlowLocalIP, testFlowRemoteIP,
+		&layers.UDP{SrcPort: 40000, DstPort: 53}, nil))
+	assert.Empty(t, errs)
+	assert.Empty(t, derived)
+
+	ended := tracker.Expire(start.Add(ICMPFlowTimeout))
+	require.Len(t, ended, 2)
+	for _, event := range ended {
+		assert.Equal(t, int(events.NetFlowICMPEnd), event.EventID)
+		// Each side sent a packet of 28 bytes
+		assert.Equal(t, []interface{}{uint64(1), uint64(28), uint64(1), uint64(28)}, argValues(event)[5:])
+	}
+}
+
+func Test_FlowTracker_Bounded(t *testing.T) {
+	t.Parallel()
+
+	active := counter.NewCounter(0)
+	evicted := counter.NewCounter(0)
+	tracker := testFlowTracker(t, false, 2, active, evicted)
+	derive := tracker.NetFlowConnless()
+
+	for port := uint16(1); port <= 3; port++ {
+		derived, errs := derive(testFlowEvent(t, int(port), true, testFlowLocalIP, testFlowRemoteIP,
+			&layers.UDP{SrcPort: layers.UDPPort(port), DstPort: 53}, nil))
+		require.Empty(t, errs)
+
+		// The third flow ends the least recently seen one
+		if port < 3 {
+			require.Len(t, derived, 1)
+			continue
+		}
+		require.Len(t, derived, 2)
+		assert.Equal(t, int(events.NetFlowUDPEnd), derived[0].EventID)
+		assert.Equal(t, uint16(1), derived[0].Args[3].Value)
+		assert.Equal(t, int(events.NetFlowUDPBegin), derived[1].EventID)
+	}
+	assert.Equal(t, uint64(2), active.Get())
+	assert.Equal(t, uint64(1), evicted.Get())
+
+	ended := tracker.Flush(time.Unix(0, 0))
+	require.Len(t, ended, 2)
+	assert.Equal(t, uint16(2), ended[0].Args[3].Value)
+	assert.Equal(t, uint16(3), ended[1].Args[3].Value)
+	assert.Equal(t, uint64(0), active.Get())
+}
+
+func Test_FlowTracker_Disabled(t *testing.T) {
+	t.Parallel()
+
+	disabled := func() bool { return false }
+	tracker, err := InitUDPFlowTracker(nil, disabled, disabled, nil, nil)
+	require.NoError(t, err)
+
+	// Flows are still tracked, without deriving events
+	derived, errs := tracker.NetFlowConnless()(testFlowEvent(t, 1, true, testFlowLocalIP, testFlowRemoteIP,
+		&layers.UDP{SrcPort: 40000, DstPort: 53}, nil))
+	assert.Empty(t, errs)
+	assert.Empty(t, derived)
+	assert.Equal(t, uint64(1), tracker.active.Get())
+	assert.Empty(t, tracker.Flush(time.Unix(0, 0)))
+}
+
+// argValues returns the values of the event arguments
+func argValues(event trace.Event) []interface{} {
+	values := make([]interface{}, 0, len(event.Args))
+	for _, arg := range event.Args {
+		values = append(values, arg.Value)
+	}
+
+	return values
+}
@@ -471,3 +471,87 @@ func NetPacketHTTPResponse() DeriveFunction {
 		},
 	)
 }
+
+//
+// TLS
+//
+
+func NetPacketTLSClientHello() DeriveFunction {
+	return deriveSingleEvent(events.NetPacketTLSClientHello,
+		func(event *trace.Event) ([]interface{}, error) {
+			if getPacketTLSHandshake(event) != protoTLSClientHello {
+				return nil, nil
+			}
+			packet, err := createPacketFromEvent(event)
+			if err != nil {
+				return nil, err
+			}
+			srcIP, dstIP, err := getLayer3SrcDstFromPacket(packet)
+			if err != nil {
+				return nil, err
+			}
+			srcPort, dstPort, err := getLayer4SrcPortDstPortFromPacket(packet)
+			if err != nil {
+				return nil, err
+			}
+			proto, err := getProtoTLSClientHelloFromPacket(packet)
+			if err != nil {
+				logger.Warnw("attempted to derive net_packet_tls_client_hello event from malformed packet, event will be skipped", "error", err)
+				return nil, nil
+			}
+			if proto == nil {
+				return nil, nil // regular tcp/ip packet without a client hello
+			}
+			return []interface{}{
+				srcIP,
+				dstIP,
+				srcPort,
+				dstPort,
+				trace.PacketMetadata{
+					Direction: getPacketDirection(event),
+				},
+				*proto,
+			}, nil
+		},
+	)
+}
+
+func NetPacketTLSServerHello() DeriveFunction {
+	return deriveSingleEvent(events.NetPacketTLSServerHello,
+		func(event *trace.Event) ([]interface{}, error) {
+			if getPacketTLSHandshake(event) != protoTLSServerHello {
+				return nil, nil
+			}
+			packet, err := createPacketFromEvent(event)
+			if err != nil {
+				return nil, err
+			}
+			srcIP, dstIP, err := getLayer3SrcDstFromPacket(packet)
+			if err != nil {
+				return nil, err
+			}
+			srcPort, dstPort, err := getLayer4SrcPortDstPortFromPacket(packet)
+			if err != nil {
+				return nil, err
+			}
+			proto, err := getProtoTLSServerHelloFromPacket(packet)
+			if err != nil {
+				logger.Warnw("attempted to derive net_packet_tls_server_hello event from malformed packet, event will be skipped", "error", err)
+				return nil, nil
+			}
+			if proto == nil {
+				return nil, nil // regular tcp/ip packet without a server hello
+			}
+			return []interface{}{
+				srcIP,
+				dstIP,
+				srcPort,
+				dstPort,
+				trace.PacketMetadata{
+					Direction: getPacketDirection(event),
+				},
+				*proto,
+			}, nil
+		},
+	)
+}
@@ -23,6 +23,7 @@ import (
 // 1. packet flow direction (ingress/egress)
 // 2. HTTP request/response direction
 // 3. TCP Flow begin/end
+// 4. TLS client/server hello
 
 const (
 	familyIPv4 int = 1 << iota
@@ -36,6 +37,8 @@ const (
 	flowUDPBegin
 	flowUDPEnd
 	flowSrcInitiator
+	protoTLSClientHello
+	protoTLSServerHello
 )
 
 const httpMinLen int = 7 // longest http command is "DELETE "
@@ -158,6 +161,18 @@ func getPacketHTTPDirection(event *trace.Event) int {
 	return 0
 }
 
+// getPacketTLSHandshake returns the TLS hello type of the packet from the event.
+func getPacketTLSHandshake(event *trace.Event) int {
+	flags := getFlagsFromEvent(event)
+	switch {
+	case flags&protoTLSClientHello == protoTLSClientHello:
+		return protoTLSClientHello
+	case flags&protoTLSServerHello == protoTLSServerHello:
+		return protoTLSServerHello
+	}
+	return 0
+}
+
 // createPacketFromEvent creates a gopacket.Packet from the event.
 func createPacketFromEvent(event *trace.Event) (gopacket.Packet, error) {
 	payload, err := parsePayloadArg(event)
@@ -0,0 +1,606 @@
+package derive
+
+import (
+	"crypto/md5" //nolint:gosec // JA3 and JA3S are defined as MD5 hashes
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/google/gopacket"
+	"golang.org/x/crypto/cryptobyte"
+
+	"github.com/aquasecurity/tracee/common/errfmt"
+	"github.com/aquasecurity/tracee/types/trace"
+)
+
+// TLS record, handshake and extension types (RFC 8446)
+const (
+	tlsRecordTypeHandshake uint8 = 22
+
+	tlsHandshakeTypeClientHello uint8 = 1
+	tlsHandshakeTypeServerHello uint8 = 2
+	tlsHandshakeTypeCertificate uint8 = 11
+
+	tlsExtensionServerName          uint16 = 0
+	tlsExtensionSupportedGroups     uint16 = 10
+	tlsExtensionECPointFormats      uint16 = 11
+	tlsExtensionSignatureAlgorithms uint16 = 13
+	tlsExtensionALPN                uint16 = 16
+	tlsExtensionSupportedVersions   uint16 = 43
+)
+
+const tlsMinLen int = 6 // record header + handshake type
+
+// tlsEmptyHash is the JA4 hash of an empty list
+const tlsEmptyHash = "000000000000"
+
+// tlsHandshakeMessage is a handshake message, without its header
+type tlsHandshakeMessage struct {
+	msgType uint8
+	body    []byte
+}
+
+// tlsClientHello holds the fields of a ClientHello used by the event and fingerprints
+type tlsClientHello struct {
+	version             uint16
+	cipherSuites        []uint16
+	extensions          []uint16
+	serverName          string
+	alpn                []string
+	supportedGroups     []uint16
+	ecPointFormats      []uint8
+	signatureAlgorithms []uint16
+	supportedVersions   []uint16
+}
+
+// tlsServerHello holds the fields of a ServerHello used by the event and fingerprints
+type tlsServerHello struct {
+	version          uint16
+	cipherSuite      uint16
+	extensions       []uint16
+	alpn             string
+	supportedVersion uint16
+}
+
+//
+// Handshake parsing
+//
+
+// isGREASE reports whether a value is one of the reserved GREASE values (RFC 8701),
+// which clients add at random and fingerprints ignore.
+func isGREASE(v uint16) bool {
+	return v&0x0f0f == 0x0a0a && v>>8 == v&0xff
+}
+
+// getTLSHandshakeMessages returns the handshake messages of the handshake records at the
+// start of a payload. Messages fragmented across records are reassembled, and a message
+// truncated by the end of the payload ends the list.
+func getTLSHandshakeMessages(payload []byte) []tlsHandshakeMessage {
+	var fragments []byte
+
+	records := cryptobyte.String(payload)
+	for !records.Empty() {
+		var recordType uint8
+		var version uint16
+		var length uint16
+		if !records.ReadUint8(&recordType) || !records.ReadUint16(&version) || !records.ReadUint16(&length) {
+			break
+		}
+		if recordType != tlsRecordTypeHandshake {
+			break
+		}
+		if int(length) > len(records) {
+			// The record continues in the next segments
+			fragments = append(fragments, records...)
+			break
+		}
+		fragments = append(fragments, records[:length]...)
+		records = records[length:]
+	}
+
+	var messages []tlsHandshakeMessage
+	handshake := cryptobyte.String(fragments)
+	for !handshake.Empty() {
+		var msgType uint8
+		var body cryptobyte.String
+		if !handshake.ReadUint8(&msgType) || !handshake.ReadUint24LengthPrefixed(&body) {
+			break
+		}
+		messages = append(messages, tlsHandshakeMessage{msgType: msgType, body: body})
+	}
+
+	return messages
+}
+
+// readTLSExtensions reads the extensions block of a hello message, if any, calling parse
+// with the type and data of each extension.
+func readTLSExtensions(s *cryptobyte.String, parse func(extType uint16, data cryptobyte.String) bool) bool {
+	if s.Empty() {
+		return true // hellos without extensions
+	}
+
+	var extensions cryptobyte.String
+	if !s.ReadUint16LengthPrefixed(&extensions) || !s.Empty() {
+		return false
+	}
+	for !extensions.Empty() {
+		var extType uint16
+		var data cryptobyte.String
+		if !extensions.ReadUint16(&extType) || !extensions.ReadUint16LengthPrefixed(&data) {
+			return false
+		}
+		if !parse(extType, data) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// readUint16List reads a list of uint16 values, with readPrefix reading the list
+func readUint16List(readPrefix func(*cryptobyte.String) bool) ([]uint16, bool) {
+	var list cryptobyte.String
+	if !readPrefix(&list) {
+		return nil, false
+	}
+
+	var values []uint16
+	for !list.Empty() {
+		var v uint16
+		if !list.ReadUint16(&v) {
+			return nil, false
+		}
+		values = append(values, v)
+	}
+
+	return values, true
+}
+
+// readALPNProtocols reads the protocol name list of the ALPN extension
+func readALPNProtocols(data cryptobyte.String) ([]string, bool) {
+	var list cryptobyte.String
+	if !data.ReadUint16LengthPrefixed(&list) || !data.Empty() {
+		return nil, false
+	}
+
+	var protocols []string
+	for !list.Empty() {
+		var protocol cryptobyte.String
+		if !list.ReadUint8LengthPrefixed(&protocol) {
+			return nil, false
+		}
+		protocols = append(protocols, string(protocol))
+	}
+
+	return protocols, true
+}
+
+// readServerName reads the host name of the server_name extension
+func readServerName(data cryptobyte.String) (string, bool) {
+	var list cryptobyte.String
+	if !data.ReadUint16LengthPrefixed(&list) || !data.Empty() {
+		return "", false
+	}
+
+	for !list.Empty() {
+		var nameType uint8
+		var name cryptobyte.String
+		if !list.ReadUint8(&nameType) || !list.ReadUint16LengthPrefixed(&name) {
+			return "", false
+		}
+		if nameType == 0 { // host_name
+			return string(name), true
+		}
+	}
+
+	return "", true
+}
+
+// parseTLSClientHello parses the body of a ClientHello message
+func parseTLSClientHello(body []byte) (*tlsClientHello, error) {
+	hello := &tlsClientHello{}
+	s := cryptobyte.String(body)
+
+	var random, sessionID, compressionMethods cryptobyte.String
+	var cipherSuites []uint16
+	var ok bool
+	if !s.ReadUint16(&hello.version) || !s.ReadBytes((*[]byte)(&random), 32) ||
+		!s.ReadUint8LengthPrefixed(&sessionID) {
+		return nil, errfmt.Errorf("malformed TLS client hello")
+	}
+	if cipherSuites, ok = readUint16List(s.ReadUint16LengthPrefixed); !ok {
+		return nil, errfmt.Errorf("malformed TLS client hello cipher suites")
+	}
+	if !s.ReadUint8LengthPrefixed(&compressionMethods) {
+		return nil, errfmt.Errorf("malformed TLS client hello compression methods")
+	}
+	for _, cipherSuite := range cipherSuites {
+		if !isGREASE(cipherSuite) {
+			hello.cipherSuites = append(hello.cipherSuites, cipherSuite)
+		}
+	}
+
+	ok = readTLSExtensions(&s, func(extType uint16, data cryptobyte.String) bool {
+		if isGREASE(extType) {
+			return true
+		}
+		hello.extensions = append(hello.extensions, extType)
+
+		var ok bool
+		switch extType {
+		case tlsExtensionServerName:
+			hello.serverName, ok = readServerName(data)
+		case tlsExtensionALPN:
+			hello.alpn, ok = readALPNProtocols(data)
+		case tlsExtensionSupportedGroups:
+			hello.supportedGroups, ok = readUint16List(data.ReadUint16LengthPrefixed)
+		case tlsExtensionSignatureAlgorithms:
+			hello.signatureAlgorithms, ok = readUint16List(data.ReadUint16LengthPrefixed)
+		case tlsExtensionSupportedVersions:
+			hello.supportedVersions, ok = readUint16List(data.ReadUint8LengthPrefixed)
+		case tlsExtensionECPointFormats:
+			var formats cryptobyte.String
+			ok = data.ReadUint8LengthPrefixed(&formats)
+			hello.ecPointFormats = []uint8(formats)
+		default:
+			return true
+		}
+		return ok
+	})
+	if !ok {
+		return nil, errfmt.Errorf("malformed TLS client hello extensions")
+	}
+
+	hello.supportedGroups = withoutGREASE(hello.supportedGroups)
+	hello.supportedVersions = withoutGREASE(hello.supportedVersions)
+	hello.signatureAlgorithms = withoutGREASE(hello.signatureAlgorithms)
+
+	return hello, nil
+}
+
+// parseTLSServerHello parses the body of a ServerHello message
+func parseTLSServerHello(body []byte) (*tlsServerHello, error) {
+	hello := &tlsServerHello{}
+	s := cryptobyte.String(body)
+
+	var random, sessionID cryptobyte.String
+	var compressionMethod uint8
+	if !s.ReadUint16(&hello.version) || !s.ReadBytes((*[]byte)(&random), 32) ||
+		!s.ReadUint8LengthPrefixed(&sessionID) || !s.ReadUint16(&hello.cipherSuite) ||
+		!s.ReadUint8(&compressionMethod) {
+		return nil, errfmt.Errorf("malformed TLS server hello")
+	}
+
+	ok := readTLSExtensions(&s, func(extType uint16, data cryptobyte.String) bool {
+		hello.extensions = append(hello.extensions, extType)
+
+		switch extType {
+		case tlsExtensionALPN:
+			protocols, ok := readALPNProtocols(data)
+			if !ok || len(protocols) != 1 {
+				return false
+			}
+			hello.alpn = protocols[0]
+		case tlsExtensionSupportedVersions:
+			return data.ReadUint16(&hello.supportedVersion)
+		}
+		return true
+	})
+	if !ok {
+		return nil, errfmt.Errorf("malformed TLS server hello extensions")
+	}
+
+	return hello, nil
+}
+
+// parseTLSCertificates parses the certificate chain of a TLS 1.2 (or earlier)
+// Certificate message. TLS 1.3 encrypts the certificates.
+func parseTLSCertificates(body []byte) ([]trace.ProtoTLSCertificate, error) {
+	s := cryptobyte.String(body)
+
+	var list cryptobyte.String
+	if !s.ReadUint24LengthPrefixed(&list) {
+		return nil, errfmt.Errorf("malformed TLS certificate message")
+	}
+
+	certificates := []trace.ProtoTLSCertificate{}
+	for !list.Empty() {
+		var der cryptobyte.String
+		if !list.ReadUint24LengthPrefixed(&der) {
+			return nil, errfmt.Errorf("malformed TLS certificate message")
+		}
+		certificate, err := x509.ParseCertificate(der)
+		if err != nil {
+			return nil, errfmt.WrapError(err)
+		}
+		certificates = append(certificates, trace.ProtoTLSCertificate{
+			Subject:  certificate.Subject.String(),
+			Issuer:   certificate.Issuer.String(),
+			DNSNames: certificate.DNSNames,
+		})
+	}
+
+	return certificates, nil
+}
+
+//
+// Fingerprints
+//
+
+// withoutGREASE returns the values that aren't GREASE values
+func withoutGREASE(values []uint16) []uint16 {
+	var res []uint16
+	for _, v := range values {
+		if !isGREASE(v) {
+			res = append(res, v)
+		}
+	}
+
+	return res
+}
+
+// joinDecimal joins numbers in decimal, as in JA3
+func joinDecimal[T uint8 | uint16](values []T) string {
+	res := make([]string, len(values))
+	for i, v := range values {
+		res[i] = strconv.Itoa(int(v))
+	}
+
+	return strings.Join(res, "-")
+}
+
+// joinHex joins numbers as 4 digit hex, as in JA4
+func joinHex(values []uint16) string {
+	res := make([]string, len(values))
+	for i, v := range values {
+		res[i] = fmt.Sprintf("%04x", v)
+	}
+
+	return strings.Join(res, ",")
+}
+
+// md5Hex returns the hex MD5 hash of a string
+func md5Hex(s string) string {
+	sum := md5.Sum([]byte(s)) //nolint:gosec
+	return hex.EncodeToString(sum[:])
+}
+
+// ja4Hash returns the truncated hex SHA256 hash used by JA4, or zeros if s is empty
+func ja4Hash(s string) string {
+	if s == "" {
+		return tlsEmptyHash
+	}
+	sum := sha256.Sum256([]byte(s))
+
+	return hex.EncodeToString(sum[:])[:12]
+}
+
+// ja4Version returns the JA4 code of a TLS version
+func ja4Version(version uint16) string {
+	switch version {
+	case tls.VersionTLS13:
+		return "13"
+	case tls.VersionTLS12:
+		return "12"
+	case tls.VersionTLS11:
+		return "11"
+	case tls.VersionTLS10:
+		return "10"
+	case 0x0300:
+		return "s3"
+	case 0x0002:
+		return "s2"
+	}
+
+	return "00"
+}
+
+// ja4Count returns a count in 2 digits, capped at 99
+func ja4Count(count int) string {
+	return fmt.Sprintf("%02d", min(count, 99))
+}
+
+// ja4ALPN returns the first and last characters of an ALPN protocol, their hex digits
+// if they aren't alphanumeric, or 00 without a protocol
+func ja4ALPN(protocol string) string {
+	if protocol == "" {
+		return "00"
+	}
+
+	isAlphanumeric := func(c byte) bool {
+		return (c >= '0' && c <= '9') || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+	}
+	first, last := protocol[0], protocol[len(protocol)-1]
+	if !isAlphanumeric(first) || !isAlphanumeric(last) {
+		return fmt.Sprintf("%02x", first)[:1] + fmt.Sprintf("%02x", last)[1:]
+	}
+
+	return string([]byte{first, last})
+}
+
+// highestVersion returns the highest supported version, or the legacy version field
+func (h *tlsClientHello) highestVersion() uint16 {
+	version := h.version
+	for _, v := range h.supportedVersions {
+		version = max(version, v)
+	}
+
+	return version
+}
+
+// ja3 returns the JA3 string of a ClientHello:
+// SSLVersion,Ciphers,Extensions,EllipticCurves,EllipticCurvePointFormats
+func (h *tlsClientHello) ja3() string {
+	return strings.Join([]string{
+		strconv.Itoa(int(h.version)),
+		joinDecimal(h.cipherSuites),
+		joinDecimal(h.extensions),
+		joinDecimal(h.supportedGroups),
+		joinDecimal(h.ecPointFormats),
+	}, ",")
+}
+
+// ja4 returns the JA4 fingerprint of a ClientHello over TCP
+func (h *tlsClientHello) ja4() string {
+	sni := "i"
+	if h.serverName != "" {
+		sni = "d"
+	}
+	alpn := ""
+	if len(h.alpn) > 0 {
+		alpn = h.alpn[0]
+	}
+
+	ciphers := append([]uint16{}, h.cipherSuites...)
+	sort.Slice(ciphers, func(i, j int) bool { return ciphers[i] < ciphers[j] })
+
+	// The server name and ALPN extensions are left out of the extensions hash
+	var extensions []uint16
+	for _, ext := range h.extensions {
+		if ext != tlsExtensionServerName && ext != tlsExtensionALPN {
+			extensions = append(extensions, ext)
+		}
+	}
+	sort.Slice(extensions, func(i, j int) bool { return extensions[i] < extensions[j] })
+	extensionsHash := tlsEmptyHash
+	if len(extensions) > 0 {
+		s := joinHex(extensions)
+		if len(h.signatureAlgorithms) > 0 {
+			s += "_" + joinHex(h.signatureAlgorithms)
+		}
+		extensionsHash = ja4Hash(s)
+	}
+
+	return "t" + ja4Version(h.highestVersion()) + sni + ja4Count(len(h.cipherSuites)) +
+		ja4Count(len(h.extensions)) + ja4ALPN(alpn) + "_" + ja4Hash(joinHex(ciphers)) + "_" + extensionsHash
+}
+
+// ja3s returns the JA3S string of a ServerHello: SSLVersion,Cipher,Extensions
+func (h *tlsServerHello) ja3s() string {
+	return strconv.Itoa(int(h.version)) + "," + strconv.Itoa(int(h.cipherSuite)) + "," + joinDecimal(h.extensions)
+}
+
+// ja4s returns the JA4S fingerprint of a ServerHello over TCP
+func (h *tlsServerHello) ja4s() string {
+	return "t" + ja4Version(h.negotiatedVersion()) + ja4Count(len(h.extensions)) + ja4ALPN(h.alpn) +
+		"_" + fmt.Sprintf("%04x", h.cipherSuite) + "_" + ja4Hash(joinHex(h.extensions))
+}
+
+// negotiatedVersion returns the selected supported version, or the legacy version field
+func (h *tlsServerHello) negotiatedVersion() uint16 {
+	if h.supportedVersion != 0 {
+		return h.supportedVersion
+	}
+
+	return h.version
+}
+
+//
+// Protocol
+//
+
+// getProtoTLSClientHello returns the ProtoTLSClientHello of a payload starting with a
+// ClientHello, or nil if it doesn't.
+func getProtoTLSClientHello(payload []byte) (*trace.ProtoTLSClientHello, error) {
+	if len(payload) < tlsMinLen {
+		return nil, nil
+	}
+
+	messages := getTLSHandshakeMessages(payload)
+	if len(messages) == 0 || messages[0].msgType != tlsHandshakeTypeClientHello {
+		return nil, nil
+	}
+	hello, err := parseTLSClientHello(messages[0].body)
+	if err != nil {
+		return nil, err
+	}
+
+	supportedVersions := make([]string, len(hello.supportedVersions))
+	for i, v := range hello.supportedVersions {
+		supportedVersions[i] = tls.VersionName(v)
+	}
+	cipherSuites := make([]string, len(hello.cipherSuites))
+	for i, c := range hello.cipherSuites {
+		cipherSuites[i] = tls.CipherSuiteName(c)
+	}
+	ja3 := hello.ja3()
+
+	return &trace.ProtoTLSClientHello{
+		Version:           tls.VersionName(hello.highestVersion()),
+		SupportedVersions: supportedVersions,
+		CipherSuites:      cipherSuites,
+		Extensions:        hello.extensions,
+		ServerName:        hello.serverName,
+		ALPN:              hello.alpn,
+		JA3:               ja3,
+		JA3Hash:           md5Hex(ja3),
+		JA4:               hello.ja4(),
+	}, nil
+}
+
+// getProtoTLSServerHello returns the ProtoTLSServerHello of a payload starting with a
+// ServerHello, or nil if it doesn't. The certificates are those of a Certificate
+// message following the ServerHello in the same payload.
+func getProtoTLSServerHello(payload []byte) (*trace.ProtoTLSServerHello, error) {
+	if len(payload) < tlsMinLen {
+		return nil, nil
+	}
+
+	messages := getTLSHandshakeMessages(payload)
+	if len(messages) == 0 || messages[0].msgType != tlsHandshakeTypeServerHello {
+		return nil, nil
+	}
+	hello, err := parseTLSServerHello(messages[0].body)
+	if err != nil {
+		return nil, err
+	}
+
+	certificates := []trace.ProtoTLSCertificate{}
+	if hello.negotiatedVersion() < tls.VersionTLS13 {
+		for _, message := range messages[1:] {
+			if message.msgType == tlsHandshakeTypeCertificate {
+				if certificates, err = parseTLSCertificates(message.body); err != nil {
+					return nil, err
+				}
+				break
+			}
+		}
+	}
+	ja3s := hello.ja3s()
+
+	return &trace.ProtoTLSServerHello{
+		Version:      tls.VersionName(hello.negotiatedVersion()),
+		CipherSuite:  tls.CipherSuiteName(hello.cipherSuite),
+		Extensions:   hello.extensions,
+		ALPN:         hello.alpn,
+		JA3S:         ja3s,
+		JA3SHash:     md5Hex(ja3s),
+		JA4S:         hello.ja4s(),
+		Certificates: certificates,
+	}, nil
+}
+
+// getProtoTLSClientHelloFromPacket returns the ProtoTLSClientHello from a TLS packet.
+func getProtoTLSClientHelloFromPacket(packet gopacket.Packet) (*trace.ProtoTLSClientHello, error) {
+	layer7, err := getLayer7FromPacket(packet)
+	if err != nil {
+		return nil, err
+	}
+
+	return getProtoTLSClientHello(layer7.Payload())
+}
+
+// getProtoTLSServerHelloFromPacket returns the ProtoTLSServerHello from a TLS packet.
+func getProtoTLSServerHelloFromPacket(packet gopacket.Packet) (*trace.ProtoTLSServerHello, error) {
+	layer7, err := getLayer7FromPacket(packet)
+	if err != nil {
+		return nil, err
+	}
+
+	return getProtoTLSServerHello(layer7.Payload())
+}
@@ -0,0 +1,268 @@
+package derive
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/cryptobyte"
+)
+
+// testClientHello builds a ClientHello record with GREASE values, split in two records
+func testClientHello() []byte {
+	var body cryptobyte.Builder
+	body.AddUint16(tls.VersionTLS12)
+	body.AddBytes(make([]byte, 32)) // random
+	body.AddUint8LengthPrefixed(func(b *cryptobyte.Builder) {})
+	body.AddUint16LengthPrefixed(func(b *cryptobyte.Builder) {
+		for _, c := range []uint16{0x0a0a, tls.TLS_AES_128_GCM_SHA256, tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256} {
+			b.AddUint16(c)
+		}
+	})
+	body.AddUint8LengthPrefixed(func(b *cryptobyte.Builder) { b.AddUint8(0) })
+	body.AddUint16LengthPrefixed(func(b *cryptobyte.Builder) {
+		extension := func(extType uint16, data func(b *cryptobyte.Builder)) {
+			b.AddUint16(extType)
+			b.AddUint16LengthPrefixed(data)
+		}
+		extension(0x1a1a, func(b *cryptobyte.Builder) {})
+		extension(tlsExtensionServerName, func(b *cryptobyte.Builder) {
+			b.AddUint16LengthPrefixed(func(b *cryptobyte.Builder) {
+				b.AddUint8(0)
+				b.AddUint16LengthPrefixed(func(b *cryptobyte.Builder) { b.AddBytes([]byte("example.com")) })
+			})
+		})
+		extension(tlsExtensionSupportedGroups, func(b *cryptobyte.Builder) {
+			b.AddUint16LengthPrefixed(func(b *cryptobyte.Builder) {
+				b.AddUint16(0x2a2a)
+				b.AddUint16(uint16(tls.X25519))
+				b.AddUint16(uint16(tls.CurveP256))
+			})
+		})
+		extension(tlsExtensionECPointFormats, func(b *cryptobyte.Builder) {
+			b.AddUint8LengthPrefixed(func(b *cryptobyte.Builder) { b.AddUint8(0) })
+		})
+		extension(tlsExtensionSignatureAlgorithms, func(b *cryptobyte.Builder) {
+			b.AddUint16LengthPrefixed(func(b *cryptobyte.Builder) {
+				b.AddUint16(uint16(tls.ECDSAWithP256AndSHA256))
+				b.AddUint16(uint16(tls.PSSWithSHA256))
+			})
+		})
+		extension(tlsExtensionALPN, func(b *cryptobyte.Builder) {
+			b.AddUint16LengthPrefixed(func(b *cryptobyte.Builder) {
+				for _, protocol := range []string{"h2", "http/1.1"} {
+					b.AddUint8LengthPrefixed(func(b *cryptobyte.Builder) { b.AddBytes([]byte(protocol)) })
+				}
+			})
+		})
+		extension(tlsExtensionSupportedVersions, func(b *cryptobyte.Builder) {
+			b.AddUint8LengthPrefixed(func(b *cryptobyte.Builder) {
+				b.AddUint16(0x3a3a)
+				b.AddUint16(tls.VersionTLS13)
+				b.AddUint16(tls.VersionTLS12)
+			})
+		})
+	})
+
+	var handshake cryptobyte.Builder
+	handshake.AddUint8(tlsHandshakeTypeClientHello)
+	handshake.AddUint24LengthPrefixed(func(b *cryptobyte.Builder) { b.AddBytes(body.BytesOrPanic()) })
+	message := handshake.BytesOrPanic()
+
+	var records cryptobyte.Builder
+	for _, fragment := range [][]byte{message[:20], message[20:]} {
+		records.AddUint8(tlsRecordTypeHandshake)
+		records.AddUint16(tls.VersionTLS10)
+		records.AddUint16LengthPrefixed(func(b *cryptobyte.Builder) { b.AddBytes(fragment) })
+	}
+
+	return records.BytesOrPanic()
+}
+
+// recordingConn records the data read from a connection
+type recordingConn struct {
+	net.Conn
+	read []byte
+}
+
+func (c *recordingConn) Read(b []byte) (int, error) {
+	n, err := c.Conn.Read(b)
+	c.read = append(c.read, b[:n]...)
+	return n, err
+}
+
+// testHandshake runs a handshake of a crypto/tls client and server, returning the data
+// sent by the client and by the server, each starting with their hello
+func testHandshake(t *testing.T, serverConfig *tls.Config, clientConfig *tls.Config) ([]byte, []byte) {
+	clientConn, serverConn := net.Pipe()
+	recordingClientConn := &recordingConn{Conn: clientConn}
+	recordingServerConn := &recordingConn{Conn: serverConn}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		server := tls.Server(recordingServerConn, serverConfig)
+		_ = server.Handshake()
+	}()
+	client := tls.Client(recordingClientConn, clientConfig)
+	require.NoError(t, client.Handshake())
+	<-done
+
+	// Closing the tls connections would block on their close_notify alerts
+	_ = clientConn.Close()
+	_ = serverConn.Close()
+
+	return recordingServerConn.read, recordingClientConn.read
+}
+
+// testCertificate returns a self-signed certificate
+func testCertificate(t *testing.T) tls.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "example.com", Organization: []string{"Tracee"}},
+		DNSNames:     []string{"example.com", "www.example.com"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}
+}
+
+func Test_isGREASE(t *testing.T) {
+	t.Parallel()
+
+	for _, v := range []uint16{0x0a0a, 0x1a1a, 0xfafa} {
+		assert.True(t, isGREASE(v), v)
+	}
+	for _, v := range []uint16{0x0a1a, 0x0000, 0x1301, 0xfa0a} {
+		assert.False(t, isGREASE(v), v)
+	}
+}
+
+func Test_getProtoTLSClientHello(t *testing.T) {
+	t.Parallel()
+
+	hello, err := getProtoTLSClientHello(testClientHello())
+	require.NoError(t, err)
+	require.NotNil(t, hello)
+
+	assert.Equal(t, "TLS 1.3", hello.Version)
+	assert.Equal(t, []string{"TLS 1.3", "TLS 1.2"}, hello.SupportedVersions)
+	assert.Equal(t, []string{"TLS_AES_128_GCM_SHA256", "TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256"}, hello.CipherSuites)
+	assert.Equal(t, []uint16{0, 10, 11, 13, 16, 43}, hello.Extensions)
+	assert.Equal(t, "example.com", hello.ServerName)
+	assert.Equal(t, []string{"h2", "http/1.1"}, hello.ALPN)
+	assert.Equal(t, "771,4865-49199,0-10-11-13-16-43,29-23,0", hello.JA3)
+	assert.Equal(t, "97737df38853b88c4324af06e211c4a1", hello.JA3Hash)
+	// The hashes of "1301,c02f" and "000a,000b,000d,002b_0403,0804"
+	assert.Equal(t, "t13d0206h2_c1929292aa6b_fb71836bce29", hello.JA4)
+}
+
+func Test_getProtoTLSClientHello_NotTLS(t *testing.T) {
+	t.Parallel()
+
+	for _, payload := range [][]byte{
+		nil,
+		[]byte("GET / HTTP/1.1\r\n\r\n"),
+		{tlsRecordTypeHandshake, 0x03, 0x01, 0x00, 0x04, tlsHandshakeTypeServerHello, 0, 0, 0},
+	} {
+		hello, err := getProtoTLSClientHello(payload)
+		assert.NoError(t, err)
+		assert.Nil(t, hello)
+	}
+
+	// A hello truncated in its header is malformed
+	_, err := getProtoTLSClientHello([]byte{tlsRecordTypeHandshake, 0x03, 0x01, 0x00, 0x06,
+		tlsHandshakeTypeClientHello, 0, 0, 2, 0x03, 0x03})
+	assert.Error(t, err)
+}
+
+func Test_getProtoTLS_Handshake(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		maxVersion   uint16
+		version      string
+		ja4s         string
+		certificates bool
+	}{
+		// TLS 1.3 encrypts the certificates and the ALPN extension
+		{name: "TLS 1.2", maxVersion: tls.VersionTLS12, version: "TLS 1.2", ja4s: `^t12\d{2}h2_`, certificates: true},
+		{name: "TLS 1.3", maxVersion: tls.VersionTLS13, version: "TLS 1.3", ja4s: `^t13\d{2}00_`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			serverConfig := &tls.Config{
+				Certificates: []tls.Certificate{testCertificate(t)},
+				NextProtos:   []string{"h2"},
+				MaxVersion:   tt.maxVersion,
+			}
+			clientConfig := &tls.Config{
+				ServerName:         "www.example.com",
+				NextProtos:         []string{"h2", "http/1.1"},
+				InsecureSkipVerify: true, //nolint:gosec // self-signed test certificate
+			}
+			clientFlight, serverFlight := testHandshake(t, serverConfig, clientConfig)
+
+			clientHello, err := getProtoTLSClientHello(clientFlight)
+			require.NoError(t, err)
+			require.NotNil(t, clientHello)
+			assert.Equal(t, "www.example.com", clientHello.ServerName)
+			assert.Equal(t, []string{"h2", "http/1.1"}, clientHello.ALPN)
+			assert.Equal(t, "TLS 1.3", clientHello.Version)
+			assert.Regexp(t, `^t13d\d{4}h2_[0-9a-f]{12}_[0-9a-f]{12}$`, clientHello.JA4)
+			assert.Regexp(t, `^[0-9a-f]{32}$`, clientHello.JA3Hash)
+
+			serverHello, err := getProtoTLSServerHello(serverFlight)
+			require.NoError(t, err)
+			require.NotNil(t, serverHello)
+			assert.Equal(t, tt.version, serverHello.Version)
+			assert.NotEmpty(t, serverHello.CipherSuite)
+			assert.Regexp(t, tt.ja4s+`[0-9a-f]{4}_[0-9a-f]{12}$`, serverHello.JA4S)
+			assert.Equal(t, md5Hex(serverHello.JA3S), serverHello.JA3SHash)
+
+			if !tt.certificates {
+				assert.Empty(t, serverHello.ALPN)
+				assert.Empty(t, serverHello.Certificates)
+				return
+			}
+			assert.Equal(t, "h2", serverHello.ALPN)
+			require.Len(t, serverHello.Certificates, 1)
+			assert.Equal(t, "CN=example.com,O=Tracee", serverHello.Certificates[0].Subject)
+			assert.Equal(t, "CN=example.com,O=Tracee", serverHello.Certificates[0].Issuer)
+			assert.Equal(t, []string{"example.com", "www.example.com"}, serverHello.Certificates[0].DNSNames)
+
+			// The server flight isn't a client hello
+			clientHello, err = getProtoTLSClientHello(serverFlight)
+			assert.NoError(t, err)
+			assert.Nil(t, clientHello)
+		})
+	}
+}
+
+func Test_ja4ALPN(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "00", ja4ALPN(""))
+	assert.Equal(t, "h2", ja4ALPN("h2"))
+	assert.Equal(t, "h1", ja4ALPN("http/1.1"))
+	assert.Equal(t, "69", ja4ALPN("h"+string([]byte{0xa9})))
+}
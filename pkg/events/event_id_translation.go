@@ -457,17 +457,19 @@ var EventTranslationTable = [MaxBuiltinID]pb.EventId{
 	SchedRrGetInterval32:  pb.EventId_sched_rr_get_interval_time32,
 
 	// Common events translation section
-	NetPacketBase:       pb.EventId_net_packet_base,
-	NetPacketRaw:        pb.EventId_net_packet_raw,
-	NetPacketIPBase:     pb.EventId_net_packet_ip_base,
-	NetPacketTCPBase:    pb.EventId_net_packet_tcp_base,
-	NetPacketUDPBase:    pb.EventId_net_packet_udp_base,
-	NetPacketICMPBase:   pb.EventId_net_packet_icmp_base,
-	NetPacketICMPv6Base: pb.EventId_net_packet_icmpv6_base,
-	NetPacketDNSBase:    pb.EventId_net_packet_dns_base,
-	NetPacketHTTPBase:   pb.EventId_net_packet_http_base,
-	NetPacketCapture:    pb.EventId_net_packet_capture,
-	NetPacketFlowBase:   pb.EventId_net_packet_flow_base,
+	NetPacketBase:             pb.EventId_net_packet_base,
+	NetPacketRaw:              pb.EventId_net_packet_raw,
+	NetPacketIPBase:           pb.EventId_net_packet_ip_base,
+	NetPacketTCPBase:          pb.EventId_net_packet_tcp_base,
+	NetPacketUDPBase:          pb.EventId_net_packet_udp_base,
+	NetPacketICMPBase:         pb.EventId_net_packet_icmp_base,
+	NetPacketICMPv6Base:       pb.EventId_net_packet_icmpv6_base,
+	NetPacketDNSBase:          pb.EventId_net_packet_dns_base,
+	NetPacketHTTPBase:         pb.EventId_net_packet_http_base,
+	NetPacketTLSBase:          pb.EventId_net_packet_tls_base,
+	NetPacketCapture:          pb.EventId_net_packet_capture,
+	NetPacketFlowBase:         pb.EventId_net_packet_flow_base,
+	NetPacketFlowConnlessBase: pb.EventId_net_packet_flow_connless_base,
 	// MaxNetID marker (consumes ID slot)
 	SysEnter:                     pb.EventId_sys_enter,
 	SysExit:                      pb.EventId_sys_exit,
@@ -553,20 +555,26 @@ var EventTranslationTable = [MaxBuiltinID]pb.EventId{
 	SecurityTaskPrctl:            pb.EventId_security_task_prctl,
 
 	// Events from user-space translation section
-	NetPacketIPv4:         pb.EventId_net_packet_ipv4,
-	NetPacketIPv6:         pb.EventId_net_packet_ipv6,
-	NetPacketTCP:          pb.EventId_net_packet_tcp,
-	NetPacketUDP:          pb.EventId_net_packet_udp,
-	NetPacketICMP:         pb.EventId_net_packet_icmp,
-	NetPacketICMPv6:       pb.EventId_net_packet_icmpv6,
-	NetPacketDNS:          pb.EventId_net_packet_dns,
-	NetPacketDNSRequest:   pb.EventId_net_packet_dns_request,
-	NetPacketDNSResponse:  pb.EventId_net_packet_dns_response,
-	NetPacketHTTP:         pb.EventId_net_packet_http,
-	NetPacketHTTPRequest:  pb.EventId_net_packet_http_request,
-	NetPacketHTTPResponse: pb.EventId_net_packet_http_response,
-	NetFlowTCPBegin:       pb.EventId_net_flow_tcp_begin,
-	NetFlowTCPEnd:         pb.EventId_net_flow_tcp_end,
+	NetPacketIPv4:           pb.EventId_net_packet_ipv4,
+	NetPacketIPv6:           pb.EventId_net_packet_ipv6,
+	NetPacketTCP:            pb.EventId_net_packet_tcp,
+	NetPacketUDP:            pb.EventId_net_packet_udp,
+	NetPacketICMP:           pb.EventId_net_packet_icmp,
+	NetPacketICMPv6:         pb.EventId_net_packet_icmpv6,
+	NetPacketDNS:            pb.EventId_net_packet_dns,
+	NetPacketDNSRequest:     pb.EventId_net_packet_dns_request,
+	NetPacketDNSResponse:    pb.EventId_net_packet_dns_response,
+	NetPacketHTTP:           pb.EventId_net_packet_http,
+	NetPacketHTTPRequest:    pb.EventId_net_packet_http_request,
+	NetPacketHTTPResponse:   pb.EventId_net_packet_http_response,
+	NetPacketTLSClientHello: pb.EventId_net_packet_tls_client_hello,
+	NetPacketTLSServerHello: pb.EventId_net_packet_tls_server_hello,
+	NetFlowTCPBegin:         pb.EventId_net_flow_tcp_begin,
+	NetFlowTCPEnd:           pb.EventId_net_flow_tcp_end,
+	NetFlowUDPBegin:         pb.EventId_net_flow_udp_begin,
+	NetFlowUDPEnd:           pb.EventId_net_flow_udp_end,
+	NetFlowICMPBegin:        pb.EventId_net_flow_icmp_begin,
+	NetFlowICMPEnd:          pb.EventId_net_flow_icmp_end,
 	// MaxUserNetID marker (consumes ID slot)
 	NetTCPConnect:      pb.EventId_net_tcp_connect,
 	InitNamespaces:     pb.EventId_init_namespaces,
@@ -580,6 +588,7 @@ var EventTranslationTable = [MaxBuiltinID]pb.EventId{
 	HiddenKernelModule: pb.EventId_hidden_kernel_module,
 	FtraceHook:         pb.EventId_ftrace_hook,
 	TraceeInfo:         pb.EventId_tracee_info,
+	PolicyAction:       pb.EventId_policy_action,
 }
 
 // TranslateEventID translates an internal event ID to the corresponding protobuf Event ID.
@@ -160,6 +160,10 @@ func getCType(t interface{}) string {
 		return "trace.ProtoHTTPRequest"
 	case trace.ProtoHTTPResponse:
 		return "trace.ProtoHTTPResponse"
+	case trace.ProtoTLSClientHello:
+		return "trace.ProtoTLSClientHello"
+	case trace.ProtoTLSServerHello:
+		return "trace.ProtoTLSServerHello"
 	case trace.PacketMetadata:
 		return "trace.PacketMetadata"
 	default: // TODO: how to implement pointers and maps
@@ -43,8 +43,8 @@ func TestFindingToEvent(t *testing.T) {
 			PodUID:       "uid",
 		},
 		ReturnValue:           10,
-		MatchedPoliciesKernel: 1,
-		MatchedPoliciesUser:   1,
+		MatchedPoliciesKernel: [4]uint64{1},
+		MatchedPoliciesUser:   [4]uint64{1},
 		ArgsNum:               3,
 		Args: []trace.Argument{
 			{
@@ -188,8 +188,8 @@ func createFakeEventAndFinding() detect.Finding {
 					PodUID:       "uid",
 				},
 				ReturnValue:           10,
-				MatchedPoliciesKernel: 1,
-				MatchedPoliciesUser:   1,
+				MatchedPoliciesKernel: [4]uint64{1},
+				MatchedPoliciesUser:   [4]uint64{1},
 				ArgsNum:               1,
 				Args: []trace.Argument{
 					{
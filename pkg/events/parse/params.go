@@ -108,6 +108,10 @@ func ArgZeroValueFromType(t string) interface{} {
 		return trace.ProtoHTTPRequest{}
 	case "trace.ProtoHTTPResponse":
 		return trace.ProtoHTTPResponse{}
+	case "trace.ProtoTLSClientHello":
+		return trace.ProtoTLSClientHello{}
+	case "trace.ProtoTLSServerHello":
+		return trace.ProtoTLSServerHello{}
 	case "trace.PacketMetadata":
 		return trace.PacketMetadata{}
 	case "[]trace.HookedSymbolData":
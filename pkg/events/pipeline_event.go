@@ -2,6 +2,7 @@ package events
 
 import (
 	pb "github.com/aquasecurity/tracee/api/v1beta1"
+	"github.com/aquasecurity/tracee/common/bitwise"
 	"github.com/aquasecurity/tracee/types/protocol"
 	"github.com/aquasecurity/tracee/types/trace"
 )
@@ -22,7 +23,7 @@ type PipelineEvent struct {
 
 	// MatchedPoliciesBitmap is a combined bitmap for efficient policy matching.
 	// This replaces the need to expose separate Kernel/User bitmaps to external APIs.
-	MatchedPoliciesBitmap uint64
+	MatchedPoliciesBitmap bitwise.Bitmap
 
 	// ProtoEvent is a cached protobuf representation of the event.
 	// It is lazily populated on first call to ToProto() and reused thereafter.
@@ -71,7 +72,7 @@ func (pe *PipelineEvent) Reset() {
 	}
 	pe.EventID = 0
 	pe.Timestamp = 0
-	pe.MatchedPoliciesBitmap = 0
+	pe.MatchedPoliciesBitmap = bitwise.Bitmap{}
 	if pe.protoSlab != nil {
 		protoSlabPool.Put(pe.protoSlab)
 		pe.protoSlab = nil
@@ -0,0 +1,121 @@
+package filters
+
+import (
+	"strings"
+
+	"github.com/aquasecurity/tracee/common/interfaces"
+)
+
+// Container selectors, matched against the metadata of the container store
+const (
+	ContainerImageSelector = "container.image"
+	K8sNamespaceSelector   = "k8s.namespace"
+	K8sLabelSelectorPrefix = "k8s.label."
+)
+
+// IsContainerSelector returns true if the scope name is a container selector
+func IsContainerSelector(name string) bool {
+	return name == ContainerImageSelector ||
+		name == K8sNamespaceSelector ||
+		(strings.HasPrefix(name, K8sLabelSelectorPrefix) && len(name) > len(K8sLabelSelectorPrefix))
+}
+
+// ContainerSelectorFilter selects containers by their image, and by the namespace
+// and labels of their kubernetes pod. All the selectors must match.
+type ContainerSelectorFilter struct {
+	imageFilter     *StringFilter
+	namespaceFilter *StringFilter
+	labelFilters    map[string]*StringFilter // k=label key
+	enabled         bool
+}
+
+// Compile-time check to ensure that ContainerSelectorFilter implements the Cloner interface
+var _ interfaces.Cloner[*ContainerSelectorFilter] = &ContainerSelectorFilter{}
+
+func NewContainerSelectorFilter() *ContainerSelectorFilter {
+	return &ContainerSelectorFilter{
+		imageFilter:     NewStringFilter(nil),
+		namespaceFilter: NewStringFilter(nil),
+		labelFilters:    map[string]*StringFilter{},
+	}
+}
+
+// Parse parses an expression of the given selector, e.g. "k8s.label.app" and "=web"
+func (f *ContainerSelectorFilter) Parse(selector string, operatorAndValues string) error {
+	switch {
+	case selector == ContainerImageSelector:
+		if err := f.imageFilter.Parse(operatorAndValues); err != nil {
+			return err
+		}
+	case selector == K8sNamespaceSelector:
+		if err := f.namespaceFilter.Parse(operatorAndValues); err != nil {
+			return err
+		}
+	case IsContainerSelector(selector):
+		key := strings.TrimPrefix(selector, K8sLabelSelectorPrefix)
+		filter, ok := f.labelFilters[key]
+		if !ok {
+			filter = NewStringFilter(nil)
+		}
+		if err := filter.Parse(operatorAndValues); err != nil {
+			return err
+		}
+		f.labelFilters[key] = filter
+	default:
+		return InvalidExpression(selector + operatorAndValues)
+	}
+
+	f.Enable()
+
+	return nil
+}
+
+// Match returns true if a container with the given metadata is selected.
+// Missing labels match as empty values. Selectors without expressions are
+// disabled, so they match anything.
+func (f *ContainerSelectorFilter) Match(image, namespace string, labels map[string]string) bool {
+	if !f.enabled {
+		return true
+	}
+
+	if !f.imageFilter.filter(image) || !f.namespaceFilter.filter(namespace) {
+		return false
+	}
+	for key, filter := range f.labelFilters {
+		if !filter.filter(labels[key]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (f *ContainerSelectorFilter) Enable() {
+	f.enabled = true
+}
+
+func (f *ContainerSelectorFilter) Disable() {
+	f.enabled = false
+}
+
+func (f *ContainerSelectorFilter) Enabled() bool {
+	return f.enabled
+}
+
+func (f *ContainerSelectorFilter) Clone() *ContainerSelectorFilter {
+	if f == nil {
+		return nil
+	}
+
+	n := &ContainerSelectorFilter{
+		imageFilter:     f.imageFilter.Clone(),
+		namespaceFilter: f.namespaceFilter.Clone(),
+		labelFilters:    make(map[string]*StringFilter, len(f.labelFilters)),
+		enabled:         f.enabled,
+	}
+	for key, filter := range f.labelFilters {
+		n.labelFilters[key] = filter.Clone()
+	}
+
+	return n
+}
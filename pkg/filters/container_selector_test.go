@@ -0,0 +1,175 @@
+package filters
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// selectedContainer is the metadata of a container, and whether it is selected
+type selectedContainer struct {
+	image     string
+	namespace string
+	labels    map[string]string
+	expected  bool
+}
+
+func TestContainerSelectorFilter(t *testing.T) {
+	t.Parallel()
+
+	web := map[string]string{"app": "web", "app.kubernetes.io/part-of": "shop"}
+	db := map[string]string{"app": "db"}
+
+	tests := []struct {
+		name       string
+		selectors  [][2]string // selector and operator with values
+		containers []selectedContainer
+	}{
+		{
+			name:      "no selectors",
+			selectors: nil,
+			containers: []selectedContainer{
+				{"nginx", "prod", web, true},
+				{"", "", nil, true},
+			},
+		},
+		{
+			name:      "namespaces",
+			selectors: [][2]string{{K8sNamespaceSelector, "=prod,staging"}},
+			containers: []selectedContainer{
+				{"nginx", "prod", web, true},
+				{"nginx", "staging", web, true},
+				{"nginx", "dev", web, false},
+				{"nginx", "", nil, false},
+			},
+		},
+		{
+			name:      "excluded namespace",
+			selectors: [][2]string{{K8sNamespaceSelector, "!=kube-system"}},
+			containers: []selectedContainer{
+				{"coredns", "kube-system", nil, false},
+				{"nginx", "prod", web, true},
+				{"nginx", "", nil, true},
+			},
+		},
+		{
+			name: "labels",
+			selectors: [][2]string{
+				{K8sLabelSelectorPrefix + "app", "=web"},
+				{K8sLabelSelectorPrefix + "app.kubernetes.io/part-of", "=shop"},
+			},
+			containers: []selectedContainer{
+				{"nginx", "prod", web, true},
+				{"nginx", "prod", map[string]string{"app": "web"}, false},
+				{"postgres", "prod", db, false},
+			},
+		},
+		{
+			name:      "missing label",
+			selectors: [][2]string{{K8sLabelSelectorPrefix + "app", "!=db"}},
+			containers: []selectedContainer{
+				{"nginx", "prod", web, true},
+				{"postgres", "prod", db, false},
+				{"busybox", "", nil, true},
+			},
+		},
+		{
+			name: "image wildcards and namespace",
+			selectors: [][2]string{
+				{ContainerImageSelector, "=*nginx*"},
+				{K8sNamespaceSelector, "=prod"},
+			},
+			containers: []selectedContainer{
+				{"docker.io/library/nginx:1.25", "prod", web, true},
+				{"docker.io/library/nginx:1.25", "dev", web, false},
+				{"docker.io/library/postgres:16", "prod", db, false},
+			},
+		},
+		{
+			name: "image equality wins over exclusion",
+			selectors: [][2]string{
+				{ContainerImageSelector, "=*nginx*"},
+				{ContainerImageSelector, "!=*-debug*"},
+			},
+			containers: []selectedContainer{
+				{"docker.io/library/nginx-debug:1.25", "prod", web, true},
+				{"docker.io/library/busybox-debug:1.36", "prod", nil, false},
+				{"docker.io/library/postgres:16", "prod", db, true},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			filter := NewContainerSelectorFilter()
+			for _, selector := range tt.selectors {
+				require.NoError(t, filter.Parse(selector[0], selector[1]))
+			}
+			assert.Equal(t, len(tt.selectors) > 0, filter.Enabled())
+
+			clone := filter.Clone()
+			for _, c := range tt.containers {
+				assert.Equal(t, c.expected, filter.Match(c.image, c.namespace, c.labels), "%+v", c)
+				assert.Equal(t, c.expected, clone.Match(c.image, c.namespace, c.labels), "%+v", c)
+			}
+
+			// Disabled selectors match any container
+			filter.Disable()
+			for _, c := range tt.containers {
+				assert.True(t, filter.Match(c.image, c.namespace, c.labels))
+			}
+		})
+	}
+}
+
+func TestContainerSelectorFilter_Parse(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name              string
+		selector          string
+		operatorAndValues string
+		expected          error
+	}{
+		{"unknown selector", "k8s.annotation.app", "=web", InvalidExpression("k8s.annotation.app=web")},
+		{"label without key", "k8s.label.", "=web", InvalidExpression("k8s.label.=web")},
+		{"missing values", K8sNamespaceSelector, "!=", InvalidExpression("!=")},
+		{"missing operator", ContainerImageSelector, "", InvalidExpression("")},
+		{"any value", ContainerImageSelector, "=*", InvalidValue("*")},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			filter := NewContainerSelectorFilter()
+			err := filter.Parse(tt.selector, tt.operatorAndValues)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.expected.Error())
+			assert.False(t, filter.Enabled())
+		})
+	}
+}
+
+func TestIsContainerSelector(t *testing.T) {
+	t.Parallel()
+
+	for name, expected := range map[string]bool{
+		"k8s.namespace":    true,
+		"k8s.label.app":    true,
+		"container.image":  true,
+		"k8s.label.":       false,
+		"k8s.pod":          false,
+		"container":        false,
+		"container.images": false,
+	} {
+		assert.Equal(t, expected, IsContainerSelector(name), name)
+	}
+}
@@ -46,7 +46,7 @@ func (kdf *KernelDataFilter) IsKernelFilterEnabled(field string) bool {
 }
 
 type DataFilter struct {
-	filters          map[string]Filter[*StringFilter]
+	filters          map[string]*DataFieldFilter
 	kernelDataFilter *KernelDataFilter
 	enabled          bool
 	skipKernelFilter bool // For detector filters - always apply userspace filtering
@@ -57,7 +57,7 @@ var _ interfaces.Cloner[*DataFilter] = &DataFilter{}
 
 func NewDataFilter() *DataFilter {
 	return &DataFilter{
-		filters:          map[string]Filter[*StringFilter]{},
+		filters:          map[string]*DataFieldFilter{},
 		kernelDataFilter: NewKernelDataFilter(),
 		enabled:          false,
 		skipKernelFilter: false,
@@ -68,7 +68,7 @@ func NewDataFilter() *DataFilter {
 // Detectors don't have kernel-side filtering, so all filtering must happen in userspace
 func NewDetectorDataFilter() *DataFilter {
 	return &DataFilter{
-		filters:          map[string]Filter[*StringFilter]{},
+		filters:          map[string]*DataFieldFilter{},
 		kernelDataFilter: NewKernelDataFilter(),
 		enabled:          false,
 		skipKernelFilter: true, // Force userspace filtering
@@ -90,14 +90,19 @@ func (f *DataFilter) Equalities() (StringFilterEqualities, error) {
 	// selected data name
 	dataField := "pathname"
 
-	fieldName, ok := f.filters[dataField]
+	fieldFilter, ok := f.filters[dataField]
 	if !ok {
 		return StringFilterEqualities{}, fmt.Errorf("field %s does not exist in filters", dataField)
 	}
 
-	filter, ok := fieldName.(*StringFilter)
-	if !ok {
-		return StringFilterEqualities{}, fmt.Errorf("failed to assert field %s as *StringFilter", dataField)
+	// the kernel can't evaluate typed expressions, so the field is filtered in userspace only
+	if fieldFilter.typed() {
+		return StringFilterEqualities{}, fmt.Errorf("field %s has expressions not supported by the kernel", dataField)
+	}
+
+	filter := fieldFilter.StringFilter()
+	if filter == nil {
+		return StringFilterEqualities{}, fmt.Errorf("field %s has no string expressions", dataField)
 	}
 
 	equalities := filter.Equalities()
@@ -112,11 +117,30 @@ func (f *DataFilter) Equalities() (StringFilterEqualities, error) {
 	}, nil
 }
 
-// GetFieldFilters returns the data filters map
-// writing to the map may have unintentional consequences, avoid doing so
+// GetFieldFilters returns the string filters of the data fields, omitting the fields without
+// string expressions
+// writing to the filters may have unintentional consequences, avoid doing so
 // TODO: encapsulate by replacing this function with "GetFieldFilter(fieldName string) StringFilter"
 func (f *DataFilter) GetFieldFilters() map[string]Filter[*StringFilter] {
-	return f.filters
+	fieldFilters := make(map[string]Filter[*StringFilter], len(f.filters))
+	for fieldName, filter := range f.filters {
+		if strFilter := filter.StringFilter(); strFilter != nil {
+			fieldFilters[fieldName] = strFilter
+		}
+	}
+
+	return fieldFilters
+}
+
+// isKernelFiltered returns true if the field was already filtered in kernel space
+func (f *DataFilter) isKernelFiltered(fieldName string) bool {
+	if f.skipKernelFilter || !f.kernelDataFilter.IsKernelFilterEnabled(fieldName) {
+		return false
+	}
+
+	// typed expressions are evaluated in userspace only (see Equalities)
+	filter, ok := f.filters[fieldName]
+	return ok && !filter.typed()
 }
 
 func (f *DataFilter) Filter(data []trace.Argument) bool {
@@ -133,7 +157,7 @@ func (f *DataFilter) Filter(data []trace.Argument) bool {
 		// TODO: Rethink whether using an integer instead of a string
 		// would improve efficiency in the args structure.
 		// Skip kernel filter optimization if this is a detector filter
-		if !f.skipKernelFilter && f.kernelDataFilter.IsKernelFilterEnabled(fieldName) {
+		if f.isKernelFiltered(fieldName) {
 			continue
 		}
 
@@ -148,9 +172,7 @@ func (f *DataFilter) Filter(data []trace.Argument) bool {
 			return false
 		}
 
-		// TODO: use type assertion instead of string conversion
-		fieldVal = fmt.Sprint(fieldVal)
-
+		// the field filter converts the value as its expressions require
 		res := filter.Filter(fieldVal)
 		if !res {
 			return false
@@ -169,7 +191,7 @@ func (f *DataFilter) FilterProto(data []*v1beta1.EventValue) bool {
 	}
 
 	for fieldName, filter := range f.filters {
-		if !f.skipKernelFilter && f.kernelDataFilter.IsKernelFilterEnabled(fieldName) {
+		if f.isKernelFiltered(fieldName) {
 			continue
 		}
 
@@ -189,8 +211,7 @@ func (f *DataFilter) FilterProto(data []*v1beta1.EventValue) bool {
 				found = true
 				if s, ok := ev.GetValue().(*v1beta1.EventValue_Str); ok {
 					// Fast path: string values need no boxing or
-					// fmt.Sprint allocation (fmt.Sprint of a string is
-					// the string itself).
+					// fmt.Sprint allocation in the field filter.
 					if !filter.Filter(s.Str) {
 						return false
 					}
@@ -208,7 +229,6 @@ func (f *DataFilter) FilterProto(data []*v1beta1.EventValue) bool {
 			continue
 		}
 
-		fieldVal = fmt.Sprint(fieldVal)
 		if !filter.Filter(fieldVal) {
 			return false
 		}
@@ -262,9 +282,11 @@ func (f *DataFilter) Parse(id events.ID, fieldName string, operatorAndValues str
 
 	// check if data field name exists for this event
 	fieldFound := false
+	fieldType := ""
 	for i := range eventFields {
 		if eventFields[i].Name == fieldName {
 			fieldFound = true
+			fieldType = eventFields[i].Type
 			break
 		}
 	}
@@ -321,9 +343,9 @@ func (f *DataFilter) Parse(id events.ID, fieldName string, operatorAndValues str
 	}
 
 	err := f.parseFilter(fieldName, operatorAndValues,
-		func() Filter[*StringFilter] {
-			// TODO: map data type to an appropriate filter constructor
-			return NewStringFilter(valueHandler)
+		func() *DataFieldFilter {
+			// the field type selects the expressions supported besides string ones
+			return NewDataFieldFilter(fieldType, valueHandler)
 		})
 	if err != nil {
 		return errfmt.WrapError(err)
@@ -336,7 +358,7 @@ func (f *DataFilter) Parse(id events.ID, fieldName string, operatorAndValues str
 
 // parseFilter adds an data filter with the relevant filterConstructor.
 // The user must responsibly supply a reliable Filter object.
-func (f *DataFilter) parseFilter(fieldName string, operatorAndValues string, filterConstructor func() Filter[*StringFilter]) error {
+func (f *DataFilter) parseFilter(fieldName string, operatorAndValues string, filterConstructor func() *DataFieldFilter) error {
 	if _, ok := f.filters[fieldName]; !ok {
 		// store new event data filter if missing
 		dataFilter := filterConstructor()
@@ -400,9 +422,9 @@ func (f *DataFilter) enableKernelFilterArg(fieldName string) {
 		return
 	}
 
-	strFilter, ok := filter.(*StringFilter)
-	if !ok {
-		logger.Debugw("Failed to assert", "fieldName", fieldName)
+	strFilter := filter.StringFilter()
+	if strFilter == nil {
+		logger.Debugw("No string filter", "fieldName", fieldName)
 		return
 	}
 
@@ -0,0 +1,537 @@
+package filters
+
+import (
+	"fmt"
+	"net"
+	"net/netip"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/aquasecurity/tracee/api/v1beta1"
+	"github.com/aquasecurity/tracee/common/errfmt"
+	"github.com/aquasecurity/tracee/common/interfaces"
+	"github.com/aquasecurity/tracee/types/trace"
+)
+
+const (
+	regexValuePrefix   = "~" // "=~^/etc/.*\.conf$": anchored regex over a string field
+	bitmaskValuePrefix = "&" // "=&0x40": all the bits of the mask are set in an integer field
+)
+
+// DataFieldFilter filters the values of an event data field. Plain values of the '=' and
+// '!=' operators are string expressions, compared with the value string as before. Other
+// expressions depend on the field type in the event definition:
+//
+//   - integer fields: numeric ranges ('<', '<=', '>', '>=') and bitmasks ('=&MASK')
+//   - SockAddr fields: IP addresses and CIDRs, matched against the sockaddr address
+//   - string fields: CIDRs, anchored regexes ('=~REGEX') and globs ('?', or '*' inside the value)
+//
+// All the expressions of a field follow the priority of string expressions: a value matching
+// an '=' expression (or a range) passes, otherwise it passes only if '!=' expressions are given
+// and it matches none of them.
+type DataFieldFilter struct {
+	fieldType    string
+	valueHandler ValueHandler
+	strFilter    *StringFilter          // nil until a string expression is given
+	signedRange  *NumericFilter[int64]  // ranges of signed fields
+	rangeFilter  *NumericFilter[uint64] // ranges of unsigned fields
+	masks        []uint64
+	notMasks     []uint64
+	prefixes     []netip.Prefix
+	notPrefixes  []netip.Prefix
+	patterns     []*regexp.Regexp // regexes and globs
+	notPatterns  []*regexp.Regexp
+	enabled      bool
+}
+
+// Compile-time check to ensure that DataFieldFilter implements the Cloner interface
+var _ interfaces.Cloner[*DataFieldFilter] = &DataFieldFilter{}
+
+// NewDataFieldFilter creates a filter of a data field of the given type. The value handler
+// applies to the values of its string expressions.
+func NewDataFieldFilter(fieldType string, valHandler ValueHandler) *DataFieldFilter {
+	return &DataFieldFilter{
+		fieldType:    fieldType,
+		valueHandler: valHandler,
+	}
+}
+
+// StringFilter returns the filter of the string expressions of the field, or nil if none
+// were given.
+func (f *DataFieldFilter) StringFilter() *StringFilter {
+	return f.strFilter
+}
+
+// typed returns true if the filter has expressions other than string ones
+func (f *DataFieldFilter) typed() bool {
+	return f.signedRange != nil || f.rangeFilter != nil ||
+		len(f.masks) > 0 || len(f.notMasks) > 0 ||
+		len(f.prefixes) > 0 || len(f.notPrefixes) > 0 ||
+		len(f.patterns) > 0 || len(f.notPatterns) > 0
+}
+
+func (f *DataFieldFilter) Filter(val interface{}) bool {
+	if !f.enabled {
+		return true
+	}
+
+	str, ok := val.(string)
+	if !ok && (f.strFilter != nil || len(f.patterns) > 0 || len(f.notPatterns) > 0) {
+		str = fmt.Sprint(val)
+	}
+	if !f.typed() {
+		return f.strFilter != nil && f.strFilter.filter(str)
+	}
+
+	if f.matchEqual(val, str) {
+		return true
+	}
+	if f.hasNotEqual() {
+		return !f.matchNotEqual(val, str)
+	}
+
+	return false
+}
+
+// matchEqual returns true if the value matches an '=' expression or a range
+func (f *DataFieldFilter) matchEqual(val interface{}, str string) bool {
+	if f.strFilter != nil && f.strFilter.matchEqual(str) {
+		return true
+	}
+	if f.signedRange != nil {
+		if num, ok := toInt64(val); ok && f.signedRange.filter(num) {
+			return true
+		}
+	}
+	if f.rangeFilter != nil {
+		if num, ok := toUint64(val); ok && f.rangeFilter.filter(num) {
+			return true
+		}
+	}
+	if len(f.masks) > 0 && matchMasks(f.masks, val) {
+		return true
+	}
+	if len(f.prefixes) > 0 && matchPrefixes(f.prefixes, val) {
+		return true
+	}
+
+	return matchPatterns(f.patterns, str)
+}
+
+// matchNotEqual returns true if the value matches a '!=' expression
+func (f *DataFieldFilter) matchNotEqual(val interface{}, str string) bool {
+	if f.strFilter != nil && f.strFilter.matchNotEqual(str) {
+		return true
+	}
+	if len(f.notMasks) > 0 && matchMasks(f.notMasks, val) {
+		return true
+	}
+	if len(f.notPrefixes) > 0 && matchPrefixes(f.notPrefixes, val) {
+		return true
+	}
+
+	return matchPatterns(f.notPatterns, str)
+}
+
+func (f *DataFieldFilter) hasNotEqual() bool {
+	return (f.strFilter != nil && f.strFilter.hasNotEqual()) ||
+		len(f.notMasks) > 0 || len(f.notPrefixes) > 0 || len(f.notPatterns) > 0
+}
+
+func (f *DataFieldFilter) Parse(operatorAndValues string) error {
+	operatorString, valuesString, err := splitOperatorAndValues(operatorAndValues)
+	if err != nil {
+		return errfmt.WrapError(err)
+	}
+	operator := stringToOperator(operatorString)
+
+	// A regex is a single value, as it may contain commas
+	if regex, ok := strings.CutPrefix(valuesString, regexValuePrefix); ok {
+		if err := f.addPattern(operator, "^(?:"+regex+")$", operatorAndValues); err != nil {
+			return errfmt.WrapError(err)
+		}
+		f.Enable()
+		return nil
+	}
+
+	strValues := []string{}
+	for _, val := range strings.Split(valuesString, ",") {
+		handled, err := f.addTyped(operator, val, operatorAndValues)
+		if err != nil {
+			return errfmt.WrapError(err)
+		}
+		if !handled {
+			strValues = append(strValues, val)
+		}
+	}
+
+	if len(strValues) > 0 {
+		if f.strFilter == nil {
+			f.strFilter = NewStringFilter(f.valueHandler)
+		}
+		err := f.strFilter.Parse(operatorString + strings.Join(strValues, ","))
+		if err != nil {
+			return errfmt.WrapError(err)
+		}
+	}
+
+	f.Enable()
+
+	return nil
+}
+
+// addTyped adds the value as an expression of the field type, returning false if the
+// value is a string expression.
+func (f *DataFieldFilter) addTyped(operator Operator, val, expression string) (bool, error) {
+	switch {
+	case f.isInteger():
+		if operator != Equal && operator != NotEqual {
+			return true, f.addRange(operator, val, expression)
+		}
+		if mask, ok := strings.CutPrefix(val, bitmaskValuePrefix); ok {
+			bits, err := strconv.ParseUint(mask, 0, 64)
+			if err != nil {
+				return true, InvalidValue(val)
+			}
+			if operator == Equal {
+				f.masks = append(f.masks, bits)
+			} else {
+				f.notMasks = append(f.notMasks, bits)
+			}
+			return true, nil
+		}
+
+	case f.fieldType == "SockAddr" || f.isString():
+		if operator != Equal && operator != NotEqual {
+			return false, nil
+		}
+		if prefix, ok := f.parsePrefix(val); ok {
+			if operator == Equal {
+				f.prefixes = append(f.prefixes, prefix)
+			} else {
+				f.notPrefixes = append(f.notPrefixes, prefix)
+			}
+			return true, nil
+		}
+		if f.isString() && isGlob(val) {
+			return true, f.addPattern(operator, globToRegex(val), expression)
+		}
+	}
+
+	return false, nil
+}
+
+// addRange adds a range expression of an integer field. Values may be given in any base
+// supported by strconv ("0x40", "0o755", "0b11").
+func (f *DataFieldFilter) addRange(operator Operator, val, expression string) error {
+	if f.isSigned() {
+		num, err := strconv.ParseInt(val, 0, 64)
+		if err != nil {
+			return InvalidValue(val)
+		}
+		if f.signedRange == nil {
+			f.signedRange = NewIntFilter()
+		}
+		f.signedRange.add(num, operator)
+		f.signedRange.Enable()
+		return nil
+	}
+
+	num, err := strconv.ParseUint(val, 0, 64)
+	if err != nil {
+		return InvalidValue(val)
+	}
+	// Check for invalid unsigned operation: 'uint<0'
+	if operator == Lower && num == 0 {
+		return InvalidExpression(expression)
+	}
+	if f.rangeFilter == nil {
+		f.rangeFilter = NewUIntFilter()
+	}
+	f.rangeFilter.add(num, operator)
+	f.rangeFilter.Enable()
+
+	return nil
+}
+
+func (f *DataFieldFilter) addPattern(operator Operator, pattern, expression string) error {
+	if !f.isString() {
+		return InvalidFilterType()
+	}
+	if operator != Equal && operator != NotEqual {
+		return UnsupportedOperator(operator)
+	}
+
+	regex, err := regexp.Compile(pattern)
+	if err != nil {
+		return InvalidExpression(expression)
+	}
+	if operator == Equal {
+		f.patterns = append(f.patterns, regex)
+	} else {
+		f.notPatterns = append(f.notPatterns, regex)
+	}
+
+	return nil
+}
+
+// parsePrefix parses a CIDR value. Plain IP addresses are prefixes of SockAddr fields only:
+// string fields keep comparing them as strings.
+func (f *DataFieldFilter) parsePrefix(val string) (netip.Prefix, bool) {
+	if prefix, err := netip.ParsePrefix(val); err == nil {
+		return prefix.Masked(), true
+	}
+	if f.fieldType != "SockAddr" {
+		return netip.Prefix{}, false
+	}
+	addr, err := netip.ParseAddr(val)
+	if err != nil {
+		return netip.Prefix{}, false
+	}
+
+	return netip.PrefixFrom(addr.Unmap(), addr.Unmap().BitLen()), true
+}
+
+func (f *DataFieldFilter) isInteger() bool {
+	switch f.fieldType {
+	case "int", "int8", "int16", "int32", "int64",
+		"uint", "uint8", "uint16", "uint32", "uint64", "trace.Pointer":
+		return true
+	}
+	return false
+}
+
+func (f *DataFieldFilter) isSigned() bool {
+	switch f.fieldType {
+	case "int", "int8", "int16", "int32", "int64":
+		return true
+	}
+	return false
+}
+
+// isString returns true for string fields, and for fields of an unknown type (e.g. dynamic
+// fields of signature events).
+func (f *DataFieldFilter) isString() bool {
+	return f.fieldType == "string" || f.fieldType == ""
+}
+
+func (f *DataFieldFilter) Enable() {
+	f.enabled = true
+	if f.strFilter != nil {
+		f.strFilter.Enable()
+	}
+}
+
+func (f *DataFieldFilter) Disable() {
+	f.enabled = false
+	if f.strFilter != nil {
+		f.strFilter.Disable()
+	}
+}
+
+func (f *DataFieldFilter) Enabled() bool {
+	return f.enabled
+}
+
+func (f *DataFieldFilter) Clone() *DataFieldFilter {
+	if f == nil {
+		return nil
+	}
+
+	n := &DataFieldFilter{
+		fieldType:    f.fieldType,
+		valueHandler: f.valueHandler,
+		strFilter:    f.strFilter.Clone(),
+		signedRange:  f.signedRange.Clone(),
+		rangeFilter:  f.rangeFilter.Clone(),
+		masks:        slices.Clone(f.masks),
+		notMasks:     slices.Clone(f.notMasks),
+		prefixes:     slices.Clone(f.prefixes),
+		notPrefixes:  slices.Clone(f.notPrefixes),
+		patterns:     slices.Clone(f.patterns), // compiled regexes are safe for concurrent use
+		notPatterns:  slices.Clone(f.notPatterns),
+		enabled:      f.enabled,
+	}
+
+	return n
+}
+
+// splitOperatorAndValues splits an expression into its operator (=, !=, <, <=, >, >=) and
+// its values.
+func splitOperatorAndValues(operatorAndValues string) (string, string, error) {
+	for _, operator := range []string{"!=", "<=", ">=", "=", "<", ">"} {
+		if values, ok := strings.CutPrefix(operatorAndValues, operator); ok {
+			if values == "" {
+				return "", "", InvalidExpression(operatorAndValues)
+			}
+			return operator, values, nil
+		}
+	}
+
+	return "", "", InvalidExpression(operatorAndValues)
+}
+
+// isGlob returns true if the value has wildcards other than the leading and trailing '*' of
+// prefix, suffix and contains expressions.
+func isGlob(val string) bool {
+	trimmed := strings.TrimSuffix(strings.TrimPrefix(val, "*"), "*")
+	return strings.ContainsAny(trimmed, "*?")
+}
+
+// globToRegex returns the anchored regex of a glob, where '*' matches any characters
+// (including '/', as prefix and suffix expressions do) and '?' a single one.
+func globToRegex(glob string) string {
+	var regex strings.Builder
+	regex.WriteString("^")
+	for _, r := range glob {
+		switch r {
+		case '*':
+			regex.WriteString(".*")
+		case '?':
+			regex.WriteString(".")
+		default:
+			regex.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	regex.WriteString("$")
+
+	return regex.String()
+}
+
+func matchMasks(masks []uint64, val interface{}) bool {
+	bits, ok := toUint64(val)
+	if !ok {
+		num, ok := toInt64(val)
+		if !ok {
+			return false
+		}
+		bits = uint64(num)
+	}
+	for _, mask := range masks {
+		if bits&mask == mask {
+			return true
+		}
+	}
+
+	return false
+}
+
+func matchPrefixes(prefixes []netip.Prefix, val interface{}) bool {
+	addr, ok := toAddr(val)
+	if !ok {
+		return false
+	}
+	for _, prefix := range prefixes {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func matchPatterns(patterns []*regexp.Regexp, str string) bool {
+	for _, pattern := range patterns {
+		if pattern.MatchString(str) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// toInt64 returns the value of an integer, or of a string holding one, as int64
+func toInt64(val interface{}) (int64, bool) {
+	switch v := val.(type) {
+	case int:
+		return int64(v), true
+	case int8:
+		return int64(v), true
+	case int16:
+		return int64(v), true
+	case int32:
+		return int64(v), true
+	case int64:
+		return v, true
+	case string:
+		num, err := strconv.ParseInt(v, 0, 64)
+		return num, err == nil
+	}
+
+	num, ok := toUint64(val)
+	if !ok || num > uint64(1<<63-1) {
+		return 0, false
+	}
+
+	return int64(num), true
+}
+
+// toUint64 returns the value of a non-negative integer, or of a string holding one, as uint64
+func toUint64(val interface{}) (uint64, bool) {
+	switch v := val.(type) {
+	case uint:
+		return uint64(v), true
+	case uint8:
+		return uint64(v), true
+	case uint16:
+		return uint64(v), true
+	case uint32:
+		return uint64(v), true
+	case uint64:
+		return v, true
+	case trace.Pointer:
+		return uint64(v), true
+	case string:
+		num, err := strconv.ParseUint(v, 0, 64)
+		return num, err == nil
+	case int, int8, int16, int32, int64:
+		num, _ := toInt64(v)
+		if num < 0 {
+			return 0, false
+		}
+		return uint64(num), true
+	}
+
+	return 0, false
+}
+
+// toAddr returns the IP address of a value: an IP string or net.IP, or the address of a
+// sockaddr, either decoded (map) or protobuf.
+func toAddr(val interface{}) (netip.Addr, bool) {
+	var (
+		addr netip.Addr
+		err  error
+		ok   bool
+	)
+
+	switch v := val.(type) {
+	case string:
+		addr, err = netip.ParseAddr(v)
+		ok = err == nil
+	case net.IP:
+		addr, ok = netip.AddrFromSlice(v)
+	case map[string]string:
+		return toAddr(sockaddrAddress(v["sin_addr"], v["sin6_addr"]))
+	case *v1beta1.EventValue:
+		if sockaddr := v.GetSockaddr(); sockaddr != nil {
+			return toAddr(sockaddrAddress(sockaddr.GetSinAddr(), sockaddr.GetSin6Addr()))
+		}
+	case *v1beta1.SockAddr:
+		return toAddr(sockaddrAddress(v.GetSinAddr(), v.GetSin6Addr()))
+	}
+	if !ok {
+		return netip.Addr{}, false
+	}
+
+	return addr.Unmap(), true
+}
+
+// sockaddrAddress returns the address of an AF_INET or AF_INET6 sockaddr
+func sockaddrAddress(sinAddr, sin6Addr string) string {
+	if sinAddr != "" {
+		return sinAddr
+	}
+	return sin6Addr
+}
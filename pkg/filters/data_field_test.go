@@ -0,0 +1,187 @@
+package filters
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aquasecurity/tracee/api/v1beta1"
+	"github.com/aquasecurity/tracee/types/trace"
+)
+
+// dataFieldValue is a value of a data field, and whether it passes the filter
+type dataFieldValue struct {
+	value    interface{}
+	expected bool
+}
+
+func TestDataFieldFilter(t *testing.T) {
+	t.Parallel()
+
+	sockaddr := func(addr string) map[string]string {
+		if net.ParseIP(addr).To4() == nil {
+			return map[string]string{"sa_family": "AF_INET6", "sin6_addr": addr, "sin6_port": "443"}
+		}
+		return map[string]string{"sa_family": "AF_INET", "sin_addr": addr, "sin_port": "443"}
+	}
+
+	tests := []struct {
+		name        string
+		fieldType   string
+		expressions []string
+		values      []dataFieldValue
+	}{
+		{
+			name:        "signed range",
+			fieldType:   "int32",
+			expressions: []string{">2", "<10"},
+			values:      []dataFieldValue{{int32(2), false}, {int32(3), true}, {int32(9), true}, {int32(10), false}, {"5", true}, {"x", false}},
+		},
+		{
+			name:        "signed range of negative values",
+			fieldType:   "int64",
+			expressions: []string{"<=-1"},
+			values:      []dataFieldValue{{int64(-1), true}, {int64(-100), true}, {int64(0), false}},
+		},
+		{
+			name:        "unsigned range in other bases",
+			fieldType:   "uint16",
+			expressions: []string{">=0o4000"},
+			values:      []dataFieldValue{{uint16(0o4755), true}, {uint16(0o4000), true}, {uint16(0o755), false}, {uint32(0o6755), true}},
+		},
+		{
+			name:        "range or string equality",
+			fieldType:   "uint64",
+			expressions: []string{"=1", ">100"},
+			values:      []dataFieldValue{{uint64(1), true}, {uint64(2), false}, {uint64(101), true}, {trace.Pointer(200), true}},
+		},
+		{
+			name:        "bitmask",
+			fieldType:   "int32",
+			expressions: []string{"=&0x41"},
+			values:      []dataFieldValue{{int32(0x41), true}, {int32(0x241), true}, {int32(0x40), false}, {int32(-1), true}},
+		},
+		{
+			name:        "not bitmask",
+			fieldType:   "uint64",
+			expressions: []string{"!=&4"},
+			values:      []dataFieldValue{{uint64(3), true}, {uint64(7), false}},
+		},
+		{
+			name:        "sockaddr cidr",
+			fieldType:   "SockAddr",
+			expressions: []string{"=10.0.0.0/8,fd00::/8"},
+			values: []dataFieldValue{
+				{sockaddr("10.1.2.3"), true},
+				{sockaddr("11.0.0.1"), false},
+				{sockaddr("fd00::1"), true},
+				{map[string]string{"sa_family": "AF_UNIX", "sun_path": "/run/docker.sock"}, false},
+				{&v1beta1.SockAddr{SinAddr: "10.1.2.3"}, true},
+				{&v1beta1.SockAddr{Sin6Addr: "::ffff:10.0.0.1"}, true},
+				{&v1beta1.SockAddr{SunPath: "/run/docker.sock"}, false},
+				{&v1beta1.EventValue{Value: &v1beta1.EventValue_Sockaddr{Sockaddr: &v1beta1.SockAddr{SinAddr: "10.1.2.3"}}}, true},
+			},
+		},
+		{
+			name:        "sockaddr address exclusion",
+			fieldType:   "SockAddr",
+			expressions: []string{"!=127.0.0.1,::1"},
+			values:      []dataFieldValue{{sockaddr("127.0.0.1"), false}, {sockaddr("::1"), false}, {sockaddr("10.0.0.1"), true}},
+		},
+		{
+			name:        "string cidr with string equality",
+			fieldType:   "string",
+			expressions: []string{"=192.168.0.0/16,10.0.0.1"},
+			values:      []dataFieldValue{{"192.168.1.1", true}, {"10.0.0.1", true}, {"10.0.0.2", false}, {net.IP{192, 168, 1, 1}, true}},
+		},
+		{
+			name:        "anchored regex",
+			fieldType:   "string",
+			expressions: []string{`=~/etc/[a-z]+\.(conf|cfg)`},
+			values:      []dataFieldValue{{"/etc/nginx.conf", true}, {"/etc/app.cfg", true}, {"/etc/nginx.conf.bak", false}, {"/x/etc/a.conf", false}},
+		},
+		{
+			name:        "not regex with commas",
+			fieldType:   "string",
+			expressions: []string{"!=~[a-z]{1,3}"},
+			values:      []dataFieldValue{{"ls", false}, {"bash", true}},
+		},
+		{
+			name:        "glob",
+			fieldType:   "string",
+			expressions: []string{"=/home/*/.ssh/id_?sa"},
+			values:      []dataFieldValue{{"/home/user/.ssh/id_rsa", true}, {"/home/a/b/.ssh/id_dsa", true}, {"/home/user/.ssh/id_ed25519", false}},
+		},
+		{
+			name:        "glob and prefix exclusions",
+			fieldType:   "string",
+			expressions: []string{"!=/tmp/*", "!=*.log.?"},
+			values:      []dataFieldValue{{"/tmp/x", false}, {"/var/app.log.1", false}, {"/var/app.log", true}},
+		},
+		{
+			name:        "equality wins over exclusion",
+			fieldType:   "string",
+			expressions: []string{"=/etc/shadow", "!=~/etc/.*"},
+			values:      []dataFieldValue{{"/etc/shadow", true}, {"/etc/passwd", false}, {"/bin/ls", true}},
+		},
+		{
+			name:        "dynamic field",
+			fieldType:   "",
+			expressions: []string{"=~[0-9]+"},
+			values:      []dataFieldValue{{"42", true}, {42, true}, {"x", false}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			filter := NewDataFieldFilter(tt.fieldType, nil)
+			for _, expression := range tt.expressions {
+				require.NoError(t, filter.Parse(expression))
+			}
+
+			for _, v := range tt.values {
+				assert.Equal(t, v.expected, filter.Filter(v.value), "%v", v.value)
+			}
+
+			// Clones filter the same
+			clone := filter.Clone()
+			for _, v := range tt.values {
+				assert.Equal(t, v.expected, clone.Filter(v.value), "%v", v.value)
+			}
+		})
+	}
+}
+
+func TestDataFieldFilter_Parse(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		fieldType  string
+		expression string
+		expected   error
+	}{
+		{"string range", "string", ">10", UnsupportedOperator(Greater)},
+		{"invalid range value", "int32", ">ten", InvalidValue("ten")},
+		{"unsigned lower than 0", "uint32", "<0", InvalidExpression("<0")},
+		{"invalid bitmask", "uint64", "=&x", InvalidValue("&x")},
+		{"regex of an integer field", "int32", "=~[0-9]+", InvalidFilterType()},
+		{"invalid regex", "string", "=~(", InvalidExpression("=~(")},
+		{"regex range", "string", ">~a", UnsupportedOperator(Greater)},
+		{"missing values", "string", "!=", InvalidExpression("!=")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := NewDataFieldFilter(tt.fieldType, nil).Parse(tt.expression)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.expected.Error())
+		})
+	}
+}
@@ -96,8 +96,8 @@ func TestDataFilterProto_Int32ArrayMatch(t *testing.T) {
 	t.Parallel()
 
 	f := NewDetectorDataFilter()
-	require.NoError(t, f.parseFilter("argv", "=[1 2 3]", func() Filter[*StringFilter] {
-		return NewStringFilter(nil)
+	require.NoError(t, f.parseFilter("argv", "=[1 2 3]", func() *DataFieldFilter {
+		return NewDataFieldFilter("", nil)
 	}))
 	f.Enable()
 
@@ -112,6 +112,43 @@ func TestDataFilterProto_Int32ArrayMatch(t *testing.T) {
 	assert.Equal(t, f.Filter(traceEvt.Args), f.FilterProto(data))
 }
 
+func TestDataFilterProto_TypedMatch(t *testing.T) {
+	t.Parallel()
+
+	f := NewDetectorDataFilter()
+	require.NoError(t, f.Parse(events.SecuritySocketConnect, "remote_addr", "!=127.0.0.0/8,::1"))
+	require.NoError(t, f.Parse(events.SecuritySocketConnect, "type", ">=2"))
+
+	connect := func(addr string, sockType int32) []*v1beta1.EventValue {
+		return []*v1beta1.EventValue{
+			{Name: "remote_addr", Value: &v1beta1.EventValue_Sockaddr{
+				Sockaddr: &v1beta1.SockAddr{SaFamily: v1beta1.SaFamilyT_AF_INET, SinAddr: addr, SinPort: 53},
+			}},
+			{Name: "type", Value: &v1beta1.EventValue_Int32{Int32: sockType}},
+		}
+	}
+
+	for _, tc := range []struct {
+		data     []*v1beta1.EventValue
+		expected bool
+	}{
+		{connect("8.8.8.8", 2), true},
+		{connect("127.0.0.53", 2), false},
+		{connect("8.8.8.8", 1), false},
+	} {
+		assert.Equal(t, tc.expected, f.FilterProto(tc.data))
+
+		traceEvt := events.ConvertFromProto(&v1beta1.Event{Data: tc.data})
+		assert.Equal(t, tc.expected, f.Filter(traceEvt.Args))
+	}
+
+	// Decoded sockaddrs are maps
+	assert.False(t, f.Filter([]trace.Argument{
+		{ArgMeta: trace.ArgMeta{Name: "remote_addr"}, Value: map[string]string{"sa_family": "AF_INET", "sin_addr": "127.0.0.1"}},
+		{ArgMeta: trace.ArgMeta{Name: "type"}, Value: int32(2)},
+	}))
+}
+
 func TestProtoValueToInterface(t *testing.T) {
 	t.Parallel()
 
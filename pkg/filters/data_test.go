@@ -30,6 +30,7 @@ func TestDataFilterClone(t *testing.T) {
 
 	opt1 := cmp.AllowUnexported(
 		DataFilter{},
+		DataFieldFilter{},
 		StringFilter{},
 		sets.PrefixSet{},
 		sets.SuffixSet{},
@@ -280,3 +281,26 @@ func TestDatasFilter_Filter(t *testing.T) {
 		})
 	}
 }
+
+func TestDataFilter_TypedKernelField(t *testing.T) {
+	t.Parallel()
+
+	filter := NewDataFilter()
+	require.NoError(t, filter.Parse(events.SecurityFileOpen, "pathname", "=/etc/*"))
+
+	equalities, err := filter.Equalities()
+	require.NoError(t, err)
+	require.Contains(t, equalities.PrefixEqual, "/etc/")
+
+	// Kernel filtered values aren't filtered again
+	require.True(t, filter.Filter([]trace.Argument{newArgument("pathname", "string", "/var/log/syslog")}))
+
+	// Once the field has a glob, the kernel can't filter it, and userspace does
+	require.NoError(t, filter.Parse(events.SecurityFileOpen, "pathname", "=/home/*/.ssh/*"))
+	_, err = filter.Equalities()
+	require.Error(t, err)
+
+	require.True(t, filter.Filter([]trace.Argument{newArgument("pathname", "string", "/etc/passwd")}))
+	require.True(t, filter.Filter([]trace.Argument{newArgument("pathname", "string", "/home/user/.ssh/id_rsa")}))
+	require.False(t, filter.Filter([]trace.Argument{newArgument("pathname", "string", "/var/log/syslog")}))
+}
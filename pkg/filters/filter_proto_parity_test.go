@@ -167,14 +167,52 @@ func TestDataFilterProto_ParityWithConvertFromProto(t *testing.T) {
 				{Name: "fd", Value: &v1beta1.EventValue_Int32{Int32: 3}},
 			},
 		},
+		{
+			name:    "int32 range",
+			eventID: events.Read,
+			field:   "fd",
+			expr:    ">2",
+			data: []*v1beta1.EventValue{
+				{Name: "fd", Value: &v1beta1.EventValue_Int32{Int32: 3}},
+			},
+		},
+		{
+			name:    "uint64 bitmask",
+			eventID: events.SecurityMmapFile,
+			field:   "prot",
+			expr:    "=&0x4",
+			data: []*v1beta1.EventValue{
+				{Name: "prot", Value: &v1beta1.EventValue_UInt64{UInt64: 0x5}},
+			},
+		},
+		{
+			name:    "sockaddr cidr",
+			eventID: events.SecuritySocketConnect,
+			field:   "remote_addr",
+			expr:    "=10.0.0.0/8",
+			data: []*v1beta1.EventValue{
+				{Name: "remote_addr", Value: &v1beta1.EventValue_Sockaddr{
+					Sockaddr: &v1beta1.SockAddr{SaFamily: v1beta1.SaFamilyT_AF_INET, SinAddr: "10.0.0.1", SinPort: 443},
+				}},
+			},
+		},
+		{
+			name:    "pathname regex",
+			eventID: events.Openat,
+			field:   "pathname",
+			expr:    `=~/etc/.*\.conf`,
+			data: []*v1beta1.EventValue{
+				{Name: "pathname", Value: &v1beta1.EventValue_Str{Str: "/etc/nginx.conf"}},
+			},
+		},
 		{
 			name: "pointer",
 			data: []*v1beta1.EventValue{
 				{Name: "buf", Value: &v1beta1.EventValue_Pointer{Pointer: 0xdead}},
 			},
 			setupFunc: func(f *DataFilter) error {
-				return f.parseFilter("buf", "=57005", func() Filter[*StringFilter] {
-					return NewStringFilter(nil)
+				return f.parseFilter("buf", "=57005", func() *DataFieldFilter {
+					return NewDataFieldFilter("", nil)
 				})
 			},
 		},
@@ -186,8 +224,8 @@ func TestDataFilterProto_ParityWithConvertFromProto(t *testing.T) {
 				}},
 			},
 			setupFunc: func(f *DataFilter) error {
-				return f.parseFilter("argv", "=[1 2 3]", func() Filter[*StringFilter] {
-					return NewStringFilter(nil)
+				return f.parseFilter("argv", "=[1 2 3]", func() *DataFieldFilter {
+					return NewDataFieldFilter("", nil)
 				})
 			},
 		},
@@ -197,8 +235,8 @@ func TestDataFilterProto_ParityWithConvertFromProto(t *testing.T) {
 				{Name: "data", Value: &v1beta1.EventValue_Bytes{Bytes: []byte("abc")}},
 			},
 			setupFunc: func(f *DataFilter) error {
-				return f.parseFilter("data", "=[97 98 99]", func() Filter[*StringFilter] {
-					return NewStringFilter(nil)
+				return f.parseFilter("data", "=[97 98 99]", func() *DataFieldFilter {
+					return NewDataFieldFilter("", nil)
 				})
 			},
 		},
@@ -56,49 +56,57 @@ func (f *StringFilter) Filter(val interface{}) bool {
 // 2. not equals, not suffixed, not prefixed, not contains
 // This is done so if a conflicting "not" filter exists, we ignore it
 func (f *StringFilter) filter(val string) bool {
-	enabled := f.enabled
-	_, equals := f.equal[val]
-	_, notEquals := f.notEqual[val]
-	suffixes := f.suffixes
-	prefixes := f.prefixes
-	contains := f.contains
-	notSuffixes := f.notSuffixes
-	notPrefixes := f.notPrefixes
-	notContains := f.notContains
-	notEqualsSet := len(f.notEqual) > 0 || notSuffixes.Length() > 0 || notPrefixes.Length() > 0 || len(notContains) > 0
-	if !enabled {
+	if !f.enabled {
+		return true
+	}
+	if f.matchEqual(val) {
 		return true
 	}
-	if equals {
+	if f.hasNotEqual() {
+		return !f.matchNotEqual(val)
+	}
+	return false
+}
+
+// matchEqual returns true if the value is equal, suffixed, prefixed or contains a value
+func (f *StringFilter) matchEqual(val string) bool {
+	if _, equals := f.equal[val]; equals {
 		return true
 	}
-	if suffixes.Filter(val) {
+	if f.suffixes.Filter(val) {
 		return true
 	}
-	if prefixes.Filter(val) {
+	if f.prefixes.Filter(val) {
 		return true
 	}
-	for contain := range contains {
+	for contain := range f.contains {
 		if strings.Contains(val, contain) {
 			return true
 		}
 	}
-	if notEqualsSet {
-		if notSuffixes.Filter(val) {
-			return false
-		}
-		if notPrefixes.Filter(val) {
-			return false
-		}
-		for contain := range notContains {
-			if strings.Contains(val, contain) {
-				return false
-			}
+	return false
+}
+
+// matchNotEqual returns true if the value is equal, suffixed, prefixed or contains a
+// not-equal value
+func (f *StringFilter) matchNotEqual(val string) bool {
+	if f.notSuffixes.Filter(val) {
+		return true
+	}
+	if f.notPrefixes.Filter(val) {
+		return true
+	}
+	for contain := range f.notContains {
+		if strings.Contains(val, contain) {
+			return true
 		}
-		res := !notEquals || equals
-		return res
 	}
-	return false
+	_, notEquals := f.notEqual[val]
+	return notEquals
+}
+
+func (f *StringFilter) hasNotEqual() bool {
+	return len(f.notEqual) > 0 || f.notSuffixes.Length() > 0 || f.notPrefixes.Length() > 0 || len(f.notContains) > 0
 }
 
 func (f *StringFilter) Parse(operatorAndValues string) error {
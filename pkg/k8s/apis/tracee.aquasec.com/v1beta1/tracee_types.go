@@ -19,11 +19,17 @@ type PolicyInterface interface {
 
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:scope=Cluster
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 type Policy struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata"`
 	// tracee policy spec
 	Spec PolicySpec `json:"spec"`
+	// tracee policy status, reported by the operator and the tracee nodes
+	// +optional
+	Status PolicyStatus `json:"status,omitempty"`
 }
 
 func (p Policy) GetName() string {
@@ -63,6 +69,50 @@ type Rule struct {
 	Actions []string `yaml:"actions" json:"actions"`
 }
 
+// PolicyPhase is the result of applying a policy generation
+type PolicyPhase string
+
+const (
+	// PolicyPending is the phase of a policy generation no node applied yet
+	PolicyPending PolicyPhase = "Pending"
+	// PolicyAccepted is the phase of a policy generation applied by the nodes
+	PolicyAccepted PolicyPhase = "Accepted"
+	// PolicyInvalid is the phase of a policy generation refused by a node
+	PolicyInvalid PolicyPhase = "Invalid"
+)
+
+// PolicyStatus is the status of a policy
+type PolicyStatus struct {
+	// phase of the observed generation
+	// +optional
+	Phase PolicyPhase `json:"phase,omitempty"`
+	// error of an invalid policy
+	// +optional
+	Error string `json:"error,omitempty"`
+	// generation of the policy the phase refers to
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// status of the policy on each tracee node
+	// +optional
+	Nodes []PolicyNodeStatus `json:"nodes,omitempty"`
+}
+
+// PolicyNodeStatus is the status of a policy on a tracee node
+type PolicyNodeStatus struct {
+	// name of the node
+	Node string `json:"node"`
+	// last generation of the policy the node tried to apply
+	ObservedGeneration int64 `json:"observedGeneration"`
+	// last generation of the policy the node applied
+	// +optional
+	AppliedGeneration int64 `json:"appliedGeneration,omitempty"`
+	// error applying the observed generation, the node keeps the applied generation
+	// +optional
+	Error string `json:"error,omitempty"`
+	// time the node last reported the status, at least every 10 minutes
+	LastUpdateTime metav1.Time `json:"lastUpdateTime"`
+}
+
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:scope=Cluster
 // PolicyList contains a list of Policy
@@ -6,6 +6,7 @@ import (
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -46,24 +47,68 @@ func restartDaemonSet(ctx context.Context, c client.Client, namespace, name stri
 	return ctrl.Result{}, nil
 }
 
-// PolicyReconciler is the main controller for the Tracee Policy CRD. It is responsible
-// for updating the Tracee DaemonSet whenever a change is detected in a TraceePolicy
-// object.
+// PolicyReconciler is the main controller for the Tracee Policy CRD. The Tracee pods
+// watch the policies and apply their changes live, reporting the generation each node
+// applied in the policy status. The PolicyReconciler summarizes the nodes status in the
+// policy phase.
 type PolicyReconciler struct {
 	client.Client
-	Scheme          *runtime.Scheme
-	TraceeNamespace string
-	TraceeName      string
+	Scheme *runtime.Scheme
 }
 
 // +kubebuilder:rbac:groups=tracee.aquasec.com,resources=policies,verbs=get;list;watch;
-// +kubebuilder:rbac:groups=apps,resources=daemonsets,verbs=get;list;watch;patch;update;
+// +kubebuilder:rbac:groups=tracee.aquasec.com,resources=policies/status,verbs=get;patch;update;
 
 // Reconcile is where the reconciliation logic resides. Every time a change is detected in
-// a v1beta1.Policy object, this function will be called. It will update the Tracee
-// DaemonSet, so that the Tracee pods will be restarted with the new policy.
+// a v1beta1.Policy object, including the status reported by the Tracee pods, this function
+// will be called. It will update the policy phase: accepted once applied by the Tracee
+// pods, or invalid with the error of the first pod refusing it.
 func (r *PolicyReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
-	return restartDaemonSet(ctx, r.Client, r.TraceeNamespace, r.TraceeName)
+	logger := log.FromContext(ctx)
+
+	var p v1beta1.Policy
+	if err := r.Get(ctx, req.NamespacedName, &p); err != nil {
+		// deleted policies are removed by the Tracee pods
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	phase, validationError := policyPhase(&p)
+	if p.Status.Phase == phase && p.Status.Error == validationError && p.Status.ObservedGeneration == p.Generation {
+		return ctrl.Result{}, nil
+	}
+
+	p.Status.Phase = phase
+	p.Status.Error = validationError
+	p.Status.ObservedGeneration = p.Generation
+
+	if err := r.Status().Update(ctx, &p); err != nil {
+		if apierrors.IsConflict(err) {
+			// a Tracee pod reported its status meanwhile
+			return ctrl.Result{Requeue: true}, nil
+		}
+		logger.Error(err, "unable to update policy status")
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// policyPhase returns the phase of the current generation of a policy, and the
+// error of the first node refusing it
+func policyPhase(p *v1beta1.Policy) (v1beta1.PolicyPhase, string) {
+	phase := v1beta1.PolicyPending
+
+	for _, node := range p.Status.Nodes {
+		if node.ObservedGeneration != p.Generation {
+			continue
+		}
+		if node.Error != "" {
+			return v1beta1.PolicyInvalid, node.Error
+		}
+		phase = v1beta1.PolicyAccepted
+	}
+
+	return phase, ""
 }
 
 // SetupWithManager is responsible for connecting the PolicyReconciler to the main
@@ -76,7 +121,8 @@ func (r *PolicyReconciler) SetupWithManager(mgr ctrl.Manager) error {
 }
 
 // ConfigMapReconciler is the controller for the Tracee ConfigMap. It is responsible
-// for updating the Tracee DaemonSet whenever a change is detected in the Tracee ConfigMap.
+// for updating the Tracee DaemonSet whenever a change is detected in the Tracee ConfigMap,
+// since, unlike the policies, the configuration is not applied live.
 type ConfigMapReconciler struct {
 	client.Client
 	Scheme          *runtime.Scheme
@@ -0,0 +1,65 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/aquasecurity/tracee/pkg/k8s/apis/tracee.aquasec.com/v1beta1"
+)
+
+func TestPolicyPhase(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		nodes         []v1beta1.PolicyNodeStatus
+		expectedPhase v1beta1.PolicyPhase
+		expectedError string
+	}{
+		{
+			name:          "no node",
+			expectedPhase: v1beta1.PolicyPending,
+		},
+		{
+			name: "previous generation applied",
+			nodes: []v1beta1.PolicyNodeStatus{
+				{Node: "node1", ObservedGeneration: 1, AppliedGeneration: 1},
+			},
+			expectedPhase: v1beta1.PolicyPending,
+		},
+		{
+			name: "applied",
+			nodes: []v1beta1.PolicyNodeStatus{
+				{Node: "node1", ObservedGeneration: 2, AppliedGeneration: 2},
+				{Node: "node2", ObservedGeneration: 1, AppliedGeneration: 1},
+			},
+			expectedPhase: v1beta1.PolicyAccepted,
+		},
+		{
+			name: "refused",
+			nodes: []v1beta1.PolicyNodeStatus{
+				{Node: "node1", ObservedGeneration: 2, AppliedGeneration: 2},
+				{Node: "node2", ObservedGeneration: 2, AppliedGeneration: 1, Error: "event invalid_event is not valid"},
+			},
+			expectedPhase: v1beta1.PolicyInvalid,
+			expectedError: "event invalid_event is not valid",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			p := &v1beta1.Policy{
+				ObjectMeta: metav1.ObjectMeta{Name: "policy", Generation: 2},
+				Status:     v1beta1.PolicyStatus{Nodes: tc.nodes},
+			}
+
+			phase, err := policyPhase(p)
+			assert.Equal(t, tc.expectedPhase, phase)
+			assert.Equal(t, tc.expectedError, err)
+		})
+	}
+}
@@ -12,3 +12,13 @@ func IsMinkube() bool {
 func IsKind() bool {
 	return strings.HasPrefix(os.Getenv("NODE_NAME"), "kind")
 }
+
+// NodeName returns the name of the node tracee runs on
+func NodeName() string {
+	if name := os.Getenv("NODE_NAME"); name != "" {
+		return name
+	}
+
+	name, _ := os.Hostname()
+	return name
+}
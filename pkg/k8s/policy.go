@@ -3,15 +3,19 @@ package k8s
 import (
 	"context"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/util/retry"
 
 	"github.com/aquasecurity/tracee/pkg/k8s/apis/tracee.aquasec.com/v1beta1"
 )
 
 type Client struct {
-	restClient *rest.RESTClient
+	restClient     *rest.RESTClient
+	parameterCodec runtime.ParameterCodec
 }
 
 func New() (*Client, error) {
@@ -37,10 +41,28 @@ func New() (*Client, error) {
 		return nil, err
 	}
 
-	return &Client{client}, nil
+	return &Client{
+		restClient:     client,
+		parameterCodec: runtime.NewParameterCodec(scheme),
+	}, nil
 }
 
 func (c Client) GetPolicy(ctx context.Context) ([]v1beta1.PolicyInterface, error) {
+	result, err := c.ListPolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	policies := make([]v1beta1.PolicyInterface, len(result.Items))
+	for i, item := range result.Items {
+		policies[i] = item
+	}
+
+	return policies, nil
+}
+
+// ListPolicies lists the policies, with the resource version to watch them from
+func (c Client) ListPolicies(ctx context.Context) (*v1beta1.PolicyList, error) {
 	result := v1beta1.PolicyList{}
 
 	err := c.restClient.
@@ -53,10 +75,89 @@ func (c Client) GetPolicy(ctx context.Context) ([]v1beta1.PolicyInterface, error
 		return nil, err
 	}
 
-	policies := make([]v1beta1.PolicyInterface, len(result.Items))
-	for i, item := range result.Items {
-		policies[i] = item
+	return &result, nil
+}
+
+// WatchPolicies watches the changes of the policies since the given resource version
+func (c Client) WatchPolicies(ctx context.Context, resourceVersion string) (watch.Interface, error) {
+	return c.restClient.
+		Get().
+		Resource("policies").
+		VersionedParams(&metav1.ListOptions{
+			Watch:           true,
+			ResourceVersion: resourceVersion,
+		}, c.parameterCodec).
+		Watch(ctx)
+}
+
+// SetPolicyNodeStatus sets the status of a node in the status of a policy.
+// All the tracee nodes update the policies status, so conflicting updates are retried.
+func (c Client) SetPolicyNodeStatus(ctx context.Context, name string, nodeStatus v1beta1.PolicyNodeStatus) error {
+	return retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		p := v1beta1.Policy{}
+
+		err := c.restClient.
+			Get().
+			Resource("policies").
+			Name(name).
+			Do(ctx).
+			Into(&p)
+		if err != nil {
+			return err
+		}
+
+		if !setNodeStatus(&p.Status, nodeStatus) {
+			return nil
+		}
+
+		return c.restClient.
+			Put().
+			Resource("policies").
+			Name(name).
+			SubResource("status").
+			Body(&p).
+			Do(ctx).
+			Error()
+	})
+}
+
+// setNodeStatus sets the status of a node in a policy status, and removes the
+// status of the nodes which stopped reporting it (the nodes which are gone).
+// It returns false if nothing changed, and the node status is not due to be
+// refreshed.
+func setNodeStatus(status *v1beta1.PolicyStatus, nodeStatus v1beta1.PolicyNodeStatus) bool {
+	now := nodeStatus.LastUpdateTime.Time
+	changed := false
+	found := false
+
+	nodes := status.Nodes[:0]
+	for _, existing := range status.Nodes {
+		switch {
+		case existing.Node == nodeStatus.Node:
+			found = true
+			if !sameNodeStatus(existing, nodeStatus) || now.Sub(existing.LastUpdateTime.Time) >= statusHeartbeat {
+				existing = nodeStatus
+				changed = true
+			}
+		case now.Sub(existing.LastUpdateTime.Time) >= statusTTL:
+			changed = true
+			continue
+		}
+		nodes = append(nodes, existing)
+	}
+	if !found {
+		nodes = append(nodes, nodeStatus)
+		changed = true
 	}
+	status.Nodes = nodes
 
-	return policies, nil
+	return changed
+}
+
+// sameNodeStatus returns true if two statuses of a node only differ by their update time
+func sameNodeStatus(a, b v1beta1.PolicyNodeStatus) bool {
+	return a.Node == b.Node &&
+		a.ObservedGeneration == b.ObservedGeneration &&
+		a.AppliedGeneration == b.AppliedGeneration &&
+		a.Error == b.Error
 }
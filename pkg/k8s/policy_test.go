@@ -0,0 +1,56 @@
+package k8s
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/aquasecurity/tracee/pkg/k8s/apis/tracee.aquasec.com/v1beta1"
+)
+
+func TestSetNodeStatus(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	status := v1beta1.PolicyStatus{
+		Nodes: []v1beta1.PolicyNodeStatus{
+			{Node: "node1", ObservedGeneration: 1, AppliedGeneration: 1, LastUpdateTime: metav1.NewTime(now)},
+		},
+	}
+
+	// unchanged, but for the update time
+	assert.False(t, setNodeStatus(&status, v1beta1.PolicyNodeStatus{
+		Node: "node1", ObservedGeneration: 1, AppliedGeneration: 1, LastUpdateTime: metav1.NewTime(now.Add(time.Minute)),
+	}))
+
+	// unchanged, but due for a heartbeat
+	now = now.Add(statusHeartbeat)
+	assert.True(t, setNodeStatus(&status, v1beta1.PolicyNodeStatus{
+		Node: "node1", ObservedGeneration: 1, AppliedGeneration: 1, LastUpdateTime: metav1.NewTime(now),
+	}))
+	assert.Equal(t, now, status.Nodes[0].LastUpdateTime.Time)
+
+	// new generation refused
+	assert.True(t, setNodeStatus(&status, v1beta1.PolicyNodeStatus{
+		Node: "node1", ObservedGeneration: 2, AppliedGeneration: 1, Error: "invalid", LastUpdateTime: metav1.NewTime(now),
+	}))
+	assert.Len(t, status.Nodes, 1)
+	assert.Equal(t, "invalid", status.Nodes[0].Error)
+
+	// new node
+	assert.True(t, setNodeStatus(&status, v1beta1.PolicyNodeStatus{
+		Node: "node2", ObservedGeneration: 2, AppliedGeneration: 2, LastUpdateTime: metav1.NewTime(now),
+	}))
+	assert.Len(t, status.Nodes, 2)
+	assert.Equal(t, int64(2), status.Nodes[1].AppliedGeneration)
+
+	// node1 stopped reporting its status
+	now = now.Add(statusTTL)
+	assert.True(t, setNodeStatus(&status, v1beta1.PolicyNodeStatus{
+		Node: "node2", ObservedGeneration: 2, AppliedGeneration: 2, LastUpdateTime: metav1.NewTime(now),
+	}))
+	assert.Len(t, status.Nodes, 1)
+	assert.Equal(t, "node2", status.Nodes[0].Node)
+}
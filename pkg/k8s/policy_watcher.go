@@ -0,0 +1,222 @@
+package k8s
+
+import (
+	"context"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+
+	"github.com/aquasecurity/tracee/common/errfmt"
+	"github.com/aquasecurity/tracee/common/logger"
+	"github.com/aquasecurity/tracee/pkg/k8s/apis/tracee.aquasec.com/v1beta1"
+)
+
+const (
+	// retryInterval is the interval between two attempts to watch the policies
+	retryInterval = 10 * time.Second
+	// statusInterval is the interval between two reports of the policies status.
+	// Changes are coalesced meanwhile, as every report of every node changes the
+	// policies, and so is watched by all the other nodes.
+	statusInterval = 5 * time.Second
+	// statusHeartbeat is the interval after which a node reports an unchanged status
+	// again, so the other nodes know it is still running
+	statusHeartbeat = 10 * time.Minute
+	// statusTTL is the time after which the status of a node which stopped
+	// reporting it is removed
+	statusTTL = 3 * statusHeartbeat
+)
+
+// PolicyApplier applies the policies changes to a running tracee
+type PolicyApplier interface {
+	// ApplyPolicy creates the policy, or replaces the policy with the same name
+	ApplyPolicy(p v1beta1.PolicyInterface) error
+	// DeletePolicy deletes the policy with the given name
+	DeletePolicy(name string) error
+}
+
+// PolicyWatcher watches the policies custom resources, applying their changes
+// to a running tracee and reporting the result in the policies status.
+type PolicyWatcher struct {
+	client *Client
+	node   string
+	// generation of the policies applied (or refused) by name
+	observed map[string]int64
+	// generation of the policies successfully applied by name
+	applied map[string]int64
+	// error applying the observed generation of the policies by name
+	errors map[string]string
+	// time the status of the policies was last reported by name
+	reported map[string]time.Time
+	// policies which status changed since it was last reported
+	pending map[string]struct{}
+}
+
+// NewPolicyWatcher creates a policy watcher for the given node, which tracee
+// started with the given policies.
+func NewPolicyWatcher(client *Client, node string, startup []v1beta1.Policy) *PolicyWatcher {
+	w := &PolicyWatcher{
+		client:   client,
+		node:     node,
+		observed: make(map[string]int64, len(startup)),
+		applied:  make(map[string]int64, len(startup)),
+		errors:   make(map[string]string),
+		reported: make(map[string]time.Time, len(startup)),
+		pending:  make(map[string]struct{}, len(startup)),
+	}
+	for _, p := range startup {
+		w.observed[p.Name] = p.Generation
+		w.applied[p.Name] = p.Generation
+	}
+
+	return w
+}
+
+// Run watches the policies until the context is done. The policies are resynced
+// whenever the watch ends, applying the changes missed meanwhile.
+func (w *PolicyWatcher) Run(ctx context.Context, applier PolicyApplier) {
+	for {
+		err := w.syncAndWatch(ctx, applier)
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			logger.Warnw("Watching kubernetes policies", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(retryInterval):
+		}
+	}
+}
+
+func (w *PolicyWatcher) syncAndWatch(ctx context.Context, applier PolicyApplier) error {
+	list, err := w.client.ListPolicies(ctx)
+	if err != nil {
+		return errfmt.WrapError(err)
+	}
+
+	listed := make(map[string]struct{}, len(list.Items))
+	for _, p := range list.Items {
+		listed[p.Name] = struct{}{}
+		w.apply(applier, p)
+	}
+	for name := range w.observed {
+		if _, ok := listed[name]; !ok {
+			w.delete(applier, name)
+		}
+	}
+
+	watcher, err := w.client.WatchPolicies(ctx, list.ResourceVersion)
+	if err != nil {
+		return errfmt.WrapError(err)
+	}
+	defer watcher.Stop()
+
+	ticker := time.NewTicker(statusInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case event, ok := <-watcher.ResultChan():
+			if !ok {
+				// the watch timed out, resync
+				return nil
+			}
+			switch event.Type {
+			case watch.Added, watch.Modified:
+				if p, ok := event.Object.(*v1beta1.Policy); ok {
+					w.apply(applier, *p)
+				}
+			case watch.Deleted:
+				if p, ok := event.Object.(*v1beta1.Policy); ok {
+					w.delete(applier, p.Name)
+				}
+			case watch.Error:
+				return errfmt.WrapError(apierrors.FromObject(event.Object))
+			}
+		case now := <-ticker.C:
+			w.report(ctx, now)
+		}
+	}
+}
+
+// apply applies a new generation of a policy, and marks its status to be reported
+// if the status of the node in the policy is outdated
+func (w *PolicyWatcher) apply(applier PolicyApplier, p v1beta1.Policy) {
+	if observed, ok := w.observed[p.Name]; !ok || observed != p.Generation {
+		w.observed[p.Name] = p.Generation
+		delete(w.errors, p.Name)
+
+		if err := applier.ApplyPolicy(p); err != nil {
+			w.errors[p.Name] = err.Error()
+			logger.Errorw("Applying kubernetes policy", "policy", p.Name, "generation", p.Generation, "error", err)
+		} else {
+			w.applied[p.Name] = p.Generation
+			logger.Infow("Applied kubernetes policy", "policy", p.Name, "generation", p.Generation)
+		}
+	}
+
+	// most changes are the status updates of the other nodes
+	nodeStatus := w.nodeStatus(p.Name, time.Now())
+	for _, reported := range p.Status.Nodes {
+		if reported.Node == w.node && sameNodeStatus(reported, nodeStatus) {
+			w.reported[p.Name] = reported.LastUpdateTime.Time
+			delete(w.pending, p.Name)
+			return
+		}
+	}
+	w.pending[p.Name] = struct{}{}
+}
+
+// report reports the status of the policies which changed, or which last report
+// is older than the heartbeat interval
+func (w *PolicyWatcher) report(ctx context.Context, now time.Time) {
+	for name := range w.observed {
+		_, pending := w.pending[name]
+		if !pending && now.Sub(w.reported[name]) < statusHeartbeat {
+			continue
+		}
+
+		err := w.client.SetPolicyNodeStatus(ctx, name, w.nodeStatus(name, now))
+		if err != nil && !apierrors.IsNotFound(err) {
+			// keep it pending, it is reported again on the next interval
+			logger.Warnw("Reporting kubernetes policy status", "policy", name, "error", err)
+			continue
+		}
+		w.reported[name] = now
+		delete(w.pending, name)
+	}
+}
+
+// nodeStatus returns the status of the node for a policy
+func (w *PolicyWatcher) nodeStatus(name string, now time.Time) v1beta1.PolicyNodeStatus {
+	return v1beta1.PolicyNodeStatus{
+		Node:               w.node,
+		ObservedGeneration: w.observed[name],
+		AppliedGeneration:  w.applied[name],
+		Error:              w.errors[name],
+		LastUpdateTime:     metav1.NewTime(now),
+	}
+}
+
+// delete deletes a policy which custom resource was deleted
+func (w *PolicyWatcher) delete(applier PolicyApplier, name string) {
+	if _, ok := w.observed[name]; !ok {
+		return
+	}
+	delete(w.observed, name)
+	delete(w.applied, name)
+	delete(w.errors, name)
+	delete(w.reported, name)
+	delete(w.pending, name)
+
+	if err := applier.DeletePolicy(name); err != nil {
+		logger.Errorw("Deleting kubernetes policy", "policy", name, "error", err)
+		return
+	}
+	logger.Infow("Deleted kubernetes policy", "policy", name)
+}
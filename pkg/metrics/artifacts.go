@@ -0,0 +1,101 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/aquasecurity/tracee/pkg/artifacts"
+)
+
+// ArtifactsCollector is a prometheus collector exporting the stats of the captured artifacts
+type ArtifactsCollector struct {
+	filesDesc        *prometheus.Desc
+	bytesDesc        *prometheus.Desc
+	evictedDesc      *prometheus.Desc
+	expiredDesc      *prometheus.Desc
+	deduplicatedDesc *prometheus.Desc
+	stats            func() map[artifacts.Type]artifacts.TypeStats
+}
+
+// NewArtifactsCollector creates a new artifacts stats collector, reading the stats on scrape
+func NewArtifactsCollector(stats func() map[artifacts.Type]artifacts.TypeStats) *ArtifactsCollector {
+	labels := []string{"type"}
+
+	return &ArtifactsCollector{
+		filesDesc: prometheus.NewDesc(
+			"tracee_artifacts_files",
+			"artifacts kept in the artifacts output directory",
+			labels,
+			nil,
+		),
+		bytesDesc: prometheus.NewDesc(
+			"tracee_artifacts_bytes",
+			"size of the artifacts kept in the artifacts output directory",
+			labels,
+			nil,
+		),
+		evictedDesc: prometheus.NewDesc(
+			"tracee_artifacts_evicted_total",
+			"artifacts removed from the artifacts output directory to stay within the quotas",
+			labels,
+			nil,
+		),
+		expiredDesc: prometheus.NewDesc(
+			"tracee_artifacts_expired_total",
+			"artifacts removed once their retention expired",
+			labels,
+			nil,
+		),
+		deduplicatedDesc: prometheus.NewDesc(
+			"tracee_artifacts_deduplicated_total",
+			"artifacts dropped for having the content of an artifact already kept",
+			labels,
+			nil,
+		),
+		stats: stats,
+	}
+}
+
+// Describe implements prometheus.Collector
+func (c *ArtifactsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.filesDesc
+	ch <- c.bytesDesc
+	ch <- c.evictedDesc
+	ch <- c.expiredDesc
+	ch <- c.deduplicatedDesc
+}
+
+// Collect implements prometheus.Collector
+func (c *ArtifactsCollector) Collect(ch chan<- prometheus.Metric) {
+	for artifactType, stats := range c.stats() {
+		ch <- prometheus.MustNewConstMetric(
+			c.filesDesc,
+			prometheus.GaugeValue,
+			float64(stats.Files),
+			string(artifactType),
+		)
+		ch <- prometheus.MustNewConstMetric(
+			c.bytesDesc,
+			prometheus.GaugeValue,
+			float64(stats.Bytes),
+			string(artifactType),
+		)
+		ch <- prometheus.MustNewConstMetric(
+			c.evictedDesc,
+			prometheus.CounterValue,
+			float64(stats.Evicted),
+			string(artifactType),
+		)
+		ch <- prometheus.MustNewConstMetric(
+			c.expiredDesc,
+			prometheus.CounterValue,
+			float64(stats.Expired),
+			string(artifactType),
+		)
+		ch <- prometheus.MustNewConstMetric(
+			c.deduplicatedDesc,
+			prometheus.CounterValue,
+			float64(stats.Deduplicated),
+			string(artifactType),
+		)
+	}
+}
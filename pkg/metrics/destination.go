@@ -0,0 +1,116 @@
+package metrics
+
+import (
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/aquasecurity/tracee/common/counter"
+)
+
+// DestinationStats counts the events handled by an output destination
+type DestinationStats struct {
+	Name string
+	Type string
+	// events accepted by the destination endpoint
+	Delivered *counter.Counter
+	// events dropped after exhausting their retries or because the queue was full
+	Failed *counter.Counter
+	// events waiting to be delivered, in memory or on disk
+	Queued *counter.Counter
+}
+
+var destinations = struct {
+	mutex sync.Mutex
+	stats []*DestinationStats
+}{}
+
+// NewDestinationStats creates the stats of a destination, exported by the destination
+// collector registered with the prometheus metrics
+func NewDestinationStats(name, kind string) *DestinationStats {
+	stats := &DestinationStats{
+		Name:      name,
+		Type:      kind,
+		Delivered: counter.NewCounter(0),
+		Failed:    counter.NewCounter(0),
+		Queued:    counter.NewCounter(0),
+	}
+
+	destinations.mutex.Lock()
+	destinations.stats = append(destinations.stats, stats)
+	destinations.mutex.Unlock()
+
+	return stats
+}
+
+// Destinations returns the stats of all the destinations created so far
+func Destinations() []*DestinationStats {
+	destinations.mutex.Lock()
+	defer destinations.mutex.Unlock()
+
+	return append([]*DestinationStats(nil), destinations.stats...)
+}
+
+// DestinationCollector is a prometheus collector exporting the stats of the output destinations
+type DestinationCollector struct {
+	deliveredDesc *prometheus.Desc
+	failedDesc    *prometheus.Desc
+	queuedDesc    *prometheus.Desc
+}
+
+// NewDestinationCollector creates a new destination stats collector
+func NewDestinationCollector() *DestinationCollector {
+	labels := []string{"destination", "type"}
+
+	return &DestinationCollector{
+		deliveredDesc: prometheus.NewDesc(
+			"tracee_destination_events_delivered_total",
+			"events delivered by an output destination",
+			labels,
+			nil,
+		),
+		failedDesc: prometheus.NewDesc(
+			"tracee_destination_events_failed_total",
+			"events an output destination failed to deliver",
+			labels,
+			nil,
+		),
+		queuedDesc: prometheus.NewDesc(
+			"tracee_destination_events_queued",
+			"events waiting to be delivered by an output destination",
+			labels,
+			nil,
+		),
+	}
+}
+
+// Describe implements prometheus.Collector
+func (c *DestinationCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.deliveredDesc
+	ch <- c.failedDesc
+	ch <- c.queuedDesc
+}
+
+// Collect implements prometheus.Collector
+func (c *DestinationCollector) Collect(ch chan<- prometheus.Metric) {
+	for _, stats := range Destinations() {
+		ch <- prometheus.MustNewConstMetric(
+			c.deliveredDesc,
+			prometheus.CounterValue,
+			float64(stats.Delivered.Get()),
+			stats.Name, stats.Type,
+		)
+		ch <- prometheus.MustNewConstMetric(
+			c.failedDesc,
+			prometheus.CounterValue,
+			float64(stats.Failed.Get()),
+			stats.Name, stats.Type,
+		)
+		ch <- prometheus.MustNewConstMetric(
+			c.queuedDesc,
+			prometheus.GaugeValue,
+			float64(stats.Queued.Get()),
+			stats.Name, stats.Type,
+		)
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/aquasecurity/tracee/common/counter"
 	"github.com/aquasecurity/tracee/common/errfmt"
 	"github.com/aquasecurity/tracee/common/logger"
+	"github.com/aquasecurity/tracee/pkg/artifacts"
 	"github.com/aquasecurity/tracee/pkg/events"
 	"github.com/aquasecurity/tracee/pkg/version"
 )
@@ -101,10 +102,15 @@ type Stats struct {
 	LostWrCount      *counter.Counter `json:"LostWrCount"`
 	LostNtCapCount   *counter.Counter `json:"LostNtCapCount"` // lost network capture events
 	LostBPFLogsCount *counter.Counter `json:"LostBPFLogsCount"`
+	NetFlowsActive   *counter.Counter `json:"NetFlowsActive"`  // tracked connectionless flows
+	NetFlowsEvicted  *counter.Counter `json:"NetFlowsEvicted"` // flows ended to bound the tracked flows
 
 	// BPF map for on-demand perf event stats collection (METRICS build only)
 	perfEventStatsMap *bpf.BPFMap
 
+	// stats of the captured artifacts, if any
+	artifactsStats func() map[artifacts.Type]artifacts.TypeStats
+
 	Channels ChannelMetrics[*events.PipelineEvent] `json:"ChannelMetrics"`
 }
 
@@ -119,6 +125,8 @@ func NewStats() *Stats {
 		LostWrCount:      counter.NewCounter(0),
 		LostNtCapCount:   counter.NewCounter(0),
 		LostBPFLogsCount: counter.NewCounter(0),
+		NetFlowsActive:   counter.NewCounter(0),
+		NetFlowsEvicted:  counter.NewCounter(0),
 		Channels:         make(ChannelMetrics[*events.PipelineEvent]),
 	}
 
@@ -130,6 +138,11 @@ func (s *Stats) SetPerfEventStatsMap(perfEventStatsMap *bpf.BPFMap) {
 	s.perfEventStatsMap = perfEventStatsMap
 }
 
+// SetArtifactsStats sets the source of the captured artifacts stats
+func (s *Stats) SetArtifactsStats(artifactsStats func() map[artifacts.Type]artifacts.TypeStats) {
+	s.artifactsStats = artifactsStats
+}
+
 // BPFPerfEventStats holds the BPF perf event stats
 type BPFPerfEventStats struct {
 	Attempts map[events.ID]uint64
@@ -247,11 +260,41 @@ func (s *Stats) RegisterPrometheus() error {
 		return errfmt.WrapError(err)
 	}
 
+	err = prometheus.Register(prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Namespace: "tracee",
+		Name:      "network_flows_active",
+		Help:      "udp and icmp flows being tracked",
+	}, func() float64 { return float64(s.NetFlowsActive.Get()) }))
+	if err != nil {
+		return errfmt.WrapError(err)
+	}
+
+	err = prometheus.Register(prometheus.NewCounterFunc(prometheus.CounterOpts{
+		Namespace: "tracee",
+		Name:      "network_flows_evicted_total",
+		Help:      "udp and icmp flows ended early to bound the tracked flows",
+	}, func() float64 { return float64(s.NetFlowsEvicted.Get()) }))
+	if err != nil {
+		return errfmt.WrapError(err)
+	}
+
 	err = s.Channels.RegisterChannels()
 	if err != nil {
 		return errfmt.WrapError(err)
 	}
 
+	err = prometheus.Register(NewDestinationCollector())
+	if err != nil {
+		return errfmt.WrapError(err)
+	}
+
+	if s.artifactsStats != nil {
+		err = prometheus.Register(NewArtifactsCollector(s.artifactsStats))
+		if err != nil {
+			return errfmt.WrapError(err)
+		}
+	}
+
 	return nil
 }
 
@@ -0,0 +1,376 @@
+// Package otlp exports tracee events and metrics to OpenTelemetry collectors, over the
+// OTLP gRPC or HTTP protocols.
+package otlp
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	colmetricspb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+	metricspb "go.opentelemetry.io/proto/otlp/metrics/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/aquasecurity/tracee/common/errfmt"
+	"github.com/aquasecurity/tracee/common/logger"
+)
+
+const (
+	logsPath    = "/v1/logs"
+	metricsPath = "/v1/metrics"
+)
+
+// ClientOptions are the URL query parameters configuring the client. Other parameters
+// are left to the caller.
+var ClientOptions = []string{
+	"timeout", "retries", "retryBackoff", "retryMaxBackoff",
+	"header", "tlsCA", "tlsSkipVerify",
+}
+
+// PartialSuccessError is returned when the collector accepted an export request but
+// rejected some of its items. It isn't retried.
+type PartialSuccessError struct {
+	Rejected int64
+	Message  string
+}
+
+func (e *PartialSuccessError) Error() string {
+	return fmt.Sprintf("collector rejected %d items: %s", e.Rejected, e.Message)
+}
+
+// Client sends OTLP export requests to a collector, retrying the failures the OTLP
+// specification defines as transient with an exponential backoff.
+type Client struct {
+	endpoint        string
+	timeout         time.Duration
+	retries         int
+	retryBackoff    time.Duration
+	retryMaxBackoff time.Duration
+	headers         http.Header
+
+	// gRPC transport
+	conn    *grpc.ClientConn
+	logs    collogspb.LogsServiceClient
+	metrics colmetricspb.MetricsServiceClient
+
+	// HTTP transport
+	httpClient *http.Client
+}
+
+// NewClient creates a client for the collector at the given URL:
+//
+//	grpc://host:port, grpcs://host:port       OTLP/gRPC, in plain text or over TLS
+//	http://host:port[/path], https://...      OTLP/HTTP, posting to <path>/v1/logs and <path>/v1/metrics
+//
+// The connection is established lazily, an unreachable collector fails the exports.
+func NewClient(rawURL string) (*Client, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, errfmt.Errorf("unable to parse URL %q: %v", rawURL, err)
+	}
+	if u.Host == "" {
+		return nil, errfmt.Errorf("missing collector address in otlp URL %q", rawURL)
+	}
+	parameters := u.Query()
+
+	c := &Client{headers: http.Header{}}
+	if err := c.parseOptions(parameters); err != nil {
+		return nil, err
+	}
+
+	useTLS := false
+	switch u.Scheme {
+	case "grpcs", "https":
+		useTLS = true
+	case "grpc", "http":
+	default:
+		return nil, errfmt.Errorf("unsupported protocol for otlp destination: %s, expected grpc, grpcs, http or https", u.Scheme)
+	}
+
+	var tlsConfig *tls.Config
+	if useTLS {
+		if tlsConfig, err = clientTLSConfig(parameters); err != nil {
+			return nil, err
+		}
+	}
+
+	if strings.HasPrefix(u.Scheme, "grpc") {
+		if strings.Trim(u.Path, "/") != "" {
+			return nil, errfmt.Errorf("unexpected path %q in otlp gRPC URL", u.Path)
+		}
+		creds := insecure.NewCredentials()
+		if tlsConfig != nil {
+			creds = credentials.NewTLS(tlsConfig)
+		}
+		c.conn, err = grpc.NewClient(u.Host, grpc.WithTransportCredentials(creds))
+		if err != nil {
+			return nil, errfmt.Errorf("unable to create otlp gRPC client: %v", err)
+		}
+		c.endpoint = u.Host
+		c.logs = collogspb.NewLogsServiceClient(c.conn)
+		c.metrics = colmetricspb.NewMetricsServiceClient(c.conn)
+		return c, nil
+	}
+
+	c.endpoint = u.Scheme + "://" + u.Host + strings.TrimSuffix(u.Path, "/")
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+	c.httpClient = &http.Client{Transport: transport}
+
+	return c, nil
+}
+
+// parseOptions parses the request and retry options
+func (c *Client) parseOptions(parameters url.Values) error {
+	var err error
+
+	if c.timeout, err = durationParameter(parameters, "timeout", "10s"); err != nil {
+		return err
+	}
+
+	retries := parameterValue(parameters, "retries", "3")
+	if c.retries, err = strconv.Atoi(retries); err != nil || c.retries < 0 {
+		return errfmt.Errorf("invalid otlp retries value %q, expected a non negative number", retries)
+	}
+	if c.retryBackoff, err = durationParameter(parameters, "retryBackoff", "1s"); err != nil {
+		return err
+	}
+	if c.retryMaxBackoff, err = durationParameter(parameters, "retryMaxBackoff", "30s"); err != nil {
+		return err
+	}
+	c.retryMaxBackoff = max(c.retryMaxBackoff, c.retryBackoff)
+
+	for _, header := range parameters["header"] {
+		name, value, found := strings.Cut(header, ":")
+		name = strings.TrimSpace(name)
+		if !found || name == "" {
+			return errfmt.Errorf("invalid otlp header %q, expected Name:Value", header)
+		}
+		c.headers.Add(name, strings.TrimSpace(value))
+	}
+
+	return nil
+}
+
+// clientTLSConfig returns the TLS configuration of the connections to the collector
+func clientTLSConfig(parameters url.Values) (*tls.Config, error) {
+	skipVerify := parameterValue(parameters, "tlsSkipVerify", "false")
+	insecureSkipVerify, err := strconv.ParseBool(skipVerify)
+	if err != nil {
+		return nil, errfmt.Errorf("unable to convert tlsSkipVerify value %q: %v", skipVerify, err)
+	}
+	config := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: insecureSkipVerify, //nolint:gosec // explicitly requested by the user
+	}
+
+	if caFile := parameterValue(parameters, "tlsCA", ""); caFile != "" {
+		pem, err := os.ReadFile(caFile)
+		if err != nil {
+			return nil, errfmt.Errorf("unable to read otlp tlsCA file: %v", err)
+		}
+		config.RootCAs = x509.NewCertPool()
+		if !config.RootCAs.AppendCertsFromPEM(pem) {
+			return nil, errfmt.Errorf("no certificate found in otlp tlsCA file %s", caFile)
+		}
+	}
+
+	return config, nil
+}
+
+// Endpoint returns the collector address, for logging
+func (c *Client) Endpoint() string {
+	return c.endpoint
+}
+
+// ExportLogs sends log records to the collector
+func (c *Client) ExportLogs(ctx context.Context, resourceLogs []*logspb.ResourceLogs) error {
+	req := &collogspb.ExportLogsServiceRequest{ResourceLogs: resourceLogs}
+	resp := &collogspb.ExportLogsServiceResponse{}
+
+	err := c.export(ctx, func(ctx context.Context) (bool, error) {
+		if c.logs != nil {
+			var err error
+			if resp, err = c.logs.Export(ctx, req); err != nil {
+				return grpcRetryable(err), errfmt.WrapError(err)
+			}
+			return false, nil
+		}
+		return c.post(ctx, logsPath, req, resp)
+	})
+	if err != nil {
+		return err
+	}
+
+	if partial := resp.GetPartialSuccess(); partial.GetRejectedLogRecords() > 0 {
+		return &PartialSuccessError{Rejected: partial.GetRejectedLogRecords(), Message: partial.GetErrorMessage()}
+	}
+
+	return nil
+}
+
+// ExportMetrics sends metrics to the collector
+func (c *Client) ExportMetrics(ctx context.Context, resourceMetrics []*metricspb.ResourceMetrics) error {
+	req := &colmetricspb.ExportMetricsServiceRequest{ResourceMetrics: resourceMetrics}
+	resp := &colmetricspb.ExportMetricsServiceResponse{}
+
+	err := c.export(ctx, func(ctx context.Context) (bool, error) {
+		if c.metrics != nil {
+			var err error
+			if resp, err = c.metrics.Export(ctx, req); err != nil {
+				return grpcRetryable(err), errfmt.WrapError(err)
+			}
+			return false, nil
+		}
+		return c.post(ctx, metricsPath, req, resp)
+	})
+	if err != nil {
+		return err
+	}
+
+	if partial := resp.GetPartialSuccess(); partial.GetRejectedDataPoints() > 0 {
+		return &PartialSuccessError{Rejected: partial.GetRejectedDataPoints(), Message: partial.GetErrorMessage()}
+	}
+
+	return nil
+}
+
+// Close closes the connections to the collector
+func (c *Client) Close() error {
+	if c.conn != nil {
+		return errfmt.WrapError(c.conn.Close())
+	}
+	c.httpClient.CloseIdleConnections()
+
+	return nil
+}
+
+// export sends a request, retrying it with an exponential backoff while the failure is
+// retryable. Retries stop when ctx is done.
+func (c *Client) export(ctx context.Context, send func(context.Context) (bool, error)) error {
+	if len(c.headers) > 0 && c.conn != nil {
+		pairs := make([]string, 0, 2*len(c.headers))
+		for name, values := range c.headers {
+			for _, value := range values {
+				pairs = append(pairs, strings.ToLower(name), value)
+			}
+		}
+		ctx = metadata.AppendToOutgoingContext(ctx, pairs...)
+	}
+
+	backoff := c.retryBackoff
+	for attempt := 0; ; attempt++ {
+		reqCtx, cancel := context.WithTimeout(ctx, c.timeout)
+		retryable, err := send(reqCtx)
+		cancel()
+		if err == nil || !retryable || attempt >= c.retries {
+			return err
+		}
+
+		logger.Debugw("Retrying otlp export", "endpoint", c.endpoint, "attempt", attempt+1, "wait", backoff, "error", err)
+
+		timer := time.NewTimer(backoff)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		}
+		backoff = min(backoff*2, c.retryMaxBackoff)
+	}
+}
+
+// post sends a request over OTLP/HTTP in the binary protobuf encoding, returning
+// whether a failure is worth retrying
+func (c *Client) post(ctx context.Context, path string, req, resp proto.Message) (bool, error) {
+	body, err := proto.Marshal(req)
+	if err != nil {
+		return false, errfmt.WrapError(err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint+path, bytes.NewReader(body))
+	if err != nil {
+		return false, errfmt.WrapError(err)
+	}
+	httpReq.Header = c.headers.Clone()
+	httpReq.Header.Set("Content-Type", "application/x-protobuf")
+
+	httpResp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return true, errfmt.WrapError(err)
+	}
+	defer func() { _ = httpResp.Body.Close() }()
+
+	respBody, err := io.ReadAll(io.LimitReader(httpResp.Body, 1<<20))
+	if err != nil {
+		return true, errfmt.WrapError(err)
+	}
+
+	if httpResp.StatusCode < 200 || httpResp.StatusCode >= 300 {
+		err := fmt.Errorf("http status: %d", httpResp.StatusCode)
+		switch httpResp.StatusCode {
+		case http.StatusTooManyRequests, http.StatusBadGateway,
+			http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+			return true, err
+		}
+		return false, err
+	}
+
+	if err := proto.Unmarshal(respBody, resp); err != nil {
+		return false, errfmt.Errorf("invalid otlp response: %v", err)
+	}
+
+	return false, nil
+}
+
+// grpcRetryable returns true if a gRPC failure is transient, as defined by the OTLP
+// specification
+func grpcRetryable(err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+
+	switch status.Code(err) {
+	case codes.Canceled, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Aborted,
+		codes.OutOfRange, codes.Unavailable, codes.DataLoss:
+		return true
+	}
+
+	return false
+}
+
+func parameterValue(parameters url.Values, key string, defaultValue string) string {
+	if values, found := parameters[key]; found && values[0] != "" {
+		return values[0]
+	}
+
+	return defaultValue
+}
+
+func durationParameter(parameters url.Values, key string, defaultValue string) (time.Duration, error) {
+	value := parameterValue(parameters, key, defaultValue)
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, errfmt.Errorf("unable to convert %s value %q: %v", key, value, err)
+	}
+
+	return d, nil
+}
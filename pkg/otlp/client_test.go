@@ -0,0 +1,286 @@
+package otlp
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	colmetricspb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+	metricspb "go.opentelemetry.io/proto/otlp/metrics/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// collector is an in-process stand-in for an OTLP collector, failing the first requests
+// with the configured errors
+type collector struct {
+	collogspb.UnimplementedLogsServiceServer
+
+	mutex    sync.Mutex
+	failures []error
+	rejected int64
+	logs     []*collogspb.ExportLogsServiceRequest
+	metrics  []*colmetricspb.ExportMetricsServiceRequest
+	headers  []string
+	attempts int
+}
+
+// nextFailure returns the error the next request fails with, if any
+func (c *collector) nextFailure() error {
+	c.attempts++
+	if len(c.failures) == 0 {
+		return nil
+	}
+	err := c.failures[0]
+	c.failures = c.failures[1:]
+
+	return err
+}
+
+func (c *collector) Export(ctx context.Context, req *collogspb.ExportLogsServiceRequest) (*collogspb.ExportLogsServiceResponse, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		c.headers = append(c.headers, md.Get("x-tenant")...)
+	}
+	if err := c.nextFailure(); err != nil {
+		return nil, err
+	}
+	c.logs = append(c.logs, req)
+
+	resp := &collogspb.ExportLogsServiceResponse{}
+	if c.rejected > 0 {
+		resp.PartialSuccess = &collogspb.ExportLogsPartialSuccess{RejectedLogRecords: c.rejected, ErrorMessage: "too old"}
+	}
+
+	return resp, nil
+}
+
+// metricsService adapts the collector to the metrics service, whose Export method has
+// the same name as the logs one
+type metricsService struct {
+	colmetricspb.UnimplementedMetricsServiceServer
+	*collector
+}
+
+func (s metricsService) Export(_ context.Context, req *colmetricspb.ExportMetricsServiceRequest) (*colmetricspb.ExportMetricsServiceResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if err := s.nextFailure(); err != nil {
+		return nil, err
+	}
+	s.metrics = append(s.metrics, req)
+
+	return &colmetricspb.ExportMetricsServiceResponse{}, nil
+}
+
+// startGRPCCollector serves the collector over OTLP/gRPC, returning its address
+func startGRPCCollector(t *testing.T, c *collector) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	server := grpc.NewServer()
+	collogspb.RegisterLogsServiceServer(server, c)
+	colmetricspb.RegisterMetricsServiceServer(server, metricsService{collector: c})
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(server.Stop)
+
+	return listener.Addr().String()
+}
+
+// startHTTPCollector serves the collector over OTLP/HTTP, failing the first requests
+// with the given status codes
+func startHTTPCollector(t *testing.T, c *collector, statusCodes ...int) string {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.mutex.Lock()
+		defer c.mutex.Unlock()
+
+		c.attempts++
+		c.headers = append(c.headers, r.Header.Values("X-Tenant")...)
+		if len(statusCodes) > 0 {
+			w.WriteHeader(statusCodes[0])
+			statusCodes = statusCodes[1:]
+			return
+		}
+
+		body, err := io.ReadAll(r.Body)
+		if err != nil || r.Header.Get("Content-Type") != "application/x-protobuf" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		var resp proto.Message
+		switch r.URL.Path {
+		case "/otlp" + logsPath:
+			req := &collogspb.ExportLogsServiceRequest{}
+			if err := proto.Unmarshal(body, req); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			c.logs = append(c.logs, req)
+			resp = &collogspb.ExportLogsServiceResponse{}
+		case "/otlp" + metricsPath:
+			req := &colmetricspb.ExportMetricsServiceRequest{}
+			if err := proto.Unmarshal(body, req); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			c.metrics = append(c.metrics, req)
+			resp = &colmetricspb.ExportMetricsServiceResponse{}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		data, _ := proto.Marshal(resp)
+		w.Header().Set("Content-Type", "application/x-protobuf")
+		_, _ = w.Write(data)
+	}))
+	t.Cleanup(server.Close)
+
+	return server.URL + "/otlp"
+}
+
+func testLogs() []*logspb.ResourceLogs {
+	return []*logspb.ResourceLogs{{ScopeLogs: []*logspb.ScopeLogs{{LogRecords: []*logspb.LogRecord{{EventName: "execve"}}}}}}
+}
+
+func TestClient_GRPC(t *testing.T) {
+	t.Parallel()
+
+	c := &collector{failures: []error{
+		status.Error(codes.Unavailable, "starting"),
+		status.Error(codes.ResourceExhausted, "busy"),
+	}}
+	addr := startGRPCCollector(t, c)
+
+	client, err := NewClient("grpc://" + addr + "?retryBackoff=1ms&header=X-Tenant:team-a")
+	require.NoError(t, err)
+	defer func() { _ = client.Close() }()
+
+	require.NoError(t, client.ExportLogs(context.Background(), testLogs()))
+	require.NoError(t, client.ExportMetrics(context.Background(), []*metricspb.ResourceMetrics{{}}))
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	assert.Equal(t, 4, c.attempts)
+	require.Len(t, c.logs, 1)
+	assert.Equal(t, "execve", c.logs[0].ResourceLogs[0].ScopeLogs[0].LogRecords[0].EventName)
+	assert.Len(t, c.metrics, 1)
+	assert.Contains(t, c.headers, "team-a")
+}
+
+func TestClient_GRPCFailures(t *testing.T) {
+	t.Parallel()
+
+	c := &collector{failures: []error{
+		status.Error(codes.InvalidArgument, "bad request"),
+		status.Error(codes.Unavailable, "down"),
+		status.Error(codes.Unavailable, "down"),
+	}}
+	addr := startGRPCCollector(t, c)
+
+	client, err := NewClient("grpc://" + addr + "?retries=1&retryBackoff=1ms")
+	require.NoError(t, err)
+	defer func() { _ = client.Close() }()
+
+	// Not retried
+	err = client.ExportLogs(context.Background(), testLogs())
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), err)
+	// Retried once
+	assert.Error(t, client.ExportLogs(context.Background(), testLogs()))
+
+	c.mutex.Lock()
+	c.rejected = 2
+	c.mutex.Unlock()
+	var partial *PartialSuccessError
+	err = client.ExportLogs(context.Background(), testLogs())
+	require.ErrorAs(t, err, &partial)
+	assert.Equal(t, int64(2), partial.Rejected)
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	assert.Equal(t, 4, c.attempts)
+}
+
+func TestClient_HTTP(t *testing.T) {
+	t.Parallel()
+
+	c := &collector{}
+	endpoint := startHTTPCollector(t, c, http.StatusServiceUnavailable, http.StatusTooManyRequests)
+
+	client, err := NewClient(endpoint + "/?retryBackoff=1ms&header=X-Tenant:team-a")
+	require.NoError(t, err)
+	defer func() { _ = client.Close() }()
+
+	require.NoError(t, client.ExportLogs(context.Background(), testLogs()))
+	require.NoError(t, client.ExportMetrics(context.Background(), []*metricspb.ResourceMetrics{{}}))
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	assert.Equal(t, 4, c.attempts)
+	require.Len(t, c.logs, 1)
+	assert.Equal(t, "execve", c.logs[0].ResourceLogs[0].ScopeLogs[0].LogRecords[0].EventName)
+	assert.Len(t, c.metrics, 1)
+	assert.Equal(t, []string{"team-a", "team-a", "team-a", "team-a"}, c.headers)
+}
+
+func TestClient_HTTPFailures(t *testing.T) {
+	t.Parallel()
+
+	c := &collector{}
+	endpoint := startHTTPCollector(t, c, http.StatusBadRequest, http.StatusBadGateway, http.StatusBadGateway)
+
+	client, err := NewClient(endpoint + "?retries=1&retryBackoff=1ms")
+	require.NoError(t, err)
+	defer func() { _ = client.Close() }()
+
+	// Not retried
+	assert.Error(t, client.ExportLogs(context.Background(), testLogs()))
+	// Retried once
+	assert.Error(t, client.ExportLogs(context.Background(), testLogs()))
+
+	c.mutex.Lock()
+	assert.Equal(t, 3, c.attempts)
+	c.mutex.Unlock()
+
+	// Retries stop when the context is done
+	unreachable, err := NewClient("http://127.0.0.1:1?retries=100&retryBackoff=1h")
+	require.NoError(t, err)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	assert.Error(t, unreachable.ExportLogs(ctx, testLogs()))
+}
+
+func TestNewClient_InvalidOptions(t *testing.T) {
+	t.Parallel()
+
+	for _, rawURL := range []string{
+		"tcp://localhost:4317",
+		"grpc:///",
+		"grpc://localhost:4317/v1/logs",
+		"grpc://localhost:4317?timeout=soon",
+		"grpc://localhost:4317?retries=-1",
+		"grpc://localhost:4317?retryBackoff=often",
+		"http://localhost:4318?header=X-Tenant",
+		"https://localhost:4318?tlsSkipVerify=maybe",
+		"grpcs://localhost:4317?tlsCA=/nonexistent/ca.pem",
+	} {
+		_, err := NewClient(rawURL)
+		assert.Error(t, err, rawURL)
+	}
+}
@@ -0,0 +1,229 @@
+package otlp
+
+import (
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+
+	pb "github.com/aquasecurity/tracee/api/v1beta1"
+	"github.com/aquasecurity/tracee/common/errfmt"
+	"github.com/aquasecurity/tracee/pkg/version"
+)
+
+// scopeName is the instrumentation scope of the exported logs and metrics
+const scopeName = "tracee"
+
+// Logs converts events to OTLP log records, grouped by the resource their workload maps
+// to: the host, the process, its container and kubernetes pod. Events that fail to
+// convert are skipped and counted in the returned number.
+func Logs(events []*pb.Event, hostname string) ([]*logspb.ResourceLogs, int) {
+	var (
+		resourceLogs []*logspb.ResourceLogs
+		failed       int
+	)
+	byResource := map[string]*logspb.ScopeLogs{}
+	observed := uint64(time.Now().UnixNano())
+
+	for _, event := range events {
+		record, err := logRecord(event, observed)
+		if err != nil {
+			failed++
+			continue
+		}
+
+		attributes := resourceAttributes(event, hostname)
+		key := attributesKey(attributes)
+		scopeLogs, ok := byResource[key]
+		if !ok {
+			scopeLogs = &logspb.ScopeLogs{
+				Scope: &commonpb.InstrumentationScope{Name: scopeName, Version: version.GetVersion()},
+			}
+			byResource[key] = scopeLogs
+			resourceLogs = append(resourceLogs, &logspb.ResourceLogs{
+				Resource:  &resourcepb.Resource{Attributes: attributes},
+				ScopeLogs: []*logspb.ScopeLogs{scopeLogs},
+			})
+		}
+		scopeLogs.LogRecords = append(scopeLogs.LogRecords, record)
+	}
+
+	return resourceLogs, failed
+}
+
+// hostAttributes returns the attributes of the resource all the exports come from
+func hostAttributes(hostname string) []*commonpb.KeyValue {
+	return []*commonpb.KeyValue{
+		stringAttribute("service.name", "tracee"),
+		stringAttribute("service.version", version.GetVersion()),
+		stringAttribute("host.name", hostname),
+	}
+}
+
+// resourceAttributes maps the workload of an event to the OpenTelemetry semantic
+// conventions resource attributes
+func resourceAttributes(event *pb.Event, hostname string) []*commonpb.KeyValue {
+	attributes := hostAttributes(hostname)
+	workload := event.GetWorkload()
+
+	if process := workload.GetProcess(); process != nil {
+		if process.GetHostPid() != nil {
+			attributes = append(attributes, intAttribute("process.pid", int64(process.GetHostPid().GetValue())))
+		}
+		if path := process.GetExecutable().GetPath(); path != "" {
+			attributes = append(attributes,
+				stringAttribute("process.executable.path", path),
+				stringAttribute("process.executable.name", filepath.Base(path)),
+			)
+		}
+	}
+
+	if container := workload.GetContainer(); container.GetId() != "" {
+		attributes = append(attributes, stringAttribute("container.id", container.GetId()))
+		if container.GetName() != "" {
+			attributes = append(attributes, stringAttribute("container.name", container.GetName()))
+		}
+		if image := container.GetImage(); image.GetName() != "" {
+			attributes = append(attributes, stringAttribute("container.image.name", image.GetName()))
+		}
+		if image := container.GetImage(); image.GetId() != "" {
+			attributes = append(attributes, stringAttribute("container.image.id", image.GetId()))
+		}
+	}
+
+	if k8s := workload.GetK8S(); k8s != nil {
+		if pod := k8s.GetPod(); pod.GetName() != "" {
+			attributes = append(attributes,
+				stringAttribute("k8s.pod.name", pod.GetName()),
+				stringAttribute("k8s.pod.uid", pod.GetUid()),
+			)
+		}
+		if namespace := k8s.GetNamespace().GetName(); namespace != "" {
+			attributes = append(attributes, stringAttribute("k8s.namespace.name", namespace))
+		}
+	}
+
+	return attributes
+}
+
+// logRecord converts an event to a log record. The body is the event in json, its
+// threat maps to the record severity.
+func logRecord(event *pb.Event, observed uint64) (*logspb.LogRecord, error) {
+	body, err := event.MarshalJSON()
+	if err != nil {
+		return nil, errfmt.WrapError(err)
+	}
+
+	severityNumber, severityText := severity(event)
+	record := &logspb.LogRecord{
+		ObservedTimeUnixNano: observed,
+		SeverityNumber:       severityNumber,
+		SeverityText:         severityText,
+		EventName:            event.GetName(),
+		Body:                 &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: string(body)}},
+		Attributes: []*commonpb.KeyValue{
+			intAttribute("tracee.event.id", int64(event.GetId())),
+		},
+	}
+	if event.GetTimestamp() != nil {
+		record.TimeUnixNano = uint64(event.GetTimestamp().AsTime().UnixNano())
+	}
+
+	if thread := event.GetWorkload().GetProcess().GetThread(); thread != nil {
+		if thread.GetHostTid() != nil {
+			record.Attributes = append(record.Attributes, intAttribute("thread.id", int64(thread.GetHostTid().GetValue())))
+		}
+		if thread.GetName() != "" {
+			record.Attributes = append(record.Attributes, stringAttribute("thread.name", thread.GetName()))
+		}
+	}
+	if matched := event.GetPolicies().GetMatched(); len(matched) > 0 {
+		record.Attributes = append(record.Attributes, stringsAttribute("tracee.policies", matched))
+	}
+
+	if threat := event.GetThreat(); threat != nil {
+		record.Attributes = append(record.Attributes, stringAttribute("tracee.threat.name", threat.GetName()))
+		if tactic := threat.GetMitre().GetTactic().GetName(); tactic != "" {
+			record.Attributes = append(record.Attributes, stringAttribute("tracee.threat.mitre.tactic", tactic))
+		}
+		if technique := threat.GetMitre().GetTechnique(); technique.GetName() != "" {
+			record.Attributes = append(record.Attributes,
+				stringAttribute("tracee.threat.mitre.technique.id", technique.GetId()),
+				stringAttribute("tracee.threat.mitre.technique.name", technique.GetName()),
+			)
+		}
+	}
+
+	return record, nil
+}
+
+// severity maps the threat severity of an event to a log severity. Events without a
+// threat are informational.
+func severity(event *pb.Event) (logspb.SeverityNumber, string) {
+	threat := event.GetThreat()
+	if threat == nil {
+		return logspb.SeverityNumber_SEVERITY_NUMBER_INFO, pb.Severity_INFO.String()
+	}
+
+	text := threat.GetSeverity().String()
+	switch threat.GetSeverity() {
+	case pb.Severity_LOW:
+		return logspb.SeverityNumber_SEVERITY_NUMBER_WARN, text
+	case pb.Severity_MEDIUM:
+		return logspb.SeverityNumber_SEVERITY_NUMBER_ERROR, text
+	case pb.Severity_HIGH:
+		return logspb.SeverityNumber_SEVERITY_NUMBER_FATAL, text
+	case pb.Severity_CRITICAL:
+		return logspb.SeverityNumber_SEVERITY_NUMBER_FATAL4, text
+	}
+
+	return logspb.SeverityNumber_SEVERITY_NUMBER_INFO, text
+}
+
+// attributesKey returns a key identifying a set of string and int attributes built in
+// a fixed order
+func attributesKey(attributes []*commonpb.KeyValue) string {
+	var b strings.Builder
+	for _, attribute := range attributes {
+		b.WriteString(attribute.GetKey())
+		b.WriteByte('=')
+		if value, ok := attribute.GetValue().GetValue().(*commonpb.AnyValue_IntValue); ok {
+			b.WriteString(strconv.FormatInt(value.IntValue, 10))
+		} else {
+			b.WriteString(strconv.Quote(attribute.GetValue().GetStringValue()))
+		}
+		b.WriteByte(';')
+	}
+
+	return b.String()
+}
+
+func stringAttribute(key, value string) *commonpb.KeyValue {
+	return &commonpb.KeyValue{
+		Key:   key,
+		Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: value}},
+	}
+}
+
+func intAttribute(key string, value int64) *commonpb.KeyValue {
+	return &commonpb.KeyValue{
+		Key:   key,
+		Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_IntValue{IntValue: value}},
+	}
+}
+
+func stringsAttribute(key string, values []string) *commonpb.KeyValue {
+	array := &commonpb.ArrayValue{}
+	for _, value := range values {
+		array.Values = append(array.Values, &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: value}})
+	}
+
+	return &commonpb.KeyValue{
+		Key:   key,
+		Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_ArrayValue{ArrayValue: array}},
+	}
+}
@@ -0,0 +1,144 @@
+package otlp
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	pb "github.com/aquasecurity/tracee/api/v1beta1"
+)
+
+// attributeMap returns the string and int attributes by key
+func attributeMap(attributes []*commonpb.KeyValue) map[string]any {
+	m := map[string]any{}
+	for _, attribute := range attributes {
+		switch value := attribute.GetValue().GetValue().(type) {
+		case *commonpb.AnyValue_IntValue:
+			m[attribute.GetKey()] = value.IntValue
+		case *commonpb.AnyValue_StringValue:
+			m[attribute.GetKey()] = value.StringValue
+		case *commonpb.AnyValue_ArrayValue:
+			var values []string
+			for _, v := range value.ArrayValue.GetValues() {
+				values = append(values, v.GetStringValue())
+			}
+			m[attribute.GetKey()] = values
+		}
+	}
+
+	return m
+}
+
+func TestLogs_Resources(t *testing.T) {
+	t.Parallel()
+
+	podProcess := &pb.Workload{
+		Process: &pb.Process{
+			HostPid:    wrapperspb.UInt32(1234),
+			Executable: &pb.Executable{Path: "/usr/bin/curl"},
+			Thread:     &pb.Thread{Name: "curl", HostTid: wrapperspb.UInt32(1235)},
+		},
+		Container: &pb.Container{
+			Id:    "c0ffee",
+			Name:  "web",
+			Image: &pb.ContainerImage{Id: "sha256:1", Name: "nginx:1.27"},
+		},
+		K8S: &pb.K8S{
+			Pod:       &pb.Pod{Name: "web-0", Uid: "uid-0"},
+			Namespace: &pb.K8SNamespace{Name: "prod"},
+		},
+	}
+
+	events := []*pb.Event{
+		{Name: "execve", Id: pb.EventId(59), Workload: podProcess, Timestamp: timestamppb.New(time.Unix(10, 0))},
+		{Name: "host_event"},
+		{Name: "openat", Workload: podProcess, Policies: &pb.Policies{Matched: []string{"p1", "p2"}}},
+	}
+
+	resourceLogs, failed := Logs(events, "node-1")
+	require.Zero(t, failed)
+	require.Len(t, resourceLogs, 2)
+
+	pod := attributeMap(resourceLogs[0].GetResource().GetAttributes())
+	assert.Equal(t, map[string]any{
+		"service.name":            "tracee",
+		"service.version":         pod["service.version"],
+		"host.name":               "node-1",
+		"process.pid":             int64(1234),
+		"process.executable.path": "/usr/bin/curl",
+		"process.executable.name": "curl",
+		"container.id":            "c0ffee",
+		"container.name":          "web",
+		"container.image.name":    "nginx:1.27",
+		"container.image.id":      "sha256:1",
+		"k8s.pod.name":            "web-0",
+		"k8s.pod.uid":             "uid-0",
+		"k8s.namespace.name":      "prod",
+	}, pod)
+
+	host := attributeMap(resourceLogs[1].GetResource().GetAttributes())
+	assert.Equal(t, "node-1", host["host.name"])
+	assert.NotContains(t, host, "process.pid")
+
+	// The events of a resource are grouped together, in order
+	records := resourceLogs[0].GetScopeLogs()[0].GetLogRecords()
+	require.Len(t, records, 2)
+	assert.Equal(t, "execve", records[0].GetEventName())
+	assert.Equal(t, uint64(10*time.Second), records[0].GetTimeUnixNano())
+	assert.Equal(t, map[string]any{
+		"tracee.event.id": int64(59),
+		"thread.id":       int64(1235),
+		"thread.name":     "curl",
+	}, attributeMap(records[0].GetAttributes()))
+	assert.Equal(t, []string{"p1", "p2"}, attributeMap(records[1].GetAttributes())["tracee.policies"])
+
+	var body map[string]any
+	require.NoError(t, json.Unmarshal([]byte(records[0].GetBody().GetStringValue()), &body))
+	assert.Equal(t, "execve", body["name"])
+}
+
+func TestLogs_Severity(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		threat         *pb.Threat
+		expectedNumber logspb.SeverityNumber
+		expectedText   string
+	}{
+		{threat: nil, expectedNumber: logspb.SeverityNumber_SEVERITY_NUMBER_INFO, expectedText: "INFO"},
+		{threat: &pb.Threat{Severity: pb.Severity_INFO}, expectedNumber: logspb.SeverityNumber_SEVERITY_NUMBER_INFO, expectedText: "INFO"},
+		{threat: &pb.Threat{Severity: pb.Severity_LOW}, expectedNumber: logspb.SeverityNumber_SEVERITY_NUMBER_WARN, expectedText: "LOW"},
+		{threat: &pb.Threat{Severity: pb.Severity_MEDIUM}, expectedNumber: logspb.SeverityNumber_SEVERITY_NUMBER_ERROR, expectedText: "MEDIUM"},
+		{threat: &pb.Threat{Severity: pb.Severity_HIGH}, expectedNumber: logspb.SeverityNumber_SEVERITY_NUMBER_FATAL, expectedText: "HIGH"},
+		{threat: &pb.Threat{Severity: pb.Severity_CRITICAL}, expectedNumber: logspb.SeverityNumber_SEVERITY_NUMBER_FATAL4, expectedText: "CRITICAL"},
+	}
+
+	for _, tc := range tests {
+		resourceLogs, _ := Logs([]*pb.Event{{Name: "e", Threat: tc.threat}}, "node-1")
+		record := resourceLogs[0].GetScopeLogs()[0].GetLogRecords()[0]
+		assert.Equal(t, tc.expectedNumber, record.GetSeverityNumber(), tc.expectedText)
+		assert.Equal(t, tc.expectedText, record.GetSeverityText())
+	}
+
+	threat := &pb.Threat{
+		Name:     "Anti-Debugging",
+		Severity: pb.Severity_HIGH,
+		Mitre: &pb.Mitre{
+			Tactic:    &pb.MitreTactic{Name: "Defense Evasion"},
+			Technique: &pb.MitreTechnique{Id: "T1622", Name: "Debugger Evasion"},
+		},
+	}
+	resourceLogs, _ := Logs([]*pb.Event{{Name: "anti_debugging", Threat: threat}}, "node-1")
+	attributes := attributeMap(resourceLogs[0].GetScopeLogs()[0].GetLogRecords()[0].GetAttributes())
+	assert.Equal(t, "Anti-Debugging", attributes["tracee.threat.name"])
+	assert.Equal(t, "Defense Evasion", attributes["tracee.threat.mitre.tactic"])
+	assert.Equal(t, "T1622", attributes["tracee.threat.mitre.technique.id"])
+	assert.Equal(t, "Debugger Evasion", attributes["tracee.threat.mitre.technique.name"])
+}
@@ -0,0 +1,236 @@
+package otlp
+
+import (
+	"context"
+	"math"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	metricspb "go.opentelemetry.io/proto/otlp/metrics/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+
+	"github.com/aquasecurity/tracee/common/errfmt"
+	"github.com/aquasecurity/tracee/common/logger"
+	"github.com/aquasecurity/tracee/pkg/version"
+)
+
+// MetricsExporter periodically exports the metrics registered with prometheus to an
+// OTLP collector, as an alternative to scraping the /metrics endpoint. Counters are
+// exported as cumulative sums, from the time the exporter was created.
+type MetricsExporter struct {
+	client    *Client
+	gatherer  prometheus.Gatherer
+	interval  time.Duration
+	resource  *resourcepb.Resource
+	startTime time.Time
+}
+
+// NewMetricsExporter creates an exporter of the default prometheus registry to the
+// collector at the given URL. Besides the client options, the URL takes the export
+// interval option.
+func NewMetricsExporter(rawURL string) (*MetricsExporter, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, errfmt.Errorf("unable to parse URL %q: %v", rawURL, err)
+	}
+
+	interval, err := durationParameter(u.Query(), "interval", "30s")
+	if err != nil {
+		return nil, err
+	}
+	if interval <= 0 {
+		return nil, errfmt.Errorf("invalid otlp metrics interval %s, expected a positive duration", interval)
+	}
+
+	hostname, err := os.Hostname()
+	if err != nil {
+		return nil, errfmt.Errorf("unable to get the hostname: %v", err)
+	}
+
+	client, err := NewClient(rawURL)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MetricsExporter{
+		client:    client,
+		gatherer:  prometheus.DefaultGatherer,
+		interval:  interval,
+		resource:  &resourcepb.Resource{Attributes: hostAttributes(hostname)},
+		startTime: time.Now(),
+	}, nil
+}
+
+// Run exports the metrics every interval until ctx is done, then exports them a last time
+func (e *MetricsExporter) Run(ctx context.Context) {
+	logger.Debugw("Exporting metrics over otlp", "endpoint", e.client.Endpoint(), "interval", e.interval)
+
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			// The final export isn't retried past the client timeout
+			if err := e.export(context.Background()); err != nil {
+				logger.Errorw("Error exporting metrics over otlp", "error", err)
+			}
+			_ = e.client.Close()
+			return
+		case <-ticker.C:
+			if err := e.export(ctx); err != nil {
+				logger.Errorw("Error exporting metrics over otlp", "error", err)
+			}
+		}
+	}
+}
+
+// export gathers the metrics and sends them to the collector
+func (e *MetricsExporter) export(ctx context.Context) error {
+	families, err := e.gatherer.Gather()
+	if err != nil {
+		// Gather returns what it could collect along with the error
+		logger.Warnw("Error gathering metrics", "error", err)
+	}
+	if len(families) == 0 {
+		return nil
+	}
+
+	resourceMetrics := []*metricspb.ResourceMetrics{
+		{
+			Resource: e.resource,
+			ScopeMetrics: []*metricspb.ScopeMetrics{
+				{
+					Scope:   &commonpb.InstrumentationScope{Name: scopeName, Version: version.GetVersion()},
+					Metrics: Metrics(families, e.startTime, time.Now()),
+				},
+			},
+		},
+	}
+
+	return e.client.ExportMetrics(ctx, resourceMetrics)
+}
+
+// Metrics converts prometheus metric families to OTLP metrics: counters to cumulative
+// monotonic sums, gauges and untyped metrics to gauges, histograms and summaries to
+// their OTLP counterparts. The metric labels become the data point attributes.
+func Metrics(families []*dto.MetricFamily, start, now time.Time) []*metricspb.Metric {
+	startNano := uint64(start.UnixNano())
+	nowNano := uint64(now.UnixNano())
+
+	metrics := make([]*metricspb.Metric, 0, len(families))
+	for _, family := range families {
+		metric := &metricspb.Metric{
+			Name:        family.GetName(),
+			Description: family.GetHelp(),
+		}
+
+		switch family.GetType() {
+		case dto.MetricType_COUNTER:
+			sum := &metricspb.Sum{
+				AggregationTemporality: metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE,
+				IsMonotonic:            true,
+			}
+			for _, m := range family.GetMetric() {
+				sum.DataPoints = append(sum.DataPoints, numberDataPoint(m, m.GetCounter().GetValue(), startNano, nowNano))
+			}
+			metric.Data = &metricspb.Metric_Sum{Sum: sum}
+
+		case dto.MetricType_GAUGE, dto.MetricType_UNTYPED:
+			gauge := &metricspb.Gauge{}
+			for _, m := range family.GetMetric() {
+				value := m.GetGauge().GetValue()
+				if family.GetType() == dto.MetricType_UNTYPED {
+					value = m.GetUntyped().GetValue()
+				}
+				gauge.DataPoints = append(gauge.DataPoints, numberDataPoint(m, value, 0, nowNano))
+			}
+			metric.Data = &metricspb.Metric_Gauge{Gauge: gauge}
+
+		case dto.MetricType_HISTOGRAM:
+			histogram := &metricspb.Histogram{
+				AggregationTemporality: metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE,
+			}
+			for _, m := range family.GetMetric() {
+				histogram.DataPoints = append(histogram.DataPoints, histogramDataPoint(m, startNano, nowNano))
+			}
+			metric.Data = &metricspb.Metric_Histogram{Histogram: histogram}
+
+		case dto.MetricType_SUMMARY:
+			summary := &metricspb.Summary{}
+			for _, m := range family.GetMetric() {
+				dataPoint := &metricspb.SummaryDataPoint{
+					Attributes:        labelAttributes(m),
+					StartTimeUnixNano: startNano,
+					TimeUnixNano:      nowNano,
+					Count:             m.GetSummary().GetSampleCount(),
+					Sum:               m.GetSummary().GetSampleSum(),
+				}
+				for _, q := range m.GetSummary().GetQuantile() {
+					dataPoint.QuantileValues = append(dataPoint.QuantileValues, &metricspb.SummaryDataPoint_ValueAtQuantile{
+						Quantile: q.GetQuantile(),
+						Value:    q.GetValue(),
+					})
+				}
+				summary.DataPoints = append(summary.DataPoints, dataPoint)
+			}
+			metric.Data = &metricspb.Metric_Summary{Summary: summary}
+
+		default:
+			continue
+		}
+
+		metrics = append(metrics, metric)
+	}
+
+	return metrics
+}
+
+func numberDataPoint(m *dto.Metric, value float64, startNano, nowNano uint64) *metricspb.NumberDataPoint {
+	return &metricspb.NumberDataPoint{
+		Attributes:        labelAttributes(m),
+		StartTimeUnixNano: startNano,
+		TimeUnixNano:      nowNano,
+		Value:             &metricspb.NumberDataPoint_AsDouble{AsDouble: value},
+	}
+}
+
+// histogramDataPoint converts the cumulative prometheus buckets to the per bucket counts
+// of OTLP, the last count being the overflow above the highest bound
+func histogramDataPoint(m *dto.Metric, startNano, nowNano uint64) *metricspb.HistogramDataPoint {
+	h := m.GetHistogram()
+	sum := h.GetSampleSum()
+	dataPoint := &metricspb.HistogramDataPoint{
+		Attributes:        labelAttributes(m),
+		StartTimeUnixNano: startNano,
+		TimeUnixNano:      nowNano,
+		Count:             h.GetSampleCount(),
+		Sum:               &sum,
+	}
+
+	var previous uint64
+	for _, bucket := range h.GetBucket() {
+		if math.IsInf(bucket.GetUpperBound(), 1) {
+			continue
+		}
+		dataPoint.ExplicitBounds = append(dataPoint.ExplicitBounds, bucket.GetUpperBound())
+		dataPoint.BucketCounts = append(dataPoint.BucketCounts, bucket.GetCumulativeCount()-previous)
+		previous = bucket.GetCumulativeCount()
+	}
+	dataPoint.BucketCounts = append(dataPoint.BucketCounts, h.GetSampleCount()-previous)
+
+	return dataPoint
+}
+
+func labelAttributes(m *dto.Metric) []*commonpb.KeyValue {
+	attributes := make([]*commonpb.KeyValue, 0, len(m.GetLabel()))
+	for _, label := range m.GetLabel() {
+		attributes = append(attributes, stringAttribute(label.GetName(), label.GetValue()))
+	}
+
+	return attributes
+}
@@ -0,0 +1,129 @@
+package otlp
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metricspb "go.opentelemetry.io/proto/otlp/metrics/v1"
+)
+
+func testRegistry(t *testing.T) *prometheus.Registry {
+	registry := prometheus.NewRegistry()
+
+	events := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "tracee_events_total",
+		Help: "events",
+	}, []string{"destination"})
+	events.WithLabelValues("d1").Add(3)
+	queued := prometheus.NewGauge(prometheus.GaugeOpts{Name: "tracee_queued", Help: "queued"})
+	queued.Set(7)
+	latency := prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "tracee_latency_seconds",
+		Buckets: []float64{1, 2},
+	})
+	for _, v := range []float64{0.5, 1.5, 1.5, 5} {
+		latency.Observe(v)
+	}
+	summary := prometheus.NewSummary(prometheus.SummaryOpts{
+		Name:       "tracee_size_bytes",
+		Objectives: map[float64]float64{0.5: 0.05},
+	})
+	summary.Observe(10)
+
+	require.NoError(t, registry.Register(events))
+	require.NoError(t, registry.Register(queued))
+	require.NoError(t, registry.Register(latency))
+	require.NoError(t, registry.Register(summary))
+
+	return registry
+}
+
+func TestMetrics(t *testing.T) {
+	t.Parallel()
+
+	families, err := testRegistry(t).Gather()
+	require.NoError(t, err)
+
+	start := time.Unix(100, 0)
+	now := time.Unix(200, 0)
+	metrics := map[string]*metricspb.Metric{}
+	for _, metric := range Metrics(families, start, now) {
+		metrics[metric.GetName()] = metric
+	}
+	require.Len(t, metrics, 4)
+
+	sum := metrics["tracee_events_total"].GetSum()
+	require.NotNil(t, sum)
+	assert.True(t, sum.GetIsMonotonic())
+	assert.Equal(t, metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE, sum.GetAggregationTemporality())
+	require.Len(t, sum.GetDataPoints(), 1)
+	assert.Equal(t, 3.0, sum.GetDataPoints()[0].GetAsDouble())
+	assert.Equal(t, uint64(start.UnixNano()), sum.GetDataPoints()[0].GetStartTimeUnixNano())
+	assert.Equal(t, uint64(now.UnixNano()), sum.GetDataPoints()[0].GetTimeUnixNano())
+	assert.Equal(t, map[string]any{"destination": "d1"}, attributeMap(sum.GetDataPoints()[0].GetAttributes()))
+	assert.Equal(t, "events", metrics["tracee_events_total"].GetDescription())
+
+	gauge := metrics["tracee_queued"].GetGauge()
+	require.NotNil(t, gauge)
+	assert.Equal(t, 7.0, gauge.GetDataPoints()[0].GetAsDouble())
+
+	histogram := metrics["tracee_latency_seconds"].GetHistogram()
+	require.NotNil(t, histogram)
+	dataPoint := histogram.GetDataPoints()[0]
+	assert.Equal(t, uint64(4), dataPoint.GetCount())
+	assert.Equal(t, 8.5, dataPoint.GetSum())
+	assert.Equal(t, []float64{1, 2}, dataPoint.GetExplicitBounds())
+	assert.Equal(t, []uint64{1, 2, 1}, dataPoint.GetBucketCounts())
+
+	summary := metrics["tracee_size_bytes"].GetSummary()
+	require.NotNil(t, summary)
+	assert.Equal(t, uint64(1), summary.GetDataPoints()[0].GetCount())
+	require.Len(t, summary.GetDataPoints()[0].GetQuantileValues(), 1)
+	assert.Equal(t, 10.0, summary.GetDataPoints()[0].GetQuantileValues()[0].GetValue())
+}
+
+func TestMetricsExporter(t *testing.T) {
+	t.Parallel()
+
+	c := &collector{}
+	addr := startGRPCCollector(t, c)
+
+	exporter, err := NewMetricsExporter("grpc://" + addr + "?interval=10ms")
+	require.NoError(t, err)
+	exporter.gatherer = testRegistry(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		exporter.Run(ctx)
+		close(done)
+	}()
+
+	require.Eventually(t, func() bool {
+		c.mutex.Lock()
+		defer c.mutex.Unlock()
+		return len(c.metrics) >= 2
+	}, 5*time.Second, 10*time.Millisecond)
+	cancel()
+	<-done
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	resourceMetrics := c.metrics[0].GetResourceMetrics()
+	require.Len(t, resourceMetrics, 1)
+	assert.Equal(t, "tracee", attributeMap(resourceMetrics[0].GetResource().GetAttributes())["service.name"])
+	assert.Len(t, resourceMetrics[0].GetScopeMetrics()[0].GetMetrics(), 4)
+
+	for _, rawURL := range []string{
+		"grpc://localhost:4317?interval=often",
+		"grpc://localhost:4317?interval=0s",
+		"tcp://localhost:4317",
+	} {
+		_, err := NewMetricsExporter(rawURL)
+		assert.Error(t, err, rawURL)
+	}
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/aquasecurity/tracee/common/errfmt"
 	"github.com/aquasecurity/tracee/common/logger"
+	"github.com/aquasecurity/tracee/pkg/artifacts"
 	"github.com/aquasecurity/tracee/types/trace"
 )
 
@@ -14,16 +15,23 @@ type PcapCache struct {
 	itemType  PcapType
 }
 
-func newPcapCache(itemType PcapType) (*PcapCache, error) {
+// newPcapCache creates a cache of pcap files of the given type. Closed is
+// called for each pcap file closed, evicted from the cache or destroyed.
+func newPcapCache(itemType PcapType, closed func(artifacts.Artifact)) (*PcapCache, error) {
 	cache, err := lru.NewWithEvict(
 		pcapsToCache,
 		func(_ string, item *Pcap,
 		) {
-			if err := item.pcapWriter.Flush(); err != nil {
-				logger.Errorw("Flushing pcap", "error", err)
-			}
-			if err := item.pcapFile.Close(); err != nil {
+			if err := item.close(); err != nil {
 				logger.Errorw("Closing file", "error", err)
+				return
+			}
+			if closed != nil {
+				closed(artifacts.Artifact{
+					Type:    artifacts.Network,
+					Path:    item.pcapPath,
+					Context: item.context,
+				})
 			}
 		})
 
@@ -59,13 +67,7 @@ func (p *PcapCache) get(event *trace.Event) (*Pcap, error) {
 }
 
 func (p *PcapCache) destroy() error {
-	for _, key := range p.itemCache.Keys() {
-		item, _ := p.itemCache.Get(key)
-		if err := item.close(); err != nil {
-			logger.Errorw("Closing file", "error", err)
-		}
-	}
-	p.itemCache.Purge()
+	p.itemCache.Purge() // closes all items (evict callback)
 
 	return nil
 }
@@ -157,16 +157,18 @@ func mkdirForPcapType(o *os.File, c string, t PcapType) error {
 	return nil
 }
 
-// getPcapFileAndWriter returns a file descriptor and and its associated pcap
-// writer depending on the type "t" given (a Pcap interface implementation).
+// getPcapFileAndWriter returns the path of a pcap file, its file descriptor
+// and its associated pcap writer depending on the type "t" given (a Pcap
+// interface implementation).
 func getPcapFileAndWriter(event *trace.Event, t PcapType) (
+	string,
 	*os.File,
 	*pcapgo.NgWriter,
 	error,
 ) {
 	pcapFilePath, err := getPcapFileName(event, t)
 	if err != nil {
-		return nil, nil, errfmt.WrapError(err)
+		return "", nil, nil, errfmt.WrapError(err)
 	}
 
 	file, err := fileutil.OpenAt(
@@ -176,14 +178,14 @@ func getPcapFileAndWriter(event *trace.Event, t PcapType) (
 		0644,
 	)
 	if err != nil {
-		return nil, nil, errfmt.WrapError(fmt.Errorf("failed to open file %s at %s: %w", pcapFilePath, outputDirectory.Name(), err))
+		return "", nil, nil, errfmt.WrapError(fmt.Errorf("failed to open file %s at %s: %w", pcapFilePath, outputDirectory.Name(), err))
 	}
 
 	logger.Debugw("pcap file (re)opened", "filename", pcapFilePath)
 
 	iface, err := GenerateInterface(event, t)
 	if err != nil {
-		return nil, nil, errfmt.WrapError(err)
+		return "", nil, nil, errfmt.WrapError(err)
 	}
 
 	writer, err := pcapgo.NewNgWriterInterface(
@@ -192,14 +194,14 @@ func getPcapFileAndWriter(event *trace.Event, t PcapType) (
 		pcapgo.DefaultNgWriterOptions,
 	)
 	if err != nil {
-		return nil, nil, errfmt.WrapError(err)
+		return "", nil, nil, errfmt.WrapError(err)
 	}
 	err = writer.Flush()
 	if err != nil {
-		return nil, nil, errfmt.WrapError(err)
+		return "", nil, nil, errfmt.WrapError(err)
 	}
 
-	return file, writer, nil
+	return pcapFilePath, file, writer, nil
 }
 
 // configToPcapType converts a simple bool like config struct to internal config
@@ -9,6 +9,7 @@ import (
 
 	"github.com/aquasecurity/tracee/common/errfmt"
 	"github.com/aquasecurity/tracee/common/logger"
+	"github.com/aquasecurity/tracee/pkg/artifacts"
 	"github.com/aquasecurity/tracee/types/trace"
 )
 
@@ -64,6 +65,8 @@ type Pcap struct {
 	pcapType    PcapType         // Process, Container or Command
 	pcapFile    *os.File         // pcap file descriptor
 	pcapWriter  *pcapgo.NgWriter // pcap writer descriptor
+	pcapPath    string           // pcap file path, relative to the output directory
+	context     artifacts.Context
 }
 
 func NewPcap(e *trace.Event, t PcapType) (*Pcap, error) {
@@ -73,7 +76,15 @@ func NewPcap(e *trace.Event, t PcapType) (*Pcap, error) {
 		pcapType: t,
 	}
 
-	p.pcapFile, p.pcapWriter, err = getPcapFileAndWriter(e, t)
+	// the single pcap file has no process or container context
+	switch t {
+	case Process:
+		p.context = artifacts.Context{ContainerID: e.Container.ID, HostPid: uint32(e.HostProcessID)}
+	case Container, Command:
+		p.context = artifacts.Context{ContainerID: e.Container.ID}
+	}
+
+	p.pcapPath, p.pcapFile, p.pcapWriter, err = getPcapFileAndWriter(e, t)
 
 	return p, errfmt.WrapError(err)
 }
@@ -5,6 +5,7 @@ import (
 
 	"github.com/aquasecurity/tracee/common/errfmt"
 	"github.com/aquasecurity/tracee/common/logger"
+	"github.com/aquasecurity/tracee/pkg/artifacts"
 	"github.com/aquasecurity/tracee/pkg/config"
 	"github.com/aquasecurity/tracee/pkg/events"
 	"github.com/aquasecurity/tracee/types/trace"
@@ -30,7 +31,10 @@ type Pcaps struct {
 	pcapCaches map[PcapType]*PcapCache
 }
 
-func New(simple config.PcapsConfig, output *os.File) (*Pcaps, error) {
+// New creates the pcap files caches of the requested types, writing the pcap
+// files into output. Closed is called for each pcap file closed (it might be
+// reopened, and appended to, later on).
+func New(simple config.PcapsConfig, output *os.File, closed func(artifacts.Artifact)) (*Pcaps, error) {
 	var err error
 
 	cfg := configToPcapType(simple)
@@ -48,7 +52,7 @@ func New(simple config.PcapsConfig, output *os.File) (*Pcaps, error) {
 	for t := range caches {
 		if cfg&t == t { // if type was requested, init its cache
 			logger.Debugw("pcap enabled: " + t.String())
-			caches[t], err = newPcapCache(t)
+			caches[t], err = newPcapCache(t, closed)
 			if err != nil {
 				return nil, errfmt.WrapError(err)
 			}
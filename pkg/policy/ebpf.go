@@ -10,6 +10,7 @@ import (
 
 	bpf "github.com/aquasecurity/libbpfgo"
 
+	"github.com/aquasecurity/tracee/common/bitwise"
 	"github.com/aquasecurity/tracee/common/errfmt"
 	"github.com/aquasecurity/tracee/common/logger"
 	"github.com/aquasecurity/tracee/common/proc"
@@ -279,7 +280,7 @@ func (ps *policies) createNewFilterMapsVersion(bpfModule *bpf.Module) error {
 }
 
 type eventConfig struct {
-	submitForPolicies uint64
+	submitForPolicies bitwise.Bitmap
 	fieldTypes        uint64
 	dataFilter        dataFilterConfig
 }
@@ -373,12 +374,9 @@ func updateUIntFilterBPF[T uint32 | uint64](ps *policies, uintEqualities map[T]e
 		u32Key := uint32(k) // Convert to uint32 for BPF map
 		keyPointer := unsafe.Pointer(&u32Key)
 
-		eqVal := make([]byte, equalityValueSize)
+		eqVal := v.encode()
 		valuePointer := unsafe.Pointer(&eqVal[0])
 
-		binary.LittleEndian.PutUint64(eqVal[0:8], v.equalsInPolicies)
-		binary.LittleEndian.PutUint64(eqVal[8:16], v.keyUsedInPolicies)
-
 		bpfMap, ok := ps.bpfInnerMaps[innerMapName]
 		if !ok {
 			return errfmt.Errorf("bpf map not found: %s", innerMapName)
@@ -406,12 +404,9 @@ func (ps *policies) updateStringFilterBPF(strEqualities map[string]equality, inn
 		copy(byteStr, k)
 		keyPointer := unsafe.Pointer(&byteStr[0])
 
-		eqVal := make([]byte, equalityValueSize)
+		eqVal := v.encode()
 		valuePointer := unsafe.Pointer(&eqVal[0])
 
-		binary.LittleEndian.PutUint64(eqVal[0:8], v.equalsInPolicies)
-		binary.LittleEndian.PutUint64(eqVal[8:16], v.keyUsedInPolicies)
-
 		bpfMap, ok := ps.bpfInnerMaps[innerMapName]
 		if !ok {
 			return errfmt.Errorf("bpf map not found: %s", innerMapName)
@@ -433,12 +428,9 @@ func (ps *policies) updateProcTreeFilterBPF(procTreeEqualities map[uint32]equali
 		u32Key := pid
 		keyPointer := unsafe.Pointer(&u32Key)
 
-		eqVal := make([]byte, equalityValueSize)
+		eqVal := v.encode()
 		valuePointer := unsafe.Pointer(&eqVal[0])
 
-		binary.LittleEndian.PutUint64(eqVal[0:8], v.equalsInPolicies)
-		binary.LittleEndian.PutUint64(eqVal[8:16], v.keyUsedInPolicies)
-
 		bpfMap, ok := ps.bpfInnerMaps[innerMapName]
 		if !ok {
 			return errfmt.Errorf("bpf map not found: %s", innerMapName)
@@ -541,12 +533,9 @@ func (ps *policies) updateBinaryFilterBPF(binEqualities map[filters.NSBinary]equ
 		}
 		keyPointer := unsafe.Pointer(&binBytes[0])
 
-		eqVal := make([]byte, equalityValueSize)
+		eqVal := v.encode()
 		valuePointer := unsafe.Pointer(&eqVal[0])
 
-		binary.LittleEndian.PutUint64(eqVal[0:8], v.equalsInPolicies)
-		binary.LittleEndian.PutUint64(eqVal[8:16], v.keyUsedInPolicies)
-
 		bpfMap, ok := ps.bpfInnerMaps[innerMapName]
 		if !ok {
 			return errfmt.Errorf("bpf map not found: %s", innerMapName)
@@ -582,12 +571,9 @@ func (ps *policies) updateStringDataFilterLPMBPF(dataEqualities map[KernelDataFi
 
 		keyPointer := unsafe.Pointer(&binBytes[0])
 
-		eqVal := make([]byte, equalityValueSize)
+		eqVal := v.encode()
 		valuePointer := unsafe.Pointer(&eqVal[0])
 
-		binary.LittleEndian.PutUint64(eqVal[0:8], v.equalsInPolicies)
-		binary.LittleEndian.PutUint64(eqVal[8:16], v.keyUsedInPolicies)
-
 		innerMapName := fmt.Sprintf("%s_%d_%d", innerMapName, ps.version(), uint32(k.ID))
 
 		bpfMap, ok := ps.bpfInnerMaps[innerMapName]
@@ -620,12 +606,9 @@ func (ps *policies) updateStringDataFilterBPF(dataEqualities map[KernelDataField
 
 		keyPointer := unsafe.Pointer(&binBytes[0])
 
-		eqVal := make([]byte, equalityValueSize)
+		eqVal := v.encode()
 		valuePointer := unsafe.Pointer(&eqVal[0])
 
-		binary.LittleEndian.PutUint64(eqVal[0:8], v.equalsInPolicies)
-		binary.LittleEndian.PutUint64(eqVal[8:16], v.keyUsedInPolicies)
-
 		innerMapName := fmt.Sprintf("%s_%d_%d", innerMapName, ps.version(), uint32(k.ID))
 
 		bpfMap, ok := ps.bpfInnerMaps[innerMapName]
@@ -642,7 +625,7 @@ func (ps *policies) updateStringDataFilterBPF(dataEqualities map[KernelDataField
 
 type procInfo struct {
 	newProc        bool
-	followPolicies uint64
+	followPolicies bitwise.Bitmap
 	mntNS          uint32
 	binaryBytes    [maxBpfBinPathSize]byte
 	binNoMnt       uint32
@@ -672,7 +655,7 @@ func populateProcInfoMap(bpfModule *bpf.Module, binEqualities map[filters.NSBina
 			// init phase. As Policies are updated at runtime, this is not true anymore.
 			procInfo := procInfo{
 				newProc:        false,
-				followPolicies: 0,
+				followPolicies: bitwise.Bitmap{},
 				mntNS:          bin.MntNS,
 				binaryBytes:    *binBytesCopy,
 				binNoMnt:       0, // always 0, see bin_no_mnt in tracee.bpf.c
@@ -841,36 +824,36 @@ func (ps *policies) createNewPoliciesConfigMap(bpfModule *bpf.Module) error {
 // Order of fields is important, as it is used as a value for
 // the PoliciesConfigMap BPF map.
 type PoliciesConfig struct {
-	UIDFilterEnabled         uint64
-	PIDFilterEnabled         uint64
-	MntNsFilterEnabled       uint64
-	PidNsFilterEnabled       uint64
-	UtsNsFilterEnabled       uint64
-	CommFilterEnabled        uint64
-	CgroupIdFilterEnabled    uint64
-	ContFilterEnabled        uint64
-	NewContFilterEnabled     uint64
-	ContStartedFilterEnabled uint64
-	NewPidFilterEnabled      uint64
-	ProcTreeFilterEnabled    uint64
-	BinPathFilterEnabled     uint64
-	FollowFilterEnabled      uint64
-
-	UIDFilterMatchIfKeyMissing         uint64
-	PIDFilterMatchIfKeyMissing         uint64
-	MntNsFilterMatchIfKeyMissing       uint64
-	PidNsFilterMatchIfKeyMissing       uint64
-	UtsNsFilterMatchIfKeyMissing       uint64
-	CommFilterMatchIfKeyMissing        uint64
-	CgroupIdFilterMatchIfKeyMissing    uint64
-	ContFilterMatchIfKeyMissing        uint64
-	NewContFilterMatchIfKeyMissing     uint64
-	ContStartedFilterMatchIfKeyMissing uint64
-	NewPidFilterMatchIfKeyMissing      uint64
-	ProcTreeFilterMatchIfKeyMissing    uint64
-	BinPathFilterMatchIfKeyMissing     uint64
-
-	EnabledPolicies uint64
+	UIDFilterEnabled         bitwise.Bitmap
+	PIDFilterEnabled         bitwise.Bitmap
+	MntNsFilterEnabled       bitwise.Bitmap
+	PidNsFilterEnabled       bitwise.Bitmap
+	UtsNsFilterEnabled       bitwise.Bitmap
+	CommFilterEnabled        bitwise.Bitmap
+	CgroupIdFilterEnabled    bitwise.Bitmap
+	ContFilterEnabled        bitwise.Bitmap
+	NewContFilterEnabled     bitwise.Bitmap
+	ContStartedFilterEnabled bitwise.Bitmap
+	NewPidFilterEnabled      bitwise.Bitmap
+	ProcTreeFilterEnabled    bitwise.Bitmap
+	BinPathFilterEnabled     bitwise.Bitmap
+	FollowFilterEnabled      bitwise.Bitmap
+
+	UIDFilterMatchIfKeyMissing         bitwise.Bitmap
+	PIDFilterMatchIfKeyMissing         bitwise.Bitmap
+	MntNsFilterMatchIfKeyMissing       bitwise.Bitmap
+	PidNsFilterMatchIfKeyMissing       bitwise.Bitmap
+	UtsNsFilterMatchIfKeyMissing       bitwise.Bitmap
+	CommFilterMatchIfKeyMissing        bitwise.Bitmap
+	CgroupIdFilterMatchIfKeyMissing    bitwise.Bitmap
+	ContFilterMatchIfKeyMissing        bitwise.Bitmap
+	NewContFilterMatchIfKeyMissing     bitwise.Bitmap
+	ContStartedFilterMatchIfKeyMissing bitwise.Bitmap
+	NewPidFilterMatchIfKeyMissing      bitwise.Bitmap
+	ProcTreeFilterMatchIfKeyMissing    bitwise.Bitmap
+	BinPathFilterMatchIfKeyMissing     bitwise.Bitmap
+
+	EnabledPolicies bitwise.Bitmap
 
 	UidMax uint64
 	UidMin uint64
@@ -896,92 +879,93 @@ func (ps *policies) computePoliciesConfig() *PoliciesConfig {
 	cfg := &PoliciesConfig{}
 
 	for _, p := range ps.allFromMap() {
-		offset := p.ID
+		offset := uint(p.ID)
 
 		// bitmap indicating which policies have filters enabled
 		if p.UIDFilter.Enabled() {
-			cfg.UIDFilterEnabled |= 1 << offset
+			cfg.UIDFilterEnabled.Set(offset)
 		}
 		if p.PIDFilter.Enabled() {
-			cfg.PIDFilterEnabled |= 1 << offset
+			cfg.PIDFilterEnabled.Set(offset)
 		}
 		if p.MntNSFilter.Enabled() {
-			cfg.MntNsFilterEnabled |= 1 << offset
+			cfg.MntNsFilterEnabled.Set(offset)
 		}
 		if p.PidNSFilter.Enabled() {
-			cfg.PidNsFilterEnabled |= 1 << offset
+			cfg.PidNsFilterEnabled.Set(offset)
 		}
 		if p.UTSFilter.Enabled() {
-			cfg.UtsNsFilterEnabled |= 1 << offset
+			cfg.UtsNsFilterEnabled.Set(offset)
 		}
 		if p.CommFilter.Enabled() {
-			cfg.CommFilterEnabled |= 1 << offset
+			cfg.CommFilterEnabled.Set(offset)
 		}
-		if p.ContIDFilter.Enabled() {
-			cfg.CgroupIdFilterEnabled |= 1 << offset
+		if p.ContIDFilter.Enabled() || p.ContSelector.Enabled() {
+			cfg.CgroupIdFilterEnabled.Set(offset)
 		}
 		if p.ContFilter.Enabled() {
-			cfg.ContFilterEnabled |= 1 << offset
+			cfg.ContFilterEnabled.Set(offset)
 		}
 		if p.NewContFilter.Enabled() {
-			cfg.NewContFilterEnabled |= 1 << offset
+			cfg.NewContFilterEnabled.Set(offset)
 		}
 		if p.ContStartedFilter.Enabled() {
-			cfg.ContStartedFilterEnabled |= 1 << offset
+			cfg.ContStartedFilterEnabled.Set(offset)
 		}
 		if p.NewPidFilter.Enabled() {
-			cfg.NewPidFilterEnabled |= 1 << offset
+			cfg.NewPidFilterEnabled.Set(offset)
 		}
 		if p.ProcessTreeFilter.Enabled() {
-			cfg.ProcTreeFilterEnabled |= 1 << offset
+			cfg.ProcTreeFilterEnabled.Set(offset)
 		}
 		if p.BinaryFilter.Enabled() {
-			cfg.BinPathFilterEnabled |= 1 << offset
+			cfg.BinPathFilterEnabled.Set(offset)
 		}
 		if p.Follow {
-			cfg.FollowFilterEnabled |= 1 << offset
+			cfg.FollowFilterEnabled.Set(offset)
 		}
 		// bitmap indicating whether to match a rule if the key is missing from its filter map
 		if p.UIDFilter.MatchIfKeyMissing() {
-			cfg.UIDFilterMatchIfKeyMissing |= 1 << offset
+			cfg.UIDFilterMatchIfKeyMissing.Set(offset)
 		}
 		if p.PIDFilter.MatchIfKeyMissing() {
-			cfg.PIDFilterMatchIfKeyMissing |= 1 << offset
+			cfg.PIDFilterMatchIfKeyMissing.Set(offset)
 		}
 		if p.MntNSFilter.MatchIfKeyMissing() {
-			cfg.MntNsFilterMatchIfKeyMissing |= 1 << offset
+			cfg.MntNsFilterMatchIfKeyMissing.Set(offset)
 		}
 		if p.PidNSFilter.MatchIfKeyMissing() {
-			cfg.PidNsFilterMatchIfKeyMissing |= 1 << offset
+			cfg.PidNsFilterMatchIfKeyMissing.Set(offset)
 		}
 		if p.UTSFilter.MatchIfKeyMissing() {
-			cfg.UtsNsFilterMatchIfKeyMissing |= 1 << offset
+			cfg.UtsNsFilterMatchIfKeyMissing.Set(offset)
 		}
 		if p.CommFilter.MatchIfKeyMissing() {
-			cfg.CommFilterMatchIfKeyMissing |= 1 << offset
+			cfg.CommFilterMatchIfKeyMissing.Set(offset)
 		}
-		if p.ContIDFilter.MatchIfKeyMissing() {
-			cfg.CgroupIdFilterMatchIfKeyMissing |= 1 << offset
+		// container selectors select containers only, whatever their operators
+		if p.ContIDFilter.MatchIfKeyMissing() && !p.ContSelector.Enabled() {
+			cfg.CgroupIdFilterMatchIfKeyMissing.Set(offset)
 		}
 		if p.ContFilter.MatchIfKeyMissing() {
-			cfg.ContFilterMatchIfKeyMissing |= 1 << offset
+			cfg.ContFilterMatchIfKeyMissing.Set(offset)
 		}
 		if p.NewContFilter.MatchIfKeyMissing() {
-			cfg.NewContFilterMatchIfKeyMissing |= 1 << offset
+			cfg.NewContFilterMatchIfKeyMissing.Set(offset)
 		}
 		if p.ContStartedFilter.MatchIfKeyMissing() {
-			cfg.ContStartedFilterMatchIfKeyMissing |= 1 << offset
+			cfg.ContStartedFilterMatchIfKeyMissing.Set(offset)
 		}
 		if p.NewPidFilter.MatchIfKeyMissing() {
-			cfg.NewPidFilterMatchIfKeyMissing |= 1 << offset
+			cfg.NewPidFilterMatchIfKeyMissing.Set(offset)
 		}
 		if p.ProcessTreeFilter.MatchIfKeyMissing() {
-			cfg.ProcTreeFilterMatchIfKeyMissing |= 1 << offset
+			cfg.ProcTreeFilterMatchIfKeyMissing.Set(offset)
 		}
 		if p.BinaryFilter.MatchIfKeyMissing() {
-			cfg.BinPathFilterMatchIfKeyMissing |= 1 << offset
+			cfg.BinPathFilterMatchIfKeyMissing.Set(offset)
 		}
-		cfg.EnabledPolicies |= 1 << offset
+		cfg.EnabledPolicies.Set(offset)
 	}
 
 	cfg.UidMax = ps.uidFilterMax
@@ -1,6 +1,7 @@
 package policy
 
 import (
+	"encoding/binary"
 	"strings"
 
 	"github.com/aquasecurity/tracee/common/bitwise"
@@ -12,15 +13,27 @@ import (
 // equality mirrors the C struct equality (eq_t).
 // Check it for more info.
 type equality struct {
-	equalsInPolicies  uint64
-	keyUsedInPolicies uint64
+	equalsInPolicies  bitwise.Bitmap
+	keyUsedInPolicies bitwise.Bitmap
 }
 
 const (
-	// 8 bytes for equalsInPolicies and 8 bytes for keyUsedInPolicies
-	equalityValueSize = 16
+	// a bitmap for equalsInPolicies and a bitmap for keyUsedInPolicies
+	equalityValueSize = 2 * bitwise.BitmapWords * 8
 )
 
+// encode encodes the equality as the value of a filter map (eq_t).
+func (eq *equality) encode() []byte {
+	eqVal := make([]byte, equalityValueSize)
+
+	for i := range bitwise.BitmapWords {
+		binary.LittleEndian.PutUint64(eqVal[i*8:], eq.equalsInPolicies[i])
+		binary.LittleEndian.PutUint64(eqVal[(bitwise.BitmapWords+i)*8:], eq.keyUsedInPolicies[i])
+	}
+
+	return eqVal
+}
+
 // filtersEqualities stores the equalities for each filter in the policies
 type filtersEqualities struct {
 	uidEqualities        map[uint32]equality
@@ -50,15 +63,15 @@ type equalityUpdater func(eq *equality, policyID uint)
 // notEqualUpdate updates the equality as not equal with the given policyID.
 func notEqualUpdate(eq *equality, policyID uint) {
 	// NotEqual == 0, so clear n bitmap bit
-	bitwise.ClearBit(&eq.equalsInPolicies, policyID)
-	bitwise.SetBit(&eq.keyUsedInPolicies, policyID)
+	eq.equalsInPolicies.Clear(policyID)
+	eq.keyUsedInPolicies.Set(policyID)
 }
 
 // equalUpdate updates the equality as equal with the given policyID.
 func equalUpdate(eq *equality, policyID uint) {
 	// Equal == 1, so set n bitmap bit
-	bitwise.SetBit(&eq.equalsInPolicies, policyID)
-	bitwise.SetBit(&eq.keyUsedInPolicies, policyID)
+	eq.equalsInPolicies.Set(policyID)
+	eq.keyUsedInPolicies.Set(policyID)
 }
 
 // updateEqualities updates the equalities map with the given filter equalities
@@ -213,6 +226,30 @@ func (ps *policies) computeFilterEqualities(
 			fEqs.cgroupIdEqualities[uint64(cgroupIDs[0])] = eq
 		}
 
+		// ContSelectors: resolved to the cgroups of the known containers. Since they
+		// share the cgroup id filter, a cgroup is equal only if selected and not excluded
+		// by the container id filter. Host cgroups are missing keys, which never match
+		// (see computePoliciesConfig).
+		if p.ContSelector.Enabled() {
+			for cgroupID, cont := range cts.GetContainerCgroups() {
+				eq := fEqs.cgroupIdEqualities[uint64(cgroupID)]
+
+				contIDMatch := p.ContIDFilter.MatchIfKeyMissing()
+				if !p.ContIDFilter.Enabled() {
+					contIDMatch = true
+				} else if eq.keyUsedInPolicies.Has(policyID) {
+					contIDMatch = eq.equalsInPolicies.Has(policyID)
+				}
+
+				if contIDMatch && p.ContSelector.Match(cont.Image, cont.Pod.Namespace, cont.Labels) {
+					equalUpdate(&eq, policyID)
+				} else {
+					notEqualUpdate(&eq, policyID)
+				}
+				fEqs.cgroupIdEqualities[uint64(cgroupID)] = eq
+			}
+		}
+
 		// UTSFilters
 		utsEqualities := p.UTSFilter.Equalities()
 		updateEqualities(fEqs.utsEqualities, utsEqualities.ExactNotEqual, notEqual, policyID)
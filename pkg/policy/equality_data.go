@@ -1,6 +1,7 @@
 package policy
 
 import (
+	"github.com/aquasecurity/tracee/common/bitwise"
 	"github.com/aquasecurity/tracee/common/stringutil"
 	"github.com/aquasecurity/tracee/pkg/events"
 	"github.com/aquasecurity/tracee/pkg/filters"
@@ -12,12 +13,12 @@ type dataFilterConfig struct {
 }
 
 type stringFilterConfig struct {
-	prefixEnabled           uint64
-	suffixEnabled           uint64
-	exactEnabled            uint64
-	prefixMatchIfKeyMissing uint64
-	suffixMatchIfKeyMissing uint64
-	exactMatchIfKeyMissing  uint64
+	prefixEnabled           bitwise.Bitmap
+	suffixEnabled           bitwise.Bitmap
+	exactEnabled            bitwise.Bitmap
+	prefixMatchIfKeyMissing bitwise.Bitmap
+	suffixMatchIfKeyMissing bitwise.Bitmap
+	exactMatchIfKeyMissing  bitwise.Bitmap
 }
 
 type KernelDataFields struct {
@@ -26,27 +27,27 @@ type KernelDataFields struct {
 }
 
 func (d *stringFilterConfig) EnableExact(policyID int) {
-	d.exactEnabled |= 1 << policyID
+	d.exactEnabled.Set(uint(policyID))
 }
 
 func (d *stringFilterConfig) EnablePrefix(policyID int) {
-	d.prefixEnabled |= 1 << policyID
+	d.prefixEnabled.Set(uint(policyID))
 }
 
 func (d *stringFilterConfig) EnableSuffix(policyID int) {
-	d.suffixEnabled |= 1 << policyID
+	d.suffixEnabled.Set(uint(policyID))
 }
 
 func (d *stringFilterConfig) EnablePrefixMatchIfKeyMissing(policyID int) {
-	d.prefixMatchIfKeyMissing |= 1 << policyID
+	d.prefixMatchIfKeyMissing.Set(uint(policyID))
 }
 
 func (d *stringFilterConfig) EnableSuffixMatchIfKeyMissing(policyID int) {
-	d.suffixMatchIfKeyMissing |= 1 << policyID
+	d.suffixMatchIfKeyMissing.Set(uint(policyID))
 }
 
 func (d *stringFilterConfig) EnableExactMatchIfKeyMissing(policyID int) {
-	d.exactMatchIfKeyMissing |= 1 << policyID
+	d.exactMatchIfKeyMissing.Set(uint(policyID))
 }
 
 func combineEventBitmap(eventsMap map[events.ID]stringFilterConfig, eventID events.ID, strCfgFilter *stringFilterConfig) {
@@ -63,12 +64,12 @@ func combineEventBitmap(eventsMap map[events.ID]stringFilterConfig, eventID even
 		return
 	}
 
-	existingFilter.prefixEnabled |= strCfgFilter.prefixEnabled
-	existingFilter.suffixEnabled |= strCfgFilter.suffixEnabled
-	existingFilter.exactEnabled |= strCfgFilter.exactEnabled
-	existingFilter.prefixMatchIfKeyMissing |= strCfgFilter.prefixMatchIfKeyMissing
-	existingFilter.suffixMatchIfKeyMissing |= strCfgFilter.suffixMatchIfKeyMissing
-	existingFilter.exactMatchIfKeyMissing |= strCfgFilter.exactMatchIfKeyMissing
+	existingFilter.prefixEnabled.SetBits(strCfgFilter.prefixEnabled)
+	existingFilter.suffixEnabled.SetBits(strCfgFilter.suffixEnabled)
+	existingFilter.exactEnabled.SetBits(strCfgFilter.exactEnabled)
+	existingFilter.prefixMatchIfKeyMissing.SetBits(strCfgFilter.prefixMatchIfKeyMissing)
+	existingFilter.suffixMatchIfKeyMissing.SetBits(strCfgFilter.suffixMatchIfKeyMissing)
+	existingFilter.exactMatchIfKeyMissing.SetBits(strCfgFilter.exactMatchIfKeyMissing)
 
 	eventsMap[eventID] = existingFilter
 }
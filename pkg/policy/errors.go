@@ -5,6 +5,27 @@ import (
 	"fmt"
 )
 
+var (
+	// ErrPolicyNotFound is matched by the errors of policies not found
+	ErrPolicyNotFound = errors.New("policy not found")
+	// ErrPolicyExists is matched by the errors of policies already existing
+	ErrPolicyExists = errors.New("policy already exists")
+)
+
+// policyError is an error matching one of the policy errors above with errors.Is
+type policyError struct {
+	msg  string
+	kind error
+}
+
+func (e *policyError) Error() string {
+	return e.msg
+}
+
+func (e *policyError) Is(target error) bool {
+	return target == e.kind
+}
+
 func PolicyNilError() error {
 	return errors.New("policy cannot be nil")
 }
@@ -18,13 +39,22 @@ func PoliciesOutOfRangeError(idx int) error {
 }
 
 func PolicyAlreadyExistsError(name string, idx int) error {
-	return fmt.Errorf("policy [%s] already exists at index [%d]", name, idx)
+	return &policyError{
+		msg:  fmt.Sprintf("policy [%s] already exists at index [%d]", name, idx),
+		kind: ErrPolicyExists,
+	}
 }
 
 func PolicyNotFoundByIDError(idx int) error {
-	return fmt.Errorf("policy not found at index [%d]", idx)
+	return &policyError{
+		msg:  fmt.Sprintf("policy not found at index [%d]", idx),
+		kind: ErrPolicyNotFound,
+	}
 }
 
 func PolicyNotFoundByNameError(name string) error {
-	return fmt.Errorf("policy [%s] not found", name)
+	return &policyError{
+		msg:  fmt.Sprintf("policy [%s] not found", name),
+		kind: ErrPolicyNotFound,
+	}
 }
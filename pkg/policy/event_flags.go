@@ -7,12 +7,12 @@ type eventFlags struct {
 	// policiesSubmit is a bitmask with the policies that require the event,
 	// if matched, to be submitted to the userland from the ebpf program.
 	// It is computed on policies updates.
-	policiesSubmit uint64
+	policiesSubmit bitwise.Bitmap
 
 	// policiesEmit is a bitmask with the policies that require the event,
 	// if matched, to be emitted in the pipeline sink stage.
 	// It is computed on policies updates.
-	policiesEmit uint64
+	policiesEmit bitwise.Bitmap
 
 	// requiredBySignature indicates if the event is required by a signature event.
 	requiredBySignature bool
@@ -29,13 +29,13 @@ type eventFlags struct {
 
 type eventFlagsOption func(*eventFlags)
 
-func eventFlagsWithSubmit(submit uint64) eventFlagsOption {
+func eventFlagsWithSubmit(submit bitwise.Bitmap) eventFlagsOption {
 	return func(es *eventFlags) {
 		es.policiesSubmit = submit
 	}
 }
 
-func eventFlagsWithEmit(emit uint64) eventFlagsOption {
+func eventFlagsWithEmit(emit bitwise.Bitmap) eventFlagsOption {
 	return func(es *eventFlags) {
 		es.policiesEmit = emit
 	}
@@ -56,8 +56,8 @@ func eventFlagsWithEnabled(enabled bool) eventFlagsOption {
 func newEventFlags(options ...eventFlagsOption) *eventFlags {
 	// default values
 	ef := &eventFlags{
-		policiesSubmit:      0,
-		policiesEmit:        0,
+		policiesSubmit:      bitwise.Bitmap{},
+		policiesEmit:        bitwise.Bitmap{},
 		requiredBySignature: false,
 		enabled:             false,
 	}
@@ -75,19 +75,19 @@ func newEventFlags(options ...eventFlagsOption) *eventFlags {
 //
 
 func (ef *eventFlags) enableSubmission(policyId int) {
-	bitwise.SetBit(&ef.policiesSubmit, uint(policyId))
+	ef.policiesSubmit.Set(uint(policyId))
 }
 
 func (ef *eventFlags) enableEmission(policyId int) {
-	bitwise.SetBit(&ef.policiesEmit, uint(policyId))
+	ef.policiesEmit.Set(uint(policyId))
 }
 
 func (ef *eventFlags) disableSubmission(policyId int) {
-	bitwise.ClearBit(&ef.policiesSubmit, uint(policyId))
+	ef.policiesSubmit.Clear(uint(policyId))
 }
 
 func (ef *eventFlags) disableEmission(policyId int) {
-	bitwise.ClearBit(&ef.policiesEmit, uint(policyId))
+	ef.policiesEmit.Clear(uint(policyId))
 }
 
 func (ef *eventFlags) enableEvent() {
@@ -13,15 +13,15 @@ func TestNewEventFlags(t *testing.T) {
 	t.Parallel()
 
 	ef := newEventFlags()
-	emit := uint64(0)
-	submit := uint64(0)
+	emit := bitwise.Bitmap{}
+	submit := bitwise.Bitmap{}
 
 	assert.Equal(t, emit, ef.policiesSubmit)
 	assert.Equal(t, submit, ef.policiesEmit)
 	assert.False(t, ef.enabled)
 
-	submit = uint64(1 << 0)
-	emit = uint64(1<<1 | 1<<2)
+	submit = bitwise.NewBitmap(0)
+	emit = bitwise.NewBitmap(1, 2, 200)
 	efWithOptions := newEventFlags(
 		eventFlagsWithSubmit(submit),
 		eventFlagsWithEmit(emit),
@@ -38,7 +38,10 @@ func TestEnableSubmission(t *testing.T) {
 
 	ef := newEventFlags()
 	ef.enableSubmission(1)
-	assert.True(t, bitwise.HasBit(ef.policiesSubmit, 1))
+	assert.True(t, ef.policiesSubmit.Has(1))
+
+	ef.enableSubmission(200)
+	assert.True(t, ef.policiesSubmit.Has(200))
 }
 
 // TestEnableEmission tests the enableEmission function.
@@ -47,7 +50,7 @@ func TestEnableEmission(t *testing.T) {
 
 	ef := newEventFlags()
 	ef.enableEmission(1)
-	assert.True(t, bitwise.HasBit(ef.policiesEmit, 1))
+	assert.True(t, ef.policiesEmit.Has(1))
 
 	ef.enableEmission(-1)
 }
@@ -59,7 +62,7 @@ func TestDisableSubmission(t *testing.T) {
 	ef := newEventFlags()
 	ef.enableSubmission(42)
 	ef.disableSubmission(42)
-	assert.False(t, bitwise.HasBit(ef.policiesSubmit, 42))
+	assert.False(t, ef.policiesSubmit.Has(42))
 }
 
 // TestDisableEmission tests the disableEmission function.
@@ -69,7 +72,7 @@ func TestDisableEmission(t *testing.T) {
 	ef := newEventFlags()
 	ef.enableEmission(42)
 	ef.disableEmission(42)
-	assert.False(t, bitwise.HasBit(ef.policiesEmit, 42))
+	assert.False(t, ef.policiesEmit.Has(42))
 }
 
 // TestEnableEvent tests the enableEvent function.
@@ -1,19 +1,25 @@
 package policy
 
 import (
+	"slices"
+
 	bpf "github.com/aquasecurity/libbpfgo"
 
 	"github.com/aquasecurity/tracee/common/bitwise"
 	"github.com/aquasecurity/tracee/common/interfaces"
 	"github.com/aquasecurity/tracee/common/logger"
+	"github.com/aquasecurity/tracee/pkg/actions"
 	"github.com/aquasecurity/tracee/pkg/events"
 	"github.com/aquasecurity/tracee/pkg/filters"
 )
 
-const (
-	PolicyMax  = int(64)
-	PolicyAll  = ^uint64(0)
-	PolicyNone = uint64(0)
+// PolicyMax is the maximum number of policies, the number of bits of the policies
+// bitmaps. It must match MAX_POLICIES in the eBPF code.
+const PolicyMax = int(bitwise.BitmapBits)
+
+var (
+	PolicyAll  = bitwise.FullBitmap()
+	PolicyNone = bitwise.Bitmap{}
 )
 
 var AlwaysSubmit = events.EventState{
@@ -37,7 +43,7 @@ type policies struct {
 	uidFilterableInUserland bool
 	pidFilterableInUserland bool
 	filterableInUserland    bool
-	containerFiltersEnabled uint64 // bitmap of policies that have at least one container filter type enabled
+	containerFiltersEnabled bitwise.Bitmap // bitmap of policies that have at least one container filter type enabled
 }
 
 func NewPolicies() *policies {
@@ -55,7 +61,7 @@ func NewPolicies() *policies {
 		uidFilterableInUserland: false,
 		pidFilterableInUserland: false,
 		filterableInUserland:    false,
-		containerFiltersEnabled: 0,
+		containerFiltersEnabled: bitwise.Bitmap{},
 	}
 }
 
@@ -73,13 +79,13 @@ func (ps *policies) version() uint16 {
 }
 
 // withContainerFilterEnabled returns a bitmap of policies that have at least one container filter type enabled.
-func (ps *policies) withContainerFilterEnabled() uint64 {
+func (ps *policies) withContainerFilterEnabled() bitwise.Bitmap {
 	return ps.containerFiltersEnabled
 }
 
 // containerFilterEnabled returns true if at least one policy has a container filter type enabled.
 func (ps *policies) containerFilterEnabled() bool {
-	return ps.withContainerFilterEnabled() > 0
+	return !ps.withContainerFilterEnabled().IsZero()
 }
 
 // set sets a policy in the policies, given an ID.
@@ -147,7 +153,10 @@ func (ps *policies) remove(name string) error {
 	}
 
 	id := p.ID
-	ps.policiesList = append(ps.policiesList[:id], ps.policiesList[id+1:]...)
+	// policies are listed in the order they were set, not by ID
+	ps.policiesList = slices.DeleteFunc(slices.Clone(ps.policiesList), func(listed *Policy) bool {
+		return listed == p
+	})
 	delete(ps.policiesMapByID, id)
 	delete(ps.policiesMapByName, p.Name)
 	ps.policiesArray[id] = nil
@@ -181,11 +190,11 @@ func (ps *policies) lookupByName(name string) (*Policy, error) {
 
 // matchedNames returns a list of matched policies names based on
 // the given matched bitmap.
-func (ps *policies) matchedNames(matched uint64) []string {
+func (ps *policies) matchedNames(matched bitwise.Bitmap) []string {
 	names := []string{}
 
 	for _, p := range ps.allFromMap() {
-		if bitwise.HasBit(matched, uint(p.ID)) {
+		if matched.Has(uint(p.ID)) {
 			names = append(names, p.Name)
 		}
 	}
@@ -193,6 +202,42 @@ func (ps *policies) matchedNames(matched uint64) []string {
 	return names
 }
 
+// matchedActions returns the response actions of the given event rule in
+// each of the matched policies.
+func (ps *policies) matchedActions(id events.ID, matched bitwise.Bitmap) []PolicyActions {
+	var matchedActions []PolicyActions
+
+	for _, p := range ps.allFromMap() {
+		if !matched.Has(uint(p.ID)) {
+			continue
+		}
+		rule, ok := p.Rules[id]
+		if !ok || len(rule.Actions) == 0 {
+			continue
+		}
+		matchedActions = append(matchedActions, PolicyActions{
+			PolicyID:   p.ID,
+			PolicyName: p.Name,
+			Actions:    rule.Actions,
+		})
+	}
+
+	return matchedActions
+}
+
+// allActions returns the response actions of all policy rules.
+func (ps *policies) allActions() []actions.Action {
+	var all []actions.Action
+
+	for _, p := range ps.allFromMap() {
+		for _, rule := range p.Rules {
+			all = append(all, rule.Actions...)
+		}
+	}
+
+	return all
+}
+
 // allFromMap returns a map of allFromMap policies by ID.
 // When iterating, the order is not guaranteed.
 func (ps *policies) allFromMap() map[int]*Policy {
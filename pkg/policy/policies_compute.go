@@ -115,11 +115,11 @@ func (ps *policies) calculateGlobalMinMax() {
 }
 
 func (ps *policies) updateContainerFilterEnabled() {
-	ps.containerFiltersEnabled = 0
+	ps.containerFiltersEnabled = bitwise.Bitmap{}
 
 	for _, p := range ps.allFromMap() {
 		if p.ContainerFilterEnabled() {
-			bitwise.SetBit(&ps.containerFiltersEnabled, uint(p.ID))
+			ps.containerFiltersEnabled.Set(uint(p.ID))
 		}
 	}
 }
@@ -1,6 +1,7 @@
 package policy
 
 import (
+	"fmt"
 	"reflect"
 	"sync"
 	"sync/atomic"
@@ -9,6 +10,8 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/stretchr/testify/require"
 
+	"github.com/aquasecurity/tracee/common/bitwise"
+	"github.com/aquasecurity/tracee/pkg/actions"
 	"github.com/aquasecurity/tracee/pkg/events"
 	"github.com/aquasecurity/tracee/pkg/filters"
 	"github.com/aquasecurity/tracee/pkg/filters/sets"
@@ -57,12 +60,14 @@ func TestPoliciesClone(t *testing.T) {
 		filters.BoolFilter{},
 		filters.NumericFilter[int64]{},
 		filters.DataFilter{},
+		filters.DataFieldFilter{},
 		filters.ScopeFilter{},
 		filters.ProcessTreeFilter{},
 		filters.BinaryFilter{},
 		sets.PrefixSet{},
 		sets.SuffixSet{},
 		filters.KernelDataFilter{},
+		filters.ContainerSelectorFilter{},
 	)
 	opt2 := cmp.FilterPath(
 		func(p cmp.Path) bool {
@@ -93,3 +98,65 @@ func TestPoliciesClone(t *testing.T) {
 		t.Errorf("Changes to copied policy affected the original: %+v", ps)
 	}
 }
+
+func TestPoliciesMatchedActions(t *testing.T) {
+	t.Parallel()
+
+	kill, err := actions.Parse("kill")
+	require.NoError(t, err)
+	capture, err := actions.Parse("capture-exec")
+	require.NoError(t, err)
+
+	newRule := func(id events.ID, ruleActions ...actions.Action) RuleData {
+		return RuleData{
+			EventID:     id,
+			ScopeFilter: filters.NewScopeFilter(),
+			DataFilter:  filters.NewDataFilter(),
+			RetFilter:   filters.NewIntFilter(),
+			Actions:     ruleActions,
+		}
+	}
+
+	ps := NewPolicies()
+
+	p0 := NewPolicy()
+	p0.Name = "p0"
+	p0.Rules[events.Read] = newRule(events.Read, kill)
+	p0.Rules[events.Write] = newRule(events.Write)
+	require.NoError(t, ps.add(p0))
+
+	p1 := NewPolicy()
+	p1.Name = "p1"
+	p1.Rules[events.Read] = newRule(events.Read, capture)
+	require.NoError(t, ps.add(p1))
+
+	require.Equal(t,
+		[]PolicyActions{{PolicyID: 1, PolicyName: "p1", Actions: []actions.Action{capture}}},
+		ps.matchedActions(events.Read, bitwise.NewBitmap(1)),
+	)
+	require.Len(t, ps.matchedActions(events.Read, bitwise.NewBitmap(0, 1)), 2)
+	require.Empty(t, ps.matchedActions(events.Write, bitwise.NewBitmap(0, 1)), "rule without actions")
+	require.Empty(t, ps.matchedActions(events.Read, bitwise.NewBitmap(2)), "no matched policy")
+	require.Len(t, ps.allActions(), 2)
+}
+
+func TestPoliciesAddMax(t *testing.T) {
+	t.Parallel()
+
+	ps := NewPolicies()
+
+	for i := 0; i < PolicyMax; i++ {
+		p := NewPolicy()
+		p.Name = fmt.Sprintf("p%d", i)
+		require.NoError(t, ps.add(p))
+		require.Equal(t, i, p.ID)
+	}
+
+	p := NewPolicy()
+	p.Name = "one-too-many"
+	require.Error(t, ps.add(p))
+
+	last, err := ps.lookupByName(fmt.Sprintf("p%d", PolicyMax-1))
+	require.NoError(t, err)
+	require.Equal(t, []string{last.Name}, ps.matchedNames(bitwise.NewBitmap(uint(PolicyMax-1))))
+}
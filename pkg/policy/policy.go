@@ -2,6 +2,7 @@ package policy
 
 import (
 	"github.com/aquasecurity/tracee/common/interfaces"
+	"github.com/aquasecurity/tracee/pkg/actions"
 	"github.com/aquasecurity/tracee/pkg/events"
 	"github.com/aquasecurity/tracee/pkg/filters"
 )
@@ -20,6 +21,7 @@ type Policy struct {
 	NewContFilter     *filters.BoolFilter
 	ContIDFilter      *filters.StringFilter
 	ContStartedFilter *filters.BoolFilter
+	ContSelector      *filters.ContainerSelectorFilter
 	ProcessTreeFilter *filters.ProcessTreeFilter
 	BinaryFilter      *filters.BinaryFilter
 	Follow            bool
@@ -31,6 +33,14 @@ type RuleData struct {
 	ScopeFilter *filters.ScopeFilter
 	DataFilter  *filters.DataFilter
 	RetFilter   *filters.NumericFilter[int64]
+	Actions     []actions.Action // response actions run when the rule matches
+}
+
+// PolicyActions are the response actions of a policy rule
+type PolicyActions struct {
+	PolicyID   int
+	PolicyName string
+	Actions    []actions.Action
 }
 
 // Compile-time check to ensure that Policy implements the Cloner interface
@@ -51,6 +61,7 @@ func NewPolicy() *Policy {
 		NewContFilter:     filters.NewBoolFilter(),
 		ContIDFilter:      filters.NewStringFilter(nil),
 		ContStartedFilter: filters.NewBoolFilter(),
+		ContSelector:      filters.NewContainerSelectorFilter(),
 		ProcessTreeFilter: filters.NewProcessTreeFilter(),
 		BinaryFilter:      filters.NewBinaryFilter(),
 		Follow:            false,
@@ -63,7 +74,8 @@ func (p *Policy) ContainerFilterEnabled() bool {
 	return (p.ContFilter.Enabled() && p.ContFilter.Value()) ||
 		(p.NewContFilter.Enabled() && p.NewContFilter.Value()) ||
 		p.ContIDFilter.Enabled() ||
-		p.ContStartedFilter.Enabled()
+		p.ContStartedFilter.Enabled() ||
+		p.ContSelector.Enabled()
 }
 
 func (p *Policy) Clone() *Policy {
@@ -86,6 +98,7 @@ func (p *Policy) Clone() *Policy {
 	n.NewContFilter = p.NewContFilter.Clone()
 	n.ContIDFilter = p.ContIDFilter.Clone()
 	n.ContStartedFilter = p.ContStartedFilter.Clone()
+	n.ContSelector = p.ContSelector.Clone()
 	n.ProcessTreeFilter = p.ProcessTreeFilter.Clone()
 	n.BinaryFilter = p.BinaryFilter.Clone()
 	n.Follow = p.Follow
@@ -95,6 +108,7 @@ func (p *Policy) Clone() *Policy {
 			ScopeFilter: ruleData.ScopeFilter.Clone(),
 			DataFilter:  ruleData.DataFilter.Clone(),
 			RetFilter:   ruleData.RetFilter.Clone(),
+			Actions:     append([]actions.Action(nil), ruleData.Actions...),
 		}
 	}
 
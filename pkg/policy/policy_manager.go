@@ -1,14 +1,18 @@
 package policy
 
 import (
+	"errors"
+	"slices"
 	"sync"
 
 	bpf "github.com/aquasecurity/libbpfgo"
 
+	"github.com/aquasecurity/tracee/common/bitwise"
 	"github.com/aquasecurity/tracee/common/capabilities"
 	"github.com/aquasecurity/tracee/common/errfmt"
 	"github.com/aquasecurity/tracee/common/interfaces"
 	"github.com/aquasecurity/tracee/common/logger"
+	"github.com/aquasecurity/tracee/pkg/actions"
 	"github.com/aquasecurity/tracee/pkg/config"
 	"github.com/aquasecurity/tracee/pkg/datastores/container"
 	"github.com/aquasecurity/tracee/pkg/datastores/dns"
@@ -33,6 +37,7 @@ type Manager struct {
 	evtsDepsManager *dependencies.Manager
 	ps              *policies
 	rules           map[events.ID]*eventFlags
+	baseEvents      map[events.ID]struct{} // events selected regardless of the policies
 }
 
 func NewManager(
@@ -100,13 +105,13 @@ func (m *Manager) subscribeDependencyHandlers() {
 // AddDependencyEventToRules adds for management an event that is a dependency of other events.
 // The difference from chosen events is that it doesn't affect its eviction.
 func (m *Manager) addDependencyEventToRules(evtID events.ID, dependentEvts []events.ID) {
-	var newSubmit uint64
+	var newSubmit bitwise.Bitmap
 	var reqByDependent bool
 
 	for _, dependentEvent := range dependentEvts {
 		currentFlags, ok := m.rules[dependentEvent]
 		if ok {
-			newSubmit |= currentFlags.policiesSubmit
+			newSubmit.SetBits(currentFlags.policiesSubmit)
 			// Mark as required if dependent is a signature or detector event
 			// This ensures the dependency flows through the pipeline even if not explicitly selected
 			defn := events.Core.GetDefinitionByID(dependentEvent)
@@ -127,8 +132,8 @@ func (m *Manager) addDependencyEventToRules(evtID events.ID, dependentEvts []eve
 func (m *Manager) addEventFlags(id events.ID, chosenFlags *eventFlags) {
 	currentFlags, ok := m.rules[id]
 	if ok {
-		currentFlags.policiesSubmit |= chosenFlags.policiesSubmit
-		currentFlags.policiesEmit |= chosenFlags.policiesEmit
+		currentFlags.policiesSubmit.SetBits(chosenFlags.policiesSubmit)
+		currentFlags.policiesEmit.SetBits(chosenFlags.policiesEmit)
 		currentFlags.requiredBySignature = chosenFlags.requiredBySignature
 		currentFlags.enabled = chosenFlags.enabled
 		return
@@ -153,16 +158,18 @@ func (m *Manager) addDependenciesToRulesRecursive(eventNode *dependencies.EventN
 	}
 }
 
-func (m *Manager) selectEvent(eventID events.ID, chosenState *eventFlags) {
+func (m *Manager) selectEvent(eventID events.ID, chosenState *eventFlags) error {
 	m.addEventFlags(eventID, chosenState)
 	eventNode, err := m.evtsDepsManager.SelectEvent(eventID)
 	if err != nil {
 		logger.Errorw("Event selection failed",
 			"event", events.Core.GetDefinitionByID(eventID).GetName())
-		return
+		return errfmt.Errorf("event %s could not be selected: %v", events.Core.GetDefinitionByID(eventID).GetName(), err)
 	}
 
 	m.addDependenciesToRulesRecursive(eventNode)
+
+	return nil
 }
 
 func (m *Manager) removeEventFromRules(evtID events.ID) {
@@ -258,7 +265,7 @@ func (m *Manager) selectConfiguredEvents() {
 	}
 }
 
-func (m *Manager) selectUserEvents() {
+func (m *Manager) selectUserEvents() error {
 	// Events chosen by the user
 	userEvents := make(map[events.ID]*eventFlags)
 
@@ -276,9 +283,14 @@ func (m *Manager) selectUserEvents() {
 		}
 	}
 
+	var errs []error
 	for id, flags := range userEvents {
-		m.selectEvent(id, flags)
+		if err := m.selectEvent(id, flags); err != nil {
+			errs = append(errs, err)
+		}
 	}
+
+	return errors.Join(errs...)
 }
 
 func (m *Manager) updateCapsForSelectedEvents() error {
@@ -311,7 +323,15 @@ func (m *Manager) initialize() error {
 	m.subscribeDependencyHandlers()
 	m.selectMandatoryEvents()
 	m.selectConfiguredEvents()
-	m.selectUserEvents()
+
+	// the events selected so far (and their dependencies) are kept when policies change
+	m.baseEvents = make(map[events.ID]struct{}, len(m.rules))
+	for id := range m.rules {
+		m.baseEvents[id] = struct{}{}
+	}
+
+	// failing user events are logged, tracing the other ones
+	_ = m.selectUserEvents()
 	err := m.updateCapsForSelectedEvents()
 	if err != nil {
 		return errfmt.WrapError(err)
@@ -322,7 +342,7 @@ func (m *Manager) initialize() error {
 
 // IsEnabled tests if a event, or a policy per event is enabled (in the future it will also check if a policy is enabled)
 // TODO: add metrics about an event being enabled/disabled, or a policy being enabled/disabled?
-func (m *Manager) IsEnabled(matchedPolicies uint64, id events.ID) bool {
+func (m *Manager) IsEnabled(matchedPolicies bitwise.Bitmap, id events.ID) bool {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
 
@@ -334,7 +354,7 @@ func (m *Manager) IsEnabled(matchedPolicies uint64, id events.ID) bool {
 }
 
 // IsRuleEnabled returns true if a given event policy is enabled for a given rule
-func (m *Manager) IsRuleEnabled(matchedPolicies uint64, id events.ID) bool {
+func (m *Manager) IsRuleEnabled(matchedPolicies bitwise.Bitmap, id events.ID) bool {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
 
@@ -342,13 +362,13 @@ func (m *Manager) IsRuleEnabled(matchedPolicies uint64, id events.ID) bool {
 }
 
 // not synchronized, use IsRuleEnabled instead
-func (m *Manager) isRuleEnabled(matchedPolicies uint64, id events.ID) bool {
+func (m *Manager) isRuleEnabled(matchedPolicies bitwise.Bitmap, id events.ID) bool {
 	flags, ok := m.rules[id]
 	if !ok {
 		return false
 	}
 
-	return flags.policiesEmit&matchedPolicies != 0
+	return flags.policiesEmit.Intersects(matchedPolicies)
 }
 
 // IsEventEnabled returns true if a given event policy is enabled for a given rule
@@ -465,28 +485,28 @@ func (m *Manager) IsRequiredBySignature(id events.ID) bool {
 	return flags.requiredBySignature
 }
 
-func (m *Manager) MatchEvent(id events.ID, matched uint64) uint64 {
+func (m *Manager) MatchEvent(id events.ID, matched bitwise.Bitmap) bitwise.Bitmap {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
 
 	flags, ok := m.rules[id]
 	if !ok {
-		return 0
+		return PolicyNone
 	}
 
-	return flags.policiesEmit & matched
+	return flags.policiesEmit.And(matched)
 }
 
-func (m *Manager) MatchEventInAnyPolicy(id events.ID) uint64 {
+func (m *Manager) MatchEventInAnyPolicy(id events.ID) bitwise.Bitmap {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
 
 	flags, ok := m.rules[id]
 	if !ok {
-		return 0
+		return PolicyNone
 	}
 
-	return (flags.policiesEmit | flags.policiesSubmit) & PolicyAll
+	return flags.policiesEmit.Or(flags.policiesSubmit).And(PolicyAll)
 }
 
 func (m *Manager) EventsSelected() []events.ID {
@@ -515,7 +535,7 @@ func (m *Manager) EventsToSubmit() []events.ID {
 
 	eventsToSubmit := []events.ID{}
 	for evt, flags := range m.rules {
-		if flags.policiesSubmit != 0 {
+		if !flags.policiesSubmit.IsZero() {
 			eventsToSubmit = append(eventsToSubmit, evt)
 		}
 	}
@@ -532,7 +552,7 @@ func (m *Manager) IsEventToEmit(id events.ID) bool {
 		return false
 	}
 
-	return flags.policiesEmit != 0
+	return !flags.policiesEmit.IsZero()
 }
 
 func (m *Manager) IsEventToSubmit(id events.ID) bool {
@@ -544,7 +564,7 @@ func (m *Manager) IsEventToSubmit(id events.ID) bool {
 		return false
 	}
 
-	return flags.policiesSubmit != 0
+	return !flags.policiesSubmit.IsZero()
 }
 
 // IsContainerFilterSetOnEvent checks if container filters are configured for the given event.
@@ -587,7 +607,7 @@ func (m *Manager) IsContainerFilterSetOnEvent(id events.ID) (forHost bool, forCo
 			}
 		}
 
-		if p.ContIDFilter.Enabled() {
+		if p.ContIDFilter.Enabled() || p.ContSelector.Enabled() {
 			forContainer = true
 		}
 
@@ -632,20 +652,52 @@ func (m *Manager) FilterableInUserland() bool {
 	return m.ps.filterableInUserland
 }
 
-func (m *Manager) WithContainerFilterEnabled() uint64 {
+func (m *Manager) WithContainerFilterEnabled() bitwise.Bitmap {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
 
 	return m.ps.withContainerFilterEnabled()
 }
 
-func (m *Manager) MatchedNames(matched uint64) []string {
+// ContainerSelectorsEnabled returns true if a policy selects containers by their
+// metadata, so its eBPF filters depend on the running containers.
+func (m *Manager) ContainerSelectorsEnabled() bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	for _, p := range m.ps.allFromMap() {
+		if p.ContSelector.Enabled() {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (m *Manager) MatchedNames(matched bitwise.Bitmap) []string {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
 
 	return m.ps.matchedNames(matched)
 }
 
+// MatchedActions returns the response actions of the given event rule in each
+// of the matched policies.
+func (m *Manager) MatchedActions(id events.ID, matched bitwise.Bitmap) []PolicyActions {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.ps.matchedActions(id, matched)
+}
+
+// Actions returns the response actions of all policy rules.
+func (m *Manager) Actions() []actions.Action {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.ps.allActions()
+}
+
 func (m *Manager) LookupByName(name string) (*Policy, error) {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
@@ -653,6 +705,158 @@ func (m *Manager) LookupByName(name string) (*Policy, error) {
 	return m.ps.lookupByName(name)
 }
 
+//
+// Runtime policies changes.
+// The events rules are recomputed from the policies, resetting the changes made
+// with EnableEvent, DisableEvent, EnableRule and DisableRule. UpdateBPF must be
+// called afterwards, creating new maps, to apply the policies to the eBPF side.
+//
+
+// AddPolicy adds a policy in the first free ID, selecting the events of its rules.
+func (m *Manager) AddPolicy(p *Policy) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	next := m.ps.Clone()
+	if err := next.add(p); err != nil {
+		return errfmt.WrapError(err)
+	}
+
+	return m.switchPolicies(next)
+}
+
+// ReplacePolicy replaces the policy with the same name, keeping its ID.
+func (m *Manager) ReplacePolicy(p *Policy) error {
+	if p == nil {
+		return PolicyNilError()
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	existing, err := m.ps.lookupByName(p.Name)
+	if err != nil {
+		return errfmt.WrapError(err)
+	}
+
+	next := m.ps.Clone()
+	if err := next.remove(p.Name); err != nil {
+		return errfmt.WrapError(err)
+	}
+	p.ID = existing.ID
+	if err := next.set(p); err != nil {
+		return errfmt.WrapError(err)
+	}
+
+	return m.switchPolicies(next)
+}
+
+// RemovePolicy removes a policy by name, unselecting the events no other policy selects.
+func (m *Manager) RemovePolicy(name string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	next := m.ps.Clone()
+	if err := next.remove(name); err != nil {
+		return errfmt.WrapError(err)
+	}
+
+	return m.switchPolicies(next)
+}
+
+// Policies returns the policies, ordered by ID.
+func (m *Manager) Policies() []*Policy {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	all := make([]*Policy, 0, m.ps.count())
+	for _, p := range m.ps.allFromArray() {
+		if p != nil {
+			all = append(all, p)
+		}
+	}
+
+	return all
+}
+
+// PolicyEvents returns the IDs of the events selected by the rules of a policy, sorted.
+func PolicyEvents(p *Policy) []events.ID {
+	ids := make([]events.ID, 0, len(p.Rules))
+	for id := range p.Rules {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+
+	return ids
+}
+
+// switchPolicies makes the given policies the current ones, selecting the
+// events of their rules and unselecting the events no longer needed. If an
+// event can't be selected, the current policies are kept. Not synchronized.
+func (m *Manager) switchPolicies(next *policies) error {
+	previous := m.ps
+	previousEvents := userEventsOf(previous)
+	nextEvents := userEventsOf(next)
+
+	m.ps = next
+	err := m.reselectEvents()
+	if err != nil {
+		// restore the previous policies, unselecting the events they don't need
+		m.ps = previous
+		if restoreErr := m.reselectEvents(); restoreErr != nil {
+			logger.Errorw("Failed to restore the previous policies events", "error", restoreErr)
+		}
+		m.unselectUserEvents(nextEvents, previousEvents)
+
+		return errfmt.WrapError(err)
+	}
+
+	m.unselectUserEvents(previousEvents, nextEvents)
+
+	if err := m.updateCapsForSelectedEvents(); err != nil {
+		return errfmt.WrapError(err)
+	}
+
+	return nil
+}
+
+// reselectEvents recomputes the rules of all events, selecting the events of the
+// current policies rules. Not synchronized.
+func (m *Manager) reselectEvents() error {
+	m.rules = make(map[events.ID]*eventFlags)
+	m.selectMandatoryEvents()
+	m.selectConfiguredEvents()
+
+	return m.selectUserEvents()
+}
+
+// unselectUserEvents unselects the given events, unless they are still needed
+// by the kept ones or selected regardless of the policies. Not synchronized.
+func (m *Manager) unselectUserEvents(unselect, keep map[events.ID]struct{}) {
+	for id := range unselect {
+		if _, ok := keep[id]; ok {
+			continue
+		}
+		if _, ok := m.baseEvents[id]; ok {
+			continue
+		}
+		// the dependencies manager removes it from the rules (if not a dependency)
+		m.evtsDepsManager.UnselectEvent(id)
+	}
+}
+
+// userEventsOf returns the events selected by the rules of the given policies.
+func userEventsOf(ps *policies) map[events.ID]struct{} {
+	userEvents := make(map[events.ID]struct{})
+	for _, p := range ps.policiesList {
+		for id := range p.Rules {
+			userEvents[id] = struct{}{}
+		}
+	}
+
+	return userEvents
+}
+
 func (m *Manager) UpdateBPF(
 	bpfModule *bpf.Module,
 	cts *container.Manager,
@@ -0,0 +1,86 @@
+package policy
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aquasecurity/tracee/common/bitwise"
+	"github.com/aquasecurity/tracee/pkg/events"
+	"github.com/aquasecurity/tracee/pkg/events/dependencies"
+	"github.com/aquasecurity/tracee/pkg/filters"
+)
+
+// newBenchManager returns a manager with the given number of policies, all of them
+// selecting the same event, and a bitmap with all those policies matched.
+func newBenchManager(b *testing.B, count int, id events.ID) (*Manager, bitwise.Bitmap) {
+	b.Helper()
+
+	depsManager := dependencies.NewDependenciesManager(
+		func(id events.ID) events.DependencyStrategy {
+			return events.Core.GetDefinitionByID(id).GetDependencies()
+		})
+
+	policies := make([]*Policy, 0, count)
+	var matched bitwise.Bitmap
+	for i := 0; i < count; i++ {
+		p := NewPolicy()
+		p.ID = i
+		p.Name = fmt.Sprintf("policy-%d", i)
+		p.Rules[id] = RuleData{
+			EventID:     id,
+			DataFilter:  filters.NewDataFilter(),
+			RetFilter:   filters.NewIntFilter(),
+			ScopeFilter: filters.NewScopeFilter(),
+		}
+		policies = append(policies, p)
+		matched.Set(uint(i))
+	}
+
+	policyManager, err := NewManager(ManagerConfig{}, depsManager, policies...)
+	if err != nil {
+		b.Fatalf("failed to create policy manager: %v", err)
+	}
+
+	return policyManager, matched
+}
+
+var benchPolicyCounts = []int{1, 64, PolicyMax}
+
+func BenchmarkPolicyManagerIsEnabled(b *testing.B) {
+	for _, count := range benchPolicyCounts {
+		b.Run(fmt.Sprintf("%d policies", count), func(b *testing.B) {
+			policyManager, matched := newBenchManager(b, count, events.Read)
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				_ = policyManager.IsEnabled(matched, events.Read)
+			}
+		})
+	}
+}
+
+func BenchmarkPolicyManagerMatchEvent(b *testing.B) {
+	for _, count := range benchPolicyCounts {
+		b.Run(fmt.Sprintf("%d policies", count), func(b *testing.B) {
+			policyManager, matched := newBenchManager(b, count, events.Read)
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				_ = policyManager.MatchEvent(events.Read, matched)
+			}
+		})
+	}
+}
+
+func BenchmarkPolicyManagerMatchedNames(b *testing.B) {
+	for _, count := range benchPolicyCounts {
+		b.Run(fmt.Sprintf("%d policies", count), func(b *testing.B) {
+			policyManager, matched := newBenchManager(b, count, events.Read)
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				_ = policyManager.MatchedNames(matched)
+			}
+		})
+	}
+}
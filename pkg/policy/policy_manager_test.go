@@ -6,6 +6,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/aquasecurity/tracee/common/bitwise"
 	"github.com/aquasecurity/tracee/pkg/events"
 	"github.com/aquasecurity/tracee/pkg/events/dependencies"
 	"github.com/aquasecurity/tracee/pkg/filters"
@@ -22,9 +23,9 @@ func TestPolicyManagerEnableRule(t *testing.T) {
 	policyManager, err := NewManager(ManagerConfig{}, depsManager)
 	assert.NoError(t, err)
 
-	policy1Mached := uint64(0b10)
-	policy2Mached := uint64(0b100)
-	policy1And2Mached := uint64(0b110)
+	policy1Mached := bitwise.NewBitmap(1)
+	policy2Mached := bitwise.NewBitmap(2)
+	policy1And2Mached := bitwise.NewBitmap(1, 2)
 
 	assert.False(t, policyManager.IsRuleEnabled(policy1Mached, events.SecurityBPF))
 	assert.False(t, policyManager.IsRuleEnabled(policy2Mached, events.SecurityBPF))
@@ -59,9 +60,9 @@ func TestPolicyManagerDisableRule(t *testing.T) {
 	policyManager, err := NewManager(ManagerConfig{}, depsManager)
 	assert.NoError(t, err)
 
-	policy1Mached := uint64(0b10)
-	policy2Mached := uint64(0b100)
-	policy1And2Mached := uint64(0b110)
+	policy1Mached := bitwise.NewBitmap(1)
+	policy2Mached := bitwise.NewBitmap(2)
+	policy1And2Mached := bitwise.NewBitmap(1, 2)
 
 	err = policyManager.EnableRule(1, events.SecurityBPF)
 	assert.NoError(t, err)
@@ -306,9 +307,9 @@ func TestPolicyManagerIsEnabled(t *testing.T) {
 	policyManager, err := NewManager(ManagerConfig{}, depsManager)
 	assert.NoError(t, err)
 
-	policy1Mached := uint64(0b10)
-	policy2Mached := uint64(0b100)
-	policy1And2Mached := uint64(0b110)
+	policy1Mached := bitwise.NewBitmap(1)
+	policy2Mached := bitwise.NewBitmap(2)
+	policy1And2Mached := bitwise.NewBitmap(1, 2)
 
 	assert.False(t, policyManager.IsEnabled(policy1Mached, events.SecurityBPF))
 	assert.False(t, policyManager.IsEnabled(policy2Mached, events.SecurityBPF))
@@ -425,6 +426,16 @@ func createPolicyWithMultipleFilters(t *testing.T, id int, name string, eventID
 	return p
 }
 
+func createPolicyWithContSelector(t *testing.T, id int, name string, eventID events.ID, selector, filterValue string) *Policy {
+	t.Helper()
+	p := createPolicyNoFilters(t, id, name, eventID)
+	if err := p.ContSelector.Parse(selector, filterValue); err != nil {
+		t.Fatalf("failed to parse ContSelector: %v", err)
+	}
+
+	return p
+}
+
 func createPolicyNoFilters(t *testing.T, id int, name string, eventID events.ID) *Policy {
 	t.Helper()
 	p := NewPolicy()
@@ -618,6 +629,17 @@ func TestPolicyManagerIsContainerFilterSetOnEvent(t *testing.T) {
 			expectHost:      false,
 			expectContainer: true,
 		},
+		{
+			name: "single policy with container selector excluding a namespace",
+			createPolicies: func(t *testing.T) []*Policy {
+				return []*Policy{
+					createPolicyWithContSelector(t, 0, "not-kube-system", events.SecurityBPF, "k8s.namespace", "!=kube-system"),
+				}
+			},
+			eventID:         events.SecurityBPF,
+			expectHost:      false,
+			expectContainer: true,
+		},
 		{
 			name: "policy with container ID filter and container=false",
 			createPolicies: func(t *testing.T) []*Policy {
@@ -742,3 +764,82 @@ func TestPolicyManagerIsContainerFilterSetOnEvent(t *testing.T) {
 		})
 	}
 }
+
+func TestPolicyManagerRuntimePolicies(t *testing.T) {
+	t.Parallel()
+
+	depsManager := dependencies.NewDependenciesManager(
+		func(id events.ID) events.DependencyStrategy {
+			return events.Core.GetDefinitionByID(id).GetDependencies()
+		})
+
+	initial := createPolicyNoFilters(t, 0, "initial", events.SecurityBPF)
+	policyManager, err := NewManager(ManagerConfig{}, depsManager, initial)
+	assert.NoError(t, err)
+
+	// A new policy takes the first free ID and selects its events
+	added := createPolicyNoFilters(t, 0, "added", events.SecurityFileOpen)
+	assert.NoError(t, policyManager.AddPolicy(added))
+	assert.Equal(t, 1, added.ID)
+	assert.True(t, policyManager.IsEventSelected(events.SecurityFileOpen))
+	assert.Equal(t, bitwise.NewBitmap(1), policyManager.MatchEventInAnyPolicy(events.SecurityFileOpen))
+	assert.Equal(t, bitwise.NewBitmap(0), policyManager.MatchEventInAnyPolicy(events.SecurityBPF))
+
+	err = policyManager.AddPolicy(createPolicyNoFilters(t, 0, "added", events.SecurityBPF))
+	assert.ErrorIs(t, err, ErrPolicyExists)
+
+	// A replaced policy keeps its ID, its events no longer used are unselected
+	replaced := createPolicyNoFilters(t, 0, "added", events.SecurityBPF)
+	assert.NoError(t, policyManager.ReplacePolicy(replaced))
+	assert.Equal(t, 1, replaced.ID)
+	assert.False(t, policyManager.IsEventSelected(events.SecurityFileOpen))
+	assert.Equal(t, bitwise.NewBitmap(0, 1), policyManager.MatchEventInAnyPolicy(events.SecurityBPF))
+
+	err = policyManager.ReplacePolicy(createPolicyNoFilters(t, 0, "missing", events.SecurityBPF))
+	assert.ErrorIs(t, err, ErrPolicyNotFound)
+
+	// A removed policy frees its bit, events selected regardless of policies are kept
+	assert.NoError(t, policyManager.RemovePolicy("initial"))
+	assert.Equal(t, bitwise.NewBitmap(1), policyManager.MatchEventInAnyPolicy(events.SecurityBPF))
+	assert.True(t, policyManager.IsEventSelected(events.SchedProcessExec))
+
+	policies := policyManager.Policies()
+	assert.Len(t, policies, 1)
+	assert.Equal(t, "added", policies[0].Name)
+	assert.Equal(t, []events.ID{events.SecurityBPF}, PolicyEvents(policies[0]))
+
+	err = policyManager.RemovePolicy("initial")
+	assert.ErrorIs(t, err, ErrPolicyNotFound)
+
+	assert.NoError(t, policyManager.RemovePolicy("added"))
+	assert.False(t, policyManager.IsEventSelected(events.SecurityBPF))
+	assert.Empty(t, policyManager.Policies())
+}
+
+func TestPolicyManagerContainerSelectors(t *testing.T) {
+	t.Parallel()
+
+	depsManager := dependencies.NewDependenciesManager(
+		func(id events.ID) events.DependencyStrategy {
+			return events.Core.GetDefinitionByID(id).GetDependencies()
+		})
+
+	policyManager, err := NewManager(ManagerConfig{},
+		depsManager,
+		createPolicyWithContIDFilter(t, 0, "not-container", events.SecurityBPF, "!=abc123"),
+	)
+	assert.NoError(t, err)
+	assert.False(t, policyManager.ContainerSelectorsEnabled())
+
+	selector := createPolicyWithContSelector(t, 0, "not-kube-system", events.SecurityBPF, "k8s.namespace", "!=kube-system")
+	assert.NoError(t, policyManager.AddPolicy(selector))
+	assert.True(t, policyManager.ContainerSelectorsEnabled())
+
+	// Selectors use the cgroup id filter, never matching missing keys (e.g. the host)
+	cfg := policyManager.ps.computePoliciesConfig()
+	assert.Equal(t, bitwise.NewBitmap(0, 1), cfg.CgroupIdFilterEnabled)
+	assert.Equal(t, bitwise.NewBitmap(0), cfg.CgroupIdFilterMatchIfKeyMissing)
+
+	assert.NoError(t, policyManager.RemovePolicy("not-kube-system"))
+	assert.False(t, policyManager.ContainerSelectorsEnabled())
+}
@@ -15,6 +15,8 @@ func TestPolicyClone(t *testing.T) {
 	policy := NewPolicy()
 	err := policy.PIDFilter.Parse("=1")
 	require.NoError(t, err)
+	err = policy.ContSelector.Parse("k8s.label.app", "=web")
+	require.NoError(t, err)
 
 	copy := policy.Clone()
 
@@ -28,6 +30,7 @@ func TestPolicyClone(t *testing.T) {
 		filters.ScopeFilter{},
 		filters.ProcessTreeFilter{},
 		filters.BinaryFilter{},
+		filters.ContainerSelectorFilter{},
 		sets.PrefixSet{},
 		sets.SuffixSet{},
 		filters.KernelDataFilter{},
@@ -9,7 +9,7 @@ import (
 )
 
 const (
-	maxSnapshots = PolicyMax
+	maxSnapshots = 64 // MAX_FILTER_VERSION in the eBPF code
 )
 
 // snapshot is a snapshot of the Policies at a given version.
@@ -1,6 +1,7 @@
 package v1beta1
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"os"
@@ -12,7 +13,9 @@ import (
 	"k8s.io/apimachinery/pkg/util/validation"
 
 	"github.com/aquasecurity/tracee/common/errfmt"
+	"github.com/aquasecurity/tracee/pkg/actions"
 	"github.com/aquasecurity/tracee/pkg/events"
+	"github.com/aquasecurity/tracee/pkg/filters"
 	k8s "github.com/aquasecurity/tracee/pkg/k8s/apis/tracee.aquasec.com/v1beta1"
 )
 
@@ -136,13 +139,10 @@ func (p PolicyFile) validateDefaultActions() error {
 	return validateActions(p.GetName(), p.GetDefaultActions())
 }
 
-func validateActions(policyName string, actions []string) error {
-	for _, action := range actions {
-		switch action {
-		case "log", "print": // supported actions
-			continue
-		default:
-			return errfmt.Errorf("policy %s, action %s is not valid", policyName, action)
+func validateActions(policyName string, actionList []string) error {
+	for _, action := range actionList {
+		if _, err := actions.Parse(action); err != nil {
+			return errfmt.Errorf("policy %s, action %s is not valid: %v", policyName, action, err)
 		}
 	}
 
@@ -180,7 +180,8 @@ func (p PolicyFile) validateScope() error {
 			return err
 		}
 
-		var found bool
+		// container selectors: k8s.namespace, k8s.label.<key> and container.image
+		found := filters.IsContainerSelector(scope)
 		for _, s := range scopes {
 			if scope == s {
 				found = true
@@ -403,15 +404,27 @@ func PoliciesFromPaths(paths []string) ([]k8s.PolicyInterface, error) {
 }
 
 func getPoliciesFromFile(filePath string) (PolicyFile, error) {
-	var p PolicyFile
-
 	data, err := os.ReadFile(filePath)
 	if err != nil {
-		return p, err
+		return PolicyFile{}, err
 	}
 
+	return parsePolicy(data, strings.HasSuffix(filePath, ".json"))
+}
+
+// PolicyFromBytes parses and validates a policy, given in any of the policy file
+// formats. JSON is told apart from YAML by its leading brace.
+func PolicyFromBytes(data []byte) (PolicyFile, error) {
+	isJSON := bytes.HasPrefix(bytes.TrimSpace(data), []byte("{"))
+
+	return parsePolicy(data, isJSON)
+}
+
+// parsePolicy parses and validates a policy, in JSON or YAML
+func parsePolicy(data []byte, isJSON bool) (PolicyFile, error) {
+	var p PolicyFile
+
 	// Detect format from data
-	isJSON := strings.HasSuffix(filePath, ".json")
 	format, err := peekPolicyFormat(data, isJSON)
 	if err != nil {
 		return p, err
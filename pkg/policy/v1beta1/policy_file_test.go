@@ -182,6 +182,42 @@ func TestPolicyValidate(t *testing.T) {
 			},
 			expectedError: errors.New("v1beta1.PolicyFile.validateScope: policy invalid-scope, scope random is not valid"),
 		},
+		{
+			testName: "container selectors scope",
+			policy: PolicyFile{
+				APIVersion: "tracee.aquasec.com/v1beta1",
+				Kind:       "Policy",
+				Metadata: Metadata{
+					Name: "container-selectors-scope",
+				},
+				Spec: k8s.PolicySpec{
+					Scope:          []string{"k8s.namespace=prod", "k8s.label.app.kubernetes.io/name=web", "container.image!=*-debug*"},
+					DefaultActions: []string{"log"},
+					Rules: []k8s.Rule{
+						{Event: "write"},
+					},
+				},
+			},
+			expectedError: nil,
+		},
+		{
+			testName: "label selector without key",
+			policy: PolicyFile{
+				APIVersion: "tracee.aquasec.com/v1beta1",
+				Kind:       "Policy",
+				Metadata: Metadata{
+					Name: "label-selector-without-key",
+				},
+				Spec: k8s.PolicySpec{
+					Scope:          []string{"k8s.label.=web"},
+					DefaultActions: []string{"log"},
+					Rules: []k8s.Rule{
+						{Event: "write"},
+					},
+				},
+			},
+			expectedError: errors.New("v1beta1.PolicyFile.validateScope: policy label-selector-without-key, scope k8s.label. is not valid"),
+		},
 		{
 			testName: "global scope must be unique",
 			policy: PolicyFile{
@@ -260,6 +296,43 @@ func TestPolicyValidate(t *testing.T) {
 			},
 			expectedError: errors.New("v1beta1.validateActions: policy invalid-policy-action, action audit is not valid"),
 		},
+		{
+			testName: "invalid rule action",
+			policy: PolicyFile{
+				APIVersion: "tracee.aquasec.com/v1beta1",
+				Kind:       "Policy",
+				Metadata: Metadata{
+					Name: "invalid-rule-action",
+				},
+				Spec: k8s.PolicySpec{
+					Scope:          []string{"global"},
+					DefaultActions: []string{"log"},
+					Rules: []k8s.Rule{
+						{Event: "write", Actions: []string{"signal=SIGFOO"}},
+					},
+				},
+			},
+			expectedError: errors.New("v1beta1.validateActions: policy invalid-rule-action, action signal=SIGFOO is not valid"),
+		},
+		{
+			testName: "response actions",
+			policy: PolicyFile{
+				APIVersion: "tracee.aquasec.com/v1beta1",
+				Kind:       "Policy",
+				Metadata: Metadata{
+					Name: "response-actions",
+				},
+				Spec: k8s.PolicySpec{
+					Scope:          []string{"global"},
+					DefaultActions: []string{"log"},
+					Rules: []k8s.Rule{
+						{Event: "write", Actions: []string{"kill,rate=1/1m", "capture-exec,dry-run"}},
+						{Event: "security_socket_connect", Actions: []string{"pcap=30s", "forward=siem"}},
+					},
+				},
+			},
+			expectedError: nil,
+		},
 		{
 			testName: "invalid retval",
 			policy: PolicyFile{
@@ -1442,3 +1515,77 @@ spec:
 		})
 	}
 }
+
+func TestPolicyFromBytes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		testName      string
+		document      string
+		expectedName  string
+		expectedError string
+	}{
+		{
+			testName: "plain yaml",
+			document: `type: policy
+name: plain-policy
+scope:
+  - global
+rules:
+  - event: sched_process_exec
+`,
+			expectedName: "plain-policy",
+		},
+		{
+			testName: "k8s yaml",
+			document: `apiVersion: tracee.aquasec.com/v1beta1
+kind: Policy
+metadata:
+  name: k8s-policy
+spec:
+  scope:
+    - global
+  rules:
+    - event: sched_process_exec
+`,
+			expectedName: "k8s-policy",
+		},
+		{
+			testName:     "plain json",
+			document:     ` {"type": "policy", "name": "json-policy", "scope": ["global"], "rules": [{"event": "sched_process_exec"}]}`,
+			expectedName: "json-policy",
+		},
+		{
+			testName: "invalid policy",
+			document: `type: policy
+name: invalid-policy
+scope:
+  - global
+rules:
+  - event: non_existing_event
+`,
+			expectedError: "policy invalid-policy, event non_existing_event is not valid",
+		},
+		{
+			testName:      "not a policy",
+			document:      `{"type": "detector"}`,
+			expectedError: "policy name  is invalid",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.testName, func(t *testing.T) {
+			t.Parallel()
+
+			p, err := PolicyFromBytes([]byte(tc.document))
+			if tc.expectedError != "" {
+				assert.ErrorContains(t, err, tc.expectedError)
+				return
+			}
+
+			assert.NilError(t, err)
+			assert.Equal(t, p.GetName(), tc.expectedName)
+			assert.Equal(t, p.GetRules()[0].Event, "sched_process_exec")
+		})
+	}
+}
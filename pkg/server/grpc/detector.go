@@ -0,0 +1,187 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/aquasecurity/tracee/api/v1beta1"
+	"github.com/aquasecurity/tracee/api/v1beta1/detection"
+	"github.com/aquasecurity/tracee/pkg/detectors"
+	tracee "github.com/aquasecurity/tracee/pkg/ebpf"
+)
+
+// DetectorLoader builds a detector from a YAML detector document
+type DetectorLoader func(document []byte) (detection.EventDetector, error)
+
+type DetectorService struct {
+	pb.UnimplementedDetectorServiceServer
+	tracee         *tracee.Tracee
+	detectorLoader DetectorLoader
+}
+
+func (s *DetectorService) ListDetectors(ctx context.Context, in *pb.ListDetectorsRequest) (*pb.ListDetectorsResponse, error) {
+	var infos []detectors.DetectorInfo
+
+	if len(in.Ids) == 0 {
+		infos = s.tracee.Detectors()
+	} else {
+		infos = make([]detectors.DetectorInfo, 0, len(in.Ids))
+		for _, id := range in.Ids {
+			info, err := s.tracee.Detector(id)
+			if err != nil {
+				return nil, detectorStatusError(err)
+			}
+			infos = append(infos, info)
+		}
+	}
+
+	out := make([]*pb.Detector, 0, len(infos))
+	for _, info := range infos {
+		out = append(out, convertDetectorToProto(info))
+	}
+
+	return &pb.ListDetectorsResponse{Detectors: out}, nil
+}
+
+func (s *DetectorService) EnableDetector(ctx context.Context, in *pb.EnableDetectorRequest) (*pb.EnableDetectorResponse, error) {
+	if err := s.tracee.EnableDetector(in.Id); err != nil {
+		return nil, detectorStatusError(err)
+	}
+
+	detector, err := s.detector(in.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.EnableDetectorResponse{Detector: detector}, nil
+}
+
+func (s *DetectorService) DisableDetector(ctx context.Context, in *pb.DisableDetectorRequest) (*pb.DisableDetectorResponse, error) {
+	if err := s.tracee.DisableDetector(in.Id); err != nil {
+		return nil, detectorStatusError(err)
+	}
+
+	detector, err := s.detector(in.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DisableDetectorResponse{Detector: detector}, nil
+}
+
+func (s *DetectorService) LoadDetector(ctx context.Context, in *pb.LoadDetectorRequest) (*pb.LoadDetectorResponse, error) {
+	detector, err := s.loadDetector(in.Detector)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.tracee.LoadDetector(detector); err != nil {
+		return nil, detectorStatusError(err)
+	}
+
+	out, err := s.detector(detector.GetDefinition().ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.LoadDetectorResponse{Detector: out}, nil
+}
+
+// loadDetector parses and validates a YAML detector document
+func (s *DetectorService) loadDetector(document string) (detection.EventDetector, error) {
+	if s.detectorLoader == nil {
+		return nil, status.Error(codes.Unimplemented, "detector loading is not enabled")
+	}
+
+	detector, err := s.detectorLoader([]byte(document))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return detector, nil
+}
+
+// detector returns the current state of a detector
+func (s *DetectorService) detector(id string) (*pb.Detector, error) {
+	info, err := s.tracee.Detector(id)
+	if err != nil {
+		return nil, detectorStatusError(err)
+	}
+
+	return convertDetectorToProto(info), nil
+}
+
+// detectorStatusError returns the gRPC status of a detector management error
+func detectorStatusError(err error) error {
+	switch {
+	case errors.Is(err, detectors.ErrDetectorNotRegistered):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, detectors.ErrDetectorRegistered):
+		return status.Error(codes.AlreadyExists, err.Error())
+	}
+
+	return status.Error(codes.FailedPrecondition, err.Error())
+}
+
+func convertDetectorToProto(info detectors.DetectorInfo) *pb.Detector {
+	def := info.Definition
+	produced := &def.ProducedEvent
+
+	return &pb.Detector{
+		Id:       def.ID,
+		Enabled:  info.Enabled,
+		Selected: info.Selected,
+		ProducedEvent: &pb.EventDefinition{
+			Id:          int32(info.EventID),
+			Name:        produced.Name,
+			Version:     produced.Version,
+			Description: produced.Description,
+			Tags:        produced.Tags,
+			Fields:      produced.Fields,
+		},
+		Threat:       def.ThreatMetadata,
+		Requirements: convertDetectorRequirementsToProto(def.Requirements),
+		Health: &pb.DetectorHealth{
+			EventsProcessed:  info.Stats.EventsProcessed,
+			EventsProduced:   info.Stats.EventsProduced,
+			Errors:           info.Stats.Errors,
+			ExecutionSeconds: info.Stats.ExecutionTime.Seconds(),
+		},
+	}
+}
+
+func convertDetectorRequirementsToProto(req detection.DetectorRequirements) *pb.DetectorRequirements {
+	out := &pb.DetectorRequirements{
+		Architectures:    req.Architectures,
+		MinTraceeVersion: req.MinTraceeVersion,
+		MaxTraceeVersion: req.MaxTraceeVersion,
+	}
+
+	for _, event := range req.Events {
+		out.Events = append(out.Events, &pb.DetectorEventRequirement{
+			Name:         event.Name,
+			Optional:     event.Dependency == detection.DependencyOptional,
+			MinVersion:   event.MinVersion,
+			MaxVersion:   event.MaxVersion,
+			DataFilters:  event.DataFilters,
+			ScopeFilters: event.ScopeFilters,
+		})
+	}
+	for _, dataStore := range req.DataStores {
+		out.DataStores = append(out.DataStores, &pb.DetectorRequirement{
+			Name:     dataStore.Name,
+			Optional: dataStore.Dependency == detection.DependencyOptional,
+		})
+	}
+	for _, enrichment := range req.Enrichments {
+		out.Enrichments = append(out.Enrichments, &pb.DetectorRequirement{
+			Name:     enrichment.Name,
+			Optional: enrichment.Dependency == detection.DependencyOptional,
+		})
+	}
+
+	return out
+}
@@ -0,0 +1,123 @@
+package grpc
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/aquasecurity/tracee/api/v1beta1"
+	"github.com/aquasecurity/tracee/api/v1beta1/detection"
+	"github.com/aquasecurity/tracee/pkg/detectors"
+)
+
+func TestLoadDetector(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		loader       DetectorLoader
+		expectedCode codes.Code
+	}{
+		{
+			name:         "no loader",
+			expectedCode: codes.Unimplemented,
+		},
+		{
+			name: "loader error",
+			loader: func([]byte) (detection.EventDetector, error) {
+				return nil, errors.New("invalid detector")
+			},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name: "valid document",
+			loader: func([]byte) (detection.EventDetector, error) {
+				return nil, nil
+			},
+			expectedCode: codes.OK,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := &DetectorService{detectorLoader: tc.loader}
+			_, err := s.loadDetector("id: test")
+			assert.Equal(t, tc.expectedCode, status.Code(err))
+		})
+	}
+}
+
+func TestDetectorStatusError(t *testing.T) {
+	t.Parallel()
+
+	notRegistered := fmt.Errorf("detector d %w", detectors.ErrDetectorNotRegistered)
+	registered := fmt.Errorf("detector ID d %w", detectors.ErrDetectorRegistered)
+
+	assert.Equal(t, codes.NotFound, status.Code(detectorStatusError(notRegistered)))
+	assert.Equal(t, codes.AlreadyExists, status.Code(detectorStatusError(registered)))
+	assert.Equal(t, codes.FailedPrecondition, status.Code(detectorStatusError(errors.New("failed"))))
+}
+
+func TestConvertDetectorToProto(t *testing.T) {
+	t.Parallel()
+
+	info := detectors.DetectorInfo{
+		Definition: &detection.DetectorDefinition{
+			ID: "TEST-001",
+			Requirements: detection.DetectorRequirements{
+				Events: []detection.EventRequirement{
+					{Name: "sched_process_exec", DataFilters: []string{"pathname=/bin/sh"}},
+					{Name: "security_file_open", Dependency: detection.DependencyOptional},
+				},
+				DataStores: []detection.DataStoreRequirement{
+					{Name: "process", Dependency: detection.DependencyOptional},
+				},
+				Architectures: []string{"amd64"},
+			},
+			ProducedEvent: v1beta1.EventDefinition{
+				Name:    "test_detection",
+				Version: &v1beta1.Version{Major: 1},
+			},
+			ThreatMetadata: &v1beta1.Threat{Name: "Test threat", Severity: v1beta1.Severity_HIGH},
+		},
+		EventID:  7600,
+		Enabled:  true,
+		Selected: true,
+		Stats: detectors.DetectorStats{
+			EventsProcessed: 10,
+			EventsProduced:  2,
+			Errors:          1,
+			ExecutionTime:   1500 * time.Millisecond,
+		},
+	}
+
+	detector := convertDetectorToProto(info)
+	require.NotNil(t, detector)
+	assert.Equal(t, "TEST-001", detector.Id)
+	assert.True(t, detector.Enabled)
+	assert.True(t, detector.Selected)
+	assert.Equal(t, int32(7600), detector.ProducedEvent.Id)
+	assert.Equal(t, "test_detection", detector.ProducedEvent.Name)
+	assert.Equal(t, "Test threat", detector.Threat.Name)
+
+	require.Len(t, detector.Requirements.Events, 2)
+	assert.False(t, detector.Requirements.Events[0].Optional)
+	assert.Equal(t, []string{"pathname=/bin/sh"}, detector.Requirements.Events[0].DataFilters)
+	assert.True(t, detector.Requirements.Events[1].Optional)
+	require.Len(t, detector.Requirements.DataStores, 1)
+	assert.True(t, detector.Requirements.DataStores[0].Optional)
+	assert.Equal(t, []string{"amd64"}, detector.Requirements.Architectures)
+
+	assert.Equal(t, uint64(10), detector.Health.EventsProcessed)
+	assert.Equal(t, uint64(2), detector.Health.EventsProduced)
+	assert.Equal(t, uint64(1), detector.Health.Errors)
+	assert.InDelta(t, 1.5, detector.Health.ExecutionSeconds, 0.0001)
+}
@@ -270,6 +270,14 @@ func parseArgument(arg trace.Argument) (*pb.EventValue, error) {
 		return converProtoHTTPResponse(&v)
 	case *trace.ProtoHTTPResponse:
 		return converProtoHTTPResponse(v)
+	case trace.ProtoTLSClientHello:
+		return convertProtoTLSClientHello(&v)
+	case *trace.ProtoTLSClientHello:
+		return convertProtoTLSClientHello(v)
+	case trace.ProtoTLSServerHello:
+		return convertProtoTLSServerHello(&v)
+	case *trace.ProtoTLSServerHello:
+		return convertProtoTLSServerHello(v)
 	case []trace.DnsQueryData:
 		questions := make([]*pb.DnsQueryData, len(v))
 		for i, q := range v {
@@ -544,6 +552,61 @@ func converProtoHttp(v *trace.ProtoHTTP) (*pb.EventValue, error) {
 		}}, nil
 }
 
+func convertUint16ArrayToUint32(arr []uint16) []uint32 {
+	if arr == nil {
+		return nil
+	}
+	res := make([]uint32, len(arr))
+	for i, v := range arr {
+		res[i] = uint32(v)
+	}
+	return res
+}
+
+func convertProtoTLSClientHello(v *trace.ProtoTLSClientHello) (*pb.EventValue, error) {
+	return &pb.EventValue{
+		Value: &pb.EventValue_TlsClientHello{
+			TlsClientHello: &pb.TLSClientHello{
+				Version:           v.Version,
+				SupportedVersions: v.SupportedVersions,
+				CipherSuites:      v.CipherSuites,
+				Extensions:        convertUint16ArrayToUint32(v.Extensions),
+				ServerName:        sanitizeStringForProtobuf(v.ServerName),
+				Alpn:              sanitizeStringArrayForProtobuf(v.ALPN),
+				Ja3:               v.JA3,
+				Ja3Hash:           v.JA3Hash,
+				Ja4:               v.JA4,
+			},
+		},
+	}, nil
+}
+
+func convertProtoTLSServerHello(v *trace.ProtoTLSServerHello) (*pb.EventValue, error) {
+	certificates := make([]*pb.TLSCertificate, len(v.Certificates))
+	for i, c := range v.Certificates {
+		certificates[i] = &pb.TLSCertificate{
+			Subject:  sanitizeStringForProtobuf(c.Subject),
+			Issuer:   sanitizeStringForProtobuf(c.Issuer),
+			DnsNames: sanitizeStringArrayForProtobuf(c.DNSNames),
+		}
+	}
+
+	return &pb.EventValue{
+		Value: &pb.EventValue_TlsServerHello{
+			TlsServerHello: &pb.TLSServerHello{
+				Version:      v.Version,
+				CipherSuite:  v.CipherSuite,
+				Extensions:   convertUint16ArrayToUint32(v.Extensions),
+				Alpn:         sanitizeStringForProtobuf(v.ALPN),
+				Ja3S:         v.JA3S,
+				Ja3SHash:     v.JA3SHash,
+				Ja4S:         v.JA4S,
+				Certificates: certificates,
+			},
+		},
+	}, nil
+}
+
 func convertHttpIpv4(v *trace.ProtoIPv4) (*pb.EventValue, error) {
 	return &pb.EventValue{
 		Value: &pb.EventValue_Ipv4{
@@ -0,0 +1,109 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/aquasecurity/tracee/api/v1beta1"
+	"github.com/aquasecurity/tracee/pkg/events"
+	k8s "github.com/aquasecurity/tracee/pkg/k8s/apis/tracee.aquasec.com/v1beta1"
+	"github.com/aquasecurity/tracee/pkg/policy"
+	"github.com/aquasecurity/tracee/pkg/policy/v1beta1"
+)
+
+// PolicyBuilder builds the policy applied by tracee from a validated policy file
+type PolicyBuilder func(k8s.PolicyInterface) (*policy.Policy, error)
+
+func (s *TraceeService) CreatePolicy(ctx context.Context, in *pb.CreatePolicyRequest) (*pb.CreatePolicyResponse, error) {
+	p, err := s.buildPolicy(in.Policy)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.tracee.CreatePolicy(p); err != nil {
+		return nil, policyStatusError(err)
+	}
+
+	return &pb.CreatePolicyResponse{Policy: convertPolicyToProto(p)}, nil
+}
+
+func (s *TraceeService) UpdatePolicy(ctx context.Context, in *pb.UpdatePolicyRequest) (*pb.UpdatePolicyResponse, error) {
+	p, err := s.buildPolicy(in.Policy)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.tracee.UpdatePolicy(p); err != nil {
+		return nil, policyStatusError(err)
+	}
+
+	return &pb.UpdatePolicyResponse{Policy: convertPolicyToProto(p)}, nil
+}
+
+func (s *TraceeService) DeletePolicy(ctx context.Context, in *pb.DeletePolicyRequest) (*pb.DeletePolicyResponse, error) {
+	if err := s.tracee.DeletePolicy(in.Name); err != nil {
+		return nil, policyStatusError(err)
+	}
+
+	return &pb.DeletePolicyResponse{}, nil
+}
+
+func (s *TraceeService) ListPolicies(ctx context.Context, in *pb.ListPoliciesRequest) (*pb.ListPoliciesResponse, error) {
+	policies := s.tracee.Policies()
+
+	out := make([]*pb.PolicyInfo, 0, len(policies))
+	for _, p := range policies {
+		out = append(out, convertPolicyToProto(p))
+	}
+
+	return &pb.ListPoliciesResponse{Policies: out}, nil
+}
+
+// buildPolicy parses, validates and builds a policy document
+func (s *TraceeService) buildPolicy(document string) (*policy.Policy, error) {
+	if s.policyBuilder == nil {
+		return nil, status.Error(codes.Unimplemented, "policy changes are not enabled")
+	}
+
+	policyFile, err := v1beta1.PolicyFromBytes([]byte(document))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	p, err := s.policyBuilder(policyFile)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return p, nil
+}
+
+// policyStatusError returns the gRPC status of a policy change error
+func policyStatusError(err error) error {
+	switch {
+	case errors.Is(err, policy.ErrPolicyNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, policy.ErrPolicyExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	}
+
+	return status.Error(codes.FailedPrecondition, err.Error())
+}
+
+func convertPolicyToProto(p *policy.Policy) *pb.PolicyInfo {
+	ids := policy.PolicyEvents(p)
+
+	names := make([]string, 0, len(ids))
+	for _, id := range ids {
+		names = append(names, events.Core.GetDefinitionByID(id).GetName())
+	}
+
+	return &pb.PolicyInfo{
+		Id:     int32(p.ID),
+		Name:   p.Name,
+		Events: names,
+	}
+}
@@ -0,0 +1,106 @@
+package grpc
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/aquasecurity/tracee/pkg/events"
+	k8s "github.com/aquasecurity/tracee/pkg/k8s/apis/tracee.aquasec.com/v1beta1"
+	"github.com/aquasecurity/tracee/pkg/policy"
+)
+
+const testPolicyDocument = `
+apiVersion: tracee.aquasec.com/v1beta1
+kind: Policy
+metadata:
+  name: test-policy
+spec:
+  scope:
+    - global
+  rules:
+    - event: security_file_open
+`
+
+func TestBuildPolicy(t *testing.T) {
+	t.Parallel()
+
+	builder := func(policyFile k8s.PolicyInterface) (*policy.Policy, error) {
+		p := policy.NewPolicy()
+		p.Name = policyFile.GetName()
+		return p, nil
+	}
+
+	tests := []struct {
+		name         string
+		builder      PolicyBuilder
+		document     string
+		expectedCode codes.Code
+	}{
+		{
+			name:         "no builder",
+			document:     testPolicyDocument,
+			expectedCode: codes.Unimplemented,
+		},
+		{
+			name:         "invalid document",
+			builder:      builder,
+			document:     "kind: Policy",
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name: "builder error",
+			builder: func(k8s.PolicyInterface) (*policy.Policy, error) {
+				return nil, errors.New("failed")
+			},
+			document:     testPolicyDocument,
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "valid document",
+			builder:      builder,
+			document:     testPolicyDocument,
+			expectedCode: codes.OK,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := &TraceeService{policyBuilder: tc.builder}
+			p, err := s.buildPolicy(tc.document)
+			assert.Equal(t, tc.expectedCode, status.Code(err))
+			if tc.expectedCode == codes.OK {
+				require.NotNil(t, p)
+				assert.Equal(t, "test-policy", p.Name)
+			}
+		})
+	}
+}
+
+func TestPolicyStatusError(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, codes.NotFound, status.Code(policyStatusError(policy.PolicyNotFoundByNameError("p"))))
+	assert.Equal(t, codes.AlreadyExists, status.Code(policyStatusError(policy.PolicyAlreadyExistsError("p", 1))))
+	assert.Equal(t, codes.FailedPrecondition, status.Code(policyStatusError(errors.New("failed"))))
+}
+
+func TestConvertPolicyToProto(t *testing.T) {
+	t.Parallel()
+
+	p := policy.NewPolicy()
+	p.ID = 3
+	p.Name = "test-policy"
+	p.Rules[events.SecurityFileOpen] = policy.RuleData{EventID: events.SecurityFileOpen}
+
+	info := convertPolicyToProto(p)
+	assert.Equal(t, int32(3), info.Id)
+	assert.Equal(t, "test-policy", info.Name)
+	assert.Equal(t, []string{"security_file_open"}, info.Events)
+}
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/keepalive"
 
 	pb "github.com/aquasecurity/tracee/api/v1beta1"
@@ -22,6 +23,11 @@ type Server struct {
 	protocol   string
 	listenAddr string
 	server     *grpc.Server
+	tls        *certReloader
+	// builds the policies given to the policy RPCs, unavailable if nil
+	policyBuilder PolicyBuilder
+	// builds the detectors given to LoadDetector, unavailable if nil
+	detectorLoader DetectorLoader
 }
 
 func New(protocol, listenAddr string) *Server {
@@ -32,6 +38,34 @@ func New(protocol, listenAddr string) *Server {
 	return &Server{listener: nil, protocol: protocol, listenAddr: listenAddr}
 }
 
+// EnableTLS makes the server serve TLS with the given certificates, which are
+// reloaded when their files change. Setting a CA requires clients to present a
+// certificate signed by it (mutual TLS).
+func (s *Server) EnableTLS(config TLSConfig) error {
+	reloader, err := newCertReloader(config)
+	if err != nil {
+		return err
+	}
+	s.tls = reloader
+
+	return nil
+}
+
+// SetPolicyBuilder enables the policy RPCs, building their policies with the given builder
+func (s *Server) SetPolicyBuilder(builder PolicyBuilder) {
+	s.policyBuilder = builder
+}
+
+// SetDetectorLoader enables loading detectors at runtime, building them with the given loader
+func (s *Server) SetDetectorLoader(loader DetectorLoader) {
+	s.detectorLoader = loader
+}
+
+// TLSEnabled returns true if the server serves TLS
+func (s *Server) TLSEnabled() bool {
+	return s.tls != nil
+}
+
 func (s *Server) Start(ctx context.Context, t *tracee.Tracee, e *engine.Engine) {
 	// Create listener when starting
 	lis, err := net.Listen(s.protocol, s.listenAddr)
@@ -61,11 +95,17 @@ func (s *Server) Start(ctx context.Context, t *tracee.Tracee, e *engine.Engine)
 		Timeout: 1 * time.Second, // Wait 1 second for the ping ack before assuming the connection is dead
 	}
 
-	grpcServer := grpc.NewServer(grpc.KeepaliveParams(keepaliveParams))
+	serverOpts := []grpc.ServerOption{grpc.KeepaliveParams(keepaliveParams)}
+	if s.tls != nil {
+		serverOpts = append(serverOpts, grpc.Creds(credentials.NewTLS(s.tls.tlsConfig())))
+	}
+
+	grpcServer := grpc.NewServer(serverOpts...)
 	s.server = grpcServer
-	pb.RegisterTraceeServiceServer(grpcServer, &TraceeService{tracee: t})
+	pb.RegisterTraceeServiceServer(grpcServer, &TraceeService{tracee: t, policyBuilder: s.policyBuilder})
 	pb.RegisterDiagnosticServiceServer(grpcServer, &DiagnosticService{tracee: t})
 	pb.RegisterDataSourceServiceServer(grpcServer, &DataSourceService{sigEngine: e})
+	pb.RegisterDetectorServiceServer(grpcServer, &DetectorService{tracee: t, detectorLoader: s.detectorLoader})
 
 	// Tracee might be nil in unit tests
 	if t != nil {
@@ -73,7 +113,7 @@ func (s *Server) Start(ctx context.Context, t *tracee.Tracee, e *engine.Engine)
 	}
 
 	go func() {
-		logger.Debugw("Starting grpc server", "protocol", s.protocol, "address", s.listenAddr)
+		logger.Debugw("Starting grpc server", "protocol", s.protocol, "address", s.listenAddr, "tls", s.tls != nil)
 		if err := grpcServer.Serve(s.listener); err != nil {
 			logger.Errorw("GRPC server", "error", err)
 		}
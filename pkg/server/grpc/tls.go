@@ -0,0 +1,148 @@
+package grpc
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"os"
+	"sync"
+
+	"github.com/aquasecurity/tracee/common/errfmt"
+	"github.com/aquasecurity/tracee/common/fileutil"
+	"github.com/aquasecurity/tracee/common/logger"
+)
+
+// TLSConfig is the TLS configuration of the gRPC server
+type TLSConfig struct {
+	CertFile string // server certificate (PEM)
+	KeyFile  string // server certificate private key (PEM)
+	CAFile   string // CA bundle verifying client certificates (PEM), enables mutual TLS
+}
+
+// Enabled returns true if TLS is configured
+func (c TLSConfig) Enabled() bool {
+	return c.CertFile != "" || c.KeyFile != "" || c.CAFile != ""
+}
+
+// certReloader serves the TLS certificates of the server, reloading them when
+// their files change, so certificates can be rotated without a restart.
+type certReloader struct {
+	config TLSConfig
+
+	mu       sync.Mutex
+	cert     *tls.Certificate
+	clientCA *x509.CertPool
+	stamps   [3]fileutil.Stamp // cert, key and CA files stamps
+}
+
+// newCertReloader loads the certificates of a TLS configuration
+func newCertReloader(config TLSConfig) (*certReloader, error) {
+	if config.CertFile == "" || config.KeyFile == "" {
+		return nil, errfmt.Errorf("grpc tls requires both a certificate and a key")
+	}
+
+	r := &certReloader{config: config}
+	stamps, err := r.stat()
+	if err != nil {
+		return nil, errfmt.WrapError(err)
+	}
+	if err := r.load(stamps); err != nil {
+		return nil, errfmt.WrapError(err)
+	}
+
+	return r, nil
+}
+
+// tlsConfig returns the server TLS configuration
+func (r *certReloader) tlsConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		GetConfigForClient: r.getConfigForClient,
+	}
+}
+
+// getConfigForClient returns the TLS configuration of a new connection, with
+// the latest certificates.
+func (r *certReloader) getConfigForClient(*tls.ClientHelloInfo) (*tls.Config, error) {
+	r.reload()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	config := &tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		Certificates: []tls.Certificate{*r.cert},
+		NextProtos:   []string{"h2"},
+	}
+	if r.clientCA != nil {
+		config.ClientCAs = r.clientCA
+		config.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	return config, nil
+}
+
+// reload reloads the certificates if their files changed. A failed reload (e.g.
+// in the middle of a rotation) keeps serving the previous certificates.
+func (r *certReloader) reload() {
+	stamps, err := r.stat()
+	if err != nil {
+		logger.Warnw("Failed to check grpc tls certificates, keeping previous ones", "error", err)
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if stamps == r.stamps {
+		return
+	}
+	if err := r.load(stamps); err != nil {
+		logger.Warnw("Failed to reload grpc tls certificates, keeping previous ones", "error", err)
+		return
+	}
+	logger.Infow("Reloaded grpc tls certificates", "cert", r.config.CertFile)
+}
+
+// stat returns the stamps of the certificate files
+func (r *certReloader) stat() ([3]fileutil.Stamp, error) {
+	var stamps [3]fileutil.Stamp
+	var err error
+
+	for i, path := range []string{r.config.CertFile, r.config.KeyFile, r.config.CAFile} {
+		if path == "" {
+			continue
+		}
+		if stamps[i], err = fileutil.StatStamp(path); err != nil {
+			return stamps, err
+		}
+	}
+
+	return stamps, nil
+}
+
+// load loads the certificate files, which must match the given stamps
+// (the caller must hold the lock, if the reloader is in use).
+func (r *certReloader) load(stamps [3]fileutil.Stamp) error {
+	cert, err := tls.LoadX509KeyPair(r.config.CertFile, r.config.KeyFile)
+	if err != nil {
+		return errfmt.Errorf("failed to load grpc tls certificate: %v", err)
+	}
+
+	var clientCA *x509.CertPool
+	if r.config.CAFile != "" {
+		pem, err := os.ReadFile(r.config.CAFile)
+		if err != nil {
+			return errfmt.Errorf("failed to read grpc tls ca: %v", err)
+		}
+		clientCA = x509.NewCertPool()
+		if !clientCA.AppendCertsFromPEM(pem) {
+			return errfmt.Errorf("no valid certificates in grpc tls ca %s", r.config.CAFile)
+		}
+	}
+
+	r.cert = &cert
+	r.clientCA = clientCA
+	r.stamps = stamps
+
+	return nil
+}
@@ -0,0 +1,267 @@
+package grpc
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"io"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testCert is a certificate and its key, signed by a test CA
+type testCert struct {
+	cert    *x509.Certificate
+	key     *ecdsa.PrivateKey
+	certPEM []byte
+	keyPEM  []byte
+}
+
+func newTestCert(t *testing.T, name string, parent *testCert, isCA bool) *testCert {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	serial, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: name},
+		DNSNames:     []string{name},
+		IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1)},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		IsCA:         isCA,
+
+		BasicConstraintsValid: true,
+	}
+
+	signerCert, signerKey := template, key
+	if parent != nil {
+		signerCert, signerKey = parent.cert, parent.key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, signerCert, &key.PublicKey, signerKey)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	return &testCert{
+		cert:    cert,
+		key:     key,
+		certPEM: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		keyPEM:  pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}),
+	}
+}
+
+// write writes the certificate and its key, bumping their modification time so
+// the change is seen even within the file system time granularity
+func (c *testCert) write(t *testing.T, certFile, keyFile string, mtime time.Time) {
+	t.Helper()
+
+	require.NoError(t, os.WriteFile(certFile, c.certPEM, 0600))
+	require.NoError(t, os.WriteFile(keyFile, c.keyPEM, 0600))
+	require.NoError(t, os.Chtimes(certFile, mtime, mtime))
+	require.NoError(t, os.Chtimes(keyFile, mtime, mtime))
+}
+
+// serveTLS accepts TLS connections until the test ends, completing their handshakes
+func serveTLS(t *testing.T, config *tls.Config) string {
+	t.Helper()
+
+	lis, err := tls.Listen("tcp", "127.0.0.1:0", config)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = lis.Close()
+	})
+
+	go func() {
+		for {
+			conn, err := lis.Accept()
+			if err != nil {
+				return
+			}
+			_ = conn.(*tls.Conn).Handshake()
+			_ = conn.Close()
+		}
+	}()
+
+	return lis.Addr().String()
+}
+
+// dialTLS returns the server certificate common name, after a TLS handshake
+func dialTLS(addr string, roots *x509.CertPool, clientCert *testCert) (string, error) {
+	config := &tls.Config{
+		RootCAs:    roots,
+		ServerName: "tracee",
+		MinVersion: tls.VersionTLS12,
+	}
+	if clientCert != nil {
+		pair, err := tls.X509KeyPair(clientCert.certPEM, clientCert.keyPEM)
+		if err != nil {
+			return "", err
+		}
+		config.Certificates = []tls.Certificate{pair}
+	}
+
+	conn, err := tls.Dial("tcp", addr, config)
+	if err != nil {
+		return "", err
+	}
+	defer conn.Close()
+
+	// with TLS 1.3, a rejected client certificate is reported on the first read
+	_ = conn.SetReadDeadline(time.Now().Add(time.Second))
+	if _, err := conn.Read(make([]byte, 1)); err != nil && !errors.Is(err, io.EOF) {
+		return "", err
+	}
+
+	return conn.ConnectionState().PeerCertificates[0].Subject.CommonName, nil
+}
+
+// servedSerial returns the serial number of the certificate served by a reloader
+func servedSerial(r *certReloader) *big.Int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.cert.Leaf.SerialNumber
+}
+
+func TestTLSConfig_Enabled(t *testing.T) {
+	t.Parallel()
+
+	assert.False(t, TLSConfig{}.Enabled())
+	assert.True(t, TLSConfig{CertFile: "cert.pem", KeyFile: "key.pem"}.Enabled())
+	assert.True(t, TLSConfig{CAFile: "ca.pem"}.Enabled())
+}
+
+func TestNewCertReloader_Errors(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	ca := newTestCert(t, "ca", nil, true)
+	server := newTestCert(t, "tracee", ca, false)
+	certFile, keyFile := filepath.Join(dir, "cert.pem"), filepath.Join(dir, "key.pem")
+	server.write(t, certFile, keyFile, time.Now())
+
+	notPEM := filepath.Join(dir, "ca.pem")
+	require.NoError(t, os.WriteFile(notPEM, []byte("not a certificate"), 0600))
+
+	tests := []struct {
+		name    string
+		config  TLSConfig
+		wantErr string
+	}{
+		{name: "missing key", config: TLSConfig{CertFile: certFile}, wantErr: "requires both a certificate and a key"},
+		{name: "missing cert", config: TLSConfig{KeyFile: keyFile}, wantErr: "requires both a certificate and a key"},
+		{name: "cert file not found", config: TLSConfig{CertFile: filepath.Join(dir, "missing.pem"), KeyFile: keyFile}, wantErr: "no such file or directory"},
+		{name: "key mismatch", config: TLSConfig{CertFile: certFile, KeyFile: certFile}, wantErr: "failed to load grpc tls certificate"},
+		{name: "invalid ca", config: TLSConfig{CertFile: certFile, KeyFile: keyFile, CAFile: notPEM}, wantErr: "no valid certificates in grpc tls ca"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := newCertReloader(tt.config)
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestCertReloader_Rotation(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	certFile, keyFile := filepath.Join(dir, "cert.pem"), filepath.Join(dir, "key.pem")
+
+	ca := newTestCert(t, "ca", nil, true)
+	roots := x509.NewCertPool()
+	roots.AddCert(ca.cert)
+
+	first := newTestCert(t, "tracee", ca, false)
+	first.write(t, certFile, keyFile, time.Now())
+
+	reloader, err := newCertReloader(TLSConfig{CertFile: certFile, KeyFile: keyFile})
+	require.NoError(t, err)
+	addr := serveTLS(t, reloader.tlsConfig())
+
+	_, err = dialTLS(addr, roots, nil)
+	require.NoError(t, err)
+	assert.Equal(t, first.cert.SerialNumber, servedSerial(reloader))
+
+	// A rotated certificate is served by the next connections
+	second := newTestCert(t, "tracee", ca, false)
+	second.write(t, certFile, keyFile, time.Now().Add(time.Minute))
+
+	_, err = dialTLS(addr, roots, nil)
+	require.NoError(t, err)
+	assert.Equal(t, second.cert.SerialNumber, servedSerial(reloader))
+
+	// A half written rotation keeps the previous certificate
+	third := newTestCert(t, "tracee", ca, false)
+	require.NoError(t, os.WriteFile(certFile, third.certPEM, 0600))
+	require.NoError(t, os.Chtimes(certFile, time.Now().Add(2*time.Minute), time.Now().Add(2*time.Minute)))
+
+	_, err = dialTLS(addr, roots, nil)
+	require.NoError(t, err)
+	assert.Equal(t, second.cert.SerialNumber, servedSerial(reloader))
+
+	// and so does a missing one
+	require.NoError(t, os.Remove(keyFile))
+
+	_, err = dialTLS(addr, roots, nil)
+	require.NoError(t, err)
+	assert.Equal(t, second.cert.SerialNumber, servedSerial(reloader))
+}
+
+func TestCertReloader_MutualTLS(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	certFile, keyFile := filepath.Join(dir, "cert.pem"), filepath.Join(dir, "key.pem")
+	caFile := filepath.Join(dir, "ca.pem")
+
+	ca := newTestCert(t, "ca", nil, true)
+	roots := x509.NewCertPool()
+	roots.AddCert(ca.cert)
+	require.NoError(t, os.WriteFile(caFile, ca.certPEM, 0600))
+
+	newTestCert(t, "tracee", ca, false).write(t, certFile, keyFile, time.Now())
+
+	reloader, err := newCertReloader(TLSConfig{CertFile: certFile, KeyFile: keyFile, CAFile: caFile})
+	require.NoError(t, err)
+	addr := serveTLS(t, reloader.tlsConfig())
+
+	// no client certificate
+	_, err = dialTLS(addr, roots, nil)
+	assert.Error(t, err)
+
+	// client certificate signed by another CA
+	otherCA := newTestCert(t, "other-ca", nil, true)
+	_, err = dialTLS(addr, roots, newTestCert(t, "client", otherCA, false))
+	assert.Error(t, err)
+
+	// client certificate signed by the CA
+	name, err := dialTLS(addr, roots, newTestCert(t, "client", ca, false))
+	require.NoError(t, err)
+	assert.Equal(t, "tracee", name)
+}
@@ -2,9 +2,12 @@ package grpc
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/mennanov/fmutils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	pb "github.com/aquasecurity/tracee/api/v1beta1"
 	"github.com/aquasecurity/tracee/common/logger"
@@ -17,7 +20,8 @@ import (
 
 type TraceeService struct {
 	pb.UnimplementedTraceeServiceServer
-	tracee *tracee.Tracee
+	tracee        *tracee.Tracee
+	policyBuilder PolicyBuilder
 }
 
 func (s *TraceeService) StreamEvents(in *pb.StreamEventsRequest, grpcStream pb.TraceeService_StreamEventsServer) error {
@@ -26,12 +30,21 @@ func (s *TraceeService) StreamEvents(in *pb.StreamEventsRequest, grpcStream pb.T
 
 	streamConfig := config.Stream{
 		Filters: config.StreamFilters{
-			Policies: in.Policies,
+			Policies:   in.Policies,
+			Events:     in.Events,
+			Expression: in.Filter,
+			Containers: in.Containers,
+			Pods:       in.Pods,
+			Namespaces: in.Namespaces,
 		},
 	}
 
 	stream, err = s.tracee.Subscribe(streamConfig)
 	if err != nil {
+		var filterErr *streams.FilterError
+		if errors.As(err, &filterErr) {
+			return status.Error(codes.InvalidArgument, filterErr.Error())
+		}
 		return err
 	}
 
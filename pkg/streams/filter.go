@@ -0,0 +1,126 @@
+package streams
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/cel-go/cel"
+
+	pb "github.com/aquasecurity/tracee/api/v1beta1"
+	"github.com/aquasecurity/tracee/common/logger"
+	"github.com/aquasecurity/tracee/pkg/config"
+	"github.com/aquasecurity/tracee/pkg/detectors/yaml"
+)
+
+// filterTimeout is the evaluation timeout of a filter expression, as for YAML
+// detectors conditions
+const filterTimeout = 5 * time.Millisecond
+
+// FilterError is returned for malformed stream filters
+type FilterError struct {
+	Reason string
+}
+
+func (e *FilterError) Error() string {
+	return "invalid stream filter: " + e.Reason
+}
+
+// Filter selects the events delivered by a stream, beyond its policies and events.
+// Every set selector must match: an event matches a selector if it matches any
+// of its values.
+type Filter struct {
+	containers []string
+	pods       map[string]struct{}
+	namespaces map[string]struct{}
+	expression string
+	program    cel.Program
+}
+
+// NewFilter creates the filter of stream filters, or returns nil if they don't
+// need one (only policies and events are set).
+func NewFilter(filters config.StreamFilters) (*Filter, error) {
+	if filters.Expression == "" && len(filters.Containers) == 0 &&
+		len(filters.Pods) == 0 && len(filters.Namespaces) == 0 {
+		return nil, nil
+	}
+
+	f := &Filter{
+		pods:       toSet(filters.Pods),
+		namespaces: toSet(filters.Namespaces),
+		expression: filters.Expression,
+	}
+
+	for _, container := range filters.Containers {
+		if container == "" {
+			return nil, &FilterError{Reason: "empty container selector"}
+		}
+		f.containers = append(f.containers, container)
+	}
+	if _, ok := f.pods[""]; ok {
+		return nil, &FilterError{Reason: "empty pod selector"}
+	}
+	if _, ok := f.namespaces[""]; ok {
+		return nil, &FilterError{Reason: "empty namespace selector"}
+	}
+
+	if filters.Expression != "" {
+		program, err := yaml.CompileEventFilter(filters.Expression)
+		if err != nil {
+			return nil, &FilterError{Reason: fmt.Sprintf("expression %q: %v", filters.Expression, err)}
+		}
+		f.program = program
+	}
+
+	return f, nil
+}
+
+// Match returns true if the event passes the filter. Events failing the filter
+// expression evaluation are not delivered.
+func (f *Filter) Match(event *pb.Event) bool {
+	if len(f.containers) > 0 && !f.matchContainer(event.GetWorkload().GetContainer()) {
+		return false
+	}
+	if len(f.pods) > 0 {
+		if _, ok := f.pods[event.GetWorkload().GetK8S().GetPod().GetName()]; !ok {
+			return false
+		}
+	}
+	if len(f.namespaces) > 0 {
+		if _, ok := f.namespaces[event.GetWorkload().GetK8S().GetNamespace().GetName()]; !ok {
+			return false
+		}
+	}
+
+	if f.program != nil {
+		match, err := yaml.EvaluateCondition(f.program, event, nil, filterTimeout)
+		if err != nil {
+			logger.Debugw("Stream filter evaluation failed, dropping event", "expression", f.expression, "error", err)
+			return false
+		}
+		return match
+	}
+
+	return true
+}
+
+// matchContainer matches a container by its name or by its ID (or ID prefix)
+func (f *Filter) matchContainer(container *pb.Container) bool {
+	if container.GetId() == "" {
+		return false
+	}
+	for _, selector := range f.containers {
+		if selector == container.GetName() || strings.HasPrefix(container.GetId(), selector) {
+			return true
+		}
+	}
+	return false
+}
+
+func toSet(values []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(values))
+	for _, value := range values {
+		set[value] = struct{}{}
+	}
+	return set
+}
@@ -0,0 +1,153 @@
+package streams
+
+import (
+	"testing"
+
+	"gotest.tools/assert"
+
+	pb "github.com/aquasecurity/tracee/api/v1beta1"
+	"github.com/aquasecurity/tracee/pkg/config"
+)
+
+func workloadEvent(name, containerID, containerName, pod, namespace string) *pb.Event {
+	return &pb.Event{
+		Name: name,
+		Workload: &pb.Workload{
+			Container: &pb.Container{Id: containerID, Name: containerName},
+			K8S: &pb.K8S{
+				Pod:       &pb.Pod{Name: pod},
+				Namespace: &pb.K8SNamespace{Name: namespace},
+			},
+		},
+		Data: []*pb.EventValue{
+			{Name: "pathname", Value: &pb.EventValue_Str{Str: "/etc/shadow"}},
+		},
+	}
+}
+
+func TestNewFilter(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		filters config.StreamFilters
+		noop    bool
+		wantErr string
+	}{
+		{name: "no filter", filters: config.StreamFilters{Policies: []string{"p1"}, Events: []string{"openat"}}, noop: true},
+		{name: "expression", filters: config.StreamFilters{Expression: `event.name == "openat"`}},
+		{name: "selectors", filters: config.StreamFilters{Containers: []string{"abc"}, Pods: []string{"web"}, Namespaces: []string{"prod"}}},
+		{name: "invalid expression", filters: config.StreamFilters{Expression: "event.name =="}, wantErr: "invalid stream filter: expression"},
+		{name: "non boolean expression", filters: config.StreamFilters{Expression: "event.name"}, wantErr: "condition must return boolean"},
+		{name: "unknown field", filters: config.StreamFilters{Expression: "event.unknown == 1"}, wantErr: "invalid stream filter"},
+		{name: "empty container", filters: config.StreamFilters{Containers: []string{""}}, wantErr: "empty container selector"},
+		{name: "empty pod", filters: config.StreamFilters{Pods: []string{""}}, wantErr: "empty pod selector"},
+		{name: "empty namespace", filters: config.StreamFilters{Namespaces: []string{""}}, wantErr: "empty namespace selector"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			filter, err := NewFilter(tt.filters)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			assert.NilError(t, err)
+			assert.Equal(t, tt.noop, filter == nil)
+		})
+	}
+}
+
+func TestFilter_Match(t *testing.T) {
+	t.Parallel()
+
+	webProd := workloadEvent("openat", "0123456789abcdef", "nginx", "web", "prod")
+	dbDev := workloadEvent("execve", "fedcba9876543210", "postgres", "db", "dev")
+	host := &pb.Event{Name: "openat"}
+
+	tests := []struct {
+		name     string
+		filters  config.StreamFilters
+		expected []bool // webProd, dbDev, host
+	}{
+		{
+			name:     "container id prefix",
+			filters:  config.StreamFilters{Containers: []string{"0123"}},
+			expected: []bool{true, false, false},
+		},
+		{
+			name:     "container name",
+			filters:  config.StreamFilters{Containers: []string{"nginx", "postgres"}},
+			expected: []bool{true, true, false},
+		},
+		{
+			name:     "pod",
+			filters:  config.StreamFilters{Pods: []string{"db"}},
+			expected: []bool{false, true, false},
+		},
+		{
+			name:     "namespace",
+			filters:  config.StreamFilters{Namespaces: []string{"prod", "staging"}},
+			expected: []bool{true, false, false},
+		},
+		{
+			name:     "all selectors must match",
+			filters:  config.StreamFilters{Pods: []string{"web", "db"}, Namespaces: []string{"dev"}},
+			expected: []bool{false, true, false},
+		},
+		{
+			name:     "expression",
+			filters:  config.StreamFilters{Expression: `event.name == "openat"`},
+			expected: []bool{true, false, true},
+		},
+		{
+			name:     "expression with event data",
+			filters:  config.StreamFilters{Expression: `getEventData("pathname").startsWith("/etc")`},
+			expected: []bool{true, true, false},
+		},
+		{
+			name:     "expression and selector",
+			filters:  config.StreamFilters{Expression: `workload.container.name == "nginx"`, Namespaces: []string{"prod"}},
+			expected: []bool{true, false, false},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			filter, err := NewFilter(tt.filters)
+			assert.NilError(t, err)
+
+			for i, event := range []*pb.Event{webProd, dbDev, host} {
+				assert.Equal(t, tt.expected[i], filter.Match(event), "event %d", i)
+			}
+		})
+	}
+}
+
+func TestStreamManagerSubscribeWithFilter(t *testing.T) {
+	t.Parallel()
+
+	sm := NewStreamsManager()
+	defer sm.Close()
+
+	filter, err := NewFilter(config.StreamFilters{Namespaces: []string{"prod"}})
+	assert.NilError(t, err)
+
+	bufferConfig := config.StreamBuffer{Size: 10, Mode: config.StreamBufferDrop}
+	filtered := sm.SubscribeWithFilter(allPoliciesMask, map[int32]struct{}{}, filter, bufferConfig)
+	unfiltered := sm.Subscribe(allPoliciesMask, map[int32]struct{}{}, bufferConfig)
+
+	sm.Publish(workloadEvent("openat", "0123", "nginx", "web", "prod"), policy1Mask)
+	sm.Publish(workloadEvent("openat", "4567", "nginx", "web", "dev"), policy1Mask)
+
+	assert.Equal(t, 1, len(filtered.ReceiveEvents()))
+	assert.Equal(t, 2, len(unfiltered.ReceiveEvents()))
+}
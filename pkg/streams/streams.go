@@ -4,6 +4,7 @@ import (
 	"sync"
 
 	pb "github.com/aquasecurity/tracee/api/v1beta1"
+	"github.com/aquasecurity/tracee/common/bitwise"
 	"github.com/aquasecurity/tracee/common/logger"
 	"github.com/aquasecurity/tracee/pkg/config"
 )
@@ -11,11 +12,15 @@ import (
 // Stream is a stream of events
 type Stream struct {
 	// policy mask is a bitmap of policies that this stream is interested in
-	policyMask uint64
+	policyMask bitwise.Bitmap
 	// event to filter
 	eventMap map[int32]struct{}
 	// true if there is at least one element in the eventMap
 	eventFilter bool
+	// filter selecting the events beyond policies and events, nil if none
+	filter *Filter
+	// destinations the stream delivers to, used to forward events to a named destination
+	destinations map[string]struct{}
 	// events is a channel that is used to receive events from the stream
 	events       chan *pb.Event
 	strategyPush func(*pb.Event)
@@ -29,7 +34,7 @@ func (s *Stream) ReceiveEvents() <-chan *pb.Event {
 // Publish publishes an event to the stream,
 // but first check if this stream is interested in this event,
 // by checking the event's policy mask against the stream's policy mask.
-func (s *Stream) publish(event *pb.Event, policyBitmap uint64) {
+func (s *Stream) publish(event *pb.Event, policyBitmap bitwise.Bitmap) {
 	if s.shouldIgnorePolicy(policyBitmap) {
 		return
 	}
@@ -40,6 +45,10 @@ func (s *Stream) publish(event *pb.Event, policyBitmap uint64) {
 		}
 	}
 
+	if s.filter != nil && !s.filter.Match(event) {
+		return
+	}
+
 	// Due to the dynamic nature of this function the compiler doesn't
 	// inline this. A condition is faster (and cheaper) than a function call
 	// should we change it with a condition?
@@ -67,8 +76,8 @@ func (s *Stream) dropPublish(event *pb.Event) {
 }
 
 // shouldIgnorePolicy checks if the stream should ignore the event
-func (s *Stream) shouldIgnorePolicy(policyBitmap uint64) bool {
-	return s.policyMask&policyBitmap == 0
+func (s *Stream) shouldIgnorePolicy(policyBitmap bitwise.Bitmap) bool {
+	return !s.policyMask.Intersects(policyBitmap)
 }
 
 // close closes the stream
@@ -91,7 +100,13 @@ func NewStreamsManager() *StreamsManager {
 }
 
 // Subscribe adds a stream to the manager
-func (sm *StreamsManager) Subscribe(policyMask uint64, eventMap map[int32]struct{}, bufferConfig config.StreamBuffer) *Stream {
+func (sm *StreamsManager) Subscribe(policyMask bitwise.Bitmap, eventMap map[int32]struct{}, bufferConfig config.StreamBuffer) *Stream {
+	return sm.SubscribeWithFilter(policyMask, eventMap, nil, bufferConfig)
+}
+
+// SubscribeWithFilter adds a stream to the manager, delivering only the events
+// passing the given filter (if not nil)
+func (sm *StreamsManager) SubscribeWithFilter(policyMask bitwise.Bitmap, eventMap map[int32]struct{}, filter *Filter, bufferConfig config.StreamBuffer) *Stream {
 	sm.mutex.Lock()
 	defer sm.mutex.Unlock()
 
@@ -100,6 +115,7 @@ func (sm *StreamsManager) Subscribe(policyMask uint64, eventMap map[int32]struct
 		events:      make(chan *pb.Event, bufferConfig.Size),
 		eventMap:    eventMap,
 		eventFilter: len(eventMap) > 0,
+		filter:      filter,
 	}
 
 	switch bufferConfig.Mode {
@@ -114,6 +130,17 @@ func (sm *StreamsManager) Subscribe(policyMask uint64, eventMap map[int32]struct
 	return stream
 }
 
+// SetDestinations sets the names of the destinations a stream delivers to
+func (sm *StreamsManager) SetDestinations(stream *Stream, destinations []string) {
+	sm.mutex.Lock()
+	defer sm.mutex.Unlock()
+
+	stream.destinations = make(map[string]struct{}, len(destinations))
+	for _, destination := range destinations {
+		stream.destinations[destination] = struct{}{}
+	}
+}
+
 // Unsubscribe removes a stream from the manager
 func (sm *StreamsManager) Unsubscribe(stream *Stream) {
 	sm.mutex.Lock()
@@ -128,7 +155,7 @@ func (sm *StreamsManager) Unsubscribe(stream *Stream) {
 
 // Publish publishes an event to all streams.
 // The event is a pb.Event pointer and the policyBitmap indicates which policies matched.
-func (sm *StreamsManager) Publish(event *pb.Event, policyBitmap uint64) {
+func (sm *StreamsManager) Publish(event *pb.Event, policyBitmap bitwise.Bitmap) {
 	sm.mutex.Lock()
 	defer sm.mutex.Unlock()
 
@@ -137,6 +164,24 @@ func (sm *StreamsManager) Publish(event *pb.Event, policyBitmap uint64) {
 	}
 }
 
+// PublishTo publishes an event to the streams delivering to the given destination,
+// regardless of the streams policy and event filters.
+// It returns the number of streams the event was published to.
+func (sm *StreamsManager) PublishTo(destination string, event *pb.Event) int {
+	sm.mutex.Lock()
+	defer sm.mutex.Unlock()
+
+	published := 0
+	for stream := range sm.subscribers {
+		if _, ok := stream.destinations[destination]; ok {
+			stream.strategyPush(event)
+			published++
+		}
+	}
+
+	return published
+}
+
 // HasSubscribers returns true if there are any active subscribers
 func (sm *StreamsManager) HasSubscribers() bool {
 	sm.mutex.Lock()
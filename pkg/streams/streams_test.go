@@ -7,22 +7,21 @@ import (
 	"gotest.tools/assert"
 
 	pb "github.com/aquasecurity/tracee/api/v1beta1"
+	"github.com/aquasecurity/tracee/common/bitwise"
 	"github.com/aquasecurity/tracee/pkg/config"
 	"github.com/aquasecurity/tracee/pkg/events"
 	"github.com/aquasecurity/tracee/types/trace"
 )
 
-const (
-	policy1Mask     uint64 = 0b1
-	policy1And2Mask uint64 = 0b11
-	allPoliciesMask uint64 = 0xffffffffffffffff
-)
-
 var (
+	policy1Mask     = bitwise.NewBitmap(0)
+	policy1And2Mask = bitwise.NewBitmap(0, 1)
+	allPoliciesMask = bitwise.FullBitmap()
+
 	// Create pb.Events for testing
-	policy1Event = mustConvertEvent(&trace.Event{MatchedPoliciesUser: 0b1})
-	policy2Event = mustConvertEvent(&trace.Event{MatchedPoliciesUser: 0b10})
-	policy3Event = mustConvertEvent(&trace.Event{MatchedPoliciesUser: 0b100})
+	policy1Event = mustConvertEvent(&trace.Event{MatchedPoliciesUser: bitwise.NewBitmap(0)})
+	policy2Event = mustConvertEvent(&trace.Event{MatchedPoliciesUser: bitwise.NewBitmap(1)})
+	policy3Event = mustConvertEvent(&trace.Event{MatchedPoliciesUser: bitwise.NewBitmap(2)})
 )
 
 func mustConvertEvent(e *trace.Event) *pb.Event {
@@ -84,21 +83,21 @@ func TestStreamManager(t *testing.T) {
 
 	go func() {
 		for i := 0; i < 100; i++ {
-			sm.Publish(policy1Event, 0b1)
+			sm.Publish(policy1Event, bitwise.NewBitmap(0))
 		}
 		publishersWG.Done()
 	}()
 
 	go func() {
 		for i := 0; i < 100; i++ {
-			sm.Publish(policy2Event, 0b10)
+			sm.Publish(policy2Event, bitwise.NewBitmap(1))
 		}
 		publishersWG.Done()
 	}()
 
 	go func() {
 		for i := 0; i < 100; i++ {
-			sm.Publish(policy3Event, 0b100)
+			sm.Publish(policy3Event, bitwise.NewBitmap(2))
 		}
 		publishersWG.Done()
 	}()
@@ -119,44 +118,44 @@ func Test_shouldIgnorePolicy(t *testing.T) {
 
 	tests := []struct {
 		name         string
-		policyMask   uint64
-		policyBitmap uint64
+		policyMask   bitwise.Bitmap
+		policyBitmap bitwise.Bitmap
 		expected     bool
 	}{
 		{
 			name:         "event matched policy 1, policy mask 1",
-			policyMask:   0b1,
-			policyBitmap: 0b1,
+			policyMask:   bitwise.NewBitmap(0),
+			policyBitmap: bitwise.NewBitmap(0),
 			expected:     false,
 		},
 		{
 			name:         "event matched policy 1, policy mask 2",
-			policyMask:   0b10,
-			policyBitmap: 0b1,
+			policyMask:   bitwise.NewBitmap(1),
+			policyBitmap: bitwise.NewBitmap(0),
 			expected:     true,
 		},
 		{
 			name:         "event matched policy 1, catch all policy mask",
-			policyMask:   0xffffffffffffffff,
-			policyBitmap: 0b1,
+			policyMask:   bitwise.FullBitmap(),
+			policyBitmap: bitwise.NewBitmap(0),
 			expected:     false,
 		},
 		{
 			name:         "event matched policy 1 and policy 2, policy mask 1",
-			policyMask:   0b1,
-			policyBitmap: 0b11,
+			policyMask:   bitwise.NewBitmap(0),
+			policyBitmap: bitwise.NewBitmap(0, 1),
 			expected:     false,
 		},
 		{
 			name:         "event matched policy 1 and policy 2, policy mask 2",
-			policyMask:   0b10,
-			policyBitmap: 0b11,
+			policyMask:   bitwise.NewBitmap(1),
+			policyBitmap: bitwise.NewBitmap(0, 1),
 			expected:     false,
 		},
 		{
 			name:         "event matched policy 1 and policy 2, catch all policy mask",
-			policyMask:   0xffffffffffffffff,
-			policyBitmap: 0b11,
+			policyMask:   bitwise.FullBitmap(),
+			policyBitmap: bitwise.NewBitmap(0, 1),
 			expected:     false,
 		},
 	}
@@ -172,3 +171,25 @@ func Test_shouldIgnorePolicy(t *testing.T) {
 		})
 	}
 }
+
+func TestStreamManagerPublishTo(t *testing.T) {
+	t.Parallel()
+
+	sm := NewStreamsManager()
+	defer sm.Close()
+
+	bufferConfig := config.StreamBuffer{Size: 10, Mode: config.StreamBufferDrop}
+
+	// the policy mask doesn't apply to forwarded events
+	webhook := sm.Subscribe(policy1Mask, map[int32]struct{}{}, bufferConfig)
+	sm.SetDestinations(webhook, []string{"webhook1"})
+
+	stdout := sm.Subscribe(allPoliciesMask, map[int32]struct{}{}, bufferConfig)
+	sm.SetDestinations(stdout, []string{"stdout"})
+
+	assert.Equal(t, 1, sm.PublishTo("webhook1", policy3Event))
+	assert.Equal(t, 0, sm.PublishTo("unknown", policy3Event))
+
+	assert.Equal(t, 1, len(webhook.ReceiveEvents()))
+	assert.Equal(t, 0, len(stdout.ReceiveEvents()))
+}
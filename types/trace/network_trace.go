@@ -251,3 +251,34 @@ type ProtoHTTPResponse struct {
 	Headers       http.Header `json:"headers"`
 	ContentLength int64       `json:"content_length"`
 }
+
+// TLS
+
+type ProtoTLSClientHello struct {
+	Version           string   `json:"version"`
+	SupportedVersions []string `json:"supported_versions"`
+	CipherSuites      []string `json:"cipher_suites"`
+	Extensions        []uint16 `json:"extensions"`
+	ServerName        string   `json:"server_name"`
+	ALPN              []string `json:"alpn"`
+	JA3               string   `json:"ja3"`
+	JA3Hash           string   `json:"ja3_hash"`
+	JA4               string   `json:"ja4"`
+}
+
+type ProtoTLSServerHello struct {
+	Version      string                `json:"version"`
+	CipherSuite  string                `json:"cipher_suite"`
+	Extensions   []uint16              `json:"extensions"`
+	ALPN         string                `json:"alpn"`
+	JA3S         string                `json:"ja3s"`
+	JA3SHash     string                `json:"ja3s_hash"`
+	JA4S         string                `json:"ja4s"`
+	Certificates []ProtoTLSCertificate `json:"certificates"`
+}
+
+type ProtoTLSCertificate struct {
+	Subject  string   `json:"subject"`
+	Issuer   string   `json:"issuer"`
+	DNSNames []string `json:"dns_names"`
+}
@@ -38,8 +38,8 @@ type Event struct {
 	EventID               int          `json:"eventId,string"`
 	EventName             string       `json:"eventName"`
 	PoliciesVersion       uint16       `json:"-"`
-	MatchedPoliciesKernel uint64       `json:"-"`
-	MatchedPoliciesUser   uint64       `json:"-"`
+	MatchedPoliciesKernel [4]uint64    `json:"-"` // policies bitmap, 64 policies per word
+	MatchedPoliciesUser   [4]uint64    `json:"-"` // policies bitmap, 64 policies per word
 	MatchedPolicies       []string     `json:"matchedPolicies,omitempty"`
 	ArgsNum               int          `json:"argsNum"`
 	ReturnValue           int          `json:"returnValue"`
@@ -61,10 +61,16 @@ type Event struct {
 // murmur([]byte) where slice of bytes is a concatenation (not a sum) of the 2 values above.
 
 type Container struct {
-	ID          string `json:"id,omitempty"`
-	Name        string `json:"name,omitempty"`
-	ImageName   string `json:"image,omitempty"`
-	ImageDigest string `json:"imageDigest,omitempty"`
+	ID                string            `json:"id,omitempty"`
+	Name              string            `json:"name,omitempty"`
+	ImageName         string            `json:"image,omitempty"`
+	ImageDigest       string            `json:"imageDigest,omitempty"`
+	Labels            map[string]string `json:"labels,omitempty"` // shared with the container store, must not be modified
+	Privileged        bool              `json:"privileged,omitempty"`
+	AddedCapabilities []string          `json:"addedCapabilities,omitempty"`
+	HostNetwork       bool              `json:"hostNetwork,omitempty"`
+	HostPID           bool              `json:"hostPid,omitempty"`
+	HostIPC           bool              `json:"hostIpc,omitempty"`
 }
 
 type Kubernetes struct {
@@ -456,6 +462,34 @@ func (arg *Argument) UnmarshalJSON(b []byte) error {
 		}
 
 		arg.Value = argProtoHTTPResponse
+	case "trace.ProtoTLSClientHello":
+		var argProtoTLSClientHello ProtoTLSClientHello
+		if arg.Value != nil {
+			argProtoTLSClientHelloMap, ok := arg.Value.(map[string]interface{})
+			if !ok {
+				return errors.New("protocol TLS Client Hello: type error")
+			}
+			argProtoTLSClientHello, err = jsonConvertToProtoTLSClientHelloArg(argProtoTLSClientHelloMap)
+			if err != nil {
+				return err
+			}
+		}
+
+		arg.Value = argProtoTLSClientHello
+	case "trace.ProtoTLSServerHello":
+		var argProtoTLSServerHello ProtoTLSServerHello
+		if arg.Value != nil {
+			argProtoTLSServerHelloMap, ok := arg.Value.(map[string]interface{})
+			if !ok {
+				return errors.New("protocol TLS Server Hello: type error")
+			}
+			argProtoTLSServerHello, err = jsonConvertToProtoTLSServerHelloArg(argProtoTLSServerHelloMap)
+			if err != nil {
+				return err
+			}
+		}
+
+		arg.Value = argProtoTLSServerHello
 	case "trace.PacketMetadata":
 		var argPacketMetadata PacketMetadata
 		if arg.Value != nil {
@@ -1503,6 +1537,144 @@ func jsonConvertToProtoHTTPResponseArg(argMap map[string]interface{}) (ProtoHTTP
 	}, nil
 }
 
+func jsonConvertToProtoTLSClientHelloArg(argMap map[string]interface{}) (ProtoTLSClientHello, error) {
+	// string conversion
+	stringTypes := map[string]string{
+		"version":     "",
+		"server_name": "",
+		"ja3":         "",
+		"ja3_hash":    "",
+		"ja4":         "",
+	}
+	stringTypes, err := jsonConvertToStringTypes(argMap, stringTypes)
+	if err != nil {
+		return ProtoTLSClientHello{}, err
+	}
+
+	// []string conversion
+	stringSliceTypes := map[string][]string{
+		"supported_versions": nil,
+		"cipher_suites":      nil,
+		"alpn":               nil,
+	}
+	stringSliceTypes, err = jsonConvertToStringSliceTypes(argMap, stringSliceTypes)
+	if err != nil {
+		return ProtoTLSClientHello{}, err
+	}
+
+	// []uint16 conversion
+	uint16SliceTypes := map[string][]uint16{
+		"extensions": nil,
+	}
+	uint16SliceTypes, err = jsonConvertToUint16SliceTypes(argMap, uint16SliceTypes)
+	if err != nil {
+		return ProtoTLSClientHello{}, err
+	}
+
+	return ProtoTLSClientHello{
+		Version:           stringTypes["version"],
+		SupportedVersions: stringSliceTypes["supported_versions"],
+		CipherSuites:      stringSliceTypes["cipher_suites"],
+		Extensions:        uint16SliceTypes["extensions"],
+		ServerName:        stringTypes["server_name"],
+		ALPN:              stringSliceTypes["alpn"],
+		JA3:               stringTypes["ja3"],
+		JA3Hash:           stringTypes["ja3_hash"],
+		JA4:               stringTypes["ja4"],
+	}, nil
+}
+
+func jsonConvertToProtoTLSServerHelloArg(argMap map[string]interface{}) (ProtoTLSServerHello, error) {
+	// string conversion
+	stringTypes := map[string]string{
+		"version":      "",
+		"cipher_suite": "",
+		"alpn":         "",
+		"ja3s":         "",
+		"ja3s_hash":    "",
+		"ja4s":         "",
+	}
+	stringTypes, err := jsonConvertToStringTypes(argMap, stringTypes)
+	if err != nil {
+		return ProtoTLSServerHello{}, err
+	}
+
+	// []uint16 conversion
+	uint16SliceTypes := map[string][]uint16{
+		"extensions": nil,
+	}
+	uint16SliceTypes, err = jsonConvertToUint16SliceTypes(argMap, uint16SliceTypes)
+	if err != nil {
+		return ProtoTLSServerHello{}, err
+	}
+
+	// certificates conversion
+	certificates, exists := argMap["certificates"]
+	if !exists {
+		return ProtoTLSServerHello{}, errors.New("certificates not found in ProtoTLSServerHello arg")
+	}
+
+	var protoTLSCertificates []ProtoTLSCertificate
+	if certificates != nil {
+		certificatesSlice, ok := certificates.([]interface{})
+		if !ok {
+			return ProtoTLSServerHello{}, errors.New("certificates from ProtoTLSServerHello: type error")
+		}
+
+		for _, certificate := range certificatesSlice {
+			certificateMap, ok := certificate.(map[string]interface{})
+			if !ok {
+				return ProtoTLSServerHello{}, errors.New("certificates from ProtoTLSServerHello: type error")
+			}
+
+			protoTLSCertificate, err := jsonConvertToProtoTLSCertificateType(certificateMap)
+			if err != nil {
+				return ProtoTLSServerHello{}, err
+			}
+
+			protoTLSCertificates = append(protoTLSCertificates, protoTLSCertificate)
+		}
+	}
+
+	return ProtoTLSServerHello{
+		Version:      stringTypes["version"],
+		CipherSuite:  stringTypes["cipher_suite"],
+		Extensions:   uint16SliceTypes["extensions"],
+		ALPN:         stringTypes["alpn"],
+		JA3S:         stringTypes["ja3s"],
+		JA3SHash:     stringTypes["ja3s_hash"],
+		JA4S:         stringTypes["ja4s"],
+		Certificates: protoTLSCertificates,
+	}, nil
+}
+
+func jsonConvertToProtoTLSCertificateType(argMap map[string]interface{}) (ProtoTLSCertificate, error) {
+	// string conversion
+	stringTypes := map[string]string{
+		"subject": "",
+		"issuer":  "",
+	}
+	stringTypes, err := jsonConvertToStringTypes(argMap, stringTypes)
+	if err != nil {
+		return ProtoTLSCertificate{}, err
+	}
+
+	// []string conversion
+	stringSliceTypes := map[string][]string{
+		"dns_names": nil,
+	}
+	stringSliceTypes, err = jsonConvertToStringSliceTypes(argMap, stringSliceTypes)
+	if err != nil {
+		return ProtoTLSCertificate{}, err
+	}
+
+	return ProtoTLSCertificate{
+		Subject:  stringTypes["subject"],
+		Issuer:   stringTypes["issuer"],
+		DNSNames: stringSliceTypes["dns_names"],
+	}, nil
+}
+
 func jsonConvertToPacketMetadata(argMap map[string]interface{}) (PacketMetadata, error) {
 	uint8Types := map[string]uint8{
 		"direction": 0,
@@ -1542,6 +1714,57 @@ func jsonConvertToStringSlice(interfaceSlice []interface{}) []string {
 	return stringSlice
 }
 
+func jsonConvertToStringSliceTypes(argMap map[string]interface{}, stringSliceTypes map[string][]string) (map[string][]string, error) {
+	for key := range stringSliceTypes {
+		val, ok := argMap[key]
+		if !ok {
+			return stringSliceTypes, fmt.Errorf("key not found in argMap %s", key)
+		}
+
+		if val != nil {
+			valSlice, ok := val.([]interface{})
+			if !ok {
+				return stringSliceTypes, fmt.Errorf("couldn't convert key to []string %s", key)
+			}
+			stringSliceTypes[key] = jsonConvertToStringSlice(valSlice)
+		}
+	}
+
+	return stringSliceTypes, nil
+}
+
+func jsonConvertToUint16SliceTypes(argMap map[string]interface{}, uint16SliceTypes map[string][]uint16) (map[string][]uint16, error) {
+	for key := range uint16SliceTypes {
+		val, ok := argMap[key]
+		if !ok {
+			return uint16SliceTypes, fmt.Errorf("key not found in argMap %s", key)
+		}
+
+		if val != nil {
+			valSlice, ok := val.([]interface{})
+			if !ok {
+				return uint16SliceTypes, fmt.Errorf("couldn't convert key to []uint16 %s", key)
+			}
+
+			uint16Slice := make([]uint16, len(valSlice))
+			for i, v := range valSlice {
+				valJsonNum, ok := v.(json.Number)
+				if !ok {
+					return uint16SliceTypes, fmt.Errorf("couldn't convert key to []uint16 %s", key)
+				}
+				uint64Val, err := strconv.ParseUint(valJsonNum.String(), 10, 16)
+				if err != nil {
+					return uint16SliceTypes, err
+				}
+				uint16Slice[i] = uint16(uint64Val)
+			}
+			uint16SliceTypes[key] = uint16Slice
+		}
+	}
+
+	return uint16SliceTypes, nil
+}
+
 func jsonConvertToIntTypes(argMap map[string]interface{}, intTypes map[string]int) (map[string]int, error) {
 	for key := range intTypes {
 		val, ok := argMap[key]
@@ -2211,3 +2434,33 @@ func (e Event) GetProtoHTTPResponseByName(argName string) (ProtoHTTPResponse, er
 
 	return ProtoHTTPResponse{}, fmt.Errorf("protocol HTTP (response): type error (should be ProtoHTTPResponse, is %T)", arg.Value)
 }
+
+// GetProtoTLSClientHelloByName extracts ProtoTLSClientHello from an event argument
+func (e Event) GetProtoTLSClientHelloByName(argName string) (ProtoTLSClientHello, error) {
+	arg, err := e.GetArgumentByName(argName, GetArgOps{DefaultArgs: false})
+	if err != nil {
+		return ProtoTLSClientHello{}, err
+	}
+
+	argProtoTLSClientHello, ok := arg.Value.(ProtoTLSClientHello)
+	if ok {
+		return argProtoTLSClientHello, nil
+	}
+
+	return ProtoTLSClientHello{}, fmt.Errorf("protocol TLS (client hello): type error (should be ProtoTLSClientHello, is %T)", arg.Value)
+}
+
+// GetProtoTLSServerHelloByName extracts ProtoTLSServerHello from an event argument
+func (e Event) GetProtoTLSServerHelloByName(argName string) (ProtoTLSServerHello, error) {
+	arg, err := e.GetArgumentByName(argName, GetArgOps{DefaultArgs: false})
+	if err != nil {
+		return ProtoTLSServerHello{}, err
+	}
+
+	argProtoTLSServerHello, ok := arg.Value.(ProtoTLSServerHello)
+	if ok {
+		return argProtoTLSServerHello, nil
+	}
+
+	return ProtoTLSServerHello{}, fmt.Errorf("protocol TLS (server hello): type error (should be ProtoTLSServerHello, is %T)", arg.Value)
+}
@@ -120,6 +120,39 @@ func TestArgumentUnmarshalJSON(t *testing.T) {
 			json:   `{ "name":"test", "type":"[]string", "value": null}`,
 			expect: Argument{ArgMeta: ArgMeta{Name: "test", Type: "[]string"}, Value: nil},
 		},
+		{
+			name: "trace.ProtoTLSClientHello arg",
+			json: `{ "name":"test", "type":"trace.ProtoTLSClientHello", "value": {"version":"TLS 1.2","supported_versions":["TLS 1.3"],` +
+				`"cipher_suites":["TLS_AES_128_GCM_SHA256"],"extensions":[0,43],"server_name":"example.com","alpn":["h2"],` +
+				`"ja3":"771,4865,0-43,,","ja3_hash":"hash","ja4":"t13d0102h2_hash_hash"}}`,
+			expect: Argument{ArgMeta: ArgMeta{Name: "test", Type: "trace.ProtoTLSClientHello"}, Value: ProtoTLSClientHello{
+				Version:           "TLS 1.2",
+				SupportedVersions: []string{"TLS 1.3"},
+				CipherSuites:      []string{"TLS_AES_128_GCM_SHA256"},
+				Extensions:        []uint16{0, 43},
+				ServerName:        "example.com",
+				ALPN:              []string{"h2"},
+				JA3:               "771,4865,0-43,,",
+				JA3Hash:           "hash",
+				JA4:               "t13d0102h2_hash_hash",
+			}},
+		},
+		{
+			name: "trace.ProtoTLSServerHello arg",
+			json: `{ "name":"test", "type":"trace.ProtoTLSServerHello", "value": {"version":"TLS 1.2","cipher_suite":"TLS_RSA_WITH_AES_128_GCM_SHA256",` +
+				`"extensions":null,"alpn":"","ja3s":"771,156,","ja3s_hash":"hash","ja4s":"t120000_009c_000000000000",` +
+				`"certificates":[{"subject":"CN=example.com","issuer":"CN=CA","dns_names":["example.com"]}]}}`,
+			expect: Argument{ArgMeta: ArgMeta{Name: "test", Type: "trace.ProtoTLSServerHello"}, Value: ProtoTLSServerHello{
+				Version:     "TLS 1.2",
+				CipherSuite: "TLS_RSA_WITH_AES_128_GCM_SHA256",
+				JA3S:        "771,156,",
+				JA3SHash:    "hash",
+				JA4S:        "t120000_009c_000000000000",
+				Certificates: []ProtoTLSCertificate{
+					{Subject: "CN=example.com", Issuer: "CN=CA", DNSNames: []string{"example.com"}},
+				},
+			}},
+		},
 		{
 			name:        "err arg",
 			json:        `{ "name":"test", "type":"err", "value": 0}`,